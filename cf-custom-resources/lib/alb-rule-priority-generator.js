@@ -73,16 +73,14 @@ let report = function (
 };
 
 /**
- * Lists all the existing rules for a ALB Listener, finds the max of their
- * priorities, and then returns max + 1.
+ * Lists all the existing rules for a ALB Listener.
  *
  * @param {string} listenerArn the ARN of the ALB listener.
-
- * @returns {number} The next available ALB listener rule priority.
+ *
+ * @returns {object[]} The rules of the listener.
  */
-const calculateNextRulePriority = async function (listenerArn) {
+const listRules = async function (listenerArn) {
   var elb = new aws.ELBv2();
-  // Grab all the rules for this listener
   var marker;
   var rules = [];
   do {
@@ -96,26 +94,147 @@ const calculateNextRulePriority = async function (listenerArn) {
     rules = rules.concat(rulesResponse.Rules);
     marker = rulesResponse.NextMarker;
   } while (marker);
+  return rules;
+};
 
-  let nextRulePriority = 1;
-  if (rules.length > 0) {
-    // Take the max rule priority, and add 1 to it.
-    const rulePriorities = rules.map((rule) => {
-      if (
-        rule.Priority === "default" ||
-        rule.Priority === priorityForRootRule
-      ) {
-        // Ignore the root rule's priority since it has to be always the max value.
-        // Ignore the default rule's prority since it's the same as 0.
-        return 0;
-      }
-      return parseInt(rule.Priority);
-    });
-    const max = Math.max(...rulePriorities);
-    nextRulePriority = max + 1;
+/**
+ * Finds the max priority of the existing rules for a ALB Listener,
+ * and then returns the next `count` priorities after it.
+ *
+ * @param {object[]} rules the existing rules of the ALB listener.
+ * @param {number} count the number of priorities to return.
+ *
+ * @returns {number[]} The next available ALB listener rule priorities.
+ */
+const calculateNextRulePriorities = function (rules, count) {
+  let max = 0;
+  for (const rule of rules) {
+    if (
+      rule.Priority === "default" ||
+      rule.Priority === priorityForRootRule
+    ) {
+      // Ignore the root rule's priority since it has to be always the max value.
+      // Ignore the default rule's prority since it's the same as 0.
+      continue;
+    }
+    max = Math.max(max, parseInt(rule.Priority));
+  }
+
+  if (max + count >= parseInt(priorityForRootRule)) {
+    throw new Error(
+      `Listener has no more rule priorities available for ${count} rule(s)`
+    );
+  }
+  const priorities = [];
+  for (let i = 1; i <= count; i++) {
+    priorities.push(max + i);
+  }
+  return priorities;
+};
+
+/**
+ * Returns whether the listener rule forwards requests to the target group.
+ *
+ * @param {object} rule a rule of the ALB listener.
+ * @param {string} targetGroupArn the ARN of the target group.
+ *
+ * @returns {boolean} True if one of the rule's actions forwards to the target group.
+ */
+const forwardsTo = function (rule, targetGroupArn) {
+  return (rule.Actions || []).some((action) => {
+    if (action.TargetGroupArn === targetGroupArn) {
+      return true;
+    }
+    const targetGroups =
+      (action.ForwardConfig && action.ForwardConfig.TargetGroups) || [];
+    return targetGroups.some((tg) => tg.TargetGroupArn === targetGroupArn);
+  });
+};
+
+/**
+ * Assigns a priority to each rule path of a service.
+ * If the root path must be the last rule of the listener, then it gets the max priority and
+ * the other paths get the next available priorities.
+ * Rules that already forward to the service's target groups keep their priorities as long as
+ * the rules stay in the same order, so that updating a service doesn't shift its rules behind other services' rules.
+ *
+ * @param {string} listenerArn the ARN of the ALB listener.
+ * @param {string[]} rulePaths the paths of the service's listener rules, in order.
+ * @param {boolean} rootPathLast whether the root path "/" is routed with the max priority.
+ * @param {string[]} targetGroups the ARNs of the target groups of the service's listener rules, in the same order as rulePaths.
+ *
+ * @returns {object} The priority of the first rule under the "Priority" key,
+ * and the priority of the i-th additional rule under the "Priority<i>" key.
+ */
+const assignRulePriorities = async function (
+  listenerArn,
+  rulePaths,
+  rootPathLast,
+  targetGroups
+) {
+  const rules = await listRules(listenerArn);
+  const ownedRules = rules.filter((rule) =>
+    targetGroups.some((arn) => forwardsTo(rule, arn))
+  );
+
+  const isRoot = (path) => rootPathLast && path === "/";
+  const roots = rulePaths.filter(isRoot);
+  if (roots.length > 1) {
+    throw new Error(`Only one rule can route the root path "/"`);
   }
+  const ownsRootRule = ownedRules.some(
+    (rule) => rule.Priority === priorityForRootRule
+  );
+  if (
+    roots.length === 1 &&
+    !ownsRootRule &&
+    rules.some((rule) => rule.Priority === priorityForRootRule)
+  ) {
+    throw new Error(
+      `Rule priority ${priorityForRootRule} for the root path "/" is already used by another service in the environment`
+    );
+  }
+
+  // Keep the priority of the rule that forwards to the i-th target group while the priorities increase.
+  // Once a rule can't keep its priority, it and the rules after it get new priorities after the max.
+  const kept = [];
+  let prev = 0;
+  let reuse = true;
+  rulePaths.forEach((path, i) => {
+    if (isRoot(path)) {
+      kept.push(undefined);
+      return;
+    }
+    const owned = ownedRules.find((rule) => forwardsTo(rule, targetGroups[i]));
+    const priority =
+      owned && owned.Priority !== priorityForRootRule
+        ? parseInt(owned.Priority)
+        : NaN;
+    if (reuse && priority > prev) {
+      kept.push(priority);
+      prev = priority;
+      return;
+    }
+    reuse = false;
+    kept.push(undefined);
+  });
 
-  return nextRulePriority;
+  const newCount = rulePaths.filter(
+    (path, i) => !isRoot(path) && kept[i] === undefined
+  ).length;
+  const priorities = calculateNextRulePriorities(rules, newCount);
+  const responseData = {};
+  rulePaths.forEach((path, i) => {
+    const key = i === 0 ? "Priority" : `Priority${i}`;
+    if (isRoot(path)) {
+      responseData[key] = parseInt(priorityForRootRule);
+    } else if (kept[i] !== undefined) {
+      responseData[key] = kept[i];
+    } else {
+      responseData[key] = priorities.shift();
+    }
+  });
+  return responseData;
 };
 
 /**
@@ -125,18 +244,34 @@ exports.nextAvailableRulePriorityHandler = async function (event, context) {
   var responseData = {};
   const physicalResourceId =
     event.PhysicalResourceId || `alb-rule-priority-${event.LogicalResourceId}`;
-  var rulePriority;
 
   try {
+    const props = event.ResourceProperties || {};
+    // Services created before multiple rules were supported don't set "RulePath", and have a single rule.
+    const rulePaths = props.RulePath || [""];
+    const rootPathLast = props.RootPathLast === "true";
+    const targetGroups = props.TargetGroups || [];
     switch (event.RequestType) {
       case "Create":
-        rulePriority = await calculateNextRulePriority(
-          event.ResourceProperties.ListenerArn
+        responseData = await assignRulePriorities(
+          props.ListenerArn,
+          rulePaths,
+          rootPathLast,
+          targetGroups
         );
-        responseData.Priority = rulePriority;
         break;
-      // Do nothing on update and delete, since this isn't a "real" resource.
       case "Update":
+        if (!props.RulePath) {
+          // Do nothing on update, since this isn't a "real" resource.
+          break;
+        }
+        responseData = await assignRulePriorities(
+          props.ListenerArn,
+          rulePaths,
+          rootPathLast,
+          targetGroups
+        );
+        break;
       case "Delete":
         break;
       default:
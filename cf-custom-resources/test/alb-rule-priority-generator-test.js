@@ -368,4 +368,273 @@ describe("ALB Rule Priority Generator", () => {
         expect(request.isDone()).toBe(true);
       });
   });
+
+  test("Create operation returns a priority for each rule path", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "default",
+          IsDefault: true,
+        },
+        {
+          Priority: "3",
+          IsDefault: false,
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Priority == 4 &&
+          body.Data.Priority1 == 5 &&
+          body.Data.Priority2 == 6
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["api", "v2", "/"],
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create operation returns the max priority for the root path if it must be the last rule", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "3",
+          IsDefault: false,
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Priority == 50000 &&
+          body.Data.Priority1 == 4
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["/", "api"],
+          RootPathLast: "true",
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Create operation fails if another service routes the root path", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "50000",
+          IsDefault: false,
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            'Rule priority 50000 for the root path "/" is already used by another service in the environment (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)'
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Create",
+        RequestId: testRequestId,
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["/"],
+          RootPathLast: "true",
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Update operation assigns new priorities to new rule paths", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "50000",
+          IsDefault: false,
+          Actions: [{ Type: "forward", TargetGroupArn: "mockTargetGroup" }],
+        },
+        {
+          Priority: "7",
+          IsDefault: false,
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.PhysicalResourceId === "mockPhysicalID" &&
+          body.Data.Priority == 50000 &&
+          body.Data.Priority1 == 8
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        PhysicalResourceId: "mockPhysicalID",
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["/", "api"],
+          RootPathLast: "true",
+          TargetGroups: ["mockTargetGroup", "mockTargetGroup1"],
+        },
+        OldResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["/"],
+          RootPathLast: "true",
+          TargetGroups: ["mockTargetGroup"],
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Update operation keeps the priorities of the service's rules", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "3",
+          IsDefault: false,
+          Actions: [{ Type: "forward", TargetGroupArn: "mockTargetGroup" }],
+        },
+        {
+          Priority: "4",
+          IsDefault: false,
+          Actions: [{ Type: "forward", TargetGroupArn: "otherTargetGroup" }],
+        },
+        {
+          Priority: "5",
+          IsDefault: false,
+          Actions: [
+            {
+              Type: "forward",
+              ForwardConfig: {
+                TargetGroups: [{ TargetGroupArn: "mockTargetGroup1" }],
+              },
+            },
+          ],
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "SUCCESS" &&
+          body.Data.Priority == 3 &&
+          body.Data.Priority1 == 5 &&
+          body.Data.Priority2 == 6
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        PhysicalResourceId: "mockPhysicalID",
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["api", "v2", "v3"],
+          TargetGroups: [
+            "mockTargetGroup",
+            "mockTargetGroup1",
+            "mockTargetGroup2",
+          ],
+        },
+        OldResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["api", "v2"],
+          TargetGroups: ["mockTargetGroup", "mockTargetGroup1"],
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
+
+  test("Update operation fails if another service routes the root path and the old properties have no rule paths", () => {
+    const describeRulesFake = sinon.fake.resolves({
+      Rules: [
+        {
+          Priority: "50000",
+          IsDefault: false,
+          Actions: [{ Type: "forward", TargetGroupArn: "otherTargetGroup" }],
+        },
+      ],
+    });
+
+    AWS.mock("ELBv2", "describeRules", describeRulesFake);
+    const request = nock(ResponseURL)
+      .put("/", (body) => {
+        return (
+          body.Status === "FAILED" &&
+          body.Reason ===
+            'Rule priority 50000 for the root path "/" is already used by another service in the environment (Log: /aws/lambda/testLambda/2021/06/28/[$LATEST]9b93a7dca7344adeb193d15c092dbbfd)'
+        );
+      })
+      .reply(200);
+
+    return LambdaTester(albRulePriorityHandler.nextAvailableRulePriorityHandler)
+      .event({
+        RequestType: "Update",
+        RequestId: testRequestId,
+        PhysicalResourceId: "mockPhysicalID",
+        ResourceProperties: {
+          ListenerArn: testALBListenerArn,
+          RulePath: ["/", "api"],
+          RootPathLast: "true",
+          TargetGroups: ["mockTargetGroup", "mockTargetGroup1"],
+        },
+        OldResourceProperties: {
+          ListenerArn: testALBListenerArn,
+        },
+      })
+      .expectResolve(() => {
+        expect(request.isDone()).toBe(true);
+      });
+  });
 });
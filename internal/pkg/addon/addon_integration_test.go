@@ -64,6 +64,22 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "ddb.yml",
 		},
+		"env ddb": {
+			addonMarshaler: addon.NewDynamoDB(&addon.DynamoDBProps{
+				StorageProps: &addon.StorageProps{
+					Name:      "ddb",
+					EnvScoped: true,
+				},
+				Attributes: []addon.DDBAttribute{
+					{
+						Name:     aws.String("primary"),
+						DataType: aws.String("S"),
+					},
+				},
+				PartitionKey: aws.String("primary"),
+			}),
+			outFileName: "env-ddb.yml",
+		},
 		"s3": {
 			addonMarshaler: addon.NewS3(&addon.S3Props{
 				StorageProps: &addon.StorageProps{
@@ -72,6 +88,32 @@ func TestAddons(t *testing.T) {
 			}),
 			outFileName: "bucket.yml",
 		},
+		"redis": {
+			addonMarshaler: addon.NewRedis(&addon.RedisProps{
+				StorageProps: &addon.StorageProps{
+					Name: "redis",
+				},
+				ClusterMode: true,
+			}),
+			outFileName: "redis.yml",
+		},
+		"opensearch": {
+			addonMarshaler: addon.NewOpenSearch(&addon.OpenSearchProps{
+				StorageProps: &addon.StorageProps{
+					Name: "search",
+				},
+			}),
+			outFileName: "opensearch.yml",
+		},
+		"sqs": {
+			addonMarshaler: addon.NewSQS(&addon.SQSProps{
+				StorageProps: &addon.StorageProps{
+					Name: "jobs",
+				},
+				FIFO: true,
+			}),
+			outFileName: "sqs.yml",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -7,6 +7,7 @@ package addon
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -16,6 +17,8 @@ import (
 const (
 	// StackName is the name of the addons nested stack resource.
 	StackName = "AddonsStack"
+
+	envAddonsDirOwner = "environments" // The environment addons are under the "environments/addons/" directory.
 )
 
 type workspaceReader interface {
@@ -23,6 +26,11 @@ type workspaceReader interface {
 	ReadAddon(svcName, fileName string) ([]byte, error)
 }
 
+type envWorkspaceReader interface {
+	ReadEnvAddonsDir() ([]string, error)
+	ReadEnvAddon(fileName string) ([]byte, error)
+}
+
 // Addons represents additional resources for a workload.
 type Addons struct {
 	wlName string
@@ -58,32 +66,116 @@ func (a *Addons) Template() (string, error) {
 		}
 	}
 
+	mergedTemplate, err := mergeTemplates(a.wlName, fnames, func(fname string) ([]byte, error) {
+		return a.ws.ReadAddon(a.wlName, fname)
+	})
+	if err != nil {
+		return "", err
+	}
+	out, err := yaml.Marshal(mergedTemplate)
+	if err != nil {
+		return "", fmt.Errorf("marshal merged addons template: %w", err)
+	}
+	return string(out), nil
+}
+
+// EnvAddons represents additional resources shared by the workloads of an environment.
+// They are deployed as a nested stack of the environment stack, so they outlive the workloads.
+type EnvAddons struct {
+	ws envWorkspaceReader
+}
+
+// NewEnv creates an EnvAddons object for the addons under the "environments/addons/" directory.
+func NewEnv() (*EnvAddons, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("workspace cannot be created: %w", err)
+	}
+	return &EnvAddons{
+		ws: ws,
+	}, nil
+}
+
+// Template merges CloudFormation templates under the "environments/addons/" directory
+// into a single CloudFormation template and returns it.
+// Each output of the template is exported as "${App}-${Env}-AddonsStack-{logical ID}" so that workloads can import it.
+//
+// If the addons directory doesn't exist, it returns the empty string and
+// ErrAddonsNotFound.
+func (a *EnvAddons) Template() (string, error) {
+	fnames, err := a.ws.ReadEnvAddonsDir()
+	if err != nil {
+		return "", &ErrAddonsNotFound{
+			WlName:    envAddonsDirOwner,
+			ParentErr: err,
+		}
+	}
+
+	mergedTemplate, err := mergeTemplates(envAddonsDirOwner, fnames, a.ws.ReadEnvAddon)
+	if err != nil {
+		return "", err
+	}
+	if err := mergedTemplate.exportOutputs(); err != nil {
+		return "", err
+	}
+	out, err := yaml.Marshal(mergedTemplate)
+	if err != nil {
+		return "", fmt.Errorf("marshal merged environment addons template: %w", err)
+	}
+	return string(out), nil
+}
+
+// Outputs returns the outputs of the environment addon defined in "environments/addons/{name}.yml".
+func (a *EnvAddons) Outputs(name string) ([]Output, error) {
+	fnames, err := a.ws.ReadEnvAddonsDir()
+	if err != nil {
+		return nil, &ErrAddonsNotFound{
+			WlName:    envAddonsDirOwner,
+			ParentErr: err,
+		}
+	}
+	for _, fname := range filterYAMLfiles(fnames) {
+		if strings.TrimSuffix(fname, filepath.Ext(fname)) != name {
+			continue
+		}
+		out, err := a.ws.ReadEnvAddon(fname)
+		if err != nil {
+			return nil, fmt.Errorf("read addon %s under %s: %w", fname, envAddonsDirOwner, err)
+		}
+		outputs, err := Outputs(string(out))
+		if err != nil {
+			return nil, fmt.Errorf("get outputs of addon %s under %s: %w", fname, envAddonsDirOwner, err)
+		}
+		return outputs, nil
+	}
+	return nil, fmt.Errorf("addon %s not found under %s", name, envAddonsDirOwner)
+}
+
+// mergeTemplates merges the YAML files among fnames, read with readFile, into a single CloudFormation template.
+// The owner is the name of the directory that holds the "addons/" directory.
+func mergeTemplates(owner string, fnames []string, readFile func(fname string) ([]byte, error)) (*cfnTemplate, error) {
 	yamlFiles := filterYAMLfiles(fnames)
 	if len(yamlFiles) == 0 {
-		return "", &ErrAddonsNotFound{
-			WlName: a.wlName,
+		return nil, &ErrAddonsNotFound{
+			WlName: owner,
 		}
 	}
 
 	mergedTemplate := newCFNTemplate("merged")
 	for _, fname := range yamlFiles {
-		out, err := a.ws.ReadAddon(a.wlName, fname)
+		out, err := readFile(fname)
 		if err != nil {
-			return "", fmt.Errorf("read addon %s under %s: %w", fname, a.wlName, err)
+			return nil, fmt.Errorf("read addon %s under %s: %w", fname, owner, err)
 		}
 		tpl := newCFNTemplate(fname)
 		if err := yaml.Unmarshal(out, tpl); err != nil {
-			return "", fmt.Errorf("unmarshal addon %s under %s: %w", fname, a.wlName, err)
+			return nil, fmt.Errorf("unmarshal addon %s under %s: %w", fname, owner, err)
 		}
 		if err := mergedTemplate.merge(tpl); err != nil {
-			return "", err
+			return nil, err
 		}
 	}
-	out, err := yaml.Marshal(mergedTemplate)
-	if err != nil {
-		return "", fmt.Errorf("marshal merged addons template: %w", err)
-	}
-	return string(out), nil
+	return mergedTemplate, nil
 }
 
 func filterYAMLfiles(files []string) []string {
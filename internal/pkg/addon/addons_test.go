@@ -242,3 +242,143 @@ func TestAddons_Template(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvAddons_Template(t *testing.T) {
+	testErr := errors.New("some error")
+	testCases := map[string]struct {
+		setupMocks func(ws *mocks.MockenvWorkspaceReader)
+
+		wantedTemplate string
+		wantedErr      error
+	}{
+		"return ErrAddonsNotFound if the environment addons directory doesn't exist": {
+			setupMocks: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvAddonsDir().Return(nil, testErr)
+			},
+			wantedErr: &ErrAddonsNotFound{
+				WlName:    "environments",
+				ParentErr: testErr,
+			},
+		},
+		"return ErrAddonsNotFound if the environment addons directory is empty": {
+			setupMocks: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvAddonsDir().Return([]string{".gitkeep"}, nil)
+			},
+			wantedErr: &ErrAddonsNotFound{
+				WlName: "environments",
+			},
+		},
+		"return err if an output is already exported": {
+			setupMocks: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvAddonsDir().Return([]string{"exported.yml"}, nil)
+				exported, _ := ioutil.ReadFile(filepath.Join("testdata", "env", "exported.yml"))
+				ws.EXPECT().ReadEnvAddon("exported.yml").Return(exported, nil)
+			},
+			wantedErr: errors.New(`output "jobsURL" defined in "exported.yml" must not have an "Export" field: it is exported as "${App}-${Env}-AddonsStack-jobsURL"`),
+		},
+		"merge the addons and export their outputs": {
+			setupMocks: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvAddonsDir().Return([]string{"jobs.yml", "orders.yml"}, nil)
+				jobs, _ := ioutil.ReadFile(filepath.Join("testdata", "env", "jobs.yml"))
+				ws.EXPECT().ReadEnvAddon("jobs.yml").Return(jobs, nil)
+				orders, _ := ioutil.ReadFile(filepath.Join("testdata", "env", "orders.yml"))
+				ws.EXPECT().ReadEnvAddon("orders.yml").Return(orders, nil)
+			},
+			wantedTemplate: func() string {
+				wanted, _ := ioutil.ReadFile(filepath.Join("testdata", "env", "wanted.yml"))
+				return string(wanted)
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockenvWorkspaceReader(ctrl)
+			tc.setupMocks(ws)
+			addons := &EnvAddons{
+				ws: ws,
+			}
+
+			// WHEN
+			actualTemplate, actualErr := addons.Template()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wantedTemplate, actualTemplate)
+			}
+		})
+	}
+}
+
+func TestEnvAddons_Outputs(t *testing.T) {
+	testCases := map[string]struct {
+		inName     string
+		setupMocks func(ws *mocks.MockenvWorkspaceReader)
+
+		wantedOutputs []Output
+		wantedErr     error
+	}{
+		"return err if the addon doesn't exist": {
+			inName: "payments",
+			setupMocks: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvAddonsDir().Return([]string{"jobs.yml", "orders.yml"}, nil)
+			},
+			wantedErr: errors.New("addon payments not found under environments"),
+		},
+		"wrap err if the addon can't be read": {
+			inName: "orders",
+			setupMocks: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvAddonsDir().Return([]string{"orders.yaml"}, nil)
+				ws.EXPECT().ReadEnvAddon("orders.yaml").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read addon orders.yaml under environments: some error"),
+		},
+		"return the outputs of the addon": {
+			inName: "orders",
+			setupMocks: func(ws *mocks.MockenvWorkspaceReader) {
+				ws.EXPECT().ReadEnvAddonsDir().Return([]string{"jobs.yml", "orders.yml"}, nil)
+				orders, _ := ioutil.ReadFile(filepath.Join("testdata", "env", "orders.yml"))
+				ws.EXPECT().ReadEnvAddon("orders.yml").Return(orders, nil)
+			},
+			wantedOutputs: []Output{
+				{
+					Name: "ordersName",
+				},
+				{
+					Name:            "ordersAccessPolicy",
+					IsManagedPolicy: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockenvWorkspaceReader(ctrl)
+			tc.setupMocks(ws)
+			addons := &EnvAddons{
+				ws: ws,
+			}
+
+			// WHEN
+			actualOutputs, actualErr := addons.Outputs(tc.inName)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, actualErr)
+				require.Equal(t, tc.wantedOutputs, actualOutputs)
+			}
+		})
+	}
+}
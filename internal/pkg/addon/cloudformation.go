@@ -114,6 +114,34 @@ func (t *cfnTemplate) mergeOutputs(outputs yaml.Node) error {
 	return mergeSingleLevelMaps(&t.Outputs, &outputs)
 }
 
+// envAddonsExportPrefix prefixes the exports of the environment addons, so that they can't collide with the exports of the environment stack.
+const envAddonsExportPrefix = "${App}-${Env}-AddonsStack-"
+
+// exportOutputs adds an export named "${App}-${Env}-AddonsStack-{logical ID}" to each output of t.
+// If an output already has an export, returns an error as workloads wouldn't be able to import it by name.
+func (t *cfnTemplate) exportOutputs() error {
+	for _, content := range mappingContents(&t.Outputs) {
+		name := content.keyNode.Value
+		if content.valueNode.Kind != yaml.MappingNode {
+			return fmt.Errorf(`output "%s" defined in "%s" is not a map`, name, t.templateNameFor[content.keyNode])
+		}
+		if _, ok := mappingNode(content.valueNode)["Export"]; ok {
+			return fmt.Errorf(`output "%s" defined in "%s" must not have an "Export" field: it is exported as "%s%s"`,
+				name, t.templateNameFor[content.keyNode], envAddonsExportPrefix, name)
+		}
+		content.valueNode.Content = append(content.valueNode.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: "Export"},
+			&yaml.Node{
+				Kind: yaml.MappingNode,
+				Content: []*yaml.Node{
+					{Kind: yaml.ScalarNode, Value: "Name"},
+					{Kind: yaml.ScalarNode, Tag: "!Sub", Value: envAddonsExportPrefix + name},
+				},
+			})
+	}
+	return nil
+}
+
 // assignNewNodesTo associates every new node added to the template t with the tplName.
 func (t *cfnTemplate) assignNewNodesTo(tplName string) {
 	if t == nil {
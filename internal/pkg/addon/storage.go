@@ -13,9 +13,12 @@ import (
 )
 
 const (
-	dynamoDbAddonPath = "addons/ddb/cf.yml"
-	s3AddonPath       = "addons/s3/cf.yml"
-	rdsAddonPath      = "addons/aurora/cf.yml"
+	dynamoDbAddonPath   = "addons/ddb/cf.yml"
+	s3AddonPath         = "addons/s3/cf.yml"
+	rdsAddonPath        = "addons/aurora/cf.yml"
+	redisAddonPath      = "addons/redis/cf.yml"
+	openSearchAddonPath = "addons/opensearch/cf.yml"
+	sqsAddonPath        = "addons/sqs/cf.yml"
 )
 
 const (
@@ -24,6 +27,15 @@ const (
 	RDSEngineTypePostgreSQL = "PostgreSQL"
 )
 
+const (
+	// Default node type of the ElastiCache Redis replication group.
+	RedisDefaultNodeType = "cache.t3.micro"
+	// Default instance type of the OpenSearch domain data nodes.
+	OpenSearchDefaultInstanceType = "t3.small.search"
+	// Default number of receives of a SQS message before it's moved to the dead-letter queue.
+	SQSDefaultMaxReceiveCount = 10
+)
+
 var regexpMatchAttribute = regexp.MustCompile(`^(\S+):([sbnSBN])`)
 
 var storageTemplateFunctions = map[string]interface{}{
@@ -57,9 +69,35 @@ type RDS struct {
 	parser template.Parser
 }
 
+// Redis contains configuration options which fully describe an ElastiCache Redis replication group.
+// Implements the encoding.BinaryMarshaler interface.
+type Redis struct {
+	RedisProps
+
+	parser template.Parser
+}
+
+// OpenSearch contains configuration options which fully describe an OpenSearch domain.
+// Implements the encoding.BinaryMarshaler interface.
+type OpenSearch struct {
+	OpenSearchProps
+
+	parser template.Parser
+}
+
+// SQS contains configuration options which fully describe a SQS queue and its dead-letter queue.
+// Implements the encoding.BinaryMarshaler interface.
+type SQS struct {
+	SQSProps
+
+	parser template.Parser
+}
+
 // StorageProps holds basic input properties for addon.NewDynamoDB() or addon.NewS3().
 type StorageProps struct {
 	Name string
+	// Whether the storage is an environment addon shared by the workloads of the environment.
+	EnvScoped bool
 }
 
 // S3Props contains S3-specific properties for addon.NewS3().
@@ -102,6 +140,33 @@ type RDSProps struct {
 	ParameterGroup string
 	// The copilot environments found inside the current app.
 	Envs []string
+	// Whether the cluster is an environment addon shared by the workloads of the environment.
+	EnvScoped bool
+}
+
+// RedisProps holds ElastiCache Redis-specific properties for addon.NewRedis().
+type RedisProps struct {
+	*StorageProps
+	// Whether the data is partitioned across multiple shards.
+	ClusterMode bool
+	// The compute and memory capacity of the nodes, such as "cache.t3.micro".
+	NodeType string
+}
+
+// OpenSearchProps holds OpenSearch-specific properties for addon.NewOpenSearch().
+type OpenSearchProps struct {
+	*StorageProps
+	// The instance type of the data nodes, such as "t3.small.search".
+	InstanceType string
+}
+
+// SQSProps holds SQS-specific properties for addon.NewSQS().
+type SQSProps struct {
+	*StorageProps
+	// Whether the queue is a FIFO queue.
+	FIFO bool
+	// The number of receives of a message before it's moved to the dead-letter queue.
+	MaxReceiveCount int
 }
 
 // MarshalBinary serializes the DynamoDB object into a binary YAML CF template.
@@ -162,6 +227,75 @@ func NewRDS(input RDSProps) *RDS {
 	}
 }
 
+// MarshalBinary serializes the Redis object into a binary YAML CF template.
+// Implements the encoding.BinaryMarshaler interface.
+func (r *Redis) MarshalBinary() ([]byte, error) {
+	content, err := r.parser.Parse(redisAddonPath, *r, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// NewRedis creates a new Redis marshaler which can be used to write CF via addonWriter.
+func NewRedis(input *RedisProps) *Redis {
+	props := *input
+	if props.NodeType == "" {
+		props.NodeType = RedisDefaultNodeType
+	}
+	return &Redis{
+		RedisProps: props,
+
+		parser: template.New(),
+	}
+}
+
+// MarshalBinary serializes the OpenSearch object into a binary YAML CF template.
+// Implements the encoding.BinaryMarshaler interface.
+func (o *OpenSearch) MarshalBinary() ([]byte, error) {
+	content, err := o.parser.Parse(openSearchAddonPath, *o, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// NewOpenSearch creates a new OpenSearch marshaler which can be used to write CF via addonWriter.
+func NewOpenSearch(input *OpenSearchProps) *OpenSearch {
+	props := *input
+	if props.InstanceType == "" {
+		props.InstanceType = OpenSearchDefaultInstanceType
+	}
+	return &OpenSearch{
+		OpenSearchProps: props,
+
+		parser: template.New(),
+	}
+}
+
+// MarshalBinary serializes the SQS object into a binary YAML CF template.
+// Implements the encoding.BinaryMarshaler interface.
+func (q *SQS) MarshalBinary() ([]byte, error) {
+	content, err := q.parser.Parse(sqsAddonPath, *q, template.WithFuncs(storageTemplateFunctions))
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// NewSQS creates a new SQS marshaler which can be used to write CF via addonWriter.
+func NewSQS(input *SQSProps) *SQS {
+	props := *input
+	if props.MaxReceiveCount == 0 {
+		props.MaxReceiveCount = SQSDefaultMaxReceiveCount
+	}
+	return &SQS{
+		SQSProps: props,
+
+		parser: template.New(),
+	}
+}
+
 // BuildPartitionKey generates the properties required to specify the partition key
 // based on customer inputs.
 func (p *DynamoDBProps) BuildPartitionKey(partitionKey string) error {
@@ -195,6 +195,15 @@ func (cs *changeSet) execute() error {
 // createAndExecute calls create and then execute.
 // If the change set is empty, returns a ErrChangeSetEmpty.
 func (cs *changeSet) createAndExecute(conf *stackConfig) error {
+	if err := cs.createOrDeleteIfEmpty(conf); err != nil {
+		return err
+	}
+	return cs.execute()
+}
+
+// createOrDeleteIfEmpty calls create.
+// If the change set is empty, deletes it and returns a ErrChangeSetEmpty.
+func (cs *changeSet) createOrDeleteIfEmpty(conf *stackConfig) error {
 	if err := cs.create(conf); err != nil {
 		// It's possible that there are no changes between the previous and proposed stack change sets.
 		// We make a call to describe the change set to see if that is indeed the case and handle it gracefully.
@@ -215,7 +224,7 @@ func (cs *changeSet) createAndExecute(conf *stackConfig) error {
 		}
 		return fmt.Errorf("%w: %s", err, descr.StatusReason)
 	}
-	return cs.execute()
+	return nil
 }
 
 // delete removes the change set.
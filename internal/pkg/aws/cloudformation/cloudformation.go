@@ -92,6 +92,12 @@ func (c *CloudFormation) DescribeChangeSet(changeSetID, stackName string) (*Chan
 	return out, nil
 }
 
+// DeleteChangeSet deletes a change set that wasn't executed.
+func (c *CloudFormation) DeleteChangeSet(changeSetID, stackName string) error {
+	cs := &changeSet{name: changeSetID, stackName: stackName, client: c.client}
+	return cs.delete()
+}
+
 // WaitForCreate blocks until the stack is created or until the max attempt window expires.
 func (c *CloudFormation) WaitForCreate(ctx context.Context, stackName string) error {
 	err := c.client.WaitUntilStackCreateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
@@ -119,6 +125,28 @@ func (c *CloudFormation) Update(stack *Stack) (changeSetID string, err error) {
 	return c.update(stack)
 }
 
+// CreateChangeSet creates a change set to update an existing stack without executing it, and returns the change set ID.
+// If there are no changes for the stack, deletes the empty change set and returns ErrChangeSetEmpty.
+func (c *CloudFormation) CreateChangeSet(stack *Stack) (changeSetID string, err error) {
+	descr, err := c.Describe(stack.Name)
+	if err != nil {
+		return "", err
+	}
+	if StackStatus(aws.StringValue(descr.StackStatus)).InProgress() {
+		return "", &ErrStackUpdateInProgress{
+			Name: stack.Name,
+		}
+	}
+	cs, err := newUpdateChangeSet(c.client, stack.Name)
+	if err != nil {
+		return "", err
+	}
+	if err := cs.createOrDeleteIfEmpty(stack.stackConfig); err != nil {
+		return "", err
+	}
+	return cs.name, nil
+}
+
 // UpdateAndWait calls Update and then blocks until the stack is updated or until the max attempt window expires.
 func (c *CloudFormation) UpdateAndWait(stack *Stack) error {
 	if _, err := c.Update(stack); err != nil {
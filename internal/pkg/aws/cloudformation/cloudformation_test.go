@@ -508,6 +508,136 @@ func TestCloudFormation_Update(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DeleteChangeSet(t *testing.T) {
+	t.Run("returns a wrapped error if the change set can't be deleted", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockclient(ctrl)
+		m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+			ChangeSetName: aws.String(mockChangeSetID),
+			StackName:     aws.String("phonetool-test"),
+		}).Return(nil, errors.New("some error"))
+		cfn := CloudFormation{
+			client: m,
+		}
+
+		// WHEN
+		err := cfn.DeleteChangeSet(mockChangeSetID, "phonetool-test")
+
+		// THEN
+		require.EqualError(t, err, fmt.Sprintf("delete change set %s for stack phonetool-test: some error", mockChangeSetID))
+	})
+
+	t.Run("deletes the change set", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		m := mocks.NewMockclient(ctrl)
+		m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+			ChangeSetName: aws.String(mockChangeSetID),
+			StackName:     aws.String("phonetool-test"),
+		}).Return(&cloudformation.DeleteChangeSetOutput{}, nil)
+		cfn := CloudFormation{
+			client: m,
+		}
+
+		// WHEN
+		err := cfn.DeleteChangeSet(mockChangeSetID, "phonetool-test")
+
+		// THEN
+		require.NoError(t, err)
+	})
+}
+
+func TestCloudFormation_CreateChangeSet(t *testing.T) {
+	const (
+		mockStackName     = "id"
+		mockChangeSetName = "copilot-31323334-3536-4738-b930-313233333435"
+	)
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) client
+		wantedErr  error
+	}{
+		"fail if the stack is already in progress": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateInProgress)}},
+				}, nil)
+				return m
+			},
+			wantedErr: &ErrStackUpdateInProgress{
+				Name: mockStack.Name,
+			},
+		},
+		"delete change set and throw ErrChangeSetEmpty if the change set is empty": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(nil, errors.New("some error"))
+				m.EXPECT().DescribeChangeSet(gomock.Any()).
+					Return(&cloudformation.DescribeChangeSetOutput{
+						Changes:      []*cloudformation.Change{},
+						StatusReason: aws.String("The submitted information didn't contain changes. Submit different information to create a change set."),
+					}, nil)
+				m.EXPECT().DeleteChangeSet(&cloudformation.DeleteChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+					StackName:     aws.String(mockStackName),
+				}).Return(nil, nil)
+				return m
+			},
+			wantedErr: fmt.Errorf("change set with name copilot-31323334-3536-4738-b930-313233333435 for stack id has no changes"),
+		},
+		"create the change set without executing it": {
+			createMock: func(ctrl *gomock.Controller) client {
+				m := mocks.NewMockclient(ctrl)
+				m.EXPECT().DescribeStacks(gomock.Any()).Return(&cloudformation.DescribeStacksOutput{
+					Stacks: []*cloudformation.Stack{{StackStatus: aws.String(cloudformation.StackStatusUpdateComplete)}},
+				}, nil)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return(&cloudformation.CreateChangeSetOutput{
+					Id: aws.String(mockChangeSetName),
+				}, nil)
+				m.EXPECT().WaitUntilChangeSetCreateCompleteWithContext(gomock.Any(), &cloudformation.DescribeChangeSetInput{
+					ChangeSetName: aws.String(mockChangeSetName),
+				}, gomock.Any()).Return(nil)
+				m.EXPECT().ExecuteChangeSet(gomock.Any()).Times(0)
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			seed := bytes.NewBufferString("12345678901233456789") // always generate the same UUID
+			uuid.SetRand(seed)
+			defer uuid.SetRand(nil)
+
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := CloudFormation{
+				client: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, err := c.CreateChangeSet(mockStack)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, mockChangeSetName, id)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_UpdateAndWait(t *testing.T) {
 	testCases := map[string]struct {
 		createMock func(ctrl *gomock.Controller) client
@@ -0,0 +1,227 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package codedeploy provides a client to make API requests to AWS CodeDeploy.
+package codedeploy
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+)
+
+// Status of a CodeDeploy deployment.
+const (
+	DeploymentStatusCreated    = codedeploy.DeploymentStatusCreated
+	DeploymentStatusQueued     = codedeploy.DeploymentStatusQueued
+	DeploymentStatusInProgress = codedeploy.DeploymentStatusInProgress
+	DeploymentStatusBaking     = codedeploy.DeploymentStatusBaking
+	DeploymentStatusSucceeded  = codedeploy.DeploymentStatusSucceeded
+	DeploymentStatusFailed     = codedeploy.DeploymentStatusFailed
+	DeploymentStatusStopped    = codedeploy.DeploymentStatusStopped
+	DeploymentStatusReady      = codedeploy.DeploymentStatusReady
+)
+
+// Labels of the task sets in an ECS blue/green deployment.
+const (
+	TaskSetLabelBlue  = codedeploy.TargetLabelBlue
+	TaskSetLabelGreen = codedeploy.TargetLabelGreen
+)
+
+const fmtECSAppSpec = `version: 0.0
+Resources:
+  - TargetService:
+      Type: AWS::ECS::Service
+      Properties:
+        TaskDefinition: "%s"
+        LoadBalancerInfo:
+          ContainerName: "%s"
+          ContainerPort: %d
+`
+
+type api interface {
+	CreateDeployment(input *codedeploy.CreateDeploymentInput) (*codedeploy.CreateDeploymentOutput, error)
+	GetDeployment(input *codedeploy.GetDeploymentInput) (*codedeploy.GetDeploymentOutput, error)
+	GetDeploymentGroup(input *codedeploy.GetDeploymentGroupInput) (*codedeploy.GetDeploymentGroupOutput, error)
+	ListDeploymentTargets(input *codedeploy.ListDeploymentTargetsInput) (*codedeploy.ListDeploymentTargetsOutput, error)
+	GetDeploymentTarget(input *codedeploy.GetDeploymentTargetInput) (*codedeploy.GetDeploymentTargetOutput, error)
+}
+
+// CodeDeploy wraps an AWS CodeDeploy client.
+type CodeDeploy struct {
+	client api
+}
+
+// ECSDeploymentInput holds the fields required to start a blue/green deployment of an ECS service.
+type ECSDeploymentInput struct {
+	ApplicationName     string
+	DeploymentGroupName string
+	TaskDefinitionARN   string // Task definition of the replacement (green) task set.
+	ContainerName       string // Container that receives traffic from the load balancer.
+	ContainerPort       int
+}
+
+// Deployment holds the fields of a CodeDeploy deployment.
+type Deployment struct {
+	ID           string    `json:"id"`
+	Status       string    `json:"status"`
+	CreatedAt    time.Time `json:"createdAt"`
+	ErrorMessage string    `json:"errorMessage,omitempty"` // Empty unless the deployment failed or was stopped.
+	TaskSets     []TaskSet `json:"taskSets"`               // Empty if the deployment didn't create any task sets yet.
+}
+
+// IsDone returns true if the deployment reached a terminal status.
+func (d *Deployment) IsDone() bool {
+	switch d.Status {
+	case DeploymentStatusSucceeded, DeploymentStatusFailed, DeploymentStatusStopped:
+		return true
+	default:
+		return false
+	}
+}
+
+// TaskSet holds the status of an ECS task set that is part of a blue/green deployment.
+type TaskSet struct {
+	Label         string  `json:"label"` // Either "Blue" or "Green".
+	Status        string  `json:"status"`
+	TrafficWeight float64 `json:"trafficWeight"` // Percentage of production traffic routed to the task set.
+	DesiredCount  int     `json:"desiredCount"`
+	RunningCount  int     `json:"runningCount"`
+	PendingCount  int     `json:"pendingCount"`
+}
+
+// ErrDeploymentGroupNotFound occurs when a CodeDeploy application or deployment group does not exist.
+type ErrDeploymentGroupNotFound struct {
+	appName   string
+	groupName string
+}
+
+func (e *ErrDeploymentGroupNotFound) Error() string {
+	return fmt.Sprintf("deployment group %s of application %s does not exist", e.groupName, e.appName)
+}
+
+// New returns a CodeDeploy client configured against the input session.
+func New(s *session.Session) *CodeDeploy {
+	return &CodeDeploy{
+		client: codedeploy.New(s),
+	}
+}
+
+// CreateECSDeployment starts a blue/green deployment that replaces the tasks of an ECS service,
+// and returns the ID of the deployment.
+func (c *CodeDeploy) CreateECSDeployment(in ECSDeploymentInput) (string, error) {
+	appSpec := fmt.Sprintf(fmtECSAppSpec, in.TaskDefinitionARN, in.ContainerName, in.ContainerPort)
+	out, err := c.client.CreateDeployment(&codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(in.ApplicationName),
+		DeploymentGroupName: aws.String(in.DeploymentGroupName),
+		Revision: &codedeploy.RevisionLocation{
+			RevisionType: aws.String(codedeploy.RevisionLocationTypeAppSpecContent),
+			AppSpecContent: &codedeploy.AppSpecContent{
+				Content: aws.String(appSpec),
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create deployment for deployment group %s: %w", in.DeploymentGroupName, err)
+	}
+	return aws.StringValue(out.DeploymentId), nil
+}
+
+// Deployment returns the status of a deployment along with the traffic routed to each of its task sets.
+func (c *CodeDeploy) Deployment(id string) (*Deployment, error) {
+	out, err := c.client.GetDeployment(&codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get deployment %s: %w", id, err)
+	}
+	info := out.DeploymentInfo
+	deployment := &Deployment{
+		ID:        aws.StringValue(info.DeploymentId),
+		Status:    aws.StringValue(info.Status),
+		CreatedAt: aws.TimeValue(info.CreateTime),
+	}
+	if info.ErrorInformation != nil {
+		deployment.ErrorMessage = aws.StringValue(info.ErrorInformation.Message)
+	}
+	taskSets, err := c.taskSets(id)
+	if err != nil {
+		return nil, err
+	}
+	deployment.TaskSets = taskSets
+	return deployment, nil
+}
+
+// LastDeployment returns the most recent deployment attempted in a deployment group.
+// If the deployment group never had a deployment, returns nil.
+func (c *CodeDeploy) LastDeployment(appName, groupName string) (*Deployment, error) {
+	out, err := c.client.GetDeploymentGroup(&codedeploy.GetDeploymentGroupInput{
+		ApplicationName:     aws.String(appName),
+		DeploymentGroupName: aws.String(groupName),
+	})
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, &ErrDeploymentGroupNotFound{
+				appName:   appName,
+				groupName: groupName,
+			}
+		}
+		return nil, fmt.Errorf("get deployment group %s: %w", groupName, err)
+	}
+	last := out.DeploymentGroupInfo.LastAttemptedDeployment
+	if last == nil || last.DeploymentId == nil {
+		return nil, nil
+	}
+	return c.Deployment(aws.StringValue(last.DeploymentId))
+}
+
+func (c *CodeDeploy) taskSets(deploymentID string) ([]TaskSet, error) {
+	targets, err := c.client.ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(deploymentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list targets of deployment %s: %w", deploymentID, err)
+	}
+	var taskSets []TaskSet
+	for _, targetID := range targets.TargetIds {
+		out, err := c.client.GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+			DeploymentId: aws.String(deploymentID),
+			TargetId:     targetID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("get target %s of deployment %s: %w", aws.StringValue(targetID), deploymentID, err)
+		}
+		if out.DeploymentTarget == nil || out.DeploymentTarget.EcsTarget == nil {
+			continue
+		}
+		for _, ts := range out.DeploymentTarget.EcsTarget.TaskSetsInfo {
+			taskSets = append(taskSets, TaskSet{
+				Label:         aws.StringValue(ts.TaskSetLabel),
+				Status:        aws.StringValue(ts.Status),
+				TrafficWeight: aws.Float64Value(ts.TrafficWeight),
+				DesiredCount:  int(aws.Int64Value(ts.DesiredCount)),
+				RunningCount:  int(aws.Int64Value(ts.RunningCount)),
+				PendingCount:  int(aws.Int64Value(ts.PendingCount)),
+			})
+		}
+	}
+	return taskSets, nil
+}
+
+func isNotFoundErr(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	switch aerr.Code() {
+	case codedeploy.ErrCodeApplicationDoesNotExistException, codedeploy.ErrCodeDeploymentGroupDoesNotExistException:
+		return true
+	default:
+		return false
+	}
+}
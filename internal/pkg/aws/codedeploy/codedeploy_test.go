@@ -0,0 +1,337 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package codedeploy
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeDeploy_CreateECSDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedID    string
+		wantedError error
+	}{
+		"fail to create deployment": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("create deployment for deployment group phonetool-test-api: some error"),
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateDeployment(&codedeploy.CreateDeploymentInput{
+					ApplicationName:     aws.String("phonetool-test-api"),
+					DeploymentGroupName: aws.String("phonetool-test-api"),
+					Revision: &codedeploy.RevisionLocation{
+						RevisionType: aws.String("AppSpecContent"),
+						AppSpecContent: &codedeploy.AppSpecContent{
+							Content: aws.String(`version: 0.0
+Resources:
+  - TargetService:
+      Type: AWS::ECS::Service
+      Properties:
+        TaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:4"
+        LoadBalancerInfo:
+          ContainerName: "api"
+          ContainerPort: 8080
+`),
+						},
+					},
+				}).Return(&codedeploy.CreateDeploymentOutput{
+					DeploymentId: aws.String("d-1234"),
+				}, nil)
+			},
+			wantedID: "d-1234",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cd := CodeDeploy{
+				client: m,
+			}
+
+			// WHEN
+			id, err := cd.CreateECSDeployment(ECSDeploymentInput{
+				ApplicationName:     "phonetool-test-api",
+				DeploymentGroupName: "phonetool-test-api",
+				TaskDefinitionARN:   "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:4",
+				ContainerName:       "api",
+				ContainerPort:       8080,
+			})
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedID, id)
+		})
+	}
+}
+
+func TestCodeDeploy_Deployment(t *testing.T) {
+	createdAt := time.Date(2021, time.October, 1, 12, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedDeployment *Deployment
+		wantedError      error
+	}{
+		"fail to get deployment": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployment d-1234: some error"),
+		},
+		"fail to list deployment targets": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list targets of deployment d-1234: some error"),
+		},
+		"fail to get deployment target": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{
+					TargetIds: aws.StringSlice([]string{"cluster:service"}),
+				}, nil)
+				m.EXPECT().GetDeploymentTarget(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get target cluster:service of deployment d-1234: some error"),
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeployment(&codedeploy.GetDeploymentInput{
+					DeploymentId: aws.String("d-1234"),
+				}).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						DeploymentId: aws.String("d-1234"),
+						Status:       aws.String("Failed"),
+						CreateTime:   aws.Time(createdAt),
+						ErrorInformation: &codedeploy.ErrorInformation{
+							Message: aws.String("alarm went off"),
+						},
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(&codedeploy.ListDeploymentTargetsInput{
+					DeploymentId: aws.String("d-1234"),
+				}).Return(&codedeploy.ListDeploymentTargetsOutput{
+					TargetIds: aws.StringSlice([]string{"cluster:service"}),
+				}, nil)
+				m.EXPECT().GetDeploymentTarget(&codedeploy.GetDeploymentTargetInput{
+					DeploymentId: aws.String("d-1234"),
+					TargetId:     aws.String("cluster:service"),
+				}).Return(&codedeploy.GetDeploymentTargetOutput{
+					DeploymentTarget: &codedeploy.DeploymentTarget{
+						EcsTarget: &codedeploy.ECSTarget{
+							TaskSetsInfo: []*codedeploy.ECSTaskSet{
+								{
+									TaskSetLabel:  aws.String("Blue"),
+									Status:        aws.String("PRIMARY"),
+									TrafficWeight: aws.Float64(90),
+									DesiredCount:  aws.Int64(2),
+									RunningCount:  aws.Int64(2),
+									PendingCount:  aws.Int64(0),
+								},
+								{
+									TaskSetLabel:  aws.String("Green"),
+									Status:        aws.String("ACTIVE"),
+									TrafficWeight: aws.Float64(10),
+									DesiredCount:  aws.Int64(2),
+									RunningCount:  aws.Int64(1),
+									PendingCount:  aws.Int64(1),
+								},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedDeployment: &Deployment{
+				ID:           "d-1234",
+				Status:       "Failed",
+				CreatedAt:    createdAt,
+				ErrorMessage: "alarm went off",
+				TaskSets: []TaskSet{
+					{
+						Label:         "Blue",
+						Status:        "PRIMARY",
+						TrafficWeight: 90,
+						DesiredCount:  2,
+						RunningCount:  2,
+					},
+					{
+						Label:         "Green",
+						Status:        "ACTIVE",
+						TrafficWeight: 10,
+						DesiredCount:  2,
+						RunningCount:  1,
+						PendingCount:  1,
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cd := CodeDeploy{
+				client: m,
+			}
+
+			// WHEN
+			deployment, err := cd.Deployment("d-1234")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployment, deployment)
+		})
+	}
+}
+
+func TestCodeDeploy_LastDeployment(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(m *mocks.Mockapi)
+
+		wantedDeployment *Deployment
+		wantedError      error
+	}{
+		"return ErrDeploymentGroupNotFound if the deployment group does not exist": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeploymentGroup(gomock.Any()).Return(nil, awserr.New(codedeploy.ErrCodeDeploymentGroupDoesNotExistException, "does not exist", nil))
+			},
+			wantedError: &ErrDeploymentGroupNotFound{
+				appName:   "phonetool-test-api",
+				groupName: "phonetool-test-api",
+			},
+		},
+		"fail to get deployment group": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeploymentGroup(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get deployment group phonetool-test-api: some error"),
+		},
+		"return nil if there were no deployments": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeploymentGroup(&codedeploy.GetDeploymentGroupInput{
+					ApplicationName:     aws.String("phonetool-test-api"),
+					DeploymentGroupName: aws.String("phonetool-test-api"),
+				}).Return(&codedeploy.GetDeploymentGroupOutput{
+					DeploymentGroupInfo: &codedeploy.DeploymentGroupInfo{},
+				}, nil)
+			},
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetDeploymentGroup(gomock.Any()).Return(&codedeploy.GetDeploymentGroupOutput{
+					DeploymentGroupInfo: &codedeploy.DeploymentGroupInfo{
+						LastAttemptedDeployment: &codedeploy.LastDeploymentInfo{
+							DeploymentId: aws.String("d-1234"),
+						},
+					},
+				}, nil)
+				m.EXPECT().GetDeployment(gomock.Any()).Return(&codedeploy.GetDeploymentOutput{
+					DeploymentInfo: &codedeploy.DeploymentInfo{
+						DeploymentId: aws.String("d-1234"),
+						Status:       aws.String("Succeeded"),
+					},
+				}, nil)
+				m.EXPECT().ListDeploymentTargets(gomock.Any()).Return(&codedeploy.ListDeploymentTargetsOutput{}, nil)
+			},
+			wantedDeployment: &Deployment{
+				ID:     "d-1234",
+				Status: "Succeeded",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockapi(ctrl)
+			tc.mockClient(m)
+			cd := CodeDeploy{
+				client: m,
+			}
+
+			// WHEN
+			deployment, err := cd.LastDeployment("phonetool-test-api", "phonetool-test-api")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDeployment, deployment)
+		})
+	}
+}
+
+func TestDeployment_IsDone(t *testing.T) {
+	testCases := map[string]struct {
+		status string
+		wanted bool
+	}{
+		"in progress": {
+			status: DeploymentStatusInProgress,
+			wanted: false,
+		},
+		"baking": {
+			status: DeploymentStatusBaking,
+			wanted: false,
+		},
+		"succeeded": {
+			status: DeploymentStatusSucceeded,
+			wanted: true,
+		},
+		"failed": {
+			status: DeploymentStatusFailed,
+			wanted: true,
+		},
+		"stopped": {
+			status: DeploymentStatusStopped,
+			wanted: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := &Deployment{Status: tc.status}
+			require.Equal(t, tc.wanted, d.IsDone())
+		})
+	}
+}
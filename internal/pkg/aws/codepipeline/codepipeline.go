@@ -81,7 +81,7 @@ type StageAction struct {
 // AggregateStatus returns the collective status of a stage by looking at each individual action's status.
 // It returns "InProgress" if there are any actions that are in progress.
 // It returns "Failed" if there are actions that failed or were abandoned.
-// It returns "Succeeded" if all actions succeeded.
+// It returns "Succeeded" if all the actions that ran succeeded.
 // It returns "" if there is no prior execution.
 func (ss StageState) AggregateStatus() string {
 	status := map[string]int{
@@ -98,7 +98,7 @@ func (ss StageState) AggregateStatus() string {
 		return "InProgress"
 	} else if status["Failed"]+status["Abandoned"] > 0 {
 		return "Failed"
-	} else if status["Succeeded"] > 0 && status["Succeeded"] == len(ss.Actions) {
+	} else if status["Succeeded"] > 0 && status["Succeeded"] == len(ss.Actions)-status[""] {
 		return "Succeeded"
 	}
 	return ""
@@ -243,12 +243,15 @@ func (c *CodePipeline) GetPipelineState(name string) (*PipelineState, error) {
 		}
 		var actions []StageAction
 		for _, actionState := range stage.ActionStates {
+			// Actions that never ran, such as newly added pre- or post-deployments, have no latest execution.
+			var status string
 			if actionState.LatestExecution != nil {
-				actions = append(actions, StageAction{
-					Name:   aws.StringValue(actionState.ActionName),
-					Status: aws.StringValue(actionState.LatestExecution.Status),
-				})
+				status = aws.StringValue(actionState.LatestExecution.Status)
 			}
+			actions = append(actions, StageAction{
+				Name:   aws.StringValue(actionState.ActionName),
+				Status: status,
+			})
 		}
 		stageStates = append(stageStates, &StageState{
 			StageName:  stageName,
@@ -387,7 +387,12 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 			},
 			{
 				InboundTransitionState: &codepipeline.TransitionState{Enabled: aws.Bool(false)},
-				StageName:              aws.String("DeployTo-prod"),
+				ActionStates: []*codepipeline.ActionState{
+					{
+						ActionName: aws.String("PreDeployment-migrate"),
+					},
+				},
+				StageName: aws.String("DeployTo-prod"),
 			},
 		},
 		Updated: &mockTime,
@@ -459,7 +464,12 @@ func TestCodePipeline_GetPipelineState(t *testing.T) {
 						Transition: "ENABLED",
 					},
 					{
-						StageName:  "DeployTo-prod",
+						StageName: "DeployTo-prod",
+						Actions: []StageAction{
+							{
+								Name: "PreDeployment-migrate",
+							},
+						},
 						Transition: "DISABLED",
 					},
 				},
@@ -271,6 +271,20 @@ func (c *EC2) SubnetIDs(filters ...Filter) ([]string, error) {
 	return subnetIDs, nil
 }
 
+// SubnetCIDRBlocks finds the CIDR blocks of the subnets with optional filters.
+func (c *EC2) SubnetCIDRBlocks(filters ...Filter) ([]string, error) {
+	subnets, err := c.subnets(filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrBlocks := make([]string, len(subnets))
+	for idx, subnet := range subnets {
+		cidrBlocks[idx] = aws.StringValue(subnet.CidrBlock)
+	}
+	return cidrBlocks, nil
+}
+
 // SecurityGroups finds the security group IDs with optional filters.
 func (c *EC2) SecurityGroups(filters ...Filter) ([]string, error) {
 	inputFilters := toEC2Filter(filters)
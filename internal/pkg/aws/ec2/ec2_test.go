@@ -451,6 +451,70 @@ func TestEC2_SubnetIDs(t *testing.T) {
 	}
 }
 
+func TestEC2_SubnetCIDRBlocks(t *testing.T) {
+	inSubnetIDFilter := []Filter{
+		{
+			Name:   "subnet-id",
+			Values: []string{"subnet-1", "subnet-2"},
+		},
+	}
+	testCases := map[string]struct {
+		mockEC2Client func(m *mocks.Mockapi)
+
+		wantedError      error
+		wantedCIDRBlocks []string
+	}{
+		"failed to get subnets": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+					Filters: toEC2Filter(inSubnetIDFilter),
+				}).Return(nil, errors.New("error describing subnets"))
+			},
+			wantedError: fmt.Errorf("describe subnets: error describing subnets"),
+		},
+		"successfully get CIDR blocks": {
+			mockEC2Client: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSubnets(&ec2.DescribeSubnetsInput{
+					Filters: toEC2Filter(inSubnetIDFilter),
+				}).Return(&ec2.DescribeSubnetsOutput{
+					Subnets: []*ec2.Subnet{
+						{
+							SubnetId:  aws.String("subnet-1"),
+							CidrBlock: aws.String("10.0.0.0/24"),
+						},
+						{
+							SubnetId:  aws.String("subnet-2"),
+							CidrBlock: aws.String("10.0.1.0/24"),
+						},
+					},
+				}, nil)
+			},
+			wantedCIDRBlocks: []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.mockEC2Client(mockAPI)
+
+			ec2Client := EC2{
+				client: mockAPI,
+			}
+
+			cidrBlocks, err := ec2Client.SubnetCIDRBlocks(inSubnetIDFilter...)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCIDRBlocks, cidrBlocks)
+			}
+		})
+	}
+}
+
 func TestEC2_SecurityGroups(t *testing.T) {
 	testCases := map[string]struct {
 		inFilter []Filter
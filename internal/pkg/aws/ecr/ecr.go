@@ -31,6 +31,8 @@ type api interface {
 	GetAuthorizationToken(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
 	DescribeRepositories(*ecr.DescribeRepositoriesInput) (*ecr.DescribeRepositoriesOutput, error)
 	BatchDeleteImage(*ecr.BatchDeleteImageInput) (*ecr.BatchDeleteImageOutput, error)
+	DescribeImageScanFindings(*ecr.DescribeImageScanFindingsInput) (*ecr.DescribeImageScanFindingsOutput, error)
+	WaitUntilImageScanComplete(*ecr.DescribeImageScanFindingsInput) error
 }
 
 // ECR wraps an AWS ECR client.
@@ -84,6 +86,38 @@ func (c ECR) RepositoryURI(name string) (string, error) {
 	return *repo.RepositoryUri, nil
 }
 
+// ImageDigest returns the digest of the image with the given tag in the input ECR repository name.
+func (c ECR) ImageDigest(repoName, tag string) (string, error) {
+	resp, err := c.client.DescribeImages(&ecr.DescribeImagesInput{
+		RepositoryName: aws.String(repoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(tag),
+			},
+		},
+	})
+	if isImageNotFoundErr(err) {
+		return "", &ErrImageNotFound{repoName: repoName, tag: tag}
+	}
+	if err != nil {
+		return "", fmt.Errorf("ecr repo %s describe image with tag %s: %w", repoName, tag, err)
+	}
+	if len(resp.ImageDetails) == 0 {
+		return "", &ErrImageNotFound{repoName: repoName, tag: tag}
+	}
+	return aws.StringValue(resp.ImageDetails[0].ImageDigest), nil
+}
+
+// ErrImageNotFound occurs when no image with a tag exists in a repository.
+type ErrImageNotFound struct {
+	repoName string
+	tag      string
+}
+
+func (e *ErrImageNotFound) Error() string {
+	return fmt.Sprintf("no image found with tag %s in ecr repo %s", e.tag, e.repoName)
+}
+
 // Image houses metadata for ECR repository images.
 type Image struct {
 	Digest string
@@ -175,6 +209,52 @@ func (c ECR) ClearRepository(repoName string) error {
 	return err
 }
 
+// ScanFinding holds a vulnerability found by the scan of an image.
+type ScanFinding struct {
+	Name     string // Name of the vulnerability, such as a CVE ID.
+	Severity string // One of INFORMATIONAL, LOW, MEDIUM, HIGH, CRITICAL or UNDEFINED.
+	URI      string // Link to the description of the vulnerability.
+}
+
+// ImageScanFindings waits for the scan of the image with the input digest to complete,
+// then returns the vulnerabilities found in the image.
+func (c ECR) ImageScanFindings(repoName, digest string) ([]ScanFinding, error) {
+	imageID := &ecr.ImageIdentifier{
+		ImageDigest: aws.String(digest),
+	}
+	if err := c.client.WaitUntilImageScanComplete(&ecr.DescribeImageScanFindingsInput{
+		RepositoryName: aws.String(repoName),
+		ImageId:        imageID,
+	}); err != nil {
+		return nil, fmt.Errorf("wait for the scan of image %s in ecr repo %s to complete: %w", digest, repoName, err)
+	}
+	var findings []ScanFinding
+	var nextToken *string
+	for {
+		resp, err := c.client.DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+			RepositoryName: aws.String(repoName),
+			ImageId:        imageID,
+			NextToken:      nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("ecr repo %s describe scan findings of image %s: %w", repoName, digest, err)
+		}
+		if resp.ImageScanFindings != nil {
+			for _, finding := range resp.ImageScanFindings.Findings {
+				findings = append(findings, ScanFinding{
+					Name:     aws.StringValue(finding.Name),
+					Severity: aws.StringValue(finding.Severity),
+					URI:      aws.StringValue(finding.Uri),
+				})
+			}
+		}
+		if resp.NextToken == nil {
+			return findings, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
 // URIFromARN converts an ECR Repo ARN to a Repository URI
 func URIFromARN(repositoryARN string) (string, error) {
 	repoARN, err := arn.Parse(repositoryARN)
@@ -204,3 +284,11 @@ func isRepoNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+func isImageNotFoundErr(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	return aerr.Code() == ecr.ErrCodeImageNotFoundException
+}
@@ -141,6 +141,177 @@ func TestRepositoryURI(t *testing.T) {
 	}
 }
 
+func TestImageDigest(t *testing.T) {
+	mockError := errors.New("error")
+
+	mockRepoName := "mockRepoName"
+	mockTag := "v1.2.3"
+	mockInput := &ecr.DescribeImagesInput{
+		RepositoryName: aws.String(mockRepoName),
+		ImageIds: []*ecr.ImageIdentifier{
+			{
+				ImageTag: aws.String(mockTag),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantDigest string
+		wantErr    error
+	}{
+		"should return wrapped error given error returned from DescribeImages": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("ecr repo %s describe image with tag %s: %w", mockRepoName, mockTag, mockError),
+		},
+		"should return error given no images returned": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(&ecr.DescribeImagesOutput{}, nil)
+			},
+			wantErr: &ErrImageNotFound{repoName: mockRepoName, tag: mockTag},
+		},
+		"should return ErrImageNotFound if the tag doesn't exist": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(nil, awserr.New(ecr.ErrCodeImageNotFoundException, "some error", nil))
+			},
+			wantErr: &ErrImageNotFound{repoName: mockRepoName, tag: mockTag},
+		},
+		"should return image digest": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeImages(mockInput).Return(&ecr.DescribeImagesOutput{
+					ImageDetails: []*ecr.ImageDetail{
+						{
+							ImageDigest: aws.String("sha256:abc"),
+						},
+					},
+				}, nil)
+			},
+			wantDigest: "sha256:abc",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotDigest, gotErr := client.ImageDigest(mockRepoName, mockTag)
+
+			require.Equal(t, tc.wantDigest, gotDigest)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
+func TestImageScanFindings(t *testing.T) {
+	mockError := errors.New("some error")
+	mockRepoName := "mockRepoName"
+	mockDigest := "sha256:abc"
+	mockImageID := &ecr.ImageIdentifier{
+		ImageDigest: aws.String(mockDigest),
+	}
+
+	testCases := map[string]struct {
+		mockECRClient func(m *mocks.Mockapi)
+
+		wantFindings []ScanFinding
+		wantErr      error
+	}{
+		"should return wrapped error if the scan doesn't complete": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().WaitUntilImageScanComplete(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageId:        mockImageID,
+				}).Return(mockError)
+			},
+			wantErr: fmt.Errorf("wait for the scan of image %s in ecr repo %s to complete: %w", mockDigest, mockRepoName, mockError),
+		},
+		"should return wrapped error given error returned from DescribeImageScanFindings": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().WaitUntilImageScanComplete(gomock.Any()).Return(nil)
+				m.EXPECT().DescribeImageScanFindings(gomock.Any()).Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("ecr repo %s describe scan findings of image %s: %w", mockRepoName, mockDigest, mockError),
+		},
+		"should return the findings of every page": {
+			mockECRClient: func(m *mocks.Mockapi) {
+				m.EXPECT().WaitUntilImageScanComplete(gomock.Any()).Return(nil)
+				m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageId:        mockImageID,
+				}).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanFindings: &ecr.ImageScanFindings{
+						Findings: []*ecr.ImageScanFinding{
+							{
+								Name:     aws.String("CVE-2021-0001"),
+								Severity: aws.String("HIGH"),
+								Uri:      aws.String("https://cve.example.com/CVE-2021-0001"),
+							},
+						},
+					},
+					NextToken: aws.String("token"),
+				}, nil)
+				m.EXPECT().DescribeImageScanFindings(&ecr.DescribeImageScanFindingsInput{
+					RepositoryName: aws.String(mockRepoName),
+					ImageId:        mockImageID,
+					NextToken:      aws.String("token"),
+				}).Return(&ecr.DescribeImageScanFindingsOutput{
+					ImageScanFindings: &ecr.ImageScanFindings{
+						Findings: []*ecr.ImageScanFinding{
+							{
+								Name:     aws.String("CVE-2021-0002"),
+								Severity: aws.String("LOW"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantFindings: []ScanFinding{
+				{
+					Name:     "CVE-2021-0001",
+					Severity: "HIGH",
+					URI:      "https://cve.example.com/CVE-2021-0001",
+				},
+				{
+					Name:     "CVE-2021-0002",
+					Severity: "LOW",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockECRAPI := mocks.NewMockapi(ctrl)
+			tc.mockECRClient(mockECRAPI)
+
+			client := ECR{
+				mockECRAPI,
+			}
+
+			gotFindings, gotErr := client.ImageScanFindings(mockRepoName, mockDigest)
+
+			require.Equal(t, tc.wantFindings, gotFindings)
+			require.Equal(t, tc.wantErr, gotErr)
+		})
+	}
+}
+
 func TestURIFromARN(t *testing.T) {
 
 	testCases := map[string]struct {
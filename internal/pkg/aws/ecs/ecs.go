@@ -7,6 +7,7 @@ package ecs
 import (
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -40,6 +41,7 @@ type api interface {
 
 type ssmSessionStarter interface {
 	StartSession(ssmSession *ecs.Session) error
+	StartSessionWithIO(ssmSession *ecs.Session, stdin io.Reader, stdout io.Writer) error
 }
 
 // ECS wraps an AWS ECS client.
@@ -67,6 +69,10 @@ type ExecuteCommandInput struct {
 	Command   string
 	Task      string
 	Container string
+
+	// Stdin and Stdout replace the terminal as the input and output of the session if set.
+	Stdin  io.Reader
+	Stdout io.Writer
 }
 
 // New returns a Service configured against the input session.
@@ -397,7 +403,12 @@ func (e *ECS) ExecuteCommand(in ExecuteCommandInput) (err error) {
 		return &ErrExecuteCommand{err: err}
 	}
 	sessID := aws.StringValue(execCmdresp.Session.SessionId)
-	if err = e.newSessStarter().StartSession(execCmdresp.Session); err != nil {
+	if in.Stdin != nil || in.Stdout != nil {
+		err = e.newSessStarter().StartSessionWithIO(execCmdresp.Session, in.Stdin, in.Stdout)
+	} else {
+		err = e.newSessStarter().StartSession(execCmdresp.Session)
+	}
+	if err != nil {
 		err = fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
 	}
 	return err
@@ -4,8 +4,10 @@
 package ecs
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -1158,6 +1160,7 @@ func TestECS_ExecuteCommand(t *testing.T) {
 	}
 	mockErr := errors.New("some error")
 	testCases := map[string]struct {
+		inStdout        io.Writer
 		mockAPI         func(m *mocks.Mockapi)
 		mockSessStarter func(m *mocks.MockssmSessionStarter)
 		wantedError     error
@@ -1196,6 +1199,17 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				m.EXPECT().StartSession(mockSess).Return(nil)
 			},
 		},
+		"redirects the input and output of the session": {
+			inStdout: &bytes.Buffer{},
+			mockAPI: func(m *mocks.Mockapi) {
+				m.EXPECT().ExecuteCommand(mockExecCmdIn).Return(&ecs.ExecuteCommandOutput{
+					Session: mockSess,
+				}, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartSessionWithIO(mockSess, nil, &bytes.Buffer{}).Return(nil)
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1220,6 +1234,7 @@ func TestECS_ExecuteCommand(t *testing.T) {
 				Command:   "mockCommand",
 				Container: "mockContainer",
 				Task:      "mockTask",
+				Stdout:    tc.inStdout,
 			})
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
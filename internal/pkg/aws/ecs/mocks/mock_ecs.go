@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	io "io"
 	reflect "reflect"
 
 	ecs "github.com/aws/aws-sdk-go/service/ecs"
@@ -219,3 +220,17 @@ func (mr *MockssmSessionStarterMockRecorder) StartSession(ssmSession interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSession), ssmSession)
 }
+
+// StartSessionWithIO mocks base method.
+func (m *MockssmSessionStarter) StartSessionWithIO(ssmSession *ecs.Session, stdin io.Reader, stdout io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSessionWithIO", ssmSession, stdin, stdout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSessionWithIO indicates an expected call of StartSessionWithIO.
+func (mr *MockssmSessionStarterMockRecorder) StartSessionWithIO(ssmSession, stdin, stdout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSessionWithIO", reflect.TypeOf((*MockssmSessionStarter)(nil).StartSessionWithIO), ssmSession, stdin, stdout)
+}
@@ -134,6 +134,30 @@ func (t *Task) PrivateIP() (string, error) {
 	}
 }
 
+// SSMTarget returns the target of an SSM session to a container of the running task.
+// For example, "ecs:my-project-test-Cluster-9F7Y0RLP60R7_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2531612879".
+func (t *Task) SSMTarget(containerName string) (string, error) {
+	parsedARN, err := arn.Parse(aws.StringValue(t.ClusterArn))
+	if err != nil {
+		return "", fmt.Errorf("parse ECS cluster ARN: %w", err)
+	}
+	clusterName := strings.TrimPrefix(parsedARN.Resource, "cluster/")
+	taskID, err := TaskID(aws.StringValue(t.TaskArn))
+	if err != nil {
+		return "", err
+	}
+	for _, container := range t.Containers {
+		if aws.StringValue(container.Name) != containerName {
+			continue
+		}
+		if aws.StringValue(container.RuntimeId) == "" {
+			return "", fmt.Errorf("container %s of task %s is not running", containerName, taskID)
+		}
+		return fmt.Sprintf("ecs:%s_%s_%s", clusterName, taskID, aws.StringValue(container.RuntimeId)), nil
+	}
+	return "", fmt.Errorf("container %s not found in task %s", containerName, taskID)
+}
+
 func (t *Task) attachmentENI() (*ecs.Attachment, error) {
 	// Every Fargate task is provided with an ENI by default (https://docs.aws.amazon.com/AmazonECS/latest/userguide/fargate-task-networking.html).
 	// So an error is warranted if there is no ENI found.
@@ -223,6 +247,16 @@ func (t *TaskDefinition) Image(containerName string) (string, error) {
 	return "", fmt.Errorf("container %s not found", containerName)
 }
 
+// DockerLabels returns the docker labels of a container in the task definition.
+func (t *TaskDefinition) DockerLabels(containerName string) (map[string]string, error) {
+	for _, container := range t.ContainerDefinitions {
+		if aws.StringValue(container.Name) == containerName {
+			return aws.StringValueMap(container.DockerLabels), nil
+		}
+	}
+	return nil, fmt.Errorf("container %s not found", containerName)
+}
+
 // Command returns the container's command overrides of the task definition.
 func (t *TaskDefinition) Command(containerName string) ([]string, error) {
 	for _, container := range t.ContainerDefinitions {
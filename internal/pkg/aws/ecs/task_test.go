@@ -306,6 +306,68 @@ func TestTask_PrivateIP(t *testing.T) {
 	}
 }
 
+func TestTask_SSMTarget(t *testing.T) {
+	testCases := map[string]struct {
+		inContainer string
+		containers  []*ecs.Container
+
+		wantedTarget string
+		wantedErr    error
+	}{
+		"container not found": {
+			inContainer: "frontend",
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("firelens_log_router"),
+					RuntimeId: aws.String("abc-123"),
+				},
+			},
+			wantedErr: errors.New("container frontend not found in task 4082490ee6c245e09d2145010aa1ba8d"),
+		},
+		"container not running": {
+			inContainer: "frontend",
+			containers: []*ecs.Container{
+				{
+					Name: aws.String("frontend"),
+				},
+			},
+			wantedErr: errors.New("container frontend of task 4082490ee6c245e09d2145010aa1ba8d is not running"),
+		},
+		"success": {
+			inContainer: "frontend",
+			containers: []*ecs.Container{
+				{
+					Name:      aws.String("firelens_log_router"),
+					RuntimeId: aws.String("abc-123"),
+				},
+				{
+					Name:      aws.String("frontend"),
+					RuntimeId: aws.String("4082490ee6c245e09d2145010aa1ba8d-2531612879"),
+				},
+			},
+			wantedTarget: "ecs:my-project-test-Cluster-9F7Y0RLP60R7_4082490ee6c245e09d2145010aa1ba8d_4082490ee6c245e09d2145010aa1ba8d-2531612879",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := Task{
+				ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/my-project-test-Cluster-9F7Y0RLP60R7"),
+				TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/my-project-test-Cluster-9F7Y0RLP60R7/4082490ee6c245e09d2145010aa1ba8d"),
+				Containers: tc.containers,
+			}
+
+			target, err := task.SSMTarget(tc.inContainer)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTarget, target)
+			}
+		})
+	}
+}
+
 func Test_TaskID(t *testing.T) {
 	testCases := map[string]struct {
 		taskARN string
@@ -507,6 +569,56 @@ func TestTaskDefinition_Image(t *testing.T) {
 	}
 }
 
+func TestTaskDefinition_DockerLabels(t *testing.T) {
+	testCases := map[string]struct {
+		inContainerName string
+
+		wantedLabels map[string]string
+		wantedError  error
+	}{
+		"should return the container's docker labels": {
+			inContainerName: "container-2",
+			wantedLabels: map[string]string{
+				"team": "payments",
+			},
+		},
+		"container not found": {
+			inContainerName: "container-3",
+			wantedError:     errors.New("container container-3 not found"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			taskDefinition := TaskDefinition{
+				ContainerDefinitions: []*ecs.ContainerDefinition{
+					{
+						Name: aws.String("container-1"),
+					},
+					{
+						Name: aws.String("container-2"),
+						DockerLabels: map[string]*string{
+							"team": aws.String("payments"),
+						},
+					},
+				},
+			}
+
+			// WHEN
+			got, err := taskDefinition.DockerLabels(tc.inContainerName)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedLabels, got)
+		})
+	}
+}
+
 func TestTaskDefinition_Command(t *testing.T) {
 	testCases := map[string]struct {
 		inContainers    []*ecs.ContainerDefinition
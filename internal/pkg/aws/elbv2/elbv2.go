@@ -17,8 +17,13 @@ const (
 	TargetHealthStateHealthy = elbv2.TargetHealthStateEnumHealthy
 )
 
+// describeTagsMaxARNs is the max number of resource ARNs in a single DescribeTags call.
+const describeTagsMaxARNs = 20
+
 type api interface {
 	DescribeTargetHealth(input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+	DescribeRules(input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
+	DescribeTags(input *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error)
 }
 
 // ELBV2 wraps an AWS ELBV2 client.
@@ -53,6 +58,109 @@ func (e *ELBV2) TargetsHealth(targetGroupARN string) ([]*TargetHealth, error) {
 	return ret, nil
 }
 
+// ListenerRule holds the routing configuration of a listener rule.
+type ListenerRule struct {
+	Priority           string
+	PathPatterns       []string
+	HostHeaders        []string
+	HasOtherConditions bool              // Whether the rule also matches requests on conditions other than the path and host.
+	TargetGroupTags    map[string]string // Tags of the target groups that the rule forwards requests to.
+}
+
+// ListenerRules returns the rules of a listener along with the tags of the target groups that they forward to.
+func (e *ELBV2) ListenerRules(listenerARN string) ([]*ListenerRule, error) {
+	var rules []*elbv2.Rule
+	in := &elbv2.DescribeRulesInput{
+		ListenerArn: aws.String(listenerARN),
+	}
+	for {
+		out, err := e.client.DescribeRules(in)
+		if err != nil {
+			return nil, fmt.Errorf("describe rules of listener %s: %w", listenerARN, err)
+		}
+		rules = append(rules, out.Rules...)
+		if out.NextMarker == nil {
+			break
+		}
+		in.Marker = out.NextMarker
+	}
+
+	var tgARNs []string
+	for _, rule := range rules {
+		tgARNs = append(tgARNs, forwardedTargetGroups(rule)...)
+	}
+	tags, err := e.resourceTags(tgARNs)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*ListenerRule, len(rules))
+	for idx, rule := range rules {
+		lr := &ListenerRule{
+			Priority:        aws.StringValue(rule.Priority),
+			TargetGroupTags: make(map[string]string),
+		}
+		for _, cond := range rule.Conditions {
+			switch {
+			case cond.PathPatternConfig != nil:
+				lr.PathPatterns = append(lr.PathPatterns, aws.StringValueSlice(cond.PathPatternConfig.Values)...)
+			case cond.HostHeaderConfig != nil:
+				lr.HostHeaders = append(lr.HostHeaders, aws.StringValueSlice(cond.HostHeaderConfig.Values)...)
+			default:
+				lr.HasOtherConditions = true
+			}
+		}
+		for _, arn := range forwardedTargetGroups(rule) {
+			for k, v := range tags[arn] {
+				lr.TargetGroupTags[k] = v
+			}
+		}
+		ret[idx] = lr
+	}
+	return ret, nil
+}
+
+func (e *ELBV2) resourceTags(arns []string) (map[string]map[string]string, error) {
+	tags := make(map[string]map[string]string)
+	for start := 0; start < len(arns); start += describeTagsMaxARNs {
+		end := start + describeTagsMaxARNs
+		if end > len(arns) {
+			end = len(arns)
+		}
+		out, err := e.client.DescribeTags(&elbv2.DescribeTagsInput{
+			ResourceArns: aws.StringSlice(arns[start:end]),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe tags of target groups: %w", err)
+		}
+		for _, desc := range out.TagDescriptions {
+			resourceTags := make(map[string]string)
+			for _, tag := range desc.Tags {
+				resourceTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+			tags[aws.StringValue(desc.ResourceArn)] = resourceTags
+		}
+	}
+	return tags, nil
+}
+
+func forwardedTargetGroups(rule *elbv2.Rule) []string {
+	var arns []string
+	for _, action := range rule.Actions {
+		if action.TargetGroupArn != nil {
+			arns = append(arns, aws.StringValue(action.TargetGroupArn))
+			continue
+		}
+		if action.ForwardConfig == nil {
+			continue
+		}
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			arns = append(arns, aws.StringValue(tg.TargetGroupArn))
+		}
+	}
+	return arns
+}
+
 // TargetID returns the target's ID, which is either an instance or an IP address.
 func (t *TargetHealth) TargetID() string {
 	return t.targetID()
@@ -189,3 +189,140 @@ func TestTargetHealth_HealthStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestELBV2_ListenerRules(t *testing.T) {
+	testCases := map[string]struct {
+		setUpMock func(m *mocks.Mockapi)
+
+		wantedOut   []*ListenerRule
+		wantedError error
+	}{
+		"error if fail to describe rules": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe rules of listener mockListener: some error"),
+		},
+		"error if fail to describe the tags of the target groups": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(gomock.Any()).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Priority: aws.String("1"),
+							Actions: []*elbv2.Action{
+								{TargetGroupArn: aws.String("tg-1")},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeTags(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe tags of target groups: some error"),
+		},
+		"success": {
+			setUpMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("mockListener"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Priority: aws.String("1"),
+							Actions: []*elbv2.Action{
+								{TargetGroupArn: aws.String("tg-1")},
+							},
+							Conditions: []*elbv2.RuleCondition{
+								{
+									PathPatternConfig: &elbv2.PathPatternConditionConfig{
+										Values: aws.StringSlice([]string{"/api", "/api/*"}),
+									},
+								},
+								{
+									HostHeaderConfig: &elbv2.HostHeaderConditionConfig{
+										Values: aws.StringSlice([]string{"example.com"}),
+									},
+								},
+							},
+						},
+					},
+					NextMarker: aws.String("next"),
+				}, nil)
+				m.EXPECT().DescribeRules(&elbv2.DescribeRulesInput{
+					ListenerArn: aws.String("mockListener"),
+					Marker:      aws.String("next"),
+				}).Return(&elbv2.DescribeRulesOutput{
+					Rules: []*elbv2.Rule{
+						{
+							Priority: aws.String("default"),
+							Actions: []*elbv2.Action{
+								{
+									ForwardConfig: &elbv2.ForwardActionConfig{
+										TargetGroups: []*elbv2.TargetGroupTuple{
+											{TargetGroupArn: aws.String("tg-2")},
+										},
+									},
+								},
+							},
+							Conditions: []*elbv2.RuleCondition{
+								{
+									HttpHeaderConfig: &elbv2.HttpHeaderConditionConfig{
+										HttpHeaderName: aws.String("X-Canary"),
+									},
+								},
+							},
+						},
+					},
+				}, nil)
+				m.EXPECT().DescribeTags(&elbv2.DescribeTagsInput{
+					ResourceArns: aws.StringSlice([]string{"tg-1", "tg-2"}),
+				}).Return(&elbv2.DescribeTagsOutput{
+					TagDescriptions: []*elbv2.TagDescription{
+						{
+							ResourceArn: aws.String("tg-1"),
+							Tags: []*elbv2.Tag{
+								{Key: aws.String("copilot-service"), Value: aws.String("api")},
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedOut: []*ListenerRule{
+				{
+					Priority:        "1",
+					PathPatterns:    []string{"/api", "/api/*"},
+					HostHeaders:     []string{"example.com"},
+					TargetGroupTags: map[string]string{"copilot-service": "api"},
+				},
+				{
+					Priority:           "default",
+					HasOtherConditions: true,
+					TargetGroupTags:    map[string]string{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockAPI := mocks.NewMockapi(ctrl)
+			tc.setUpMock(mockAPI)
+
+			elbv2Client := ELBV2{
+				client: mockAPI,
+			}
+
+			// WHEN
+			out, err := elbv2Client.ListenerRules("mockListener")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOut, out)
+		})
+	}
+}
@@ -478,3 +478,32 @@ func TestS3_ParseURL(t *testing.T) {
 		})
 	}
 }
+
+func TestS3_URL(t *testing.T) {
+	testCases := map[string]struct {
+		inRegion string
+
+		wantedURL string
+	}{
+		"aws partition": {
+			inRegion:  "us-west-2",
+			wantedURL: "https://mockBucket.s3.us-west-2.amazonaws.com/scripts/dns-cert-validator/dd2278811c3",
+		},
+		"aws-cn partition": {
+			inRegion:  "cn-north-1",
+			wantedURL: "https://mockBucket.s3.cn-north-1.amazonaws.com.cn/scripts/dns-cert-validator/dd2278811c3",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			url := URL(tc.inRegion, "mockBucket", "scripts/dns-cert-validator/dd2278811c3")
+
+			require.Equal(t, tc.wantedURL, url)
+			bucket, key, err := ParseURL(url)
+			require.NoError(t, err)
+			require.Equal(t, "mockBucket", bucket)
+			require.Equal(t, "scripts/dns-cert-validator/dd2278811c3", key)
+		})
+	}
+}
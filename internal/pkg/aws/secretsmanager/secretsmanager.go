@@ -5,11 +5,14 @@
 package secretsmanager
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 )
@@ -17,6 +20,12 @@ import (
 type api interface {
 	CreateSecret(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
 	DeleteSecret(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
+	GetSecretValue(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+	PutSecretValue(*secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
+	TagResource(*secretsmanager.TagResourceInput) (*secretsmanager.TagResourceOutput, error)
+	RotateSecret(*secretsmanager.RotateSecretInput) (*secretsmanager.RotateSecretOutput, error)
+	ListSecrets(*secretsmanager.ListSecretsInput) (*secretsmanager.ListSecretsOutput, error)
+	DescribeSecret(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
 }
 
 // SecretsManager wraps the AWS SecretManager client.
@@ -40,6 +49,14 @@ func New() (*SecretsManager, error) {
 	}, nil
 }
 
+// NewWithSession returns a SecretsManager configured against the input session.
+func NewWithSession(s *session.Session) *SecretsManager {
+	return &SecretsManager{
+		secretsManager: secretsmanager.New(s),
+		sessionRegion:  aws.StringValue(s.Config.Region),
+	}
+}
+
 var secretTags = func() []*secretsmanager.Tag {
 	timestamp := time.Now().UTC().Format(time.UnixDate)
 	return []*secretsmanager.Tag{
@@ -88,6 +105,225 @@ func (s *SecretsManager) DeleteSecret(secretName string) error {
 	return nil
 }
 
+// GetSecretValue returns the string value of the secret with the given name or ARN.
+func (s *SecretsManager) GetSecretValue(secretName string) (string, error) {
+	resp, err := s.secretsManager.GetSecretValue(&secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get secret %s from secrets manager: %w", secretName, err)
+	}
+	return aws.StringValue(resp.SecretString), nil
+}
+
+// PutSecretInput contains fields needed to create or update a tagged secret.
+type PutSecretInput struct {
+	Name      string
+	Value     string
+	Overwrite bool
+	Tags      map[string]string
+
+	// Optional. If set, the secret is rotated by the Lambda function every RotationDays days.
+	RotationLambdaARN string
+	RotationDays      int64
+}
+
+// PutSecretOutput holds the ARN of the secret and whether it was created or overwritten.
+type PutSecretOutput struct {
+	ARN     string
+	Created bool
+}
+
+// PutSecret tries to create the secret with the tags, and overwrites it if the secret exists and `Overwrite` is true.
+// ErrSecretAlreadyExists is returned if the secret exists and `Overwrite` is false.
+// If a rotation Lambda function is given, rotation is configured once the value is stored.
+func (s *SecretsManager) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	out, err := s.createTaggedSecret(in)
+	if err != nil {
+		var errSecretExists *ErrSecretAlreadyExists
+		if !errors.As(err, &errSecretExists) || !in.Overwrite {
+			return nil, err
+		}
+		if out, err = s.overwriteSecret(in); err != nil {
+			return nil, err
+		}
+	}
+	if in.RotationLambdaARN == "" {
+		return out, nil
+	}
+	if _, err := s.secretsManager.RotateSecret(&secretsmanager.RotateSecretInput{
+		SecretId:          aws.String(in.Name),
+		RotationLambdaARN: aws.String(in.RotationLambdaARN),
+		RotationRules: &secretsmanager.RotationRulesType{
+			AutomaticallyAfterDays: aws.Int64(in.RotationDays),
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("configure rotation of secret %s: %w", in.Name, err)
+	}
+	return out, nil
+}
+
+// Secret holds the metadata of a secret.
+type Secret struct {
+	Name         string
+	ARN          string
+	LastModified time.Time
+
+	RotationEnabled   bool
+	RotationLambdaARN string
+	RotationDays      int64
+}
+
+// ListSecrets returns the secrets that have all the given tags.
+func (s *SecretsManager) ListSecrets(tags map[string]string) ([]Secret, error) {
+	var filters []*secretsmanager.Filter
+	for _, key := range sortedKeys(tags) {
+		filters = append(filters, &secretsmanager.Filter{
+			Key:    aws.String(secretsmanager.FilterNameStringTypeTagKey),
+			Values: aws.StringSlice([]string{key}),
+		}, &secretsmanager.Filter{
+			Key:    aws.String(secretsmanager.FilterNameStringTypeTagValue),
+			Values: aws.StringSlice([]string{tags[key]}),
+		})
+	}
+
+	var secrets []Secret
+	var nextToken *string
+	for {
+		out, err := s.secretsManager.ListSecrets(&secretsmanager.ListSecretsInput{
+			Filters:   filters,
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list secrets: %w", err)
+		}
+		for _, entry := range out.SecretList {
+			// The filters match tag keys and values independently, so make sure that each key has the wanted value.
+			if !hasTags(entry.Tags, tags) {
+				continue
+			}
+			secrets = append(secrets, Secret{
+				Name:              aws.StringValue(entry.Name),
+				ARN:               aws.StringValue(entry.ARN),
+				LastModified:      aws.TimeValue(entry.LastChangedDate),
+				RotationEnabled:   aws.BoolValue(entry.RotationEnabled),
+				RotationLambdaARN: aws.StringValue(entry.RotationLambdaARN),
+				RotationDays:      rotationDays(entry.RotationRules),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return secrets, nil
+}
+
+// DescribeSecret returns the metadata of the secret with the given name or ARN.
+// ErrSecretNotFound is returned if the secret doesn't exist.
+func (s *SecretsManager) DescribeSecret(secretName string) (*Secret, error) {
+	out, err := s.secretsManager.DescribeSecret(&secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(secretName),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return nil, &ErrSecretNotFound{
+				secretName: secretName,
+			}
+		}
+		return nil, fmt.Errorf("describe secret %s: %w", secretName, err)
+	}
+	return &Secret{
+		Name:              aws.StringValue(out.Name),
+		ARN:               aws.StringValue(out.ARN),
+		LastModified:      aws.TimeValue(out.LastChangedDate),
+		RotationEnabled:   aws.BoolValue(out.RotationEnabled),
+		RotationLambdaARN: aws.StringValue(out.RotationLambdaARN),
+		RotationDays:      rotationDays(out.RotationRules),
+	}, nil
+}
+
+func (s *SecretsManager) createTaggedSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	resp, err := s.secretsManager.CreateSecret(&secretsmanager.CreateSecretInput{
+		Name:         aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+		Tags:         convertTags(in.Tags),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == secretsmanager.ErrCodeResourceExistsException {
+			return nil, &ErrSecretAlreadyExists{
+				secretName: in.Name,
+				parentErr:  err,
+			}
+		}
+		return nil, fmt.Errorf("create secret %s: %w", in.Name, err)
+	}
+	return &PutSecretOutput{
+		ARN:     aws.StringValue(resp.ARN),
+		Created: true,
+	}, nil
+}
+
+func (s *SecretsManager) overwriteSecret(in PutSecretInput) (*PutSecretOutput, error) {
+	// CreateSecret is the only call that accepts tags, so the value and the tags are updated in two separate calls.
+	resp, err := s.secretsManager.PutSecretValue(&secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(in.Name),
+		SecretString: aws.String(in.Value),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update secret %s: %w", in.Name, err)
+	}
+	if _, err := s.secretsManager.TagResource(&secretsmanager.TagResourceInput{
+		SecretId: aws.String(in.Name),
+		Tags:     convertTags(in.Tags),
+	}); err != nil {
+		return nil, fmt.Errorf("add tags to secret %s: %w", in.Name, err)
+	}
+	return &PutSecretOutput{
+		ARN: aws.StringValue(resp.ARN),
+	}, nil
+}
+
+func hasTags(tags []*secretsmanager.Tag, wanted map[string]string) bool {
+	found := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		found[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	for key, value := range wanted {
+		if v, ok := found[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func rotationDays(rules *secretsmanager.RotationRulesType) int64 {
+	if rules == nil {
+		return 0
+	}
+	return aws.Int64Value(rules.AutomaticallyAfterDays)
+}
+
+func convertTags(in map[string]string) []*secretsmanager.Tag {
+	var tags []*secretsmanager.Tag
+	for _, key := range sortedKeys(in) {
+		tags = append(tags, &secretsmanager.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(in[key]),
+		})
+	}
+	return tags
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // ErrSecretAlreadyExists occurs if a secret with the same name already exists.
 type ErrSecretAlreadyExists struct {
 	secretName string
@@ -97,3 +333,12 @@ type ErrSecretAlreadyExists struct {
 func (err *ErrSecretAlreadyExists) Error() string {
 	return fmt.Sprintf("secret %s already exists", err.secretName)
 }
+
+// ErrSecretNotFound occurs if the secret doesn't exist.
+type ErrSecretNotFound struct {
+	secretName string
+}
+
+func (err *ErrSecretNotFound) Error() string {
+	return fmt.Sprintf("secret %s not found", err.secretName)
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -105,3 +106,384 @@ func TestSecretsManager_CreateSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretsManager_GetSecretValue(t *testing.T) {
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedValue   string
+		expectedError error
+	}{
+		"should wrap error returned by GetSecretValue": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String("github-token"),
+				}).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("get secret github-token from secrets manager: some error"),
+		},
+		"should return the secret string": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().GetSecretValue(&secretsmanager.GetSecretValueInput{
+					SecretId: aws.String("github-token"),
+				}).Return(&secretsmanager.GetSecretValueOutput{
+					SecretString: aws.String("H0NKH0NKH0NK"),
+				}, nil)
+			},
+			wantedValue: "H0NKH0NKH0NK",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+			tc.callMock(mockSecretsManager)
+
+			// WHEN
+			got, err := sm.GetSecretValue("github-token")
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, got)
+			}
+		})
+	}
+}
+
+func TestSecretsManager_PutSecret(t *testing.T) {
+	mockTags := []*secretsmanager.Tag{
+		{
+			Key:   aws.String("copilot-application"),
+			Value: aws.String("phonetool"),
+		},
+		{
+			Key:   aws.String("copilot-environment"),
+			Value: aws.String("test"),
+		},
+	}
+	mockExistsErr := awserr.New(secretsmanager.ErrCodeResourceExistsException, "", nil)
+	tests := map[string]struct {
+		inOverwrite      bool
+		inRotationLambda string
+		callMock         func(m *mocks.Mockapi)
+
+		wantedOutput  *PutSecretOutput
+		expectedError error
+	}{
+		"should create the secret with the tags": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(&secretsmanager.CreateSecretInput{
+					Name:         aws.String("copilot/phonetool/test/secrets/db_password"),
+					SecretString: aws.String("H0NKH0NKH0NK"),
+					Tags:         mockTags,
+				}).Return(&secretsmanager.CreateSecretOutput{
+					ARN: aws.String("arn-goose"),
+				}, nil)
+			},
+			wantedOutput: &PutSecretOutput{
+				ARN:     "arn-goose",
+				Created: true,
+			},
+		},
+		"should return ErrSecretAlreadyExists if the secret exists and overwrite is false": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockExistsErr)
+				m.EXPECT().PutSecretValue(gomock.Any()).Times(0)
+			},
+			expectedError: &ErrSecretAlreadyExists{
+				secretName: "copilot/phonetool/test/secrets/db_password",
+				parentErr:  mockExistsErr,
+			},
+		},
+		"should overwrite the value and the tags of an existing secret": {
+			inOverwrite: true,
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockExistsErr)
+				m.EXPECT().PutSecretValue(&secretsmanager.PutSecretValueInput{
+					SecretId:     aws.String("copilot/phonetool/test/secrets/db_password"),
+					SecretString: aws.String("H0NKH0NKH0NK"),
+				}).Return(&secretsmanager.PutSecretValueOutput{
+					ARN: aws.String("arn-goose"),
+				}, nil)
+				m.EXPECT().TagResource(&secretsmanager.TagResourceInput{
+					SecretId: aws.String("copilot/phonetool/test/secrets/db_password"),
+					Tags:     mockTags,
+				}).Return(&secretsmanager.TagResourceOutput{}, nil)
+			},
+			wantedOutput: &PutSecretOutput{
+				ARN: "arn-goose",
+			},
+		},
+		"should wrap the error if the tags can't be updated": {
+			inOverwrite: true,
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(nil, mockExistsErr)
+				m.EXPECT().PutSecretValue(gomock.Any()).Return(&secretsmanager.PutSecretValueOutput{}, nil)
+				m.EXPECT().TagResource(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("add tags to secret copilot/phonetool/test/secrets/db_password: some error"),
+		},
+		"should configure rotation": {
+			inRotationLambda: "arn:aws:lambda:us-west-2:1234:function:rotate",
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(&secretsmanager.CreateSecretOutput{
+					ARN: aws.String("arn-goose"),
+				}, nil)
+				m.EXPECT().RotateSecret(&secretsmanager.RotateSecretInput{
+					SecretId:          aws.String("copilot/phonetool/test/secrets/db_password"),
+					RotationLambdaARN: aws.String("arn:aws:lambda:us-west-2:1234:function:rotate"),
+					RotationRules: &secretsmanager.RotationRulesType{
+						AutomaticallyAfterDays: aws.Int64(30),
+					},
+				}).Return(&secretsmanager.RotateSecretOutput{}, nil)
+			},
+			wantedOutput: &PutSecretOutput{
+				ARN:     "arn-goose",
+				Created: true,
+			},
+		},
+		"should wrap the error if rotation can't be configured": {
+			inRotationLambda: "arn:aws:lambda:us-west-2:1234:function:rotate",
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().CreateSecret(gomock.Any()).Return(&secretsmanager.CreateSecretOutput{}, nil)
+				m.EXPECT().RotateSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("configure rotation of secret copilot/phonetool/test/secrets/db_password: some error"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.callMock(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			out, err := sm.PutSecret(PutSecretInput{
+				Name:      "copilot/phonetool/test/secrets/db_password",
+				Value:     "H0NKH0NKH0NK",
+				Overwrite: tc.inOverwrite,
+				Tags: map[string]string{
+					"copilot-environment": "test",
+					"copilot-application": "phonetool",
+				},
+				RotationLambdaARN: tc.inRotationLambda,
+				RotationDays:      30,
+			})
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out)
+		})
+	}
+}
+
+func TestSecretsManager_ListSecrets(t *testing.T) {
+	mockTime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	mockFilters := []*secretsmanager.Filter{
+		{
+			Key:    aws.String("tag-key"),
+			Values: aws.StringSlice([]string{"copilot-application"}),
+		},
+		{
+			Key:    aws.String("tag-value"),
+			Values: aws.StringSlice([]string{"phonetool"}),
+		},
+		{
+			Key:    aws.String("tag-key"),
+			Values: aws.StringSlice([]string{"copilot-environment"}),
+		},
+		{
+			Key:    aws.String("tag-value"),
+			Values: aws.StringSlice([]string{"test"}),
+		},
+	}
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedSecrets []Secret
+		expectedError error
+	}{
+		"should wrap error returned by ListSecrets": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("list secrets: some error"),
+		},
+		"should return secrets that have all the tags across pages": {
+			callMock: func(m *mocks.Mockapi) {
+				gomock.InOrder(
+					m.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+						Filters: mockFilters,
+					}).Return(&secretsmanager.ListSecretsOutput{
+						SecretList: []*secretsmanager.SecretListEntry{
+							{
+								Name:              aws.String("copilot/phonetool/test/secrets/db_password"),
+								ARN:               aws.String("arn-goose"),
+								LastChangedDate:   aws.Time(mockTime),
+								RotationEnabled:   aws.Bool(true),
+								RotationLambdaARN: aws.String("arn-rotate"),
+								RotationRules: &secretsmanager.RotationRulesType{
+									AutomaticallyAfterDays: aws.Int64(30),
+								},
+								Tags: []*secretsmanager.Tag{
+									{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+									{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+								},
+							},
+							{
+								// The environment of this secret is named "phonetool", and its application "test".
+								Name: aws.String("copilot/test/phonetool/secrets/db_password"),
+								Tags: []*secretsmanager.Tag{
+									{Key: aws.String("copilot-application"), Value: aws.String("test")},
+									{Key: aws.String("copilot-environment"), Value: aws.String("phonetool")},
+								},
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().ListSecrets(&secretsmanager.ListSecretsInput{
+						Filters:   mockFilters,
+						NextToken: aws.String("token"),
+					}).Return(&secretsmanager.ListSecretsOutput{
+						SecretList: []*secretsmanager.SecretListEntry{
+							{
+								Name: aws.String("copilot/phonetool/test/secrets/api_key"),
+								ARN:  aws.String("arn-duck"),
+								Tags: []*secretsmanager.Tag{
+									{Key: aws.String("copilot-application"), Value: aws.String("phonetool")},
+									{Key: aws.String("copilot-environment"), Value: aws.String("test")},
+								},
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedSecrets: []Secret{
+				{
+					Name:              "copilot/phonetool/test/secrets/db_password",
+					ARN:               "arn-goose",
+					LastModified:      mockTime,
+					RotationEnabled:   true,
+					RotationLambdaARN: "arn-rotate",
+					RotationDays:      30,
+				},
+				{
+					Name: "copilot/phonetool/test/secrets/api_key",
+					ARN:  "arn-duck",
+				},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.callMock(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			secrets, err := sm.ListSecrets(map[string]string{
+				"copilot-application": "phonetool",
+				"copilot-environment": "test",
+			})
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSecrets, secrets)
+		})
+	}
+}
+
+func TestSecretsManager_DescribeSecret(t *testing.T) {
+	tests := map[string]struct {
+		callMock func(m *mocks.Mockapi)
+
+		wantedSecret  *Secret
+		expectedError error
+	}{
+		"should return ErrSecretNotFound if the secret doesn't exist": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil))
+			},
+			expectedError: &ErrSecretNotFound{
+				secretName: "github-token",
+			},
+		},
+		"should wrap error returned by DescribeSecret": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			expectedError: errors.New("describe secret github-token: some error"),
+		},
+		"should return the metadata of the secret": {
+			callMock: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeSecret(&secretsmanager.DescribeSecretInput{
+					SecretId: aws.String("github-token"),
+				}).Return(&secretsmanager.DescribeSecretOutput{
+					Name: aws.String("github-token"),
+					ARN:  aws.String("arn-goose"),
+				}, nil)
+			},
+			wantedSecret: &Secret{
+				Name: "github-token",
+				ARN:  "arn-goose",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecretsManager := mocks.NewMockapi(ctrl)
+			tc.callMock(mockSecretsManager)
+			sm := SecretsManager{
+				secretsManager: mockSecretsManager,
+			}
+
+			// WHEN
+			secret, err := sm.DescribeSecret("github-token")
+
+			// THEN
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSecret, secret)
+		})
+	}
+}
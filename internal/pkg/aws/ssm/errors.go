@@ -13,3 +13,26 @@ type ErrParameterAlreadyExists struct {
 func (e *ErrParameterAlreadyExists) Error() string {
 	return fmt.Sprintf("parameter %s already exists", e.name)
 }
+
+// ErrParameterNotFound occurs when the parameter with name doesn't exist.
+type ErrParameterNotFound struct {
+	name string
+}
+
+func (e *ErrParameterNotFound) Error() string {
+	return fmt.Sprintf("parameter %s not found", e.name)
+}
+
+// ErrStartSession occurs when ssm:StartSession fails.
+type ErrStartSession struct {
+	err error
+}
+
+func (e *ErrStartSession) Error() string {
+	return fmt.Sprintf("start session: %s", e.err.Error())
+}
+
+// Unwrap returns the original error.
+func (e *ErrStartSession) Unwrap() error {
+	return e.err
+}
@@ -8,28 +8,51 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+)
+
+const (
+	portForwardingDocumentName = "AWS-StartPortForwardingSessionToRemoteHost"
+	// These parameter names are not defined as const in sdk.
+	portForwardingHostParam      = "host"
+	portForwardingPortParam      = "portNumber"
+	portForwardingLocalPortParam = "localPortNumber"
 )
 
 type api interface {
 	PutParameter(input *ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
 	AddTagsToResource(input *ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+	GetParameter(input *ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	DescribeParameters(input *ssm.DescribeParametersInput) (*ssm.DescribeParametersOutput, error)
+	DeleteParameter(input *ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	StartSession(input *ssm.StartSessionInput) (*ssm.StartSessionOutput, error)
+}
+
+type ssmSessionStarter interface {
+	StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error
 }
 
 // SSM wraps an AWS SSM client.
 type SSM struct {
-	client api
+	client         api
+	newSessStarter func() ssmSessionStarter
 }
 
 // New returns a SSM service configured against the input session.
 func New(s *session.Session) *SSM {
 	return &SSM{
 		client: ssm.New(s),
+		newSessStarter: func() ssmSessionStarter {
+			return exec.NewSSMPluginCommand(s)
+		},
 	}
 }
 
@@ -41,6 +64,14 @@ type PutSecretInput struct {
 	Tags      map[string]string
 }
 
+// PortForwardingSessionInput holds the fields needed to forward a local port to a remote host through a running container.
+type PortForwardingSessionInput struct {
+	Target     string // SSM target of the container, such as "ecs:<cluster name>_<task ID>_<container runtime ID>".
+	RemoteHost string
+	RemotePort int
+	LocalPort  int
+}
+
 // PutSecretOutput wraps an ssm PutParameterOutput struct.
 type PutSecretOutput ssm.PutParameterOutput
 
@@ -61,6 +92,106 @@ func (s *SSM) PutSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	return nil, err
 }
 
+// GetSecretValue returns the decrypted value of the parameter with the given name or ARN.
+func (s *SSM) GetSecretValue(name string) (string, error) {
+	out, err := s.client.GetParameter(&ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return "", fmt.Errorf("get parameter %s: %w", name, err)
+	}
+	return aws.StringValue(out.Parameter.Value), nil
+}
+
+// Secret holds the metadata of a SecureString parameter.
+type Secret struct {
+	Name         string
+	Version      int64
+	LastModified time.Time
+}
+
+// ListSecrets returns the SecureString parameters that have all the given tags.
+func (s *SSM) ListSecrets(tags map[string]string) ([]Secret, error) {
+	filters := []*ssm.ParameterStringFilter{
+		{
+			Key:    aws.String("Type"),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice([]string{ssm.ParameterTypeSecureString}),
+		},
+	}
+	for _, tag := range convertTags(tags) {
+		filters = append(filters, &ssm.ParameterStringFilter{
+			Key:    aws.String(fmt.Sprintf("tag:%s", aws.StringValue(tag.Key))),
+			Option: aws.String("Equals"),
+			Values: []*string{tag.Value},
+		})
+	}
+	return s.describeParameters(filters)
+}
+
+// DescribeSecret returns the metadata of the SecureString parameter with the given name.
+// ErrParameterNotFound is returned if the parameter doesn't exist.
+func (s *SSM) DescribeSecret(name string) (*Secret, error) {
+	secrets, err := s.describeParameters([]*ssm.ParameterStringFilter{
+		{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: aws.StringSlice([]string{name}),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(secrets) == 0 {
+		return nil, &ErrParameterNotFound{name}
+	}
+	return &secrets[0], nil
+}
+
+// DeleteSecret deletes the parameter with the given name.
+// ErrParameterNotFound is returned if the parameter doesn't exist.
+func (s *SSM) DeleteSecret(name string) error {
+	_, err := s.client.DeleteParameter(&ssm.DeleteParameterInput{
+		Name: aws.String(name),
+	})
+	if err == nil {
+		return nil
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == ssm.ErrCodeParameterNotFound {
+			return &ErrParameterNotFound{name}
+		}
+	}
+	return fmt.Errorf("delete parameter %s: %w", name, err)
+}
+
+func (s *SSM) describeParameters(filters []*ssm.ParameterStringFilter) ([]Secret, error) {
+	var secrets []Secret
+	var nextToken *string
+	for {
+		out, err := s.client.DescribeParameters(&ssm.DescribeParametersInput{
+			ParameterFilters: filters,
+			NextToken:        nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("describe parameters: %w", err)
+		}
+		for _, param := range out.Parameters {
+			secrets = append(secrets, Secret{
+				Name:         aws.StringValue(param.Name),
+				Version:      aws.Int64Value(param.Version),
+				LastModified: aws.TimeValue(param.LastModifiedDate),
+			})
+		}
+		if out.NextToken == nil {
+			break
+		}
+		nextToken = out.NextToken
+	}
+	return secrets, nil
+}
+
 func (s *SSM) createSecret(in PutSecretInput) (*PutSecretOutput, error) {
 	// Create a secret while adding the tags in a single call instead of separate calls to `PutParameter` and
 	// `AddTagsToResource` so that there won't be a case where the parameter is created while the tags are not added.
@@ -132,3 +263,26 @@ func convertTags(inTags map[string]string) []*ssm.Tag {
 	}
 	return tags
 }
+
+// StartPortForwardingSession forwards the local port to the remote host through the target,
+// and blocks until the session is terminated.
+func (s *SSM) StartPortForwardingSession(in PortForwardingSessionInput) error {
+	req := &ssm.StartSessionInput{
+		DocumentName: aws.String(portForwardingDocumentName),
+		Parameters: map[string][]*string{
+			portForwardingHostParam:      aws.StringSlice([]string{in.RemoteHost}),
+			portForwardingPortParam:      aws.StringSlice([]string{strconv.Itoa(in.RemotePort)}),
+			portForwardingLocalPortParam: aws.StringSlice([]string{strconv.Itoa(in.LocalPort)}),
+		},
+		Target: aws.String(in.Target),
+	}
+	resp, err := s.client.StartSession(req)
+	if err != nil {
+		return &ErrStartSession{err: err}
+	}
+	sessID := aws.StringValue(resp.SessionId)
+	if err := s.newSessStarter().StartPortForwardingSession(resp, req); err != nil {
+		return fmt.Errorf("start session %s using ssm plugin: %w", sessID, err)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 
@@ -361,3 +362,343 @@ func TestSSM_PutSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestSSM_GetSecretValue(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedValue string
+		wantedError error
+	}{
+		"returns the decrypted value of the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(&ssm.GetParameterInput{
+					Name:           aws.String("GH_TOKEN"),
+					WithDecryption: aws.Bool(true),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String("super secure token"),
+					},
+				}, nil)
+			},
+			wantedValue: "super secure token",
+		},
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get parameter GH_TOKEN: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.GetSecretValue("GH_TOKEN")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedValue, got)
+			}
+		})
+	}
+}
+
+func TestSSM_ListSecrets(t *testing.T) {
+	mockTime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecrets []Secret
+		wantedError   error
+	}{
+		"filters parameters by type and tags across pages": {
+			mockClient: func(m *mocks.Mockapi) {
+				filters := []*ssm.ParameterStringFilter{
+					{
+						Key:    aws.String("Type"),
+						Option: aws.String("Equals"),
+						Values: aws.StringSlice([]string{"SecureString"}),
+					},
+					{
+						Key:    aws.String("tag:copilot-application"),
+						Option: aws.String("Equals"),
+						Values: aws.StringSlice([]string{"phonetool"}),
+					},
+					{
+						Key:    aws.String("tag:copilot-environment"),
+						Option: aws.String("Equals"),
+						Values: aws.StringSlice([]string{"test"}),
+					},
+				}
+				gomock.InOrder(
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: filters,
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/phonetool/test/secrets/db_password"),
+								Version:          aws.Int64(2),
+								LastModifiedDate: aws.Time(mockTime),
+							},
+						},
+						NextToken: aws.String("token"),
+					}, nil),
+					m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+						ParameterFilters: filters,
+						NextToken:        aws.String("token"),
+					}).Return(&ssm.DescribeParametersOutput{
+						Parameters: []*ssm.ParameterMetadata{
+							{
+								Name:             aws.String("/copilot/phonetool/test/secrets/api_key"),
+								Version:          aws.Int64(1),
+								LastModifiedDate: aws.Time(mockTime),
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedSecrets: []Secret{
+				{
+					Name:         "/copilot/phonetool/test/secrets/db_password",
+					Version:      2,
+					LastModified: mockTime,
+				},
+				{
+					Name:         "/copilot/phonetool/test/secrets/api_key",
+					Version:      1,
+					LastModified: mockTime,
+				},
+			},
+		},
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe parameters: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.ListSecrets(map[string]string{
+				"copilot-application": "phonetool",
+				"copilot-environment": "test",
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecrets, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DescribeSecret(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedSecret *Secret
+		wantedError  error
+	}{
+		"returns the metadata of the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(&ssm.DescribeParametersInput{
+					ParameterFilters: []*ssm.ParameterStringFilter{
+						{
+							Key:    aws.String("Name"),
+							Option: aws.String("Equals"),
+							Values: aws.StringSlice([]string{"GH_TOKEN"}),
+						},
+					},
+				}).Return(&ssm.DescribeParametersOutput{
+					Parameters: []*ssm.ParameterMetadata{
+						{
+							Name:    aws.String("GH_TOKEN"),
+							Version: aws.Int64(3),
+						},
+					},
+				}, nil)
+			},
+			wantedSecret: &Secret{
+				Name:    "GH_TOKEN",
+				Version: 3,
+			},
+		},
+		"returns ErrParameterNotFound if there is no such parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeParameters(gomock.Any()).Return(&ssm.DescribeParametersOutput{}, nil)
+			},
+			wantedError: &ErrParameterNotFound{"GH_TOKEN"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			got, err := client.DescribeSecret("GH_TOKEN")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSecret, got)
+			}
+		})
+	}
+}
+
+func TestSSM_DeleteSecret(t *testing.T) {
+	testCases := map[string]struct {
+		mockClient func(*mocks.Mockapi)
+
+		wantedError error
+	}{
+		"deletes the parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(&ssm.DeleteParameterInput{
+					Name: aws.String("GH_TOKEN"),
+				}).Return(&ssm.DeleteParameterOutput{}, nil)
+			},
+		},
+		"returns ErrParameterNotFound if there is no such parameter": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, awserr.New(ssm.ErrCodeParameterNotFound, "not found", nil))
+			},
+			wantedError: &ErrParameterNotFound{"GH_TOKEN"},
+		},
+		"wraps the error": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DeleteParameter(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("delete parameter GH_TOKEN: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			client := SSM{
+				client: mockSSMClient,
+			}
+			tc.mockClient(mockSSMClient)
+
+			err := client.DeleteSecret("GH_TOKEN")
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSSM_StartPortForwardingSession(t *testing.T) {
+	mockReq := &ssm.StartSessionInput{
+		DocumentName: aws.String("AWS-StartPortForwardingSessionToRemoteHost"),
+		Parameters: map[string][]*string{
+			"host":            aws.StringSlice([]string{"db.example.com"}),
+			"portNumber":      aws.StringSlice([]string{"5432"}),
+			"localPortNumber": aws.StringSlice([]string{"15432"}),
+		},
+		Target: aws.String("ecs:cluster_task_runtime"),
+	}
+	mockResp := &ssm.StartSessionOutput{
+		SessionId: aws.String("mockSessID"),
+	}
+	testCases := map[string]struct {
+		mockClient      func(m *mocks.Mockapi)
+		mockSessStarter func(m *mocks.MockssmSessionStarter)
+
+		wantedError error
+	}{
+		"returns ErrStartSession if the session can't be started": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(mockReq).Return(nil, errors.New("some error"))
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {},
+			wantedError:     &ErrStartSession{err: errors.New("some error")},
+		},
+		"wraps the error from the ssm plugin": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(mockReq).Return(mockResp, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockResp, mockReq).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("start session mockSessID using ssm plugin: some error"),
+		},
+		"success": {
+			mockClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartSession(mockReq).Return(mockResp, nil)
+			},
+			mockSessStarter: func(m *mocks.MockssmSessionStarter) {
+				m.EXPECT().StartPortForwardingSession(mockResp, mockReq).Return(nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSMClient := mocks.NewMockapi(ctrl)
+			mockSessStarter := mocks.NewMockssmSessionStarter(ctrl)
+			tc.mockClient(mockSSMClient)
+			tc.mockSessStarter(mockSessStarter)
+			client := SSM{
+				client: mockSSMClient,
+				newSessStarter: func() ssmSessionStarter {
+					return mockSessStarter
+				},
+			}
+
+			err := client.StartPortForwardingSession(PortForwardingSessionInput{
+				Target:     "ecs:cluster_task_runtime",
+				RemoteHost: "db.example.com",
+				RemotePort: 5432,
+				LocalPort:  15432,
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,14 +6,28 @@ package stepfunctions
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sfn"
 )
 
+// Status of a state machine execution.
+const (
+	ExecutionStatusRunning   = sfn.ExecutionStatusRunning
+	ExecutionStatusSucceeded = sfn.ExecutionStatusSucceeded
+	ExecutionStatusFailed    = sfn.ExecutionStatusFailed
+	ExecutionStatusTimedOut  = sfn.ExecutionStatusTimedOut
+	ExecutionStatusAborted   = sfn.ExecutionStatusAborted
+)
+
 type api interface {
 	DescribeStateMachine(input *sfn.DescribeStateMachineInput) (*sfn.DescribeStateMachineOutput, error)
+	StartExecution(input *sfn.StartExecutionInput) (*sfn.StartExecutionOutput, error)
+	DescribeExecution(input *sfn.DescribeExecutionInput) (*sfn.DescribeExecutionOutput, error)
+	GetExecutionHistory(input *sfn.GetExecutionHistoryInput) (*sfn.GetExecutionHistoryOutput, error)
+	ListExecutions(input *sfn.ListExecutionsInput) (*sfn.ListExecutionsOutput, error)
 }
 
 // StepFunctions wraps an AWS StepFunctions client.
@@ -21,6 +35,15 @@ type StepFunctions struct {
 	client api
 }
 
+// Execution holds the fields of a state machine execution.
+type Execution struct {
+	ARN       string
+	Name      string
+	Status    string
+	StartDate time.Time
+	StopDate  *time.Time // Empty if the execution is still running.
+}
+
 // New returns StepFunctions configured against the input session.
 func New(s *session.Session) *StepFunctions {
 	return &StepFunctions{
@@ -39,3 +62,78 @@ func (s *StepFunctions) StateMachineDefinition(stateMachineARN string) (string,
 
 	return aws.StringValue(out.Definition), nil
 }
+
+// Execute starts an execution of the state machine and returns the ARN of the execution.
+func (s *StepFunctions) Execute(stateMachineARN string) (string, error) {
+	out, err := s.client.StartExecution(&sfn.StartExecutionInput{
+		StateMachineArn: aws.String(stateMachineARN),
+	})
+	if err != nil {
+		return "", fmt.Errorf("start execution of state machine %s: %w", stateMachineARN, err)
+	}
+	return aws.StringValue(out.ExecutionArn), nil
+}
+
+// DescribeExecution returns the name, status, and timestamps of a state machine execution.
+func (s *StepFunctions) DescribeExecution(executionARN string) (*Execution, error) {
+	out, err := s.client.DescribeExecution(&sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(executionARN),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("describe execution %s: %w", executionARN, err)
+	}
+	return &Execution{
+		ARN:       aws.StringValue(out.ExecutionArn),
+		Name:      aws.StringValue(out.Name),
+		Status:    aws.StringValue(out.Status),
+		StartDate: aws.TimeValue(out.StartDate),
+		StopDate:  out.StopDate,
+	}, nil
+}
+
+// ListExecutions returns up to maxResults of the most recent executions of the state machine, from newest to oldest.
+func (s *StepFunctions) ListExecutions(stateMachineARN string, maxResults int) ([]*Execution, error) {
+	out, err := s.client.ListExecutions(&sfn.ListExecutionsInput{
+		StateMachineArn: aws.String(stateMachineARN),
+		MaxResults:      aws.Int64(int64(maxResults)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list executions of state machine %s: %w", stateMachineARN, err)
+	}
+	executions := make([]*Execution, len(out.Executions))
+	for i, execution := range out.Executions {
+		executions[i] = &Execution{
+			ARN:       aws.StringValue(execution.ExecutionArn),
+			Name:      aws.StringValue(execution.Name),
+			Status:    aws.StringValue(execution.Status),
+			StartDate: aws.TimeValue(execution.StartDate),
+			StopDate:  execution.StopDate,
+		}
+	}
+	return executions, nil
+}
+
+// TaskSubmittedOutputs returns the outputs of all the tasks submitted by a state machine execution
+// in chronological order. For example, the output of an "ecs:runTask" task is the RunTask response in JSON.
+func (s *StepFunctions) TaskSubmittedOutputs(executionARN string) ([]string, error) {
+	var outputs []string
+	in := &sfn.GetExecutionHistoryInput{
+		ExecutionArn: aws.String(executionARN),
+	}
+	for {
+		out, err := s.client.GetExecutionHistory(in)
+		if err != nil {
+			return nil, fmt.Errorf("get execution history of %s: %w", executionARN, err)
+		}
+		for _, event := range out.Events {
+			if aws.StringValue(event.Type) != sfn.HistoryEventTypeTaskSubmitted || event.TaskSubmittedEventDetails == nil {
+				continue
+			}
+			outputs = append(outputs, aws.StringValue(event.TaskSubmittedEventDetails.Output))
+		}
+		if out.NextToken == nil {
+			return outputs, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
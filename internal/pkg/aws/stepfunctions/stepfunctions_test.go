@@ -7,6 +7,7 @@ package stepfunctions
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
@@ -68,3 +69,267 @@ func TestStepFunctions_StateMachineDefinition(t *testing.T) {
 		})
 	}
 }
+
+func TestStepFunctions_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError error
+		wantedARN   string
+	}{
+		"fail to start execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String("ninth inning"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("start execution of state machine ninth inning: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().StartExecution(&sfn.StartExecutionInput{
+					StateMachineArn: aws.String("ninth inning"),
+				}).Return(&sfn.StartExecutionOutput{
+					ExecutionArn: aws.String("walk-off"),
+				}, nil)
+			},
+			wantedARN: "walk-off",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.Execute("ninth inning")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_DescribeExecution(t *testing.T) {
+	startDate := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(2 * time.Minute)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError     error
+		wantedExecution *Execution
+	}{
+		"fail to describe execution": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(&sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String("walk-off"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe execution walk-off: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().DescribeExecution(&sfn.DescribeExecutionInput{
+					ExecutionArn: aws.String("walk-off"),
+				}).Return(&sfn.DescribeExecutionOutput{
+					ExecutionArn: aws.String("walk-off"),
+					Name:         aws.String("homerun"),
+					Status:       aws.String(sfn.ExecutionStatusSucceeded),
+					StartDate:    aws.Time(startDate),
+					StopDate:     aws.Time(stopDate),
+				}, nil)
+			},
+			wantedExecution: &Execution{
+				ARN:       "walk-off",
+				Name:      "homerun",
+				Status:    ExecutionStatusSucceeded,
+				StartDate: startDate,
+				StopDate:  &stopDate,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.DescribeExecution("walk-off")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecution, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_TaskSubmittedOutputs(t *testing.T) {
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError   error
+		wantedOutputs []string
+	}{
+		"fail to get execution history": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("walk-off"),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get execution history of walk-off: some error"),
+		},
+		"success with pagination": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("walk-off"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeExecutionStarted),
+						},
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String("first"),
+							},
+						},
+					},
+					NextToken: aws.String("next"),
+				}, nil)
+				m.EXPECT().GetExecutionHistory(&sfn.GetExecutionHistoryInput{
+					ExecutionArn: aws.String("walk-off"),
+					NextToken:    aws.String("next"),
+				}).Return(&sfn.GetExecutionHistoryOutput{
+					Events: []*sfn.HistoryEvent{
+						{
+							Type: aws.String(sfn.HistoryEventTypeTaskSubmitted),
+							TaskSubmittedEventDetails: &sfn.TaskSubmittedEventDetails{
+								Output: aws.String("second"),
+							},
+						},
+					},
+				}, nil)
+			},
+			wantedOutputs: []string{"first", "second"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.TaskSubmittedOutputs("walk-off")
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutputs, out)
+			}
+		})
+	}
+}
+
+func TestStepFunctions_ListExecutions(t *testing.T) {
+	startDate := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(2 * time.Minute)
+	testCases := map[string]struct {
+		mockStepFunctionsClient func(m *mocks.Mockapi)
+
+		wantedError      error
+		wantedExecutions []*Execution
+	}{
+		"fail to list executions": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("ninth inning"),
+					MaxResults:      aws.Int64(2),
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of state machine ninth inning: some error"),
+		},
+		"success": {
+			mockStepFunctionsClient: func(m *mocks.Mockapi) {
+				m.EXPECT().ListExecutions(&sfn.ListExecutionsInput{
+					StateMachineArn: aws.String("ninth inning"),
+					MaxResults:      aws.Int64(2),
+				}).Return(&sfn.ListExecutionsOutput{
+					Executions: []*sfn.ExecutionListItem{
+						{
+							ExecutionArn: aws.String("walk-off"),
+							Name:         aws.String("homerun"),
+							Status:       aws.String(sfn.ExecutionStatusRunning),
+							StartDate:    aws.Time(stopDate),
+						},
+						{
+							ExecutionArn: aws.String("strikeout"),
+							Name:         aws.String("swing"),
+							Status:       aws.String(sfn.ExecutionStatusFailed),
+							StartDate:    aws.Time(startDate),
+							StopDate:     aws.Time(stopDate),
+						},
+					},
+				}, nil)
+			},
+			wantedExecutions: []*Execution{
+				{
+					ARN:       "walk-off",
+					Name:      "homerun",
+					Status:    ExecutionStatusRunning,
+					StartDate: stopDate,
+				},
+				{
+					ARN:       "strikeout",
+					Name:      "swing",
+					Status:    ExecutionStatusFailed,
+					StartDate: startDate,
+					StopDate:  &stopDate,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStepFunctionsClient := mocks.NewMockapi(ctrl)
+			tc.mockStepFunctionsClient(mockStepFunctionsClient)
+			sfn := StepFunctions{
+				client: mockStepFunctionsClient,
+			}
+
+			out, err := sfn.ListExecutions("ninth inning", 2)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutions, out)
+			}
+		})
+	}
+}
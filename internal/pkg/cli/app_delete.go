@@ -52,6 +52,7 @@ type deleteAppOpts struct {
 
 	store                store
 	ws                   wsFileDeleter
+	pipelineLister       wsPipelineLister
 	sessProvider         sessionProvider
 	cfn                  deployer
 	prompt               prompter
@@ -60,7 +61,7 @@ type deleteAppOpts struct {
 	jobDeleteExecutor    func(jobName string) (executor, error)
 	envDeleteExecutor    func(envName string) (executeAsker, error)
 	taskDeleteExecutor   func(envName, taskName string) (executor, error)
-	deletePipelineRunner func() (cmd, error)
+	deletePipelineRunner func(pipelineName string) (cmd, error)
 }
 
 func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
@@ -81,13 +82,14 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 	}
 
 	return &deleteAppOpts{
-		deleteAppVars: vars,
-		spinner:       termprogress.NewSpinner(log.DiagnosticWriter),
-		store:         store,
-		ws:            ws,
-		sessProvider:  provider,
-		cfn:           cloudformation.New(defaultSession),
-		prompt:        prompt.New(),
+		deleteAppVars:  vars,
+		spinner:        termprogress.NewSpinner(log.DiagnosticWriter),
+		store:          store,
+		ws:             ws,
+		pipelineLister: ws,
+		sessProvider:   provider,
+		cfn:            cloudformation.New(defaultSession),
+		prompt:         prompt.New(),
 		s3: func(session *session.Session) bucketEmptier {
 			return s3.New(session)
 		},
@@ -136,9 +138,10 @@ func newDeleteAppOpts(vars deleteAppVars) (*deleteAppOpts, error) {
 			}
 			return opts, nil
 		},
-		deletePipelineRunner: func() (cmd, error) {
+		deletePipelineRunner: func(pipelineName string) (cmd, error) {
 			opts, err := newDeletePipelineOpts(deletePipelineVars{
 				appName:            vars.name,
+				name:               pipelineName,
 				skipConfirmation:   true,
 				shouldDeleteSecret: true,
 			})
@@ -198,9 +201,9 @@ func (o *deleteAppOpts) Execute() error {
 		return err
 	}
 
-	// deletePipeline must happen before deleteAppResources and deleteWs, since the pipeline delete command relies
+	// deletePipelines must happen before deleteAppResources and deleteWs, since the pipeline delete command relies
 	// on the application stackset as well as the workspace directory to still exist.
-	if err := o.deletePipeline(); err != nil {
+	if err := o.deletePipelines(); err != nil {
 		if !errors.Is(err, workspace.ErrNoPipelineInWorkspace) {
 			return err
 		}
@@ -320,12 +323,21 @@ func (o *deleteAppOpts) emptyS3Bucket() error {
 	return nil
 }
 
-func (o *deleteAppOpts) deletePipeline() error {
-	cmd, err := o.deletePipelineRunner()
+func (o *deleteAppOpts) deletePipelines() error {
+	pipelines, err := o.pipelineLister.ListPipelines()
 	if err != nil {
 		return err
 	}
-	return run(cmd)
+	for _, pipeline := range pipelines {
+		cmd, err := o.deletePipelineRunner(pipeline.Name)
+		if err != nil {
+			return err
+		}
+		if err := run(cmd); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (o *deleteAppOpts) deleteAppResources() error {
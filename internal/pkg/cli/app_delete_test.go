@@ -138,6 +138,7 @@ type deleteAppMocks struct {
 	taskDeleter     *mocks.Mockexecutor
 	bucketEmptier   *mocks.MockbucketEmptier
 	pipelineDeleter *mocks.Mockcmd
+	pipelineLister  *mocks.MockwsPipelineLister
 }
 
 func TestDeleteAppOpts_Execute(t *testing.T) {
@@ -210,6 +211,9 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppCleanResourcesStopMsg)),
 
 					// delete pipeline
+					mocks.pipelineLister.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+						{Name: "my-pipeline", Path: "copilot/pipelines/my-pipeline/manifest.yml"},
+					}, nil),
 					mocks.pipelineDeleter.EXPECT().Validate().Return(nil),
 					mocks.pipelineDeleter.EXPECT().Ask().Return(nil),
 					mocks.pipelineDeleter.EXPECT().Execute().Return(nil),
@@ -262,9 +266,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 					mocks.spinner.EXPECT().Stop(log.Ssuccess(deleteAppCleanResourcesStopMsg)),
 
 					// delete pipeline
-					mocks.pipelineDeleter.EXPECT().Validate().Return(nil),
-					mocks.pipelineDeleter.EXPECT().Ask().Return(nil),
-					mocks.pipelineDeleter.EXPECT().Execute().Return(nil),
+					mocks.pipelineLister.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 
 					// deleteAppResources
 					mocks.spinner.EXPECT().Start(deleteAppResourcesStartMsg),
@@ -325,9 +327,10 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 			}
 
 			mockPipelineDeleteCmd := mocks.NewMockcmd(ctrl)
-			mockRunnerProvider := func() (cmd, error) {
+			mockRunnerProvider := func(pipelineName string) (cmd, error) {
 				return mockPipelineDeleteCmd, nil
 			}
+			mockPipelineLister := mocks.NewMockwsPipelineLister(ctrl)
 
 			mocks := deleteAppMocks{
 				spinner:         mockSpinner,
@@ -341,6 +344,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				taskDeleter:     mockTaskDeleteExecutor,
 				bucketEmptier:   mockBucketEmptier,
 				pipelineDeleter: mockPipelineDeleteCmd,
+				pipelineLister:  mockPipelineLister,
 			}
 			test.setupMocks(mocks)
 
@@ -351,6 +355,7 @@ func TestDeleteAppOpts_Execute(t *testing.T) {
 				spinner:              mockSpinner,
 				store:                mockStore,
 				ws:                   mockWorkspace,
+				pipelineLister:       mockPipelineLister,
 				sessProvider:         mockSession,
 				cfn:                  mockDeployer,
 				s3:                   mockGetBucketEmptier,
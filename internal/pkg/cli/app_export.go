@@ -0,0 +1,165 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+const (
+	appExportNamePrompt     = "Which application would you like to export?"
+	appExportNameHelpPrompt = "An application is a collection of related services."
+
+	// appArchiveVersion is the version of the file format written by "app export".
+	appArchiveVersion = 1
+)
+
+// appArchive is the portable representation of an application written by "app export" and read by "app import".
+type appArchive struct {
+	Version      int                       `json:"version"`
+	Application  *config.Application       `json:"application"`
+	Environments []*config.Environment     `json:"environments,omitempty"`
+	Workloads    []*config.Workload        `json:"workloads,omitempty"`
+	Resources    *stack.AppResourcesConfig `json:"resources,omitempty"` // Resources deployed by the application StackSet.
+}
+
+type appExportVars struct {
+	name       string
+	outputFile string
+}
+
+type appExportOpts struct {
+	appExportVars
+
+	store     store
+	resources appResourcesConfigGetter
+	sel       appSelector
+	fs        afero.Fs
+	w         io.Writer
+}
+
+func newAppExportOpts(vars appExportVars) (*appExportOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	sess, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	return &appExportOpts{
+		appExportVars: vars,
+		store:         store,
+		resources:     cloudformation.New(sess),
+		sel:           selector.NewSelect(prompt.New(), store),
+		fs:            &afero.Afero{Fs: afero.NewOsFs()},
+		w:             os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *appExportOpts) Validate() error {
+	if o.name != "" {
+		if _, err := o.store.GetApplication(o.name); err != nil {
+			return fmt.Errorf("get application %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *appExportOpts) Ask() error {
+	if o.name != "" {
+		return nil
+	}
+	name, err := o.sel.Application(appExportNamePrompt, appExportNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.name = name
+	return nil
+}
+
+// Execute writes the metadata of the application, its environments and workloads, and the configuration
+// of its StackSet to a file that can be imported in another account with "app import".
+func (o *appExportOpts) Execute() error {
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	envs, err := o.store.ListEnvironments(o.name)
+	if err != nil {
+		return fmt.Errorf("list environments of application %s: %w", o.name, err)
+	}
+	wls, err := o.store.ListWorkloads(o.name)
+	if err != nil {
+		return fmt.Errorf("list workloads of application %s: %w", o.name, err)
+	}
+	resources, err := o.resources.AppResourcesConfig(app)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(appArchive{
+		Version:      appArchiveVersion,
+		Application:  app,
+		Environments: envs,
+		Workloads:    wls,
+		Resources:    resources,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal application %s: %w", o.name, err)
+	}
+	data = append(data, '\n')
+
+	if o.outputFile == "" {
+		if _, err := o.w.Write(data); err != nil {
+			return fmt.Errorf("write application %s: %w", o.name, err)
+		}
+		return nil
+	}
+	if err := afero.WriteFile(o.fs, o.outputFile, data, 0644); err != nil {
+		return fmt.Errorf("write application %s to %s: %w", o.name, o.outputFile, err)
+	}
+	log.Successf("Exported application %s to %s.\n", color.HighlightUserInput(o.name), color.HighlightResource(o.outputFile))
+	return nil
+}
+
+// buildAppExportCmd builds the command to export an application to a file.
+func buildAppExportCmd() *cobra.Command {
+	vars := appExportVars{}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports an application so that it can be imported in another account.",
+		Long: `Exports an application so that it can be imported in another account.
+The file holds the metadata of the application, its environments and workloads,
+and the configuration of the application's StackSet.`,
+		Example: `
+  Export the application "my-app" to a file.
+  /code $ copilot app export -n my-app --output-file my-app.json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppExportOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.outputFile, outputFileFlag, "", appExportOutputFileFlagDescription)
+	return cmd
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type appExportMocks struct {
+	store     *mocks.Mockstore
+	resources *mocks.MockappResourcesConfigGetter
+}
+
+func TestAppExportOpts_Execute(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "phonetool",
+		AccountID: "1234",
+		Domain:    "phonetool.com",
+	}
+	wantedArchive := `{
+  "version": 1,
+  "application": {
+    "name": "phonetool",
+    "account": "1234",
+    "domain": "phonetool.com",
+    "domainHostedZoneID": "",
+    "version": ""
+  },
+  "environments": [
+    {
+      "app": "phonetool",
+      "name": "test",
+      "region": "us-west-2",
+      "accountID": "5678",
+      "prod": false,
+      "registryURL": "",
+      "executionRoleARN": "",
+      "managerRoleARN": ""
+    }
+  ],
+  "workloads": [
+    {
+      "app": "phonetool",
+      "name": "frontend",
+      "type": "Load Balanced Web Service"
+    }
+  ],
+  "resources": {
+    "accounts": [
+      "1234",
+      "5678"
+    ],
+    "services": [
+      "frontend"
+    ]
+  }
+}
+`
+	testCases := map[string]struct {
+		inOutputFile string
+		setupMocks   func(m appExportMocks)
+
+		wantedErr    error
+		wantedOutput string
+		wantedFile   string
+	}{
+		"writes the application to stdout": {
+			setupMocks: func(m appExportMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test", Region: "us-west-2", AccountID: "5678"},
+				}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{
+					{App: "phonetool", Name: "frontend", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.resources.EXPECT().AppResourcesConfig(mockApp).Return(&stack.AppResourcesConfig{
+					Accounts: []string{"1234", "5678"},
+					Services: []string{"frontend"},
+					App:      "phonetool",
+					Version:  7,
+				}, nil)
+			},
+			wantedOutput: wantedArchive,
+		},
+		"writes the application to the output file": {
+			inOutputFile: "phonetool.json",
+			setupMocks: func(m appExportMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test", Region: "us-west-2", AccountID: "5678"},
+				}, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return([]*config.Workload{
+					{App: "phonetool", Name: "frontend", Type: "Load Balanced Web Service"},
+				}, nil)
+				m.resources.EXPECT().AppResourcesConfig(mockApp).Return(&stack.AppResourcesConfig{
+					Accounts: []string{"1234", "5678"},
+					Services: []string{"frontend"},
+				}, nil)
+			},
+			wantedFile: wantedArchive,
+		},
+		"wraps the error if the environments can't be listed": {
+			setupMocks: func(m appExportMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("list environments of application phonetool: some error"),
+		},
+		"returns the error if the resources of the application can't be retrieved": {
+			setupMocks: func(m appExportMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(mockApp, nil)
+				m.store.EXPECT().ListEnvironments("phonetool").Return(nil, nil)
+				m.store.EXPECT().ListWorkloads("phonetool").Return(nil, nil)
+				m.resources.EXPECT().AppResourcesConfig(mockApp).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appExportMocks{
+				store:     mocks.NewMockstore(ctrl),
+				resources: mocks.NewMockappResourcesConfigGetter(ctrl),
+			}
+			tc.setupMocks(m)
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			out := &bytes.Buffer{}
+
+			opts := &appExportOpts{
+				appExportVars: appExportVars{
+					name:       "phonetool",
+					outputFile: tc.inOutputFile,
+				},
+				store:     m.store,
+				resources: m.resources,
+				fs:        fs,
+				w:         out,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, out.String())
+			if tc.wantedFile != "" {
+				content, err := fs.ReadFile(tc.inOutputFile)
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedFile, string(content))
+			}
+		})
+	}
+}
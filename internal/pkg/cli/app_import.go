@@ -0,0 +1,352 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/dustin/go-humanize/english"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+)
+
+type appImportVars struct {
+	inputFile   string
+	dryRun      bool
+	envProfiles map[string]string // Named profiles to update the environments with, keyed by environment name.
+}
+
+type appImportOpts struct {
+	appImportVars
+
+	store    store
+	fs       afero.Fs
+	identity identityService
+	route53  domainHostedZoneGetter
+	deployer appResourcesImporter
+	prog     progress
+
+	newEnvTrustUpdater func(env *config.Environment) (envTrustUpdater, error)
+
+	archive *appArchive // Cached archive read from the input file.
+}
+
+// appImportStep is an idempotent step of the import of an application.
+type appImportStep struct {
+	desc string
+	run  func() error
+}
+
+func newAppImportOpts(vars appImportVars) (*appImportOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	sessProvider := sessions.NewProvider()
+	sess, err := sessProvider.Default()
+	if err != nil {
+		return nil, err
+	}
+	return &appImportOpts{
+		appImportVars: vars,
+		store:         store,
+		fs:            &afero.Afero{Fs: afero.NewOsFs()},
+		identity:      identity.New(sess),
+		route53:       route53.New(sess),
+		deployer:      cloudformation.New(sess),
+		prog:          termprogress.NewSpinner(log.DiagnosticWriter),
+		newEnvTrustUpdater: func(env *config.Environment) (envTrustUpdater, error) {
+			profile, ok := vars.envProfiles[env.Name]
+			if !ok {
+				envSess, err := sessProvider.DefaultWithRegion(env.Region)
+				if err != nil {
+					return nil, err
+				}
+				return cloudformation.New(envSess), nil
+			}
+			envSess, err := sessProvider.FromProfile(profile)
+			if err != nil {
+				return nil, fmt.Errorf("create session from profile %s: %w", profile, err)
+			}
+			return cloudformation.New(envSess.Copy(&aws.Config{
+				Region: aws.String(env.Region),
+			})), nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the input file can't be read or wasn't written by "app export".
+func (o *appImportOpts) Validate() error {
+	if o.inputFile == "" {
+		return fmt.Errorf("--%s is required", inputFileFlag)
+	}
+	data, err := afero.ReadFile(o.fs, o.inputFile)
+	if err != nil {
+		return fmt.Errorf("read input file %s: %w", o.inputFile, err)
+	}
+	var archive appArchive
+	if err := json.Unmarshal(data, &archive); err != nil {
+		return fmt.Errorf("unmarshal input file %s: %w", o.inputFile, err)
+	}
+	if archive.Version != appArchiveVersion {
+		return fmt.Errorf("input file %s has version %d, which isn't supported by this version of Copilot", o.inputFile, archive.Version)
+	}
+	if archive.Application == nil || archive.Application.Name == "" {
+		return fmt.Errorf("input file %s doesn't hold an application", o.inputFile)
+	}
+	for _, env := range archive.Environments {
+		if env.App != archive.Application.Name {
+			return fmt.Errorf("environment %s in input file %s belongs to application %s instead of %s", env.Name, o.inputFile, env.App, archive.Application.Name)
+		}
+	}
+	for _, wl := range archive.Workloads {
+		if wl.App != archive.Application.Name {
+			return fmt.Errorf("workload %s in input file %s belongs to application %s instead of %s", wl.Name, o.inputFile, wl.App, archive.Application.Name)
+		}
+	}
+	envs := make(map[string]bool, len(archive.Environments))
+	for _, env := range archive.Environments {
+		envs[env.Name] = true
+	}
+	for name := range o.envProfiles {
+		if !envs[name] {
+			return fmt.Errorf("environment %s of --%s is not in input file %s", name, envProfilesFlag, o.inputFile)
+		}
+	}
+	o.archive = &archive
+	return nil
+}
+
+// Ask is a no-op for this command.
+func (o *appImportOpts) Ask() error {
+	return nil
+}
+
+// Execute recreates the application in the current account from the input file.
+// Every step can be safely retried if the import fails halfway.
+func (o *appImportOpts) Execute() error {
+	caller, err := o.identity.Get()
+	if err != nil {
+		return fmt.Errorf("get identity: %w", err)
+	}
+	if err := o.validateEnvProfiles(caller.Account); err != nil {
+		return err
+	}
+	app := *o.archive.Application
+	app.AccountID = caller.Account
+	if app.Domain != "" {
+		hostedZoneID, err := o.route53.DomainHostedZoneID(app.Domain)
+		if err != nil {
+			return fmt.Errorf("get hosted zone ID for domain %s in account %s: %w", app.Domain, caller.Account, err)
+		}
+		app.DomainHostedZoneID = hostedZoneID
+	}
+
+	steps := o.steps(&app, caller)
+	if o.dryRun {
+		log.Infof("Importing application %s to account %s would run the following steps:\n", color.HighlightUserInput(app.Name), color.HighlightResource(app.AccountID))
+		for i, step := range steps {
+			log.Infof("  %d. %s\n", i+1, step.desc)
+		}
+		return nil
+	}
+	for _, step := range steps {
+		o.prog.Start(step.desc)
+		if err := step.run(); err != nil {
+			o.prog.Stop(log.Serrorf("%s\n", step.desc))
+			return err
+		}
+		o.prog.Stop(log.Ssuccessf("%s\n", step.desc))
+	}
+	log.Successf("Imported application %s to account %s.\n", color.HighlightUserInput(app.Name), color.HighlightResource(app.AccountID))
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *appImportOpts) RecommendActions() error {
+	if o.dryRun {
+		return nil
+	}
+	logRecommendedActions([]string{
+		fmt.Sprintf("Run %s to make sure the application is fully imported.", color.HighlightCode("copilot app show")),
+		fmt.Sprintf("Run %s to redeploy your services and jobs.", color.HighlightCode("copilot deploy")),
+	})
+	return nil
+}
+
+// movesAccount returns true if the application is imported to another account than the one it was exported from.
+func (o *appImportOpts) movesAccount(account string) bool {
+	return account != o.archive.Application.AccountID
+}
+
+// validateEnvProfiles returns an error if the application moves to another account
+// and there are environments in other accounts that don't have a profile to update their trust with.
+func (o *appImportOpts) validateEnvProfiles(account string) error {
+	if !o.movesAccount(account) {
+		return nil
+	}
+	var missing []string
+	for _, env := range o.archive.Environments {
+		if _, ok := o.envProfiles[env.Name]; ok || env.AccountID == account {
+			continue
+		}
+		missing = append(missing, env.Name)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	var profiles []string
+	for _, env := range missing {
+		profiles = append(profiles, fmt.Sprintf("%s=<profile>", env))
+	}
+	return fmt.Errorf("%s %s %s not in account %s: specify the named profiles to update %s with --%s %s",
+		english.PluralWord(len(missing), "environment", "environments"), english.WordSeries(missing, "and"),
+		english.PluralWord(len(missing), "is", "are"), account, english.PluralWord(len(missing), "it", "them"),
+		envProfilesFlag, strings.Join(profiles, ","))
+}
+
+func (o *appImportOpts) steps(app *config.Application, caller identity.Caller) []appImportStep {
+	var dnsAccounts, regions []string
+	for _, env := range o.archive.Environments {
+		if app.RequiresDNSDelegation() && env.AccountID != app.AccountID && !contains(env.AccountID, dnsAccounts) {
+			dnsAccounts = append(dnsAccounts, env.AccountID)
+		}
+		if !contains(env.Region, regions) {
+			regions = append(regions, env.Region)
+		}
+	}
+	sort.Strings(dnsAccounts)
+	sort.Strings(regions)
+
+	steps := []appImportStep{
+		{
+			desc: fmt.Sprintf("Deploy the infrastructure roles and StackSet of application %s.", app.Name),
+			run: func() error {
+				return o.deployer.DeployApp(&deploy.CreateAppInput{
+					Name:                  app.Name,
+					AccountID:             app.AccountID,
+					DNSDelegationAccounts: dnsAccounts,
+					DomainName:            app.Domain,
+					DomainHostedZoneID:    app.DomainHostedZoneID,
+					AdditionalTags:        app.Tags,
+					Version:               deploy.LatestAppTemplateVersion,
+				})
+			},
+		},
+		{
+			desc: fmt.Sprintf("Store application %s.", app.Name),
+			run: func() error {
+				return o.store.CreateApplication(app)
+			},
+		},
+	}
+	for _, env := range o.archive.Environments {
+		env := env
+		steps = append(steps, appImportStep{
+			desc: fmt.Sprintf("Store environment %s in account %s and region %s.", env.Name, env.AccountID, env.Region),
+			run: func() error {
+				return o.store.CreateEnvironment(env)
+			},
+		})
+	}
+	if o.movesAccount(caller.Account) {
+		for _, env := range o.archive.Environments {
+			env := env
+			steps = append(steps, appImportStep{
+				desc: fmt.Sprintf("Update environment %s to trust account %s.", env.Name, caller.Account),
+				run: func() error {
+					updater, err := o.newEnvTrustUpdater(env)
+					if err != nil {
+						return err
+					}
+					return updater.UpdateEnvironmentTrust(&deploy.AppInformation{
+						Name:                app.Name,
+						DNSName:             app.Domain,
+						AccountPrincipalARN: caller.RootUserARN,
+					}, env.Name, env.ExecutionRoleARN)
+				},
+			})
+		}
+	}
+	for _, wl := range o.archive.Workloads {
+		wl := wl
+		if contains(wl.Type, manifest.JobTypes) {
+			steps = append(steps, appImportStep{
+				desc: fmt.Sprintf("Store job %s.", wl.Name),
+				run: func() error {
+					return o.store.CreateJob(wl)
+				},
+			})
+			continue
+		}
+		steps = append(steps, appImportStep{
+			desc: fmt.Sprintf("Store service %s.", wl.Name),
+			run: func() error {
+				return o.store.CreateService(wl)
+			},
+		})
+	}
+	resources := o.archive.Resources
+	if resources == nil {
+		resources = &stack.AppResourcesConfig{}
+	}
+	desc := fmt.Sprintf("Import the resources of application %s.", app.Name)
+	if len(regions) != 0 {
+		desc = fmt.Sprintf("Import the resources of application %s in %s.", app.Name, strings.Join(regions, ", "))
+	}
+	steps = append(steps, appImportStep{
+		desc: desc,
+		run: func() error {
+			return o.deployer.ImportAppResources(app, resources, regions)
+		},
+	})
+	return steps
+}
+
+// buildAppImportCmd builds the command to import an application exported with "app export".
+func buildAppImportCmd() *cobra.Command {
+	vars := appImportVars{}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Imports an application exported from another account.",
+		Long: `Imports an application exported from another account with "copilot app export".
+The application's infrastructure roles and StackSet are deployed in the current account,
+and its environments and workloads are stored in the current account and region.
+If the application moves to another account, its environments are updated to trust the new account
+with the credentials of their own accounts.`,
+		Example: `
+  List the steps to import the application in "my-app.json" without running them.
+  /code $ copilot app import --input-file my-app.json --dry-run
+  Import the application in "my-app.json".
+  /code $ copilot app import --input-file my-app.json
+  Import the application in "my-app.json" to a new account, and update its environments with the "test" and "prod" named profiles.
+  /code $ copilot app import --input-file my-app.json --env-profiles test=test,prod=prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newAppImportOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVar(&vars.inputFile, inputFileFlag, "", appImportInputFileFlagDescription)
+	cmd.Flags().BoolVar(&vars.dryRun, dryRunFlag, false, appImportDryRunFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.envProfiles, envProfilesFlag, nil, appImportEnvProfilesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,307 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/require"
+)
+
+type appImportMocks struct {
+	store    *mocks.Mockstore
+	identity *mocks.MockidentityService
+	route53  *mocks.MockdomainHostedZoneGetter
+	deployer *mocks.MockappResourcesImporter
+	prog     *mocks.Mockprogress
+	envTrust *mocks.MockenvTrustUpdater
+}
+
+func TestAppImportOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inInputFile   string
+		inContent     string
+		inEnvProfiles map[string]string
+
+		wantedErr     error
+		wantedArchive *appArchive
+	}{
+		"requires an input file": {
+			wantedErr: errors.New("--input-file is required"),
+		},
+		"returns an error if the input file doesn't exist": {
+			inInputFile: "missing.json",
+			wantedErr:   errors.New("read input file missing.json: open missing.json: file does not exist"),
+		},
+		"returns an error if the version isn't supported": {
+			inInputFile: "phonetool.json",
+			inContent:   `{"version": 2, "application": {"name": "phonetool"}}`,
+			wantedErr:   errors.New("input file phonetool.json has version 2, which isn't supported by this version of Copilot"),
+		},
+		"returns an error if there is no application": {
+			inInputFile: "phonetool.json",
+			inContent:   `{"version": 1}`,
+			wantedErr:   errors.New("input file phonetool.json doesn't hold an application"),
+		},
+		"returns an error if an environment belongs to another application": {
+			inInputFile: "phonetool.json",
+			inContent:   `{"version": 1, "application": {"name": "phonetool"}, "environments": [{"app": "other", "name": "test"}]}`,
+			wantedErr:   errors.New("environment test in input file phonetool.json belongs to application other instead of phonetool"),
+		},
+		"returns an error if a profile is for an environment that isn't in the input file": {
+			inInputFile:   "phonetool.json",
+			inContent:     `{"version": 1, "application": {"name": "phonetool"}, "environments": [{"app": "phonetool", "name": "test"}]}`,
+			inEnvProfiles: map[string]string{"prod": "prod-profile"},
+			wantedErr:     errors.New("environment prod of --env-profiles is not in input file phonetool.json"),
+		},
+		"reads the archive": {
+			inInputFile: "phonetool.json",
+			inContent: `{
+  "version": 1,
+  "application": {"name": "phonetool", "account": "1234"},
+  "workloads": [{"app": "phonetool", "name": "frontend", "type": "Load Balanced Web Service"}],
+  "resources": {"accounts": ["1234"], "services": ["frontend"]}
+}`,
+			wantedArchive: &appArchive{
+				Version:     1,
+				Application: &config.Application{Name: "phonetool", AccountID: "1234"},
+				Workloads: []*config.Workload{
+					{App: "phonetool", Name: "frontend", Type: "Load Balanced Web Service"},
+				},
+				Resources: &stack.AppResourcesConfig{
+					Accounts: []string{"1234"},
+					Services: []string{"frontend"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			if tc.inContent != "" {
+				require.NoError(t, fs.WriteFile(tc.inInputFile, []byte(tc.inContent), 0644))
+			}
+			opts := &appImportOpts{
+				appImportVars: appImportVars{
+					inputFile:   tc.inInputFile,
+					envProfiles: tc.inEnvProfiles,
+				},
+				fs: fs,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedArchive, opts.archive)
+		})
+	}
+}
+
+func TestAppImportOpts_Execute(t *testing.T) {
+	mockArchive := &appArchive{
+		Version: 1,
+		Application: &config.Application{
+			Name:      "phonetool",
+			AccountID: "4321",
+			Domain:    "phonetool.com",
+		},
+		Environments: []*config.Environment{
+			{App: "phonetool", Name: "test", AccountID: "5678", Region: "us-west-2", ExecutionRoleARN: "arn:aws:iam::5678:role/phonetool-test-CFNExecutionRole"},
+			{App: "phonetool", Name: "prod", AccountID: "9012", Region: "us-east-1", ExecutionRoleARN: "arn:aws:iam::9012:role/phonetool-prod-CFNExecutionRole"},
+		},
+		Workloads: []*config.Workload{
+			{App: "phonetool", Name: "frontend", Type: "Load Balanced Web Service"},
+			{App: "phonetool", Name: "report", Type: "Scheduled Job"},
+		},
+		Resources: &stack.AppResourcesConfig{
+			Accounts: []string{"4321", "5678", "9012"},
+			Services: []string{"frontend", "report"},
+		},
+	}
+	wantedApp := &config.Application{
+		Name:               "phonetool",
+		AccountID:          "4321",
+		Domain:             "phonetool.com",
+		DomainHostedZoneID: "Z123",
+	}
+	testCases := map[string]struct {
+		inDryRun      bool
+		inEnvProfiles map[string]string
+		setupMocks    func(m appImportMocks)
+
+		wantedErr         error
+		wantedTrustedEnvs []string
+	}{
+		"does not run any step in dry-run mode": {
+			inDryRun: true,
+			setupMocks: func(m appImportMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "4321"}, nil)
+				m.route53.EXPECT().DomainHostedZoneID("phonetool.com").Return("Z123", nil)
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Times(0)
+				m.deployer.EXPECT().ImportAppResources(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.prog.EXPECT().Start(gomock.Any()).Times(0)
+			},
+		},
+		"imports the application in the current account": {
+			setupMocks: func(m appImportMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "4321"}, nil)
+				m.route53.EXPECT().DomainHostedZoneID("phonetool.com").Return("Z123", nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(7)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(7)
+				gomock.InOrder(
+					m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+						Name:                  "phonetool",
+						AccountID:             "4321",
+						DNSDelegationAccounts: []string{"5678", "9012"},
+						DomainName:            "phonetool.com",
+						DomainHostedZoneID:    "Z123",
+						Version:               deploy.LatestAppTemplateVersion,
+					}).Return(nil),
+					m.store.EXPECT().CreateApplication(wantedApp).Return(nil),
+					m.store.EXPECT().CreateEnvironment(mockArchive.Environments[0]).Return(nil),
+					m.store.EXPECT().CreateEnvironment(mockArchive.Environments[1]).Return(nil),
+					m.store.EXPECT().CreateService(mockArchive.Workloads[0]).Return(nil),
+					m.store.EXPECT().CreateJob(mockArchive.Workloads[1]).Return(nil),
+					m.deployer.EXPECT().ImportAppResources(wantedApp, mockArchive.Resources, []string{"us-east-1", "us-west-2"}).Return(nil),
+				)
+			},
+		},
+		"error if environments in other accounts don't have a profile to update them with": {
+			inEnvProfiles: map[string]string{"test": "test-profile"},
+			setupMocks: func(m appImportMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "1111", RootUserARN: "arn:aws:iam::1111:root"}, nil)
+				m.route53.EXPECT().DomainHostedZoneID(gomock.Any()).Times(0)
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("environment prod is not in account 1111: specify the named profiles to update it with --env-profiles prod=<profile>"),
+		},
+		"lists the steps to update the environments in dry-run mode if the application moves to another account": {
+			inDryRun:      true,
+			inEnvProfiles: map[string]string{"test": "test-profile", "prod": "prod-profile"},
+			setupMocks: func(m appImportMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "1111", RootUserARN: "arn:aws:iam::1111:root"}, nil)
+				m.route53.EXPECT().DomainHostedZoneID("phonetool.com").Return("Z123", nil)
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Times(0)
+				m.envTrust.EXPECT().UpdateEnvironmentTrust(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.prog.EXPECT().Start(gomock.Any()).Times(0)
+			},
+		},
+		"imports the application to another account and updates its environments to trust it": {
+			inEnvProfiles: map[string]string{"test": "test-profile", "prod": "prod-profile"},
+			setupMocks: func(m appImportMocks) {
+				wantedApp := &config.Application{
+					Name:               "phonetool",
+					AccountID:          "1111",
+					Domain:             "phonetool.com",
+					DomainHostedZoneID: "Z123",
+				}
+				wantedAppInfo := &deploy.AppInformation{
+					Name:                "phonetool",
+					DNSName:             "phonetool.com",
+					AccountPrincipalARN: "arn:aws:iam::1111:root",
+				}
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "1111", RootUserARN: "arn:aws:iam::1111:root"}, nil)
+				m.route53.EXPECT().DomainHostedZoneID("phonetool.com").Return("Z123", nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(9)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(9)
+				gomock.InOrder(
+					m.deployer.EXPECT().DeployApp(&deploy.CreateAppInput{
+						Name:                  "phonetool",
+						AccountID:             "1111",
+						DNSDelegationAccounts: []string{"5678", "9012"},
+						DomainName:            "phonetool.com",
+						DomainHostedZoneID:    "Z123",
+						Version:               deploy.LatestAppTemplateVersion,
+					}).Return(nil),
+					m.store.EXPECT().CreateApplication(wantedApp).Return(nil),
+					m.store.EXPECT().CreateEnvironment(mockArchive.Environments[0]).Return(nil),
+					m.store.EXPECT().CreateEnvironment(mockArchive.Environments[1]).Return(nil),
+					m.envTrust.EXPECT().UpdateEnvironmentTrust(wantedAppInfo, "test", "arn:aws:iam::5678:role/phonetool-test-CFNExecutionRole").Return(nil),
+					m.envTrust.EXPECT().UpdateEnvironmentTrust(wantedAppInfo, "prod", "arn:aws:iam::9012:role/phonetool-prod-CFNExecutionRole").Return(nil),
+					m.store.EXPECT().CreateService(mockArchive.Workloads[0]).Return(nil),
+					m.store.EXPECT().CreateJob(mockArchive.Workloads[1]).Return(nil),
+					m.deployer.EXPECT().ImportAppResources(wantedApp, mockArchive.Resources, []string{"us-east-1", "us-west-2"}).Return(nil),
+				)
+			},
+			wantedTrustedEnvs: []string{"test", "prod"},
+		},
+		"returns the error if the hosted zone of the domain isn't in the current account": {
+			setupMocks: func(m appImportMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "4321"}, nil)
+				m.route53.EXPECT().DomainHostedZoneID("phonetool.com").Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("get hosted zone ID for domain phonetool.com in account 4321: some error"),
+		},
+		"stops at the first step that fails": {
+			setupMocks: func(m appImportMocks) {
+				m.identity.EXPECT().Get().Return(identity.Caller{Account: "4321"}, nil)
+				m.route53.EXPECT().DomainHostedZoneID("phonetool.com").Return("Z123", nil)
+				m.prog.EXPECT().Start(gomock.Any()).Times(2)
+				m.prog.EXPECT().Stop(gomock.Any()).Times(2)
+				m.deployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				m.store.EXPECT().CreateApplication(gomock.Any()).Return(errors.New("some error"))
+				m.store.EXPECT().CreateEnvironment(gomock.Any()).Times(0)
+				m.deployer.EXPECT().ImportAppResources(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedErr: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := appImportMocks{
+				store:    mocks.NewMockstore(ctrl),
+				identity: mocks.NewMockidentityService(ctrl),
+				route53:  mocks.NewMockdomainHostedZoneGetter(ctrl),
+				deployer: mocks.NewMockappResourcesImporter(ctrl),
+				prog:     mocks.NewMockprogress(ctrl),
+				envTrust: mocks.NewMockenvTrustUpdater(ctrl),
+			}
+			tc.setupMocks(m)
+
+			var gotTrustedEnvs []string
+			opts := &appImportOpts{
+				appImportVars: appImportVars{
+					inputFile:   "phonetool.json",
+					dryRun:      tc.inDryRun,
+					envProfiles: tc.inEnvProfiles,
+				},
+				store:    m.store,
+				identity: m.identity,
+				route53:  m.route53,
+				deployer: m.deployer,
+				prog:     m.prog,
+				archive:  mockArchive,
+				newEnvTrustUpdater: func(env *config.Environment) (envTrustUpdater, error) {
+					gotTrustedEnvs = append(gotTrustedEnvs, env.Name)
+					return m.envTrust, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedTrustedEnvs, gotTrustedEnvs)
+		})
+	}
+}
@@ -6,6 +6,9 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
@@ -36,6 +39,11 @@ type initAppVars struct {
 	name         string
 	domainName   string
 	resourceTags map[string]string
+
+	ecrKeepImages         int
+	ecrUntaggedExpiryDays int
+	ecrScanOnPush         bool
+	ecrImmutableTags      bool
 }
 
 type initAppOpts struct {
@@ -99,6 +107,12 @@ func (o *initAppOpts) Validate() error {
 		}
 		o.cachedHostedZoneID = id
 	}
+	if o.ecrKeepImages < 0 {
+		return fmt.Errorf("--%s must be a positive number", ecrKeepImagesFlag)
+	}
+	if o.ecrUntaggedExpiryDays < 0 {
+		return fmt.Errorf("--%s must be a positive number of days", ecrUntaggedExpiryDaysFlag)
+	}
 	return nil
 }
 
@@ -200,14 +214,61 @@ func (o *initAppOpts) Execute() error {
 		Domain:             o.domainName,
 		DomainHostedZoneID: hostedZoneID,
 		Tags:               o.resourceTags,
+		ImageRepository:    o.imageRepository(),
 	}); err != nil {
 		return err
 	}
+	if err := o.updateImageRepository(); err != nil {
+		return err
+	}
 	log.Successf("The directory %s will hold service manifests for application %s.\n", color.HighlightResource(workspace.CopilotDirName), color.HighlightUserInput(o.name))
 	log.Infoln()
 	return nil
 }
 
+// imageRepository returns the settings of the workloads' image repositories from the flags, or nil if none are set.
+func (o *initAppOpts) imageRepository() *config.ImageRepository {
+	if o.ecrKeepImages == 0 && o.ecrUntaggedExpiryDays == 0 && !o.ecrScanOnPush && !o.ecrImmutableTags {
+		return nil
+	}
+	repo := &config.ImageRepository{}
+	if o.ecrKeepImages != 0 {
+		repo.KeepImages = aws.Int(o.ecrKeepImages)
+	}
+	if o.ecrUntaggedExpiryDays != 0 {
+		repo.UntaggedExpiryDays = aws.Int(o.ecrUntaggedExpiryDays)
+	}
+	if o.ecrScanOnPush {
+		repo.ScanOnPush = aws.Bool(true)
+	}
+	if o.ecrImmutableTags {
+		repo.ImmutableTags = aws.Bool(true)
+	}
+	return repo
+}
+
+// updateImageRepository stores the image repository settings from the flags if the application already existed.
+// The settings are applied to the repositories the next time each workload is deployed.
+func (o *initAppOpts) updateImageRepository() error {
+	repo := o.imageRepository()
+	if repo == nil {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.name)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.name, err)
+	}
+	if reflect.DeepEqual(app.ImageRepository, repo) {
+		return nil
+	}
+	app.ImageRepository = repo
+	if err := o.store.UpdateApplication(app); err != nil {
+		return fmt.Errorf("update image repository settings of application %s: %w", o.name, err)
+	}
+	log.Successf("Updated the image repository settings of application %s. They apply to each service and job the next time it is deployed.\n", color.HighlightUserInput(o.name))
+	return nil
+}
+
 func (o *initAppOpts) validateAppName(name string) error {
 	if err := validateAppName(name); err != nil {
 		return err
@@ -289,7 +350,9 @@ An application is a collection of containerized services that operate together.`
   Create a new application with an existing domain name in Amazon Route53.
   /code $ copilot app init --domain example.com
   Create a new application with resource tags.
-  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam`,
+  /code $ copilot app init --resource-tags department=MyDept,team=MyTeam
+  Create a new application that scans images on push and keeps the last 50 images of each service and job.
+  /code $ copilot app init --ecr-scan-on-push --ecr-keep-images 50`,
 		Args: reservedArgs,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitAppOpts(vars)
@@ -304,5 +367,9 @@ An application is a collection of containerized services that operate together.`
 	}
 	cmd.Flags().StringVar(&vars.domainName, domainNameFlag, "", domainNameFlagDescription)
 	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	cmd.Flags().IntVar(&vars.ecrKeepImages, ecrKeepImagesFlag, 0, ecrKeepImagesFlagDescription)
+	cmd.Flags().IntVar(&vars.ecrUntaggedExpiryDays, ecrUntaggedExpiryDaysFlag, 0, ecrUntaggedExpiryDaysFlagDescription)
+	cmd.Flags().BoolVar(&vars.ecrScanOnPush, ecrScanOnPushFlag, false, ecrScanOnPushFlagDescription)
+	cmd.Flags().BoolVar(&vars.ecrImmutableTags, ecrImmutableTagsFlag, false, ecrImmutableTagsFlagDescription)
 	return cmd
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 	"github.com/aws/copilot-cli/internal/pkg/aws/route53"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -21,10 +22,11 @@ import (
 
 func TestInitAppOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
-		inAppName      string
-		inDomainName   string
-		mockRoute53Svc func(m *mocks.MockdomainHostedZoneGetter)
-		mockStore      func(m *mocks.Mockstore)
+		inAppName       string
+		inDomainName    string
+		inECRKeepImages int
+		mockRoute53Svc  func(m *mocks.MockdomainHostedZoneGetter)
+		mockStore       func(m *mocks.Mockstore)
 
 		wantedError string
 	}{
@@ -125,6 +127,12 @@ func TestInitAppOpts_Validate(t *testing.T) {
 			mockStore:   func(m *mocks.Mockstore) {},
 			wantedError: "",
 		},
+		"errors if the number of images to keep is negative": {
+			inECRKeepImages: -1,
+			mockRoute53Svc:  func(m *mocks.MockdomainHostedZoneGetter) {},
+			mockStore:       func(m *mocks.Mockstore) {},
+			wantedError:     "--ecr-keep-images must be a positive number",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -140,8 +148,9 @@ func TestInitAppOpts_Validate(t *testing.T) {
 				route53: mockRoute53Svc,
 				store:   mockStore,
 				initAppVars: initAppVars{
-					name:       tc.inAppName,
-					domainName: tc.inDomainName,
+					name:          tc.inAppName,
+					domainName:    tc.inDomainName,
+					ecrKeepImages: tc.inECRKeepImages,
 				},
 			}
 
@@ -292,6 +301,7 @@ func TestInitAppOpts_Execute(t *testing.T) {
 	testCases := map[string]struct {
 		inDomainName         string
 		inDomainHostedZoneID string
+		inECRScanOnPush      bool
 
 		expectedError error
 		mocking       func(t *testing.T,
@@ -341,6 +351,42 @@ func TestInitAppOpts_Execute(t *testing.T) {
 				mockProgress.EXPECT().Stop(log.Ssuccessf(fmtAppInitComplete, "myapp"))
 			},
 		},
+		"updates the image repository settings of an existing application": {
+			inECRScanOnPush: true,
+
+			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
+				mockIdentityService *mocks.MockidentityService, mockDeployer *mocks.MockappDeployer,
+				mockProgress *mocks.Mockprogress) {
+				mockIdentityService.EXPECT().Get().Return(identity.Caller{
+					Account: "12345",
+				}, nil)
+				mockWorkspace.EXPECT().Create("myapp").Return(nil)
+				mockProgress.EXPECT().Start(gomock.Any())
+				mockDeployer.EXPECT().DeployApp(gomock.Any()).Return(nil)
+				mockProgress.EXPECT().Stop(gomock.Any())
+				mockstore.EXPECT().CreateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					Tags: map[string]string{
+						"owner": "boss",
+					},
+					ImageRepository: &config.ImageRepository{
+						ScanOnPush: aws.Bool(true),
+					},
+				}).Return(nil)
+				mockstore.EXPECT().GetApplication("myapp").Return(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+				}, nil)
+				mockstore.EXPECT().UpdateApplication(&config.Application{
+					AccountID: "12345",
+					Name:      "myapp",
+					ImageRepository: &config.ImageRepository{
+						ScanOnPush: aws.Bool(true),
+					},
+				}).Return(nil)
+			},
+		},
 		"should return error from workspace.Create": {
 			expectedError: mockError,
 			mocking: func(t *testing.T, mockstore *mocks.Mockstore, mockWorkspace *mocks.MockwsAppManager,
@@ -421,6 +467,7 @@ func TestInitAppOpts_Execute(t *testing.T) {
 					resourceTags: map[string]string{
 						"owner": "boss",
 					},
+					ecrScanOnPush: tc.inECRScanOnPush,
 				},
 				store:              mockstore,
 				identity:           mockIdentityService,
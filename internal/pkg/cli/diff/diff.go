@@ -0,0 +1,234 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package diff provides functionality to compare two YAML documents structurally and render their differences.
+package diff
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"gopkg.in/yaml.v3"
+)
+
+const indentSize = 4
+
+// Tree represents the differences between two YAML documents.
+// Only the paths that lead to a difference are kept in the tree.
+type Tree struct {
+	root *node
+}
+
+// node is a key in the YAML document that contains differences.
+// A node either has children, or is a leaf that holds the old and new values of the key.
+// The old value is nil if the key is added, and the new value is nil if the key is removed.
+type node struct {
+	key      string
+	children []*node
+
+	oldValue *yaml.Node
+	newValue *yaml.Node
+}
+
+// Parse compares the YAML documents "from" and "to" and returns the tree of their differences.
+func Parse(from, to []byte) (Tree, error) {
+	var fromNode, toNode yaml.Node
+	if err := yaml.Unmarshal(from, &fromNode); err != nil {
+		return Tree{}, fmt.Errorf("unmarshal the old document: %w", err)
+	}
+	if err := yaml.Unmarshal(to, &toNode); err != nil {
+		return Tree{}, fmt.Errorf("unmarshal the new document: %w", err)
+	}
+	return Tree{
+		root: compare("", documentContent(&fromNode), documentContent(&toNode)),
+	}, nil
+}
+
+// IsEmpty returns true if the documents don't have any differences.
+func (t Tree) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Write writes the colored differences to w.
+// Keys that are added are prefixed with "+", removed keys with "-", and modified keys with "~".
+func (t Tree) Write(w io.Writer) error {
+	if t.root == nil {
+		return nil
+	}
+	if t.root.key == "" && t.root.children == nil {
+		// The documents are entirely different.
+		return writeLeaf(w, t.root, 0)
+	}
+	for _, child := range t.root.children {
+		if err := writeNode(w, child, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func documentContent(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode {
+		return doc
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	return doc.Content[0]
+}
+
+func compare(key string, from, to *yaml.Node) *node {
+	if from == nil && to == nil {
+		return nil
+	}
+	if from == nil || to == nil || from.Kind != to.Kind || from.Tag != to.Tag {
+		// A different tag replaces the whole value, for example "!GetAtt [...]" with "!Join [...]".
+		return &node{key: key, oldValue: from, newValue: to}
+	}
+	var children []*node
+	switch from.Kind {
+	case yaml.MappingNode:
+		children = compareMappings(from, to)
+	case yaml.SequenceNode:
+		children = compareSequences(from, to)
+	default:
+		if from.Value == to.Value {
+			return nil
+		}
+		return &node{key: key, oldValue: from, newValue: to}
+	}
+	if len(children) == 0 {
+		return nil
+	}
+	return &node{key: key, children: children}
+}
+
+func compareMappings(from, to *yaml.Node) []*node {
+	fromValues, fromKeys := mappingValues(from)
+	toValues, toKeys := mappingValues(to)
+	var children []*node
+	for _, key := range fromKeys {
+		if child := compare(key, fromValues[key], toValues[key]); child != nil {
+			children = append(children, child)
+		}
+	}
+	for _, key := range toKeys {
+		if _, ok := fromValues[key]; ok {
+			continue
+		}
+		children = append(children, &node{key: key, newValue: toValues[key]})
+	}
+	return children
+}
+
+// mappingValues returns the values of a mapping node by key, and its keys in the order of the document.
+func mappingValues(mapping *yaml.Node) (map[string]*yaml.Node, []string) {
+	values := make(map[string]*yaml.Node)
+	var keys []string
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i].Value
+		values[key] = mapping.Content[i+1]
+		keys = append(keys, key)
+	}
+	return values, keys
+}
+
+func compareSequences(from, to *yaml.Node) []*node {
+	var children []*node
+	for i := 0; i < len(from.Content) || i < len(to.Content); i++ {
+		var fromItem, toItem *yaml.Node
+		if i < len(from.Content) {
+			fromItem = from.Content[i]
+		}
+		if i < len(to.Content) {
+			toItem = to.Content[i]
+		}
+		if child := compare(fmt.Sprintf("[%d]", i), fromItem, toItem); child != nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func writeNode(w io.Writer, n *node, depth int) error {
+	if n.children == nil {
+		return writeLeaf(w, n, depth)
+	}
+	if _, err := fmt.Fprintf(w, "%s%s\n", indent(depth), color.Yellow.Sprintf("~ %s:", n.key)); err != nil {
+		return err
+	}
+	for _, child := range n.children {
+		if err := writeNode(w, child, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeLeaf(w io.Writer, n *node, depth int) error {
+	prefix := indent(depth)
+	key := ""
+	if n.key != "" {
+		key = n.key + ":"
+	}
+	switch {
+	case n.oldValue == nil:
+		return writeValue(w, prefix, key, n.newValue, func(s string) string { return color.Green.Sprintf("+ %s", s) })
+	case n.newValue == nil:
+		return writeValue(w, prefix, key, n.oldValue, func(s string) string { return color.Red.Sprintf("- %s", s) })
+	case isScalar(n.oldValue) && isScalar(n.newValue):
+		_, err := fmt.Fprintf(w, "%s%s\n", prefix, color.Yellow.Sprintf("~ %s %s -> %s", key, scalar(n.oldValue), scalar(n.newValue)))
+		return err
+	}
+	if err := writeValue(w, prefix, key, n.oldValue, func(s string) string { return color.Red.Sprintf("- %s", s) }); err != nil {
+		return err
+	}
+	return writeValue(w, prefix, key, n.newValue, func(s string) string { return color.Green.Sprintf("+ %s", s) })
+}
+
+// writeValue writes the key and its value, with each line decorated by mark.
+func writeValue(w io.Writer, prefix, key string, value *yaml.Node, mark func(string) string) error {
+	if isScalar(value) {
+		_, err := fmt.Fprintf(w, "%s%s\n", prefix, mark(strings.TrimSpace(fmt.Sprintf("%s %s", key, scalar(value)))))
+		return err
+	}
+	out, err := yaml.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("marshal value of %s: %w", key, err)
+	}
+	lines := strings.Split(strings.TrimRight(string(out), "\n"), "\n")
+	if key != "" {
+		if _, err := fmt.Fprintf(w, "%s%s\n", prefix, mark(key)); err != nil {
+			return err
+		}
+		prefix += indent(1)
+	}
+	for _, line := range lines {
+		if _, err := fmt.Fprintf(w, "%s%s\n", prefix, mark(line)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isScalar(n *yaml.Node) bool {
+	return n.Kind == yaml.ScalarNode || n.Kind == yaml.AliasNode
+}
+
+// scalar returns the string representation of a scalar node, including its tag if it's a custom one like "!Ref".
+func scalar(n *yaml.Node) string {
+	value := n.Value
+	if n.Kind == yaml.AliasNode && n.Alias != nil {
+		value = n.Alias.Value
+	}
+	if strings.HasPrefix(n.Tag, "!") && !strings.HasPrefix(n.Tag, "!!") {
+		return fmt.Sprintf("%s %s", n.Tag, value)
+	}
+	return value
+}
+
+func indent(depth int) string {
+	return strings.Repeat(" ", depth*indentSize)
+}
@@ -0,0 +1,176 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTree_Write(t *testing.T) {
+	testCases := map[string]struct {
+		from string
+		to   string
+
+		wantedEmpty bool
+		wanted      string
+	}{
+		"no differences": {
+			from: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+`,
+			to: `Resources:
+  Service:
+    Type: AWS::ECS::Service
+`,
+			wantedEmpty: true,
+		},
+		"modified scalar": {
+			from: `Resources:
+  Service:
+    Properties:
+      DesiredCount: 1
+      LaunchType: FARGATE
+`,
+			to: `Resources:
+  Service:
+    Properties:
+      DesiredCount: 2
+      LaunchType: FARGATE
+`,
+			wanted: `~ Resources:
+    ~ Service:
+        ~ Properties:
+            ~ DesiredCount: 1 -> 2
+`,
+		},
+		"added and removed keys": {
+			from: `Resources:
+  Queue:
+    Type: AWS::SQS::Queue
+Outputs:
+  QueueURL:
+    Value: !Ref Queue
+`,
+			to: `Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+Outputs:
+  QueueURL:
+    Value: !Ref Queue
+`,
+			wanted: `~ Resources:
+    - Queue:
+        - Type: AWS::SQS::Queue
+    + Topic:
+        + Type: AWS::SNS::Topic
+`,
+		},
+		"modified intrinsic function": {
+			from: `Outputs:
+  Name:
+    Value: !Ref Service
+`,
+			to: `Outputs:
+  Name:
+    Value: !GetAtt Service.Name
+`,
+			wanted: `~ Outputs:
+    ~ Name:
+        ~ Value: !Ref Service -> !GetAtt Service.Name
+`,
+		},
+		"modified intrinsic function of a sequence": {
+			from: `Outputs:
+  Arn:
+    Value: !GetAtt [Service, Name]
+`,
+			to: `Outputs:
+  Arn:
+    Value: !Join [Service, Name]
+`,
+			wanted: `~ Outputs:
+    ~ Arn:
+        - Value:
+            - !GetAtt [Service, Name]
+        + Value:
+            + !Join [Service, Name]
+`,
+		},
+		"modified tag of a mapping": {
+			from: `Conditions:
+  IsProd: !Equals
+    Left: prod
+`,
+			to: `Conditions:
+  IsProd: !Not
+    Left: prod
+`,
+			wanted: `~ Conditions:
+    - IsProd:
+        - !Equals
+        - Left: prod
+    + IsProd:
+        + !Not
+        + Left: prod
+`,
+		},
+		"sequences are compared by index": {
+			from: `Subnets:
+  - subnet-1
+  - subnet-2
+`,
+			to: `Subnets:
+  - subnet-1
+  - subnet-3
+  - subnet-4
+`,
+			wanted: `~ Subnets:
+    ~ [1]: subnet-2 -> subnet-3
+    + [2]: subnet-4
+`,
+		},
+		"value changed from scalar to mapping": {
+			from: `Cpu: 256
+`,
+			to: `Cpu:
+  Ref: TaskCPU
+`,
+			wanted: `- Cpu: 256
++ Cpu:
+    + Ref: TaskCPU
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			noColor := color.NoColor
+			color.NoColor = true
+			defer func() { color.NoColor = noColor }()
+			buf := new(bytes.Buffer)
+
+			// WHEN
+			tree, err := Parse([]byte(tc.from), []byte(tc.to))
+			require.NoError(t, err)
+			err = tree.Write(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedEmpty, tree.IsEmpty())
+			require.Equal(t, tc.wanted, buf.String())
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse([]byte("a: b"), []byte("a: [b"))
+
+	require.EqualError(t, err, "unmarshal the new document: yaml: line 1: did not find expected ',' or ']'")
+}
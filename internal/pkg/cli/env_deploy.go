@@ -0,0 +1,422 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+)
+
+const (
+	envDeployAppNamePrompt = "In which application is your environment?"
+	envDeployEnvNamePrompt = "Which environment do you want to deploy?"
+	envDeployEnvNameHelp   = "Updates the environment's infrastructure with the configuration in its manifest."
+
+	fmtEnvDeployStart    = "Deploying environment %s with its manifest."
+	fmtEnvDeployFailed   = "Failed to deploy environment %s.\n"
+	fmtEnvDeployComplete = "Deployed environment %s.\n"
+)
+
+type deployEnvVars struct {
+	appName  string
+	name     string
+	showDiff bool
+}
+
+type deployEnvOpts struct {
+	deployEnvVars
+
+	store    store
+	ws       wsEnvironmentReader
+	sel      appEnvSelector
+	prog     progress
+	appCFN   appResourcesGetter
+	uploader customResourcesUploader
+	addons   templater
+
+	diffWriter io.Writer
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overridden in tests to provide mocks.
+	newEnvVersionGetter func(app, env string) (versionGetter, error)
+	newEnvDeployer      func(conf *config.Environment) (envTemplateDeployer, error)
+	newS3               func(region string) (zipAndArtifactUploader, error)
+	newTemplater        func(in *deploy.CreateEnvironmentInput) templater
+}
+
+func newEnvDeployOpts(vars deployEnvVars) (*deployEnvOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	defaultSession, err := sessions.NewProvider().Default()
+	if err != nil {
+		return nil, err
+	}
+	addons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("initiate environment addons: %w", err)
+	}
+	return &deployEnvOpts{
+		deployEnvVars: vars,
+
+		store:    store,
+		ws:       ws,
+		sel:      selector.NewSelect(prompt.New(), store),
+		prog:     termprogress.NewSpinner(log.DiagnosticWriter),
+		appCFN:   cloudformation.New(defaultSession),
+		uploader: template.New(),
+		addons:   addons,
+
+		diffWriter: os.Stdout,
+
+		newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+			d, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+				App:         app,
+				Env:         env,
+				ConfigStore: store,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("new env describer for environment %s in app %s: %w", env, app, err)
+			}
+			return d, nil
+		},
+		newEnvDeployer: func(conf *config.Environment) (envTemplateDeployer, error) {
+			sess, err := sessions.NewProvider().FromRole(conf.ManagerRoleARN, conf.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %w", conf.ManagerRoleARN, conf.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+		newS3: func(region string) (zipAndArtifactUploader, error) {
+			sess, err := sessions.NewProvider().DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create session with region %s: %w", region, err)
+			}
+			return s3.New(sess), nil
+		},
+		newTemplater: func(in *deploy.CreateEnvironmentInput) templater {
+			return stack.NewEnvStackConfig(in)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values passed by flags are invalid.
+func (o *deployEnvOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return fmt.Errorf("get application %s configuration: %w", o.appName, err)
+	}
+	if o.name == "" {
+		return nil
+	}
+	if _, err := o.store.GetEnvironment(o.appName, o.name); err != nil {
+		return fmt.Errorf("get environment %s configuration from application %s: %w", o.name, o.appName, err)
+	}
+	return nil
+}
+
+// Ask prompts for any required flags that are not set by the user.
+func (o *deployEnvOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(envDeployAppNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		env, err := o.sel.Environment(envDeployEnvNamePrompt, envDeployEnvNameHelp, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.name = env
+	}
+	return nil
+}
+
+// Execute updates the environment stack with the configuration in the environment's manifest.
+func (o *deployEnvOpts) Execute() error {
+	env, err := o.store.GetEnvironment(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", o.name, o.appName, err)
+	}
+	mft, raw, err := o.readManifest()
+	if err != nil {
+		return err
+	}
+	if err := o.validateGitHubActions(env, mft); err != nil {
+		return err
+	}
+	if err := o.validateVersion(); err != nil {
+		return err
+	}
+	deployer, err := o.newEnvDeployer(env)
+	if err != nil {
+		return err
+	}
+	in := &deploy.CreateEnvironmentInput{
+		Version: deploy.LatestEnvTemplateVersion,
+		App: deploy.AppInformation{
+			Name: o.appName,
+		},
+		Name:              o.name,
+		Mft:               mft,
+		RawMft:            raw,
+		CFNServiceRoleARN: env.ExecutionRoleARN,
+	}
+	if o.showDiff {
+		// The diff must not modify the app's bucket, so the artifacts are not uploaded.
+		// The addons template URL is a parameter of the stack, so it doesn't change the template.
+		urls, err := o.customResourceURLs(env)
+		if err != nil {
+			return err
+		}
+		in.CustomResourcesURLs = urls
+		return o.writeDiff(deployer, in)
+	}
+	urls, addonsURL, err := o.uploadArtifacts(env)
+	if err != nil {
+		return err
+	}
+	in.CustomResourcesURLs = urls
+	in.AddonsTemplateURL = addonsURL
+	if err := o.deploy(deployer, in); err != nil {
+		return err
+	}
+	env.CustomConfig = mft.CustomConfig()
+	env.Telemetry = mft.Telemetry()
+	if err := o.store.UpdateEnvironment(env); err != nil {
+		return fmt.Errorf("update environment %s configuration: %w", o.name, err)
+	}
+	return nil
+}
+
+// RecommendActions is a no-op for this command.
+func (o *deployEnvOpts) RecommendActions() error {
+	return nil
+}
+
+// readManifest returns the validated manifest of the environment along with the content of the manifest file.
+func (o *deployEnvOpts) readManifest() (*manifest.Environment, []byte, error) {
+	raw, err := o.ws.ReadEnvironmentManifest(o.name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read manifest for environment %s: %w", o.name, err)
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal manifest for environment %s: %w", o.name, err)
+	}
+	if err := mft.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("validate manifest for environment %s: %w", o.name, err)
+	}
+	if name := aws.StringValue(mft.Name); name != o.name {
+		return nil, nil, fmt.Errorf(`name "%s" in the manifest does not match environment %s`, name, o.name)
+	}
+	return mft, raw, nil
+}
+
+// validateGitHubActions returns an error if the manifest configures a GitHub Actions role
+// in an environment that is in a different account than the application.
+// The role reads the application's parameters and updates its stack set in the account of the environment.
+func (o *deployEnvOpts) validateGitHubActions(env *config.Environment, mft *manifest.Environment) error {
+	if mft.CICD.GitHubActions.IsEmpty() {
+		return nil
+	}
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	if env.AccountID != app.AccountID {
+		return fmt.Errorf(`environment %s is in account %s, but application %s is in account %s: "cicd.github_actions" requires the environment to be in the account of the application`,
+			o.name, env.AccountID, o.appName, app.AccountID)
+	}
+	return nil
+}
+
+func (o *deployEnvOpts) validateVersion() error {
+	getter, err := o.newEnvVersionGetter(o.appName, o.name)
+	if err != nil {
+		return err
+	}
+	version, err := getter.Version()
+	if err != nil {
+		return fmt.Errorf("get template version of environment %s in app %s: %w", o.name, o.appName, err)
+	}
+	if version == deploy.LegacyEnvTemplateVersion {
+		log.Errorf("Environment %s is on a legacy template version. Run %s first.\n",
+			color.HighlightUserInput(o.name), color.HighlightCode(fmt.Sprintf("copilot env upgrade -n %s", o.name)))
+		return fmt.Errorf("environment %s must be upgraded before it can be deployed", o.name)
+	}
+	return nil
+}
+
+// uploadArtifacts uploads the custom resources and the environment addons template to the app's bucket in the environment's region.
+// If the environment has no addons, the returned addons template URL is empty.
+func (o *deployEnvOpts) uploadArtifacts(env *config.Environment) (urls map[string]string, addonsURL string, err error) {
+	bucket, err := o.appBucket(env)
+	if err != nil {
+		return nil, "", err
+	}
+	s3Client, err := o.newS3(env.Region)
+	if err != nil {
+		return nil, "", err
+	}
+	urls, err = o.uploader.UploadEnvironmentCustomResources(s3.CompressAndUploadFunc(func(key string, objects ...s3.NamedBinary) (string, error) {
+		return s3Client.ZipAndUpload(bucket, key, objects...)
+	}))
+	if err != nil {
+		return nil, "", fmt.Errorf("upload custom resources to bucket %s: %w", bucket, err)
+	}
+	tpl, err := o.addons.Template()
+	if err != nil {
+		var notFoundErr *addon.ErrAddonsNotFound
+		if errors.As(err, &notFoundErr) {
+			// The environment doesn't have addons, the url is empty.
+			return urls, "", nil
+		}
+		return nil, "", fmt.Errorf("retrieve environment addons template: %w", err)
+	}
+	addonsURL, err = s3Client.PutArtifact(bucket, fmt.Sprintf(deploy.EnvAddonsCfnTemplateNameFormat, o.name), strings.NewReader(tpl))
+	if err != nil {
+		return nil, "", fmt.Errorf("put environment addons artifact to bucket %s: %w", bucket, err)
+	}
+	return urls, addonsURL, nil
+}
+
+// customResourceURLs returns the URLs of the custom resources in the app's bucket without uploading them.
+// The keys of the custom resources are derived from their content, so the URLs match the ones of a deployment.
+func (o *deployEnvOpts) customResourceURLs(env *config.Environment) (map[string]string, error) {
+	bucket, err := o.appBucket(env)
+	if err != nil {
+		return nil, err
+	}
+	urls, err := o.uploader.UploadEnvironmentCustomResources(s3.CompressAndUploadFunc(func(key string, _ ...s3.NamedBinary) (string, error) {
+		return s3.URL(env.Region, bucket, key), nil
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("get custom resource URLs in bucket %s: %w", bucket, err)
+	}
+	return urls, nil
+}
+
+// appBucket returns the name of the app's bucket in the environment's region.
+func (o *deployEnvOpts) appBucket(env *config.Environment) (string, error) {
+	app, err := o.store.GetApplication(o.appName)
+	if err != nil {
+		return "", fmt.Errorf("get application %s: %w", o.appName, err)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return "", fmt.Errorf("get app resources: %w", err)
+	}
+	return resources.S3Bucket, nil
+}
+
+func (o *deployEnvOpts) writeDiff(deployer envTemplater, in *deploy.CreateEnvironmentInput) error {
+	deployed, err := deployer.EnvironmentTemplate(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get template of environment %s: %w", o.name, err)
+	}
+	local, err := o.newTemplater(in).Template()
+	if err != nil {
+		return fmt.Errorf("generate template of environment %s: %w", o.name, err)
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(deployed),
+		B:        splitLines(local),
+		FromFile: "deployed",
+		ToFile:   "local",
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Errorf("compare templates of environment %s: %w", o.name, err)
+	}
+	if diff == "" {
+		log.Infof("No changes to environment %s.\n", color.HighlightUserInput(o.name))
+		return nil
+	}
+	fmt.Fprint(o.diffWriter, diff)
+	return nil
+}
+
+// splitLines splits s into lines that keep their trailing newline.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func (o *deployEnvOpts) deploy(deployer envDeployer, in *deploy.CreateEnvironmentInput) (err error) {
+	o.prog.Start(fmt.Sprintf(fmtEnvDeployStart, color.HighlightUserInput(o.name)))
+	defer func() {
+		if err != nil {
+			o.prog.Stop(log.Serrorf(fmtEnvDeployFailed, color.HighlightUserInput(o.name)))
+			return
+		}
+		o.prog.Stop(log.Ssuccessf(fmtEnvDeployComplete, color.HighlightUserInput(o.name)))
+	}()
+	if err := deployer.DeployEnvironment(in); err != nil {
+		return fmt.Errorf("deploy environment %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// buildEnvDeployCmd builds the command to deploy an environment with its manifest.
+func buildEnvDeployCmd() *cobra.Command {
+	vars := deployEnvVars{}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploys an environment to an application with its manifest.",
+		Long: `Deploys an environment to an application with the configuration in copilot/environments/<name>/manifest.yml.
+The addons under copilot/environments/addons/ are deployed with the environment and shared by its workloads.`,
+		Example: `
+  Deploy the "test" environment.
+  /code $ copilot env deploy --name test
+  Show the changes to the "prod" environment without deploying them.
+  /code $ copilot env deploy --name prod --diff`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newEnvDeployOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.showDiff, diffFlag, false, diffFlagDescription)
+	return cmd
+}
@@ -0,0 +1,400 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/addon"
+	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeployEnvOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+
+		setupMocks func(m *mocks.Mockstore)
+
+		wantedErr string
+	}{
+		"no error if no flags are set": {
+			setupMocks: func(m *mocks.Mockstore) {},
+		},
+		"error if the application does not exist": {
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get application phonetool configuration: some error",
+		},
+		"error if the environment does not exist": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get environment test configuration from application phonetool: some error",
+		},
+		"no error if the application and environment exist": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockstore(ctrl)
+			tc.setupMocks(m)
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inEnvName,
+				},
+				store: m,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeployEnvOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inEnvName string
+
+		setupMocks func(m *mocks.MockappEnvSelector)
+
+		wantedAppName string
+		wantedEnvName string
+		wantedErr     string
+	}{
+		"prompts for the application and the environment": {
+			setupMocks: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Application(envDeployAppNamePrompt, "").Return("phonetool", nil)
+				m.EXPECT().Environment(envDeployEnvNamePrompt, envDeployEnvNameHelp, "phonetool").Return("test", nil)
+			},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+		"wraps error from selecting the environment": {
+			inAppName: "phonetool",
+			setupMocks: func(m *mocks.MockappEnvSelector) {
+				m.EXPECT().Environment(gomock.Any(), gomock.Any(), "phonetool").Return("", errors.New("some error"))
+			},
+			wantedErr: "select environment: some error",
+		},
+		"does not prompt if the flags are set": {
+			inAppName:     "phonetool",
+			inEnvName:     "test",
+			setupMocks:    func(m *mocks.MockappEnvSelector) {},
+			wantedAppName: "phonetool",
+			wantedEnvName: "test",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockappEnvSelector(ctrl)
+			tc.setupMocks(m)
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName: tc.inAppName,
+					name:    tc.inEnvName,
+				},
+				sel: m,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedAppName, opts.appName)
+				require.Equal(t, tc.wantedEnvName, opts.name)
+			}
+		})
+	}
+}
+
+type deployEnvMocks struct {
+	store         *mocks.Mockstore
+	ws            *mocks.MockwsEnvironmentReader
+	prog          *mocks.Mockprogress
+	appCFN        *mocks.MockappResourcesGetter
+	uploader      *mocks.MockcustomResourcesUploader
+	versionGetter *mocks.MockversionGetter
+	deployer      *mocks.MockenvTemplateDeployer
+	templater     *mocks.Mocktemplater
+	addons        *mocks.Mocktemplater
+	s3            *mocks.MockzipAndArtifactUploader
+}
+
+func TestDeployEnvOpts_Execute(t *testing.T) {
+	const mockManifest = `name: test
+type: Environment
+observability:
+  container_insights: true
+`
+	mockEnv := func() *config.Environment {
+		return &config.Environment{
+			App:              "phonetool",
+			Name:             "test",
+			Region:           "us-west-2",
+			ExecutionRoleARN: "execution-role",
+			ManagerRoleARN:   "manager-role",
+		}
+	}
+	mockUploadedResources := func(m *deployEnvMocks) {
+		m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+		m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+			Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+		m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockResource": "mockURL"}, nil)
+	}
+	mockNoAddons := func(m *deployEnvMocks) {
+		m.addons.EXPECT().Template().Return("", &addon.ErrAddonsNotFound{})
+	}
+
+	testCases := map[string]struct {
+		inShowDiff bool
+		setupMocks func(m *deployEnvMocks)
+
+		wantedDiff string
+		wantedErr  string
+	}{
+		"error if the manifest can't be read": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "read manifest for environment test: some error",
+		},
+		"error if the manifest is for another environment": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte("name: prod\ntype: Environment\n"), nil)
+			},
+			wantedErr: `name "prod" in the manifest does not match environment test`,
+		},
+		"error if the environment is on the legacy template": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return(deploy.LegacyEnvTemplateVersion, nil)
+			},
+			wantedErr: "environment test must be upgraded before it can be deployed",
+		},
+		"error if the GitHub Actions role is configured in another account than the application": {
+			setupMocks: func(m *deployEnvMocks) {
+				env := mockEnv()
+				env.AccountID = "222222222222"
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(env, nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(`name: test
+type: Environment
+cicd:
+  github_actions:
+    repository: aws/copilot-cli
+`), nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool", AccountID: "111111111111"}, nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+			},
+			wantedErr: `environment test is in account 222222222222, but application phonetool is in account 111111111111: "cicd.github_actions" requires the environment to be in the account of the application`,
+		},
+		"writes the diff without deploying": {
+			inShowDiff: true,
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.6.0", nil)
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+				m.appCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+					Return(&stack.AppRegionalResources{S3Bucket: "mockBucket"}, nil)
+				m.uploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).DoAndReturn(func(upload s3.CompressAndUploadFunc) (map[string]string, error) {
+					url, err := upload("scripts/mockResource/abc")
+					require.NoError(t, err)
+					require.Equal(t, "https://mockBucket.s3.us-west-2.amazonaws.com/scripts/mockResource/abc", url)
+					return map[string]string{"mockResource": url}, nil
+				})
+				m.s3.EXPECT().ZipAndUpload(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.s3.EXPECT().PutArtifact(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.addons.EXPECT().Template().Times(0)
+				m.deployer.EXPECT().EnvironmentTemplate("phonetool", "test").Return("Resources:\n  Cluster: {}\n", nil)
+				m.templater.EXPECT().Template().Return("Resources:\n  Cluster:\n    Type: AWS::ECS::Cluster\n", nil)
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Times(0)
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Times(0)
+			},
+			wantedDiff: `--- deployed
++++ local
+@@ -1,2 +1,3 @@
+ Resources:
+-  Cluster: {}
++  Cluster:
++    Type: AWS::ECS::Cluster
+`,
+		},
+		"wraps error if the environment addons template can't be generated": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.6.0", nil)
+				mockUploadedResources(m)
+				m.addons.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			wantedErr: "retrieve environment addons template: some error",
+		},
+		"wraps error if the environment addons template can't be uploaded": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.6.0", nil)
+				mockUploadedResources(m)
+				m.addons.EXPECT().Template().Return("Resources: {}\n", nil)
+				m.s3.EXPECT().PutArtifact("mockBucket", "test.env.addons.stack.yml", gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: "put environment addons artifact to bucket mockBucket: some error",
+		},
+		"deploys the environment with its addons": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.6.0", nil)
+				mockUploadedResources(m)
+				m.addons.EXPECT().Template().Return("Resources: {}\n", nil)
+				m.s3.EXPECT().PutArtifact("mockBucket", "test.env.addons.stack.yml", gomock.Any()).Return("mockAddonsURL", nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.CreateEnvironmentInput) error {
+					require.Equal(t, "mockAddonsURL", in.AddonsTemplateURL)
+					return nil
+				})
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.store.EXPECT().UpdateEnvironment(gomock.Any()).Return(nil)
+			},
+		},
+		"wraps error if the deployment fails": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.6.0", nil)
+				mockUploadedResources(m)
+				mockNoAddons(m)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).Return(errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: "deploy environment test: some error",
+		},
+		"deploys the environment and updates its configuration": {
+			setupMocks: func(m *deployEnvMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv(), nil)
+				m.ws.EXPECT().ReadEnvironmentManifest("test").Return([]byte(mockManifest), nil)
+				m.versionGetter.EXPECT().Version().Return("v1.6.0", nil)
+				mockUploadedResources(m)
+				mockNoAddons(m)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.deployer.EXPECT().DeployEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.CreateEnvironmentInput) error {
+					require.Equal(t, deploy.LatestEnvTemplateVersion, in.Version)
+					require.Equal(t, "phonetool", in.App.Name)
+					require.Equal(t, "test", in.Name)
+					require.Equal(t, map[string]string{"mockResource": "mockURL"}, in.CustomResourcesURLs)
+					require.Equal(t, "execution-role", in.CFNServiceRoleARN)
+					require.Empty(t, in.AddonsTemplateURL)
+					require.NotNil(t, in.Mft)
+					require.Equal(t, []byte(mockManifest), in.RawMft)
+					return nil
+				})
+				m.prog.EXPECT().Stop(gomock.Any())
+				wanted := mockEnv()
+				wanted.Telemetry = &config.Telemetry{EnableContainerInsights: true}
+				m.store.EXPECT().UpdateEnvironment(wanted).Return(nil)
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &deployEnvMocks{
+				store:         mocks.NewMockstore(ctrl),
+				ws:            mocks.NewMockwsEnvironmentReader(ctrl),
+				prog:          mocks.NewMockprogress(ctrl),
+				appCFN:        mocks.NewMockappResourcesGetter(ctrl),
+				uploader:      mocks.NewMockcustomResourcesUploader(ctrl),
+				versionGetter: mocks.NewMockversionGetter(ctrl),
+				deployer:      mocks.NewMockenvTemplateDeployer(ctrl),
+				templater:     mocks.NewMocktemplater(ctrl),
+				addons:        mocks.NewMocktemplater(ctrl),
+				s3:            mocks.NewMockzipAndArtifactUploader(ctrl),
+			}
+			tc.setupMocks(m)
+			diff := &bytes.Buffer{}
+			opts := deployEnvOpts{
+				deployEnvVars: deployEnvVars{
+					appName:  "phonetool",
+					name:     "test",
+					showDiff: tc.inShowDiff,
+				},
+				store:      m.store,
+				ws:         m.ws,
+				prog:       m.prog,
+				appCFN:     m.appCFN,
+				uploader:   m.uploader,
+				addons:     m.addons,
+				diffWriter: diff,
+				newEnvVersionGetter: func(app, env string) (versionGetter, error) {
+					return m.versionGetter, nil
+				},
+				newEnvDeployer: func(conf *config.Environment) (envTemplateDeployer, error) {
+					return m.deployer, nil
+				},
+				newS3: func(region string) (zipAndArtifactUploader, error) {
+					return m.s3, nil
+				},
+				newTemplater: func(in *deploy.CreateEnvironmentInput) templater {
+					return m.templater
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDiff, diff.String())
+			}
+		})
+	}
+}
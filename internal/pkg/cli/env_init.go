@@ -23,12 +23,14 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
@@ -148,6 +150,7 @@ type initEnvOpts struct {
 	appCFN       appResourcesGetter
 	newS3        func(string) (zipAndUploader, error)
 	uploader     customResourcesUploader
+	ws           wsEnvironmentWriter
 
 	sess *session.Session // Session pointing to environment's AWS account and region.
 }
@@ -167,6 +170,11 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		return nil, fmt.Errorf("read named profiles: %w", err)
 	}
 
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+
 	prompter := prompt.New()
 	return &initEnvOpts{
 		initEnvVars:  vars,
@@ -184,6 +192,7 @@ func newInitEnvOpts(vars initEnvVars) (*initEnvOpts, error) {
 		selApp:   selector.NewSelect(prompt.New(), store),
 		uploader: template.New(),
 		appCFN:   deploycfn.New(defaultSession),
+		ws:       ws,
 		newS3: func(region string) (zipAndUploader, error) {
 			sess, err := sessProvider.DefaultWithRegion(region)
 			if err != nil {
@@ -297,9 +306,36 @@ func (o *initEnvOpts) Execute() error {
 	}
 	log.Successf("Created environment %s in region %s under application %s.\n",
 		color.HighlightUserInput(env.Name), color.Emphasize(env.Region), color.HighlightUserInput(env.App))
+
+	// 7. Write the environment manifest to the workspace.
+	o.writeManifest(env)
 	return nil
 }
 
+func (o *initEnvOpts) writeManifest(env *config.Environment) {
+	mft := manifest.NewEnvironment(&manifest.EnvironmentProps{
+		Name:         env.Name,
+		CustomConfig: env.CustomConfig,
+		Telemetry:    env.Telemetry,
+	})
+	msgFmt := "Wrote the manifest for environment %s at %s\n"
+	path, err := o.ws.WriteEnvironmentManifest(mft, env.Name)
+	if err != nil {
+		var errFileExists *workspace.ErrFileExists
+		if !errors.As(err, &errFileExists) {
+			// The environment is already created, so don't fail the command if the workspace can't be written to.
+			log.Warningf("Couldn't write the manifest for environment %s to the workspace: %v\n", env.Name, err)
+			return
+		}
+		msgFmt = "Manifest file for environment %s already exists at %s, skipping writing it.\n"
+		path = errFileExists.FileName
+	}
+	if rel, err := relPath(path); err == nil {
+		path = rel
+	}
+	log.Successf(msgFmt, color.HighlightUserInput(env.Name), color.HighlightResource(path))
+}
+
 // RecommendActions returns follow-up actions the user can take after successfully executing the command.
 func (o *initEnvOpts) RecommendActions() error {
 	return nil
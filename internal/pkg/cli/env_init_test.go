@@ -4,6 +4,7 @@
 package cli
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"net"
@@ -20,7 +21,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
@@ -695,6 +698,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 		expectCFN               func(m *mocks.MockstackExistChecker)
 		expectAppCFN            func(m *mocks.MockappResourcesGetter)
 		expectResourcesUploader func(m *mocks.MockcustomResourcesUploader)
+		expectWorkspace         func(m *mocks.MockwsEnvironmentWriter)
 
 		wantedErrorS string
 	}{
@@ -931,6 +935,15 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
 				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(nil, nil)
 			},
+			expectWorkspace: func(m *mocks.MockwsEnvironmentWriter) {
+				m.EXPECT().WriteEnvironmentManifest(gomock.Any(), "test").
+					DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+						env, ok := mft.(*manifest.Environment)
+						require.True(t, ok)
+						require.Equal(t, "test", aws.StringValue(env.Name))
+						return "/copilot/environments/test/manifest.yml", nil
+					})
+			},
 		},
 		"skips creating stack if environment stack already exists": {
 			expectStore: func(m *mocks.Mockstore) {
@@ -984,6 +997,10 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			expectResourcesUploader: func(m *mocks.MockcustomResourcesUploader) {
 				m.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
 			},
+			expectWorkspace: func(m *mocks.MockwsEnvironmentWriter) {
+				m.EXPECT().WriteEnvironmentManifest(gomock.Any(), "test").
+					Return("", &workspace.ErrFileExists{FileName: "/copilot/environments/test/manifest.yml"})
+			},
 		},
 		"failed to delegate DNS (app has Domain and env and apps are different)": {
 			expectStore: func(m *mocks.Mockstore) {
@@ -1070,6 +1087,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			mockCFN := mocks.NewMockstackExistChecker(ctrl)
 			mockResourcesUploader := mocks.NewMockcustomResourcesUploader(ctrl)
 			mockUploader := mocks.NewMockzipAndUploader(ctrl)
+			mockWorkspace := mocks.NewMockwsEnvironmentWriter(ctrl)
 			if tc.expectStore != nil {
 				tc.expectStore(mockStore)
 			}
@@ -1094,6 +1112,11 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 			if tc.expectResourcesUploader != nil {
 				tc.expectResourcesUploader(mockResourcesUploader)
 			}
+			if tc.expectWorkspace != nil {
+				tc.expectWorkspace(mockWorkspace)
+			} else {
+				mockWorkspace.EXPECT().WriteEnvironmentManifest(gomock.Any(), "test").Return("/copilot/environments/test/manifest.yml", nil).AnyTimes()
+			}
 
 			provider := sessions.NewProvider()
 			sess, _ := provider.DefaultWithRegion("us-west-2")
@@ -1115,6 +1138,7 @@ func TestInitEnvOpts_Execute(t *testing.T) {
 				sess:        sess,
 				appCFN:      mockAppCFN,
 				uploader:    mockResourcesUploader,
+				ws:          mockWorkspace,
 				newS3: func(region string) (zipAndUploader, error) {
 					return mockUploader, nil
 				},
@@ -266,7 +266,7 @@ Are you using the latest version of AWS Copilot?`, env, deploy.LatestEnvTemplate
 	return false
 }
 
-func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.Environment,
+func (o *envUpgradeOpts) upgradeEnvironment(upgrader envTemplateUpgrader, conf *config.Environment,
 	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
 	var importedVPC *config.ImportVPC
 	var adjustedVPC *config.AdjustVPC
@@ -274,6 +274,10 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 		importedVPC = conf.CustomConfig.ImportVPC
 		adjustedVPC = conf.CustomConfig.VPCConfig
 	}
+	mft, raw, err := o.deployedManifest(upgrader, conf)
+	if err != nil {
+		return err
+	}
 
 	if err := upgrader.UpgradeEnvironment(&deploy.CreateEnvironmentInput{
 		Version: toVersion,
@@ -284,6 +288,9 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 		CustomResourcesURLs: customResourcesURLs,
 		ImportVPCConfig:     importedVPC,
 		AdjustVPCConfig:     adjustedVPC,
+		Telemetry:           conf.Telemetry,
+		Mft:                 mft,
+		RawMft:              raw,
 		CFNServiceRoleARN:   conf.ExecutionRoleARN,
 	}); err != nil {
 		return fmt.Errorf("upgrade environment %s from version %s to version %s: %v", conf.Name, fromVersion, toVersion, err)
@@ -291,6 +298,28 @@ func (o *envUpgradeOpts) upgradeEnvironment(upgrader envUpgrader, conf *config.E
 	return nil
 }
 
+// deployedManifest returns the manifest that the environment was last deployed with by "env deploy",
+// so that upgrading the template keeps the configuration of the manifest.
+// If the environment was never deployed with a manifest, it returns nil.
+func (o *envUpgradeOpts) deployedManifest(cfn envTemplater, conf *config.Environment) (*manifest.Environment, []byte, error) {
+	tpl, err := cfn.EnvironmentTemplate(conf.App, conf.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get environment %s template body: %v", conf.Name, err)
+	}
+	raw, err := stack.EnvManifestFromTemplate(tpl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read the manifest of environment %s: %v", conf.Name, err)
+	}
+	if raw == nil {
+		return nil, nil, nil
+	}
+	mft, err := manifest.UnmarshalEnvironment(raw)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unmarshal the deployed manifest of environment %s: %v", conf.Name, err)
+	}
+	return mft, raw, nil
+}
+
 func (o *envUpgradeOpts) upgradeLegacyEnvironment(upgrader legacyEnvUpgrader, conf *config.Environment,
 	customResourcesURLs map[string]string, fromVersion, toVersion string) error {
 	isDefaultEnv, err := o.isDefaultLegacyTemplate(upgrader, conf.App, conf.Name)
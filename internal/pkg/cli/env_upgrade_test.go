@@ -12,6 +12,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
@@ -278,6 +279,7 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 				mockUploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(map[string]string{"mockCustomResource": "mockURL"}, nil)
 
 				mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+				mockUpgrader.EXPECT().EnvironmentTemplate("phonetool", "test").Return("Metadata:\n  Version: v0.1.0\n", nil)
 				mockUpgrader.EXPECT().UpgradeEnvironment(&deploy.CreateEnvironmentInput{
 					Version: deploy.LatestEnvTemplateVersion,
 					App: deploy.AppInformation{
@@ -533,3 +535,89 @@ func TestEnvUpgradeOpts_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvUpgradeOpts_ExecuteWithDeployedManifest(t *testing.T) {
+	// GIVEN
+	const privateOnlyMft = `name: test
+type: Environment
+network:
+  vpc:
+    private_only: true
+`
+	customResourcesURLs := map[string]string{
+		template.DNSCertValidatorFileName: "https://mockbucket.s3-us-west-2.amazonaws.com/mockkey1",
+		template.DNSDelegationFileName:    "https://mockbucket.s3-us-west-2.amazonaws.com/mockkey2",
+		template.EnableLongARNsFileName:   "https://mockbucket.s3-us-west-2.amazonaws.com/mockkey3",
+		template.CustomDomainFileName:     "https://mockbucket.s3-us-west-2.amazonaws.com/mockkey4",
+	}
+	mft, err := manifest.UnmarshalEnvironment([]byte(privateOnlyMft))
+	require.NoError(t, err)
+	deployedTpl, err := stack.NewEnvStackConfig(&deploy.CreateEnvironmentInput{
+		Version:             "v1.6.0",
+		App:                 deploy.AppInformation{Name: "phonetool"},
+		Name:                "test",
+		CustomResourcesURLs: customResourcesURLs,
+		Mft:                 mft,
+		RawMft:              []byte(privateOnlyMft),
+	}).Template()
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockEnvTpl := mocks.NewMockversionGetter(ctrl)
+	mockEnvTpl.EXPECT().Version().Return("v1.6.0", nil)
+	mockProg := mocks.NewMockprogress(ctrl)
+	mockProg.EXPECT().Start(gomock.Any())
+	mockProg.EXPECT().Stop(gomock.Any())
+	mockStore := mocks.NewMockstore(ctrl)
+	mockStore.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{
+		App:    "phonetool",
+		Name:   "test",
+		Region: "us-west-2",
+	}, nil)
+	mockStore.EXPECT().GetApplication("phonetool").Return(&config.Application{Name: "phonetool"}, nil)
+	mockAppCFN := mocks.NewMockappResourcesGetter(ctrl)
+	mockAppCFN.EXPECT().GetAppResourcesByRegion(&config.Application{Name: "phonetool"}, "us-west-2").
+		Return(&stack.AppRegionalResources{
+			S3Bucket: "mockBucket",
+		}, nil)
+	mockUploader := mocks.NewMockcustomResourcesUploader(ctrl)
+	mockUploader.EXPECT().UploadEnvironmentCustomResources(gomock.Any()).Return(customResourcesURLs, nil)
+	mockUpgrader := mocks.NewMockenvTemplateUpgrader(ctrl)
+	mockUpgrader.EXPECT().EnvironmentTemplate("phonetool", "test").Return(deployedTpl, nil)
+	mockUpgrader.EXPECT().UpgradeEnvironment(gomock.Any()).DoAndReturn(func(in *deploy.CreateEnvironmentInput) error {
+		require.Equal(t, []byte(privateOnlyMft), in.RawMft)
+		tpl, err := stack.NewEnvStackConfig(in).Template()
+		require.NoError(t, err)
+		require.NotContains(t, tpl, "AWS::EC2::NatGateway")
+		require.NotContains(t, tpl, "AWS::EC2::InternetGateway")
+		require.Contains(t, tpl, "private_only: true") // Keep the manifest for the next upgrade.
+		return nil
+	})
+
+	opts := &envUpgradeOpts{
+		envUpgradeVars: envUpgradeVars{
+			appName: "phonetool",
+			name:    "test",
+		},
+		store: mockStore,
+		prog:  mockProg,
+		newEnvVersionGetter: func(_, _ string) (versionGetter, error) {
+			return mockEnvTpl, nil
+		},
+		newTemplateUpgrader: func(conf *config.Environment) (envTemplateUpgrader, error) {
+			return mockUpgrader, nil
+		},
+		uploader: mockUploader,
+		appCFN:   mockAppCFN,
+		newS3: func(region string) (zipAndUploader, error) {
+			return mocks.NewMockzipAndUploader(ctrl), nil
+		},
+	}
+
+	// WHEN
+	err = opts.Execute()
+
+	// THEN
+	require.NoError(t, err)
+}
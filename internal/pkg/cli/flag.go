@@ -48,9 +48,14 @@ const (
 	gitBranchFlag         = "git-branch"
 	envsFlag              = "environments"
 	domainNameFlag        = "domain"
-	localFlag             = "local"
-	deleteSecretFlag      = "delete-secret"
-	svcPortFlag           = "port"
+
+	ecrKeepImagesFlag         = "ecr-keep-images"
+	ecrUntaggedExpiryDaysFlag = "ecr-untagged-expiry-days"
+	ecrScanOnPushFlag         = "ecr-scan-on-push"
+	ecrImmutableTagsFlag      = "ecr-immutable-tags"
+	localFlag                 = "local"
+	deleteSecretFlag          = "delete-secret"
+	svcPortFlag               = "port"
 
 	noSubscriptionFlag  = "no-subscribe"
 	subscribeTopicsFlag = "subscribe-topics"
@@ -65,6 +70,13 @@ const (
 	storageRDSInitialDBFlag      = "initial-db"
 	storageRDSParameterGroupFlag = "parameter-group"
 
+	storageRedisClusterModeFlag       = "cluster-mode"
+	storageRedisNodeTypeFlag          = "node-type"
+	storageOpenSearchInstanceTypeFlag = "instance-type"
+	storageSQSFIFOFlag                = "fifo"
+	storageSQSMaxReceiveCountFlag     = "max-receive-count"
+	storageLifecycleFlag              = "lifecycle"
+
 	taskGroupNameFlag   = "task-group-name"
 	countFlag           = "count"
 	cpuFlag             = "cpu"
@@ -104,11 +116,35 @@ const (
 	taskIDFlag    = "task-id"
 	containerFlag = "container"
 
-	valuesFlag        = "values"
-	overwriteFlag     = "overwrite"
-	inputFilePathFlag = "cli-input-yaml"
+	localPortFlag  = "local-port"
+	remoteHostFlag = "remote-host"
+	remotePortFlag = "remote-port"
+
+	valuesFlag          = "values"
+	overwriteFlag       = "overwrite"
+	inputFilePathFlag   = "cli-input-yaml"
+	secretBackendFlag   = "backend"
+	rotationLambdasFlag = "rotation-lambdas"
+	rotationDaysFlag    = "rotation-days"
 
 	includeStateMachineLogsFlag = "include-state-machine"
+	executionFlag               = "execution"
+	listExecutionsFlag          = "list-executions"
+
+	diffFlag      = "diff"
+	changeSetFlag = "changeset"
+
+	fromEnvFlag = "from"
+	toEnvFlag   = "to"
+
+	workloadsFlag        = "workloads"
+	pipelinePathsFlag    = "paths"
+	pipelineProviderFlag = "provider"
+
+	outputFileFlag  = "output-file"
+	inputFileFlag   = "input-file"
+	dryRunFlag      = "dry-run"
+	envProfilesFlag = "env-profiles"
 )
 
 // Short flag names.
@@ -141,6 +177,8 @@ Mutually exclusive with -%s, --%s.`, dockerFileFlagShort, dockerFileFlag)
 Mutually exclusive with -%s, --%s.`, imageFlagShort, imageFlag)
 	storageTypeFlagDescription = fmt.Sprintf(`Type of storage to add. Must be one of:
 %s.`, strings.Join(template.QuoteSliceFunc(storageTypes), ", "))
+	storageLifecycleFlagDescription = fmt.Sprintf(`Optional. Whether the storage is deleted with its workload or shared by the workloads of an environment.
+Must be one of: %s.`, strings.Join(template.QuoteSliceFunc(storageLifecycles), ", "))
 	jobTypeFlagDescription = fmt.Sprintf(`Type of job to create. Must be one of:
 %s.`, strings.Join(template.QuoteSliceFunc(manifest.JobTypes), ", "))
 	wkldTypeFlagDescription = fmt.Sprintf(`Type of job or svc to create. Must be one of:
@@ -169,9 +207,18 @@ Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 Mutually exclusive with the --%s flag.`, inputFilePathFlag)
 	secretInputFilePathFlagDescription = fmt.Sprintf(`Optional. A YAML file in which the secret values are specified.
 Mutually exclusive with the -%s ,--%s and --%s flags.`, nameFlagShort, nameFlag, valuesFlag)
+	secretBackendFlagDescription = fmt.Sprintf(`Optional. Where to store the secret. Must be one of:
+%s.`, strings.Join(template.QuoteSliceFunc(secretBackends), ", "))
+	secretRotationLambdasFlagDescription = fmt.Sprintf(`Optional. ARNs of the Lambda functions that rotate the secret in each environment.
+Specified as <environment>=<function ARN> separated by commas. Requires --%s %s.
+The functions must be tagged with "copilot-application" set to the name of the application.`, secretBackendFlag, secretBackendSecretsManager)
+	secretRotationDaysFlagDescription = fmt.Sprintf(`Optional. Number of days between rotations of the secret.
+Requires --%s.`, rotationLambdasFlag)
 
 	repoURLFlagDescription = fmt.Sprintf(`The repository URL to trigger your pipeline.
 Supported providers are: %s`, strings.Join(manifest.PipelineProviders, ", "))
+	pipelineProviderFlagDescription = fmt.Sprintf(`Optional. The system that runs the pipeline.
+Must be one of: %s. Defaults to %s.`, strings.Join(pipelineCIProviders, ", "), pipelineCIProviderCodePipeline)
 )
 
 const (
@@ -205,14 +252,30 @@ Defaults to all logs. Only one of start-time / since may be used.`
 Defaults to all logs. Only one of end-time / follow may be used.`
 	tasksLogsFlagDescription               = "Optional. Only return logs from specific task IDs."
 	includeStateMachineLogsFlagDescription = "Optional. Include logs from the state machine executions."
-	logGroupFlagDescription                = "Optional. Only return logs from specific log group."
+	executionFlagDescription               = `Optional. Only return logs from the tasks of a specific execution.
+Defaults to the most recent execution.`
+	listExecutionsFlagDescription = "Optional. List the most recent executions of the job instead of displaying logs."
+	logGroupFlagDescription       = "Optional. Only return logs from specific log group."
+	jobRunFollowFlagDescription   = `Optional. Stream the logs of the execution and wait until it completes.
+Exits with an error if the execution does not succeed.`
 
 	deployTestFlagDescription        = `Deploy your service or job to a "test" environment.`
 	githubURLFlagDescription         = "(Deprecated.) Use --url instead. Repository URL to trigger your pipeline."
 	githubAccessTokenFlagDescription = "GitHub personal access token for your repository."
 	gitBranchFlagDescription         = "Branch used to trigger your pipeline."
 	pipelineEnvsFlagDescription      = "Environments to add to the pipeline."
-	domainNameFlagDescription        = "Optional. Your existing custom domain name."
+	pipelineWorkloadsFlagDescription = `Optional. Services and jobs deployed by the pipeline.
+Defaults to all the services and jobs in the workspace.`
+	pipelinePathsFlagDescription = `Optional. File path patterns that trigger the pipeline when changed.
+Supported for GitHub and Bitbucket repositories. With the github-actions provider,
+the patterns also filter the pushes that trigger the workflow.`
+	domainNameFlagDescription = "Optional. Your existing custom domain name."
+
+	ecrKeepImagesFlagDescription         = "Optional. Number of images to retain in the ECR repository of each service and job."
+	ecrUntaggedExpiryDaysFlagDescription = "Optional. Number of days after which untagged images expire in the ECR repository of each service and job."
+	ecrScanOnPushFlagDescription         = "Optional. Scan the images of each service and job for vulnerabilities when they are pushed."
+	ecrImmutableTagsFlagDescription      = `Optional. Prevent the tags of the images of each service and job from being overwritten.
+Images are only tagged with the tag from --tag or from git, instead of "latest".`
 	envResourcesFlagDescription      = "Optional. Show the resources in your environment."
 	svcResourcesFlagDescription      = "Optional. Show the resources in your service."
 	pipelineResourcesFlagDescription = "Optional. Show the resources in your pipeline."
@@ -240,6 +303,13 @@ Must be either "MySQL" or "PostgreSQL".`
 	storageRDSInitialDBFlagDescription      = "The initial database to create in the cluster."
 	storageRDSParameterGroupFlagDescription = "Optional. The name of the parameter group to associate with the cluster."
 
+	storageRedisClusterModeFlagDescription       = "Optional. Whether to partition the data across multiple shards."
+	storageRedisNodeTypeFlagDescription          = `Optional. The node type of the replication group. Defaults to "cache.t3.micro".`
+	storageOpenSearchInstanceTypeFlagDescription = `Optional. The instance type of the data nodes. Defaults to "t3.small.search".`
+	storageSQSFIFOFlagDescription                = "Optional. Whether to create a FIFO queue."
+	storageSQSMaxReceiveCountFlagDescription     = `Optional. The number of receives of a message before it's moved to the dead-letter queue.
+Defaults to 10.`
+
 	countFlagDescription         = "Optional. The number of tasks to set up."
 	cpuFlagDescription           = "Optional. The number of CPU units to reserve for each task."
 	memoryFlagDescription        = "Optional. The amount of memory to reserve in MiB for each task."
@@ -283,10 +353,36 @@ AWS Schedule Expressions of the form "rate(10 minutes)" or "cron(0 12 L * ? 2021
 are also accepted.`
 
 	upgradeAllEnvsDescription = "Optional. Upgrade all environments."
+	diffFlagDescription       = "Optional. Show the differences between the local and the deployed template without deploying."
+	changeSetFlagDescription  = `Optional. Also create a CloudFormation change set without executing it,
+and list the resources that will be changed or replaced.`
 
 	taskIDFlagDescription      = "Optional. ID of the task you want to exec in."
 	execCommandFlagDescription = `Optional. The command that is passed to a running container.`
 	containerFlagDescription   = "Optional. The specific container you want to exec in. By default the first essential container will be used."
 
-	secretOverwriteFlagDescription = "Optional. Whether to overwrite an existing secret."
+	portForwardTaskIDFlagDescription    = "Optional. ID of the task to forward the port through. By default a running task is selected."
+	portForwardContainerFlagDescription = "Optional. The container to forward the port through. By default the first essential container will be used."
+	localPortFlagDescription            = "Optional. The port on your machine to listen on. Defaults to the remote port."
+	remoteHostFlagDescription           = `Optional. The host to forward the port to, such as the endpoint of a database.
+Defaults to "localhost", which is the running task itself.`
+	remotePortFlagDescription = "The port of the remote host to forward to."
+
+	cpContainerFlagDescription = "Optional. The container to copy files to or from. By default the first essential container will be used."
+
+	secretOverwriteFlagDescription    = "Optional. Whether to overwrite an existing secret."
+	existingSecretNameFlagDescription = "Name of the secret."
+	secretLsEnvFlagDescription        = "Optional. Only list the secrets of this environment."
+	secretShowEnvFlagDescription      = "Optional. Only show the secret in this environment."
+	secretDeleteEnvFlagDescription    = "Optional. Only delete the secret in this environment."
+
+	fromEnvFlagDescription = "Name of the environment to promote the image from."
+	toEnvFlagDescription   = "Name of the environment to promote the image to."
+
+	appExportOutputFileFlagDescription  = "Optional. Path of the file to write the exported application to. Writes to stdout by default."
+	appImportInputFileFlagDescription   = `Path of a file written by "copilot app export".`
+	appImportDryRunFlagDescription      = "Optional. List the steps to import the application without running them."
+	appImportEnvProfilesFlagDescription = `Optional. Named profiles to update the environments with if the application moves to another account.
+For example: test=test-profile,prod=prod-profile. Environments without a profile are updated
+with the current credentials, which only works for environments in the current account.`
 )
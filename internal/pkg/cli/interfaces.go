@@ -16,13 +16,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	awselbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 	"github.com/aws/copilot-cli/internal/pkg/initialize"
@@ -108,6 +113,7 @@ type applicationDeleter interface {
 
 type environmentStore interface {
 	environmentCreator
+	environmentUpdater
 	environmentGetter
 	environmentLister
 	environmentDeleter
@@ -117,6 +123,10 @@ type environmentCreator interface {
 	CreateEnvironment(env *config.Environment) error
 }
 
+type environmentUpdater interface {
+	UpdateEnvironment(env *config.Environment) error
+}
+
 type environmentGetter interface {
 	GetEnvironment(appName string, environmentName string) (*config.Environment, error)
 }
@@ -181,7 +191,7 @@ type templater interface {
 }
 
 type stackSerializer interface {
-	templater
+	cloudformation.StackConfiguration
 	SerializedParameters() (string, error)
 }
 
@@ -241,13 +251,33 @@ type copilotDirGetter interface {
 	CopilotDirPath() (string, error)
 }
 
+type wsPipelineLister interface {
+	ListPipelines() ([]workspace.PipelineManifest, error)
+}
+
 type wsPipelineManifestReader interface {
-	ReadPipelineManifest() ([]byte, error)
+	wsPipelineLister
+	ReadPipelineManifest(path string) ([]byte, error)
 }
 
 type wsPipelineWriter interface {
-	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error)
-	WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error)
+	WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error)
+	WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error)
+}
+
+type wsPipelineIniter interface {
+	wsPipelineWriter
+	WriteGitHubActionsWorkflow(marshaler encoding.BinaryMarshaler, name string) (string, error)
+	WorkloadNames() ([]string, error)
+}
+
+type wsEnvironmentWriter interface {
+	WriteEnvironmentManifest(marshaler encoding.BinaryMarshaler, envName string) (string, error)
+}
+
+type wsEnvironmentReader interface {
+	ReadEnvironmentManifest(envName string) ([]byte, error)
+	EnvNames() ([]string, error)
 }
 
 type wsServiceLister interface {
@@ -277,6 +307,11 @@ type wsWlReader interface {
 	WorkloadNames() ([]string, error)
 }
 
+type wsWlManifestReader interface {
+	wsSvcReader
+	wsJobReader
+}
+
 type wsJobDirReader interface {
 	wsJobReader
 	copilotDirGetter
@@ -303,6 +338,7 @@ type wsAppManager interface {
 
 type wsAddonManager interface {
 	WriteAddon(f encoding.BinaryMarshaler, svc, name string) (string, error)
+	WriteEnvAddon(f encoding.BinaryMarshaler, name string) (string, error)
 	wsWlReader
 }
 
@@ -314,6 +350,11 @@ type zipAndUploader interface {
 	ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error)
 }
 
+type zipAndArtifactUploader interface {
+	zipAndUploader
+	artifactUploader
+}
+
 type Uploader interface {
 	zipAndUploader
 	Upload(bucket, key string, file s3.NamedBinary) (string, error)
@@ -373,6 +414,31 @@ type appDeployer interface {
 	DeleteApp(name string) error
 }
 
+type sidecarRepoAdder interface {
+	AddSidecarsToApp(app *config.Application, wlName string, sidecars []string) error
+}
+
+type imageRepositoryUpdater interface {
+	UpdateImageRepository(app *config.Application, wlName string, repo *stack.ImageRepositoryConfig) error
+}
+
+type imageScanner interface {
+	ImageScanFindings(repoName, digest string) ([]ecr.ScanFinding, error)
+}
+
+type appResourcesConfigGetter interface {
+	AppResourcesConfig(app *config.Application) (*stack.AppResourcesConfig, error)
+}
+
+type appResourcesImporter interface {
+	DeployApp(in *deploy.CreateAppInput) error
+	ImportAppResources(app *config.Application, resources *stack.AppResourcesConfig, regions []string) error
+}
+
+type envTrustUpdater interface {
+	UpdateEnvironmentTrust(app *deploy.AppInformation, envName, cfnExecRoleARN string) error
+}
+
 type appResourcesGetter interface {
 	GetAppResourcesByRegion(app *config.Application, region string) (*stack.AppRegionalResources, error)
 	GetRegionalAppResources(app *config.Application) ([]*stack.AppRegionalResources, error)
@@ -427,6 +493,18 @@ type endpointGetter interface {
 	ServiceDiscoveryEndpoint() (string, error)
 }
 
+type publicCIDRBlocksGetter interface {
+	PublicCIDRBlocks() ([]string, error)
+}
+
+type envOutputsGetter interface {
+	Outputs() (map[string]string, error)
+}
+
+type listenerRulesDescriber interface {
+	ListenerRules(listenerARN string) ([]*awselbv2.ListenerRule, error)
+}
+
 type envTemplater interface {
 	EnvironmentTemplate(appName, envName string) (string, error)
 }
@@ -435,6 +513,15 @@ type envUpgrader interface {
 	UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error
 }
 
+type envDeployer interface {
+	DeployEnvironment(in *deploy.CreateEnvironmentInput) error
+}
+
+type envTemplateDeployer interface {
+	envDeployer
+	envTemplater
+}
+
 type legacyEnvUpgrader interface {
 	UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentInput, lbWebServices ...string) error
 	envTemplater
@@ -482,6 +569,7 @@ type configSelector interface {
 type deploySelector interface {
 	appSelector
 	DeployedService(prompt, help string, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error)
+	DeployedJob(prompt, help string, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedJob, error)
 }
 
 type pipelineSelector interface {
@@ -551,6 +639,30 @@ type serviceUpdater interface {
 
 type serviceDeployer interface {
 	DeployService(out termprogress.FileWriter, conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) error
+	DeployBlueGreen(out termprogress.FileWriter, in cloudformation.BlueGreenDeploymentInput) error
+	BlueGreenTrafficTargetGroup(stackName string) (string, error)
+}
+
+type serviceTaskDefinitionGetter interface {
+	ServiceTaskDefinitionARN(app, env, svc string) (string, error)
+}
+
+type serviceTaskDefinitionDescriber interface {
+	ServiceTaskDefinition(app, env, svc string) (*awsecs.TaskDefinition, error)
+}
+
+type imageDigestGetter interface {
+	ImageDigest(repoName, tag string) (string, error)
+}
+
+type workloadChangeSetCreator interface {
+	CreateWorkloadChangeSet(conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) (string, *awscloudformation.ChangeSetDescription, error)
+	DeleteWorkloadChangeSet(changeSetID, stackName string) error
+}
+
+type deployedStackDescriber interface {
+	Template() (string, error)
+	Describe() (describestack.StackDescription, error)
 }
 
 type apprunnerServiceDescriber interface {
@@ -561,6 +673,15 @@ type ecsCommandExecutor interface {
 	ExecuteCommand(in awsecs.ExecuteCommandInput) error
 }
 
+type ssmPortForwarder interface {
+	StartPortForwardingSession(in ssm.PortForwardingSessionInput) error
+}
+
+type fileCopier interface {
+	Upload(localPath, remotePath string) error
+	Download(remotePath, localPath string) error
+}
+
 type ssmPluginManager interface {
 	ValidateBinary() error
 	InstallLatestBinary() error
@@ -599,6 +720,20 @@ type dockerEngine interface {
 	RedirectPlatform(string) (*string, error)
 }
 
+type localContainerRunner interface {
+	CheckDockerEngineRunning() error
+	Build(args *dockerengine.BuildArguments) error
+	CreateNetwork(name string) error
+	RemoveNetwork(name string) error
+	Run(options *dockerengine.RunOptions) error
+	Stop(name string) error
+	IsContainerHealthy(name string) (bool, error)
+}
+
+type secretGetter interface {
+	GetSecretValue(name string) (string, error)
+}
+
 type codestar interface {
 	GetConnectionARN(string) (string, error)
 }
@@ -615,10 +750,41 @@ type secretPutter interface {
 	PutSecret(in ssm.PutSecretInput) (*ssm.PutSecretOutput, error)
 }
 
+type secretsManagerPutter interface {
+	PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error)
+}
+
+type ssmSecretStore interface {
+	ListSecrets(tags map[string]string) ([]ssm.Secret, error)
+	DescribeSecret(name string) (*ssm.Secret, error)
+	DeleteSecret(name string) error
+}
+
+type secretsManagerSecretStore interface {
+	ListSecrets(tags map[string]string) ([]secretsmanager.Secret, error)
+	DescribeSecret(name string) (*secretsmanager.Secret, error)
+	DeleteSecret(name string) error
+}
+
 type servicePauser interface {
 	PauseService(svcARN string) error
 }
 
+type jobRunner interface {
+	RunJob(app, env, job string) (string, error)
+	JobExecutionTasks(executionARN string) ([]*awsecs.Task, error)
+}
+
+type jobExecutionDescriber interface {
+	JobExecutions(app, env, job string, maxResults int) ([]*stepfunctions.Execution, error)
+	JobExecution(app, env, job, name string) (*stepfunctions.Execution, error)
+	JobExecutionTasks(executionARN string) ([]*awsecs.Task, error)
+}
+
+type executionDescriber interface {
+	DescribeExecution(executionARN string) (*stepfunctions.Execution, error)
+}
+
 type timeoutError interface {
 	error
 	Timeout() bool
@@ -51,6 +51,12 @@ type deployJobOpts struct {
 	s3                 artifactUploader
 	envUpgradeCmd      actionCommand
 	endpointGetter     endpointGetter
+	sidecarRepos       sidecarRepoAdder
+	imageRepos         imageRepositoryUpdater
+	imageScanner       imageScanner
+	imageDigests       imageDigestGetter
+
+	newSidecarImageBuilderPusher func(sidecar string) (imageBuilderPusher, error)
 
 	spinner progress
 	sel     wsSelector
@@ -61,6 +67,8 @@ type deployJobOpts struct {
 	targetJob         *config.Workload
 	imageDigest       string
 	buildRequired     bool
+
+	sidecarImageDigests map[string]string // Digests of the sidecar images built from a Dockerfile, keyed by sidecar name.
 }
 
 func newJobDeployOpts(vars deployWkldVars) (*deployJobOpts, error) {
@@ -149,10 +157,22 @@ func (o *deployJobOpts) Execute() error {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
 
+	if err := o.configureImageRepository(); err != nil {
+		return err
+	}
+
 	if err := o.configureContainerImage(); err != nil {
 		return err
 	}
 
+	if err := o.checkImageScanFindings(); err != nil {
+		return err
+	}
+
+	if err := o.configureSidecarImages(); err != nil {
+		return err
+	}
+
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
 	if err != nil {
 		return err
@@ -205,6 +225,11 @@ func (o *deployJobOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.newSidecarImageBuilderPusher = func(sidecar string) (imageBuilderPusher, error) {
+		return repository.New(fmt.Sprintf("%s/%s", repoName, sidecar), registry)
+	}
+	o.imageScanner = registry
+	o.imageDigests = registry
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
@@ -231,6 +256,8 @@ func (o *deployJobOpts) configureClients() error {
 		return fmt.Errorf("create default session: %w", err)
 	}
 	o.appCFN = cloudformation.New(defaultSess)
+	o.sidecarRepos = cloudformation.New(defaultSess)
+	o.imageRepos = cloudformation.New(defaultSess)
 
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
 		appName: o.appName,
@@ -243,6 +270,20 @@ func (o *deployJobOpts) configureClients() error {
 	return nil
 }
 
+func (o *deployJobOpts) configureImageRepository() error {
+	job, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	return updateImageRepository(updateImageRepositoryInput{
+		app:     o.targetApp,
+		wlName:  o.name,
+		repo:    imageRepository(job),
+		updater: o.imageRepos,
+		spinner: o.spinner,
+	})
+}
+
 func (o *deployJobOpts) configureContainerImage() error {
 	job, err := o.manifest()
 	if err != nil {
@@ -255,20 +296,85 @@ func (o *deployJobOpts) configureContainerImage() error {
 	if !required {
 		return nil
 	}
+	logMultiArchPlatforms(o.name, job)
 	// If it is built from local Dockerfile, build and push to the ECR repo.
 	buildArg, err := o.dfBuildArgs(job)
 	if err != nil {
 		return err
 	}
-	digest, err := o.imageBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArg)
+	digest, err := buildAndPushImage(buildAndPushImageInput{
+		app:           o.targetApp,
+		appName:       o.appName,
+		wlName:        o.name,
+		repo:          imageRepository(job),
+		args:          buildArg,
+		imageDigests:  o.imageDigests,
+		builderPusher: o.imageBuilderPusher,
+	})
 	if err != nil {
-		return fmt.Errorf("build and push image: %w", err)
+		return err
 	}
 	o.imageDigest = digest
 	o.buildRequired = true
 	return nil
 }
 
+func (o *deployJobOpts) checkImageScanFindings() error {
+	if o.imageDigest == "" {
+		return nil
+	}
+	job, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	return checkWorkloadImageScanFindings(workloadImageScanInput{
+		app:     o.targetApp,
+		appName: o.appName,
+		wlName:  o.name,
+		mft:     job,
+		digest:  o.imageDigest,
+		scanner: o.imageScanner,
+		spinner: o.spinner,
+	})
+}
+
+func (o *deployJobOpts) configureSidecarImages() error {
+	job, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	args, err := sidecarBuildArgs(o.imageTag, o.ws, job)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	var pushedDigests imageDigestGetter
+	if immutableImageTags(o.targetApp, imageRepository(job)) {
+		for _, arg := range args {
+			if err := excludeLatestTag(o.name, arg); err != nil {
+				return err
+			}
+		}
+		pushedDigests = o.imageDigests
+	}
+	digests, err := buildAndPushSidecars(buildAndPushSidecarsInput{
+		app:                   o.targetApp,
+		wlName:                o.name,
+		args:                  args,
+		repos:                 o.sidecarRepos,
+		newImageBuilderPusher: o.newSidecarImageBuilderPusher,
+		pushedDigests:         pushedDigests,
+		spinner:               o.spinner,
+	})
+	if err != nil {
+		return err
+	}
+	o.sidecarImageDigests = digests
+	return nil
+}
+
 func (o *deployJobOpts) dfBuildArgs(job interface{}) (*dockerengine.BuildArguments, error) {
 	copilotDir, err := o.ws.CopilotDirPath()
 	if err != nil {
@@ -294,6 +400,11 @@ func (o *deployJobOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
+	if o.targetEnvironment.IsPrivateOnly() {
+		if err := validateWorkloadForPrivateOnlyEnv(mft, o.envName); err != nil {
+			return nil, err
+		}
+	}
 	rc, err := o.runtimeConfig(addonsURL)
 	if err != nil {
 		return nil, err
@@ -316,7 +427,7 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	if err != nil {
 		return nil, err
 	}
-	if !o.buildRequired {
+	if !o.buildRequired && len(o.sidecarImageDigests) == 0 {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        addonsURL,
 			AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
@@ -329,6 +440,20 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	if err != nil {
 		return nil, fmt.Errorf("get application %s resources from region %s: %w", o.targetApp.Name, o.targetEnvironment.Region, err)
 	}
+	sidecarImages, err := sidecarECRImages(o.name, o.sidecarImageDigests, resources, o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return nil, err
+	}
+	if !o.buildRequired {
+		return &stack.RuntimeConfig{
+			AddonsTemplateURL:        addonsURL,
+			AdditionalTags:           tags.Merge(o.targetApp.Tags, o.resourceTags),
+			ServiceDiscoveryEndpoint: endpoint,
+			AccountID:                o.targetApp.AccountID,
+			Region:                   o.targetEnvironment.Region,
+			SidecarImages:            sidecarImages,
+		}, nil
+	}
 	repoURL, ok := resources.RepositoryURLs[o.name]
 	if !ok {
 		return nil, &errRepoNotFound{
@@ -348,6 +473,7 @@ func (o *deployJobOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                o.targetApp.AccountID,
 		Region:                   o.targetEnvironment.Region,
+		SidecarImages:            sidecarImages,
 	}, nil
 }
 
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -21,6 +22,7 @@ import (
 type deployJobMocks struct {
 	mockWs                 *mocks.MockwsJobDirReader
 	mockimageBuilderPusher *mocks.MockimageBuilderPusher
+	mockImageDigests       *mocks.MockimageDigestGetter
 }
 
 func TestJobDeployOpts_Validate(t *testing.T) {
@@ -223,9 +225,18 @@ image:
     dockerfile: path/to/Dockerfile
 on:
   schedule: "@daily"`)
+	mockMftImmutableTags := []byte(`name: mailer
+type: 'Scheduled Job'
+image:
+  build: path/to/Dockerfile
+  repository:
+    immutable_tags: true
+on:
+  schedule: "@daily"`)
 
 	tests := map[string]struct {
 		inputSvc   string
+		inputTag   string
 		setupMocks func(mocks deployJobMocks)
 
 		wantErr      error
@@ -260,6 +271,37 @@ on:
 				)
 			},
 		},
+		"should push the image with an immutable tag that isn't pushed yet": {
+			inputSvc: "mailer",
+			inputTag: "v1.0.0",
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadJobManifest("mailer").Return(mockMftImmutableTags, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/mailer", "v1.0.0").Return("", &ecr.ErrImageNotFound{}),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile:    filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:       filepath.Join("/ws", "root", "path", "to"),
+						Tags:          []string{"v1.0.0"},
+						ExcludeLatest: true,
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should deploy the image already pushed with an immutable tag without building and pushing": {
+			inputSvc: "mailer",
+			inputTag: "v1.0.0",
+			setupMocks: func(m deployJobMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadJobManifest("mailer").Return(mockMftImmutableTags, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/mailer", "v1.0.0").Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
 		"should return error if fail to build and push": {
 			inputSvc: "mailer",
 			setupMocks: func(m deployJobMocks) {
@@ -322,17 +364,22 @@ on:
 
 			mockWorkspace := mocks.NewMockwsJobDirReader(ctrl)
 			mockimageBuilderPusher := mocks.NewMockimageBuilderPusher(ctrl)
+			mockImageDigests := mocks.NewMockimageDigestGetter(ctrl)
 			mocks := deployJobMocks{
 				mockWs:                 mockWorkspace,
 				mockimageBuilderPusher: mockimageBuilderPusher,
+				mockImageDigests:       mockImageDigests,
 			}
 			test.setupMocks(mocks)
 			opts := deployJobOpts{
 				deployWkldVars: deployWkldVars{
-					name: test.inputSvc,
+					appName:  "phonetool",
+					name:     test.inputSvc,
+					imageTag: test.inputTag,
 				},
 				unmarshal:          manifest.UnmarshalWorkload,
 				imageBuilderPusher: mockimageBuilderPusher,
+				imageDigests:       mockImageDigests,
 				ws:                 mockWorkspace,
 			}
 
@@ -0,0 +1,162 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobDiffJobNamePrompt = "Which job would you like to compare with its deployed version?"
+	jobDiffEnvNamePrompt = "Which environment is the job deployed to?"
+)
+
+type diffJobVars struct {
+	name      string
+	envName   string
+	appName   string
+	tag       string
+	changeSet bool
+}
+
+type diffJobOpts struct {
+	diffJobVars
+
+	// Interfaces to interact with dependencies.
+	ws    wsJobDirReader
+	store store
+	sel   wsSelector
+
+	// Subcommand implementing svc_diff's Execute()
+	diffCmd    actionCommand
+	newDiffCmd func(*diffJobOpts) error
+}
+
+func newDiffJobOpts(vars diffJobVars) (*diffJobOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	return &diffJobOpts{
+		diffJobVars: vars,
+		ws:          ws,
+		store:       store,
+		sel:         selector.NewWorkspaceSelect(prompt.New(), store, ws),
+		newDiffCmd: func(o *diffJobOpts) error {
+			pkgOpts, err := newPackageSvcOpts(packageSvcVars{
+				appName: o.appName,
+			})
+			if err != nil {
+				return err
+			}
+			pkgOpts.stackSerializer = newScheduledJobStackSerializer
+			diffCmd, err := newDiffWorkloadOpts(diffSvcVars{
+				name:      o.name,
+				envName:   o.envName,
+				appName:   o.appName,
+				tag:       o.tag,
+				changeSet: o.changeSet,
+			}, pkgOpts)
+			if err != nil {
+				return err
+			}
+			o.diffCmd = diffCmd
+			return nil
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *diffJobOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		names, err := o.ws.JobNames()
+		if err != nil {
+			return fmt.Errorf("list jobs in the workspace: %w", err)
+		}
+		if !contains(o.name, names) {
+			return fmt.Errorf("job '%s' does not exist in the workspace", o.name)
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *diffJobOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Job(jobDiffJobNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select job: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		name, err := o.sel.Environment(jobDiffEnvNamePrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = name
+	}
+	return nil
+}
+
+// Execute prints the differences between the local and the deployed template of the job.
+func (o *diffJobOpts) Execute() error {
+	if err := o.newDiffCmd(o); err != nil {
+		return err
+	}
+	return o.diffCmd.Execute()
+}
+
+// RecommendActions is a no-op for this command.
+func (o *diffJobOpts) RecommendActions() error {
+	return nil
+}
+
+// buildJobDiffCmd builds the command for comparing a job's local and deployed CloudFormation templates.
+func buildJobDiffCmd() *cobra.Command {
+	vars := diffJobVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares the local and deployed AWS CloudFormation template of a job.",
+		Long: `Compares the CloudFormation template generated from the job's manifest
+with the template deployed to an environment, without deploying.`,
+		Example: `
+  Show the changes to the "report-generator" job in the "test" environment.
+  /code $ copilot job diff -n report-generator -e test
+
+  Also create a change set to list the resources that will be replaced.
+  /code $ copilot job diff -n report-generator -e test --changeset`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDiffJobOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().BoolVar(&vars.changeSet, changeSetFlag, false, changeSetFlagDescription)
+	return cmd
+}
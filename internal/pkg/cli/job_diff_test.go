@@ -0,0 +1,120 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffJobOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inJobName string
+
+		setupMocks func(ws *mocks.MockwsJobDirReader)
+
+		wantedErr string
+	}{
+		"error if the application is not set": {
+			setupMocks: func(ws *mocks.MockwsJobDirReader) {},
+			wantedErr:  errNoAppInWorkspace.Error(),
+		},
+		"error if the job is not in the workspace": {
+			inAppName: "phonetool",
+			inJobName: "report-generator",
+			setupMocks: func(ws *mocks.MockwsJobDirReader) {
+				ws.EXPECT().JobNames().Return([]string{"resizer"}, nil)
+			},
+			wantedErr: "job 'report-generator' does not exist in the workspace",
+		},
+		"no error if the job exists": {
+			inAppName: "phonetool",
+			inJobName: "report-generator",
+			setupMocks: func(ws *mocks.MockwsJobDirReader) {
+				ws.EXPECT().JobNames().Return([]string{"report-generator"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsJobDirReader(ctrl)
+			tc.setupMocks(ws)
+			opts := &diffJobOpts{
+				diffJobVars: diffJobVars{
+					appName: tc.inAppName,
+					name:    tc.inJobName,
+				},
+				ws: ws,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDiffJobOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		newDiffCmd func(ctrl *gomock.Controller) func(*diffJobOpts) error
+
+		wantedErr error
+	}{
+		"error if the diff command cannot be created": {
+			newDiffCmd: func(ctrl *gomock.Controller) func(*diffJobOpts) error {
+				return func(o *diffJobOpts) error {
+					return errors.New("some error")
+				}
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"delegates to the diff command": {
+			newDiffCmd: func(ctrl *gomock.Controller) func(*diffJobOpts) error {
+				return func(o *diffJobOpts) error {
+					mockCmd := mocks.NewMockactionCommand(ctrl)
+					mockCmd.EXPECT().Execute().Return(nil)
+					o.diffCmd = mockCmd
+					return nil
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			opts := &diffJobOpts{
+				diffJobVars: diffJobVars{
+					appName: "phonetool",
+					name:    "report-generator",
+					envName: "test",
+				},
+				newDiffCmd: tc.newDiffCmd(ctrl),
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, err)
+		})
+	}
+}
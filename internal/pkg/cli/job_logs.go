@@ -8,10 +8,15 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
@@ -19,19 +24,26 @@ import (
 )
 
 const (
-	jobAppNamePrompt = "Which application does your job belong to?"
+	jobAppNamePrompt     = "Which application does your job belong to?"
+	jobLogNamePrompt     = "Which job's logs would you like to show?"
+	jobLogNameHelpPrompt = "The logs of a deployed job will be shown."
+
+	jobLogsExecutionsLimit = 10
 )
 
 type jobLogsVars struct {
 	wkldLogsVars
 
-	includeStateMachineLogs bool // Whether to include the logs from the state machine log streams
+	includeStateMachineLogs bool   // Whether to include the logs from the state machine log streams
+	execution               string // Name of the execution to show the logs of.
+	listExecutions          bool   // Whether to list the recent executions instead of showing logs.
 }
 
 type jobLogsOpts struct {
 	jobLogsVars
 
 	wkldLogOpts
+	execDescriber jobExecutionDescriber
 }
 
 func newJobLogOpts(vars jobLogsVars) (*jobLogsOpts, error) {
@@ -62,14 +74,16 @@ func newJobLogOpts(vars jobLogsVars) (*jobLogsOpts, error) {
 			return err
 		}
 		opts.logsSvc, err = logging.NewServiceClient(&logging.NewServiceLogsConfig{
-			Sess: sess,
-			App:  opts.appName,
-			Env:  opts.envName,
-			Svc:  opts.name,
+			Sess:     sess,
+			App:      opts.appName,
+			Env:      opts.envName,
+			Svc:      opts.name,
+			WkldType: manifest.ScheduledJobType,
 		})
 		if err != nil {
 			return err
 		}
+		opts.execDescriber = ecs.New(sess)
 		return nil
 	}
 	return opts, nil
@@ -93,6 +107,14 @@ func (o *jobLogsOpts) Validate() error {
 		}
 	}
 
+	if o.execution != "" && o.taskIDs != nil {
+		return errors.New("only one of --execution or --tasks may be used")
+	}
+
+	if o.listExecutions && (o.execution != "" || o.taskIDs != nil || o.follow) {
+		return errors.New("--list-executions cannot be used with --execution, --tasks, or --follow")
+	}
+
 	if o.since != 0 && o.humanStartTime != "" {
 		return errors.New("only one of --since or --start-time may be used")
 	}
@@ -137,7 +159,7 @@ func (o *jobLogsOpts) Ask() error {
 	if err := o.askApp(); err != nil {
 		return err
 	}
-	return nil
+	return o.askJobEnvName()
 }
 
 func (o *jobLogsOpts) askApp() error {
@@ -152,8 +174,142 @@ func (o *jobLogsOpts) askApp() error {
 	return nil
 }
 
+func (o *jobLogsOpts) askJobEnvName() error {
+	deployedJob, err := o.sel.DeployedJob(jobLogNamePrompt, jobLogNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithJob(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed jobs for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
 // Execute outputs logs of the job.
+// Unless task IDs are provided, only the logs of a single execution, by default the most recent one, are displayed.
 func (o *jobLogsOpts) Execute() error {
+	if err := o.initLogsSvc(); err != nil {
+		return err
+	}
+	if o.listExecutions {
+		return o.writeExecutions()
+	}
+	eventsWriter := logging.WriteHumanLogs
+	if o.shouldOutputJSON {
+		eventsWriter = logging.WriteJSONLogs
+	}
+	var limit *int64
+	if o.limit != 0 {
+		limit = aws.Int64(int64(o.limit))
+	}
+	opts := logging.WriteLogEventsOpts{
+		Follow:                  o.follow,
+		Limit:                   limit,
+		EndTime:                 o.endTime,
+		StartTime:               o.startTime,
+		TaskIDs:                 o.taskIDs,
+		IncludeStateMachineLogs: o.includeStateMachineLogs,
+		OnEvents:                eventsWriter,
+	}
+	if o.taskIDs == nil {
+		execution, err := o.selectExecution()
+		if err != nil {
+			return err
+		}
+		opts.StateMachineExecutionARN = execution.ARN
+		if o.follow {
+			// The execution starts new tasks when the job is retried, so its tasks are looked up again on every poll.
+			opts.RefreshTaskIDs = func() ([]string, error) {
+				return o.executionTaskIDs(execution.ARN)
+			}
+		} else {
+			taskIDs, err := o.executionTaskIDs(execution.ARN)
+			if err != nil {
+				return err
+			}
+			if len(taskIDs) == 0 && !o.includeStateMachineLogs {
+				log.Infof("Execution %s of job %s has not started any tasks yet.\n", execution.Name, o.name)
+				return nil
+			}
+			opts.TaskIDs = taskIDs
+		}
+	}
+	if err := o.logsSvc.WriteLogEvents(opts); err != nil {
+		return fmt.Errorf("write log events for job %s: %w", o.name, err)
+	}
+	return nil
+}
+
+// selectExecution returns the execution passed by flag, or else the most recent execution of the job.
+func (o *jobLogsOpts) selectExecution() (*stepfunctions.Execution, error) {
+	if o.execution != "" {
+		return o.execDescriber.JobExecution(o.appName, o.envName, o.name, o.execution)
+	}
+	executions, err := o.execDescriber.JobExecutions(o.appName, o.envName, o.name, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(executions) == 0 {
+		return nil, fmt.Errorf("job %s has not been executed in environment %s", o.name, o.envName)
+	}
+	return executions[0], nil
+}
+
+// executionTaskIDs returns the IDs of the tasks started by the execution of the job.
+func (o *jobLogsOpts) executionTaskIDs(executionARN string) ([]string, error) {
+	tasks, err := o.execDescriber.JobExecutionTasks(executionARN)
+	if err != nil {
+		return nil, err
+	}
+	// The slice is non-nil even without any task, so that the logs of the other executions are never retrieved.
+	taskIDs := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		id, err := awsecs.TaskID(aws.StringValue(t.TaskArn))
+		if err != nil {
+			return nil, err
+		}
+		taskIDs = append(taskIDs, id)
+	}
+	return taskIDs, nil
+}
+
+// writeExecutions writes the most recent executions of the job.
+func (o *jobLogsOpts) writeExecutions() error {
+	executions, err := o.execDescriber.JobExecutions(o.appName, o.envName, o.name, jobLogsExecutionsLimit)
+	if err != nil {
+		return err
+	}
+	out := &describe.JobExecutions{
+		Job:        o.name,
+		Env:        o.envName,
+		Executions: make([]*describe.JobExecution, len(executions)),
+	}
+	for i, execution := range executions {
+		tasks, err := o.execDescriber.JobExecutionTasks(execution.ARN)
+		if err != nil {
+			return err
+		}
+		// Every task after the first one is a retry of the job.
+		var retries int
+		if len(tasks) > 1 {
+			retries = len(tasks) - 1
+		}
+		out.Executions[i] = &describe.JobExecution{
+			Name:      execution.Name,
+			Status:    execution.Status,
+			StartedAt: execution.StartDate,
+			StoppedAt: execution.StopDate,
+			Retries:   retries,
+		}
+	}
+	if o.shouldOutputJSON {
+		data, err := out.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, out.HumanString())
 	return nil
 }
 
@@ -161,9 +317,10 @@ func (o *jobLogsOpts) Execute() error {
 func buildJobLogsCmd() *cobra.Command {
 	vars := jobLogsVars{}
 	cmd := &cobra.Command{
-		Use:    "logs",
-		Short:  "Displays logs of a deployed job.",
-		Hidden: true,
+		Use:   "logs",
+		Short: "Displays logs of a deployed job.",
+		Long: `Displays logs of a deployed job.
+By default, only the logs of the tasks started by the most recent execution of the job are displayed.`,
 		Example: `
   Displays logs of the job "my-job" in environment "test".
   /code $ copilot job logs -n my-job -e test
@@ -171,12 +328,16 @@ func buildJobLogsCmd() *cobra.Command {
   /code $ copilot job logs --since 1h
   Displays logs from 2006-01-02T15:04:05 to 2006-01-02T15:05:05.
   /code $ copilot job logs --start-time 2006-01-02T15:04:05+00:00 --end-time 2006-01-02T15:05:05+00:00
-Displays logs from specific task IDs.
+  Displays logs from specific task IDs.
   /code $ copilot job logs --tasks 709c7eae05f947f6861b150372ddc443,1de57fd63c6a4920ac416d02add891b9
   Displays logs in real time.
   /code $ copilot job logs --follow
   Displays container logs and state machine execution logs from the last execution.
-  /code $ copilot job logs --include-state-machine`,
+  /code $ copilot job logs --include-state-machine
+  Lists the most recent executions of the job.
+  /code $ copilot job logs --list-executions
+  Displays logs of a specific execution.
+  /code $ copilot job logs --execution 2a2f6b35-0c1e-4d0b-9b55-7e6f0c2d4e1a`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newJobLogOpts(vars)
 			if err != nil {
@@ -185,7 +346,7 @@ Displays logs from specific task IDs.
 			return run(opts)
 		}),
 	}
-	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
 	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
 	cmd.Flags().StringVar(&vars.humanStartTime, startTimeFlag, "", startTimeFlagDescription)
@@ -196,5 +357,7 @@ Displays logs from specific task IDs.
 	cmd.Flags().IntVar(&vars.limit, limitFlag, 0, limitFlagDescription)
 	cmd.Flags().StringSliceVar(&vars.taskIDs, tasksFlag, nil, tasksLogsFlagDescription)
 	cmd.Flags().BoolVar(&vars.includeStateMachineLogs, includeStateMachineLogsFlag, false, includeStateMachineLogsFlagDescription)
+	cmd.Flags().StringVar(&vars.execution, executionFlag, "", executionFlagDescription)
+	cmd.Flags().BoolVar(&vars.listExecutions, listExecutionsFlag, false, listExecutionsFlagDescription)
 	return cmd
 }
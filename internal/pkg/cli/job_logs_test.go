@@ -4,13 +4,19 @@
 package cli
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -33,6 +39,9 @@ func TestJobLogs_Validate(t *testing.T) {
 		inputStartTime string
 		inputEndTime   string
 		inputSince     time.Duration
+		inputExecution string
+		inputTaskIDs   []string
+		inputListExecs bool
 
 		mockstore func(m *mocks.Mockstore)
 
@@ -76,6 +85,22 @@ func TestJobLogs_Validate(t *testing.T) {
 
 			wantedError: fmt.Errorf("some error"),
 		},
+		"returns error if execution and tasks flags are set together": {
+			inputExecution: "mockExecution",
+			inputTaskIDs:   []string{"mockTaskID"},
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("only one of --execution or --tasks may be used"),
+		},
+		"returns error if list executions and follow flags are set together": {
+			inputListExecs: true,
+			inputFollow:    true,
+
+			mockstore: func(m *mocks.Mockstore) {},
+
+			wantedError: fmt.Errorf("--list-executions cannot be used with --execution, --tasks, or --follow"),
+		},
 		"returns error if since and startTime flags are set together": {
 			inputSince:     mockSince,
 			inputStartTime: mockStartTime,
@@ -148,7 +173,10 @@ func TestJobLogs_Validate(t *testing.T) {
 						since:          tc.inputSince,
 						name:           tc.inputSvc,
 						appName:        tc.inputApp,
+						taskIDs:        tc.inputTaskIDs,
 					},
+					execution:      tc.inputExecution,
+					listExecutions: tc.inputListExecs,
 				},
 				wkldLogOpts: wkldLogOpts{
 					configStore: mockstore,
@@ -167,3 +195,318 @@ func TestJobLogs_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestJobLogs_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp     string
+		inputJob     string
+		inputEnvName string
+
+		setupMocks func(m *mocks.MockdeploySelector)
+
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"with all flags set": {
+			inputApp:     "mockApp",
+			inputJob:     "mockJob",
+			inputEnvName: "mockEnv",
+
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedJob(jobLogNamePrompt, jobLogNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{
+						Env:  "mockEnv",
+						Name: "mockJob",
+					}, nil)
+			},
+
+			wantedJob: "mockJob",
+			wantedEnv: "mockEnv",
+		},
+		"with no flag set": {
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				gomock.InOrder(
+					m.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("mockApp", nil),
+					m.EXPECT().DeployedJob(jobLogNamePrompt, jobLogNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+						Return(&selector.DeployedJob{
+							Env:  "mockEnv",
+							Name: "mockJob",
+						}, nil),
+				)
+			},
+
+			wantedJob: "mockJob",
+			wantedEnv: "mockEnv",
+		},
+		"returns error if fail to select app": {
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select application: some error"),
+		},
+		"returns error if fail to select deployed job": {
+			inputApp: "mockApp",
+
+			setupMocks: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedJob(jobLogNamePrompt, jobLogNameHelpPrompt, "mockApp", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some error"))
+			},
+
+			wantedError: fmt.Errorf("select deployed jobs for application mockApp: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			tc.setupMocks(mockSel)
+
+			jobLogs := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						envName: tc.inputEnvName,
+						name:    tc.inputJob,
+						appName: tc.inputApp,
+					},
+				},
+				wkldLogOpts: wkldLogOpts{
+					sel: mockSel,
+				},
+			}
+
+			// WHEN
+			err := jobLogs.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedJob, jobLogs.name)
+				require.Equal(t, tc.wantedEnv, jobLogs.envName)
+			}
+		})
+	}
+}
+
+type jobLogsMocks struct {
+	logsSvc       *mocks.MocklogEventsWriter
+	execDescriber *mocks.MockjobExecutionDescriber
+}
+
+func TestJobLogs_Execute(t *testing.T) {
+	const (
+		mockExecutionARN = "arn:aws:states:us-west-2:123456789012:execution:mockApp-mockEnv-mockJob:mockExecution"
+		mockTaskARN1     = "arn:aws:ecs:us-west-2:123456789012:task/mockCluster/mockTaskID1"
+		mockTaskARN2     = "arn:aws:ecs:us-west-2:123456789012:task/mockCluster/mockTaskID2"
+	)
+	startDate := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	stopDate := startDate.Add(2 * time.Minute)
+	mockExecution := &stepfunctions.Execution{
+		ARN:       mockExecutionARN,
+		Name:      "mockExecution",
+		Status:    stepfunctions.ExecutionStatusFailed,
+		StartDate: startDate,
+		StopDate:  &stopDate,
+	}
+	mockTasks := []*awsecs.Task{
+		{
+			TaskArn: aws.String(mockTaskARN1),
+		},
+		{
+			TaskArn: aws.String(mockTaskARN2),
+		},
+	}
+	testCases := map[string]struct {
+		inputTaskIDs        []string
+		inputExecution      string
+		inputListExecutions bool
+		inputJSON           bool
+		inputStateMachine   bool
+		inputFollow         bool
+
+		setupMocks func(m jobLogsMocks)
+
+		wantedOutput string
+		wantedError  error
+	}{
+		"writes the logs of the tasks passed by flag": {
+			inputTaskIDs: []string{"mockTaskID"},
+
+			setupMocks: func(m jobLogsMocks) {
+				m.logsSvc.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+					require.Equal(t, []string{"mockTaskID"}, param.TaskIDs)
+				}).Return(nil)
+			},
+		},
+		"writes the logs of the most recent execution": {
+			inputStateMachine: true,
+
+			setupMocks: func(m jobLogsMocks) {
+				gomock.InOrder(
+					m.execDescriber.EXPECT().JobExecutions("mockApp", "mockEnv", "mockJob", 1).Return([]*stepfunctions.Execution{mockExecution}, nil),
+					m.execDescriber.EXPECT().JobExecutionTasks(mockExecutionARN).Return(mockTasks, nil),
+					m.logsSvc.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+						require.Equal(t, []string{"mockTaskID1", "mockTaskID2"}, param.TaskIDs)
+						require.True(t, param.IncludeStateMachineLogs)
+						require.Equal(t, mockExecutionARN, param.StateMachineExecutionARN)
+					}).Return(nil),
+				)
+			},
+		},
+		"writes the logs of the execution passed by flag": {
+			inputExecution: "mockExecution",
+
+			setupMocks: func(m jobLogsMocks) {
+				gomock.InOrder(
+					m.execDescriber.EXPECT().JobExecution("mockApp", "mockEnv", "mockJob", "mockExecution").Return(mockExecution, nil),
+					m.execDescriber.EXPECT().JobExecutionTasks(mockExecutionARN).Return(mockTasks[:1], nil),
+					m.logsSvc.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+						require.Equal(t, []string{"mockTaskID1"}, param.TaskIDs)
+					}).Return(nil),
+				)
+			},
+		},
+		"does not write any logs if the execution has not started any tasks": {
+			inputExecution: "mockExecution",
+
+			setupMocks: func(m jobLogsMocks) {
+				gomock.InOrder(
+					m.execDescriber.EXPECT().JobExecution("mockApp", "mockEnv", "mockJob", "mockExecution").Return(mockExecution, nil),
+					m.execDescriber.EXPECT().JobExecutionTasks(mockExecutionARN).Return(nil, nil),
+				)
+				m.logsSvc.EXPECT().WriteLogEvents(gomock.Any()).Times(0)
+			},
+		},
+		"writes the state machine logs of an execution that has not started any tasks": {
+			inputStateMachine: true,
+
+			setupMocks: func(m jobLogsMocks) {
+				gomock.InOrder(
+					m.execDescriber.EXPECT().JobExecutions("mockApp", "mockEnv", "mockJob", 1).Return([]*stepfunctions.Execution{mockExecution}, nil),
+					m.execDescriber.EXPECT().JobExecutionTasks(mockExecutionARN).Return(nil, nil),
+					m.logsSvc.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+						require.Equal(t, []string{}, param.TaskIDs)
+						require.Equal(t, mockExecutionARN, param.StateMachineExecutionARN)
+					}).Return(nil),
+				)
+			},
+		},
+		"looks up the tasks of the execution on every poll when following the logs": {
+			inputFollow: true,
+
+			setupMocks: func(m jobLogsMocks) {
+				gomock.InOrder(
+					m.execDescriber.EXPECT().JobExecutions("mockApp", "mockEnv", "mockJob", 1).Return([]*stepfunctions.Execution{mockExecution}, nil),
+					m.logsSvc.EXPECT().WriteLogEvents(gomock.Any()).Do(func(param logging.WriteLogEventsOpts) {
+						require.Nil(t, param.TaskIDs)
+						require.NotNil(t, param.RefreshTaskIDs)
+						taskIDs, err := param.RefreshTaskIDs()
+						require.NoError(t, err)
+						require.Equal(t, []string{"mockTaskID1", "mockTaskID2"}, taskIDs)
+					}).Return(nil),
+				)
+				m.execDescriber.EXPECT().JobExecutionTasks(mockExecutionARN).Return(mockTasks, nil)
+			},
+		},
+		"returns error if the job has never been executed": {
+			setupMocks: func(m jobLogsMocks) {
+				m.execDescriber.EXPECT().JobExecutions("mockApp", "mockEnv", "mockJob", 1).Return(nil, nil)
+			},
+
+			wantedError: errors.New("job mockJob has not been executed in environment mockEnv"),
+		},
+		"returns error if fail to get the execution": {
+			inputExecution: "mockExecution",
+
+			setupMocks: func(m jobLogsMocks) {
+				m.execDescriber.EXPECT().JobExecution("mockApp", "mockEnv", "mockJob", "mockExecution").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"returns error if fail to write log events": {
+			inputTaskIDs: []string{"mockTaskID"},
+
+			setupMocks: func(m jobLogsMocks) {
+				m.logsSvc.EXPECT().WriteLogEvents(gomock.Any()).Return(errors.New("some error"))
+			},
+
+			wantedError: errors.New("write log events for job mockJob: some error"),
+		},
+		"lists the most recent executions": {
+			inputListExecutions: true,
+			inputJSON:           true,
+
+			setupMocks: func(m jobLogsMocks) {
+				gomock.InOrder(
+					m.execDescriber.EXPECT().JobExecutions("mockApp", "mockEnv", "mockJob", jobLogsExecutionsLimit).Return([]*stepfunctions.Execution{mockExecution}, nil),
+					m.execDescriber.EXPECT().JobExecutionTasks(mockExecutionARN).Return(mockTasks, nil),
+				)
+			},
+
+			wantedOutput: "{\"job\":\"mockJob\",\"environment\":\"mockEnv\",\"executions\":[{\"name\":\"mockExecution\",\"status\":\"FAILED\",\"startedAt\":\"2020-01-01T00:00:00Z\",\"stoppedAt\":\"2020-01-01T00:02:00Z\",\"retries\":1}]}\n",
+		},
+		"returns error if fail to list executions": {
+			inputListExecutions: true,
+
+			setupMocks: func(m jobLogsMocks) {
+				m.execDescriber.EXPECT().JobExecutions("mockApp", "mockEnv", "mockJob", jobLogsExecutionsLimit).Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := jobLogsMocks{
+				logsSvc:       mocks.NewMocklogEventsWriter(ctrl),
+				execDescriber: mocks.NewMockjobExecutionDescriber(ctrl),
+			}
+			tc.setupMocks(m)
+
+			b := &bytes.Buffer{}
+			jobLogs := &jobLogsOpts{
+				jobLogsVars: jobLogsVars{
+					wkldLogsVars: wkldLogsVars{
+						appName:          "mockApp",
+						envName:          "mockEnv",
+						name:             "mockJob",
+						taskIDs:          tc.inputTaskIDs,
+						shouldOutputJSON: tc.inputJSON,
+						follow:           tc.inputFollow,
+					},
+					execution:               tc.inputExecution,
+					listExecutions:          tc.inputListExecutions,
+					includeStateMachineLogs: tc.inputStateMachine,
+				},
+				wkldLogOpts: wkldLogOpts{
+					w:           b,
+					initLogsSvc: func() error { return nil },
+					logsSvc:     m.logsSvc,
+				},
+				execDescriber: m.execDescriber,
+			}
+
+			// WHEN
+			err := jobLogs.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedOutput, b.String())
+			}
+		})
+	}
+}
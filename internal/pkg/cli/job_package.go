@@ -76,15 +76,7 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 		prompt:         prompter,
 	}
 
-	opts.stackSerializer = func(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
-		var serializer stackSerializer
-		jobMft := mft.(*manifest.ScheduledJob)
-		serializer, err := stack.NewScheduledJob(jobMft, env.Name, app.Name, rc)
-		if err != nil {
-			return nil, fmt.Errorf("init scheduled job stack serializer: %w", err)
-		}
-		return serializer, nil
-	}
+	opts.stackSerializer = newScheduledJobStackSerializer
 
 	opts.newPackageCmd = func(o *packageJobOpts) {
 		opts.packageCmd = &packageSvcOpts{
@@ -121,6 +113,15 @@ func newPackageJobOpts(vars packageJobVars) (*packageJobOpts, error) {
 	return opts, nil
 }
 
+func newScheduledJobStackSerializer(mft interface{}, env *config.Environment, app *config.Application, rc stack.RuntimeConfig) (stackSerializer, error) {
+	jobMft := mft.(*manifest.ScheduledJob)
+	serializer, err := stack.NewScheduledJob(jobMft, env.Name, app.Name, rc)
+	if err != nil {
+		return nil, fmt.Errorf("init scheduled job stack serializer: %w", err)
+	}
+	return serializer, nil
+}
+
 // Validate returns an error if the values provided by the user are invalid.
 func (o *packageJobOpts) Validate() error {
 	if o.appName == "" {
@@ -0,0 +1,238 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/logging"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	jobRunNamePrompt     = "Which deployed job would you like to run?"
+	jobRunNameHelpPrompt = "An execution of the selected job's state machine will be started in the job's environment."
+
+	fmtJobRunStart    = "Starting an execution of job %s in environment %s."
+	fmtJobRunFailed   = "Failed to start an execution of job %s in environment %s.\n"
+	fmtJobRunSucceed  = "Started an execution of job %s in environment %s.\n"
+	fmtJobRunComplete = "Execution %s of job %s succeeded.\n"
+
+	jobExecutionPollInterval = 3 * time.Second
+)
+
+type jobRunVars struct {
+	appName string
+	envName string
+	name    string
+	follow  bool
+}
+
+type jobRunOpts struct {
+	jobRunVars
+
+	// Interfaces to dependencies.
+	store             store
+	sel               deploySelector
+	spinner           progress
+	runner            jobRunner
+	execDescriber     executionDescriber
+	newTaskLogsWriter func(tasks []*task.Task) eventsWriter
+
+	initRunner func() error // Overridden in tests.
+	sleep      func()       // Overridden in tests.
+}
+
+func newJobRunOpts(vars jobRunVars) (*jobRunOpts, error) {
+	configStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(configStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	opts := &jobRunOpts{
+		jobRunVars: vars,
+
+		store:   configStore,
+		sel:     selector.NewDeploySelect(prompt.New(), configStore, deployStore),
+		spinner: termprogress.NewSpinner(log.DiagnosticWriter),
+		sleep: func() {
+			time.Sleep(jobExecutionPollInterval)
+		},
+	}
+	opts.initRunner = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		opts.runner = ecs.New(sess)
+		opts.execDescriber = stepfunctions.New(sess)
+		opts.newTaskLogsWriter = func(tasks []*task.Task) eventsWriter {
+			return logging.NewWorkloadTaskClient(sess, opts.appName, opts.envName, opts.name, tasks)
+		}
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *jobRunOpts) Validate() error {
+	if o.appName == "" {
+		return nil
+	}
+	if _, err := o.store.GetApplication(o.appName); err != nil {
+		return err
+	}
+	if o.name != "" {
+		if _, err := o.store.GetJob(o.appName, o.name); err != nil {
+			return err
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *jobRunOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	return o.askJobEnvName()
+}
+
+func (o *jobRunOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(jobAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// askJobEnvName selects the job and the environment among the environments the job is deployed to.
+func (o *jobRunOpts) askJobEnvName() error {
+	deployedJob, err := o.sel.DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithJob(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed job for application %s: %w", o.appName, err)
+	}
+	o.name = deployedJob.Name
+	o.envName = deployedJob.Env
+	return nil
+}
+
+// Execute starts an execution of the job's state machine.
+// If follow is set, it streams the logs of the execution's tasks and returns an error if the execution does not succeed.
+func (o *jobRunOpts) Execute() error {
+	if err := o.initRunner(); err != nil {
+		return err
+	}
+	o.spinner.Start(fmt.Sprintf(fmtJobRunStart, o.name, o.envName))
+	executionARN, err := o.runner.RunJob(o.appName, o.envName, o.name)
+	if err != nil {
+		o.spinner.Stop(log.Serrorf(fmtJobRunFailed, o.name, o.envName))
+		return err
+	}
+	o.spinner.Stop(log.Ssuccessf(fmtJobRunSucceed, o.name, o.envName))
+	if !o.follow {
+		return nil
+	}
+	return o.followExecution(executionARN)
+}
+
+// followExecution streams the logs of the tasks started by the execution until the execution stops.
+func (o *jobRunOpts) followExecution(executionARN string) error {
+	streamed := make(map[string]bool)
+	for {
+		// Describe the execution before listing its tasks so that no task is missed once the execution stopped.
+		execution, err := o.execDescriber.DescribeExecution(executionARN)
+		if err != nil {
+			return err
+		}
+		tasks, err := o.runner.JobExecutionTasks(executionARN)
+		if err != nil {
+			return err
+		}
+		var newTasks []*task.Task
+		for _, t := range tasks {
+			taskARN := aws.StringValue(t.TaskArn)
+			if streamed[taskARN] {
+				continue
+			}
+			streamed[taskARN] = true
+			newTasks = append(newTasks, &task.Task{
+				TaskARN:    taskARN,
+				ClusterARN: aws.StringValue(t.ClusterArn),
+			})
+		}
+		if len(newTasks) != 0 {
+			if err := o.newTaskLogsWriter(newTasks).WriteEventsUntilStopped(); err != nil {
+				return fmt.Errorf("write logs of job %s: %w", o.name, err)
+			}
+		}
+		switch execution.Status {
+		case stepfunctions.ExecutionStatusRunning:
+			o.sleep()
+		case stepfunctions.ExecutionStatusSucceeded:
+			log.Successf(fmtJobRunComplete, execution.Name, o.name)
+			return nil
+		default:
+			return fmt.Errorf("execution %s of job %s %s", execution.Name, o.name, strings.ToLower(execution.Status))
+		}
+	}
+}
+
+// buildJobRunCmd builds the command for running a job on demand.
+func buildJobRunCmd() *cobra.Command {
+	vars := jobRunVars{}
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Runs a deployed job on demand.",
+		Long: `Runs a deployed job on demand.
+Starts an execution of the job's state machine outside of its schedule.`,
+
+		Example: `
+  Run the job "report" in the environment "test".
+  /code $ copilot job run -n report -e test
+  Run the job, stream its logs, and wait for the execution to complete.
+  /code $ copilot job run -n report -e test --follow`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newJobRunOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", jobFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().BoolVar(&vars.follow, followFlag, false, jobRunFollowFlagDescription)
+	return cmd
+}
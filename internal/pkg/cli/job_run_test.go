@@ -0,0 +1,385 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/task"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobRun_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		mockStore func(m *mocks.Mockstore)
+
+		wantedError error
+	}{
+		"skip validation if app flag is not set": {
+			inputJob: "report",
+			inputEnv: "test",
+
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"invalid app name": {
+			inputApp: "my-app",
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"invalid job name": {
+			inputApp: "my-app",
+			inputJob: "report",
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("my-app", "report").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"invalid environment name": {
+			inputApp: "my-app",
+			inputEnv: "test",
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(nil, errors.New("some error"))
+			},
+
+			wantedError: errors.New("some error"),
+		},
+		"success": {
+			inputApp: "my-app",
+			inputJob: "report",
+			inputEnv: "test",
+
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{}, nil)
+				m.EXPECT().GetJob("my-app", "report").Return(&config.Workload{}, nil)
+				m.EXPECT().GetEnvironment("my-app", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStore := mocks.NewMockstore(ctrl)
+			tc.mockStore(mockStore)
+
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					appName: tc.inputApp,
+					name:    tc.inputJob,
+					envName: tc.inputEnv,
+				},
+				store: mockStore,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJobRun_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inputApp string
+		inputJob string
+		inputEnv string
+
+		mockSel func(m *mocks.MockdeploySelector)
+
+		wantedApp   string
+		wantedJob   string
+		wantedEnv   string
+		wantedError error
+	}{
+		"with all flags set": {
+			inputApp: "my-app",
+			inputJob: "report",
+			inputEnv: "test",
+
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{Name: "report", Env: "test"}, nil)
+			},
+
+			wantedApp: "my-app",
+			wantedJob: "report",
+			wantedEnv: "test",
+		},
+		"errors if fail to select application": {
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("", errors.New("some error"))
+			},
+
+			wantedError: errors.New("select application: some error"),
+		},
+		"errors if the job is not deployed to any environment": {
+			inputApp: "my-app",
+			inputJob: "report",
+
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("no deployed jobs found in application my-app"))
+			},
+
+			wantedError: errors.New("select deployed job for application my-app: no deployed jobs found in application my-app"),
+		},
+		"prompts for all the missing values": {
+			mockSel: func(m *mocks.MockdeploySelector) {
+				m.EXPECT().Application(jobAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil)
+				m.EXPECT().DeployedJob(jobRunNamePrompt, jobRunNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedJob{Name: "report", Env: "test"}, nil)
+			},
+
+			wantedApp: "my-app",
+			wantedJob: "report",
+			wantedEnv: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSel := mocks.NewMockdeploySelector(ctrl)
+			tc.mockSel(mockSel)
+
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					appName: tc.inputApp,
+					name:    tc.inputJob,
+					envName: tc.inputEnv,
+				},
+				sel: mockSel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedApp, opts.appName)
+				require.Equal(t, tc.wantedJob, opts.name)
+				require.Equal(t, tc.wantedEnv, opts.envName)
+			}
+		})
+	}
+}
+
+type jobRunMocks struct {
+	runner        *mocks.MockjobRunner
+	execDescriber *mocks.MockexecutionDescriber
+	logsWriter    *mocks.MockeventsWriter
+	spinner       *mocks.Mockprogress
+}
+
+func TestJobRun_Execute(t *testing.T) {
+	const mockExecutionARN = "arn:aws:states:us-west-2:123456789012:execution:my-app-test-report:mockExecution"
+	mockTask1 := &awsecs.Task{
+		TaskArn:    aws.String("task1"),
+		ClusterArn: aws.String("cluster"),
+	}
+	mockTask2 := &awsecs.Task{
+		TaskArn:    aws.String("task2"),
+		ClusterArn: aws.String("cluster"),
+	}
+	testCases := map[string]struct {
+		inputFollow bool
+
+		setupMocks func(m jobRunMocks)
+
+		wantedLoggedTasks [][]*task.Task
+		wantedError       error
+	}{
+		"errors if fail to run the job": {
+			setupMocks: func(m jobRunMocks) {
+				m.spinner.EXPECT().Start("Starting an execution of job report in environment test.")
+				m.runner.EXPECT().RunJob("my-app", "test", "report").Return("", errors.New("some error"))
+				m.spinner.EXPECT().Stop(log.Serrorf("Failed to start an execution of job report in environment test.\n"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"returns right after the execution starts if not following": {
+			setupMocks: func(m jobRunMocks) {
+				m.spinner.EXPECT().Start("Starting an execution of job report in environment test.")
+				m.runner.EXPECT().RunJob("my-app", "test", "report").Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(log.Ssuccessf("Started an execution of job report in environment test.\n"))
+			},
+		},
+		"errors if fail to describe the execution": {
+			inputFollow: true,
+			setupMocks: func(m jobRunMocks) {
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.runner.EXPECT().RunJob("my-app", "test", "report").Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.execDescriber.EXPECT().DescribeExecution(mockExecutionARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"errors if fail to write the logs": {
+			inputFollow: true,
+			setupMocks: func(m jobRunMocks) {
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.runner.EXPECT().RunJob("my-app", "test", "report").Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.execDescriber.EXPECT().DescribeExecution(mockExecutionARN).Return(&stepfunctions.Execution{
+					Name:   "mockExecution",
+					Status: stepfunctions.ExecutionStatusRunning,
+				}, nil)
+				m.runner.EXPECT().JobExecutionTasks(mockExecutionARN).Return([]*awsecs.Task{mockTask1}, nil)
+				m.logsWriter.EXPECT().WriteEventsUntilStopped().Return(errors.New("some error"))
+			},
+			wantedLoggedTasks: [][]*task.Task{
+				{
+					{
+						TaskARN:    "task1",
+						ClusterARN: "cluster",
+					},
+				},
+			},
+			wantedError: errors.New("write logs of job report: some error"),
+		},
+		"streams the logs of each task once and errors if the execution fails": {
+			inputFollow: true,
+			setupMocks: func(m jobRunMocks) {
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.runner.EXPECT().RunJob("my-app", "test", "report").Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				gomock.InOrder(
+					m.execDescriber.EXPECT().DescribeExecution(mockExecutionARN).Return(&stepfunctions.Execution{
+						Name:   "mockExecution",
+						Status: stepfunctions.ExecutionStatusRunning,
+					}, nil),
+					m.execDescriber.EXPECT().DescribeExecution(mockExecutionARN).Return(&stepfunctions.Execution{
+						Name:   "mockExecution",
+						Status: stepfunctions.ExecutionStatusFailed,
+					}, nil),
+				)
+				gomock.InOrder(
+					m.runner.EXPECT().JobExecutionTasks(mockExecutionARN).Return([]*awsecs.Task{mockTask1}, nil),
+					m.runner.EXPECT().JobExecutionTasks(mockExecutionARN).Return([]*awsecs.Task{mockTask1, mockTask2}, nil),
+				)
+				m.logsWriter.EXPECT().WriteEventsUntilStopped().Return(nil).Times(2)
+			},
+			wantedLoggedTasks: [][]*task.Task{
+				{
+					{
+						TaskARN:    "task1",
+						ClusterARN: "cluster",
+					},
+				},
+				{
+					{
+						TaskARN:    "task2",
+						ClusterARN: "cluster",
+					},
+				},
+			},
+			wantedError: errors.New("execution mockExecution of job report failed"),
+		},
+		"success": {
+			inputFollow: true,
+			setupMocks: func(m jobRunMocks) {
+				m.spinner.EXPECT().Start(gomock.Any())
+				m.runner.EXPECT().RunJob("my-app", "test", "report").Return(mockExecutionARN, nil)
+				m.spinner.EXPECT().Stop(gomock.Any())
+				m.execDescriber.EXPECT().DescribeExecution(mockExecutionARN).Return(&stepfunctions.Execution{
+					Name:   "mockExecution",
+					Status: stepfunctions.ExecutionStatusSucceeded,
+				}, nil)
+				m.runner.EXPECT().JobExecutionTasks(mockExecutionARN).Return([]*awsecs.Task{mockTask1}, nil)
+				m.logsWriter.EXPECT().WriteEventsUntilStopped().Return(nil)
+			},
+			wantedLoggedTasks: [][]*task.Task{
+				{
+					{
+						TaskARN:    "task1",
+						ClusterARN: "cluster",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := jobRunMocks{
+				runner:        mocks.NewMockjobRunner(ctrl),
+				execDescriber: mocks.NewMockexecutionDescriber(ctrl),
+				logsWriter:    mocks.NewMockeventsWriter(ctrl),
+				spinner:       mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+
+			var loggedTasks [][]*task.Task
+			opts := &jobRunOpts{
+				jobRunVars: jobRunVars{
+					appName: "my-app",
+					envName: "test",
+					name:    "report",
+					follow:  tc.inputFollow,
+				},
+				spinner:       m.spinner,
+				runner:        m.runner,
+				execDescriber: m.execDescriber,
+				newTaskLogsWriter: func(tasks []*task.Task) eventsWriter {
+					loggedTasks = append(loggedTasks, tasks)
+					return m.logsWriter
+				},
+				initRunner: func() error { return nil },
+				sleep:      func() {},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.wantedLoggedTasks, loggedTasks)
+		})
+	}
+}
@@ -10,16 +10,22 @@ import (
 	reflect "reflect"
 
 	session "github.com/aws/aws-sdk-go/aws/session"
-	cloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	codepipeline "github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
+	ecr "github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	s3 "github.com/aws/copilot-cli/internal/pkg/aws/s3"
+	secretsmanager "github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	ssm "github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	config "github.com/aws/copilot-cli/internal/pkg/config"
 	deploy "github.com/aws/copilot-cli/internal/pkg/deploy"
-	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	cloudformation1 "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	stack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	describe "github.com/aws/copilot-cli/internal/pkg/describe"
+	stack0 "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	dockerengine "github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	dockerfile "github.com/aws/copilot-cli/internal/pkg/docker/dockerfile"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
@@ -794,6 +800,20 @@ func (mr *MockenvironmentStoreMockRecorder) ListEnvironments(appName interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnvironments", reflect.TypeOf((*MockenvironmentStore)(nil).ListEnvironments), appName)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentStore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentStoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentStore)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentCreator is a mock of environmentCreator interface.
 type MockenvironmentCreator struct {
 	ctrl     *gomock.Controller
@@ -831,6 +851,43 @@ func (mr *MockenvironmentCreatorMockRecorder) CreateEnvironment(env interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnvironment", reflect.TypeOf((*MockenvironmentCreator)(nil).CreateEnvironment), env)
 }
 
+// MockenvironmentUpdater is a mock of environmentUpdater interface.
+type MockenvironmentUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvironmentUpdaterMockRecorder
+}
+
+// MockenvironmentUpdaterMockRecorder is the mock recorder for MockenvironmentUpdater.
+type MockenvironmentUpdaterMockRecorder struct {
+	mock *MockenvironmentUpdater
+}
+
+// NewMockenvironmentUpdater creates a new mock instance.
+func NewMockenvironmentUpdater(ctrl *gomock.Controller) *MockenvironmentUpdater {
+	mock := &MockenvironmentUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvironmentUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvironmentUpdater) EXPECT() *MockenvironmentUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironment mocks base method.
+func (m *MockenvironmentUpdater) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockenvironmentUpdaterMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*MockenvironmentUpdater)(nil).UpdateEnvironment), env)
+}
+
 // MockenvironmentGetter is a mock of environmentGetter interface.
 type MockenvironmentGetter struct {
 	ctrl     *gomock.Controller
@@ -1243,6 +1300,20 @@ func (mr *MockstoreMockRecorder) UpdateApplication(app interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*Mockstore)(nil).UpdateApplication), app)
 }
 
+// UpdateEnvironment mocks base method.
+func (m *Mockstore) UpdateEnvironment(env *config.Environment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironment", env)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironment indicates an expected call of UpdateEnvironment.
+func (mr *MockstoreMockRecorder) UpdateEnvironment(env interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironment", reflect.TypeOf((*Mockstore)(nil).UpdateEnvironment), env)
+}
+
 // MockdeployedEnvironmentLister is a mock of deployedEnvironmentLister interface.
 type MockdeployedEnvironmentLister struct {
 	ctrl     *gomock.Controller
@@ -1678,6 +1749,21 @@ func (m *MockstackSerializer) EXPECT() *MockstackSerializerMockRecorder {
 	return m.recorder
 }
 
+// Parameters mocks base method.
+func (m *MockstackSerializer) Parameters() ([]*cloudformation.Parameter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Parameters")
+	ret0, _ := ret[0].([]*cloudformation.Parameter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Parameters indicates an expected call of Parameters.
+func (mr *MockstackSerializerMockRecorder) Parameters() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parameters", reflect.TypeOf((*MockstackSerializer)(nil).Parameters))
+}
+
 // SerializedParameters mocks base method.
 func (m *MockstackSerializer) SerializedParameters() (string, error) {
 	m.ctrl.T.Helper()
@@ -1693,6 +1779,34 @@ func (mr *MockstackSerializerMockRecorder) SerializedParameters() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SerializedParameters", reflect.TypeOf((*MockstackSerializer)(nil).SerializedParameters))
 }
 
+// StackName mocks base method.
+func (m *MockstackSerializer) StackName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StackName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// StackName indicates an expected call of StackName.
+func (mr *MockstackSerializerMockRecorder) StackName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackName", reflect.TypeOf((*MockstackSerializer)(nil).StackName))
+}
+
+// Tags mocks base method.
+func (m *MockstackSerializer) Tags() []*cloudformation.Tag {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tags")
+	ret0, _ := ret[0].([]*cloudformation.Tag)
+	return ret0
+}
+
+// Tags indicates an expected call of Tags.
+func (mr *MockstackSerializerMockRecorder) Tags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tags", reflect.TypeOf((*MockstackSerializer)(nil).Tags))
+}
+
 // Template mocks base method.
 func (m *MockstackSerializer) Template() (string, error) {
 	m.ctrl.T.Helper()
@@ -2264,6 +2378,44 @@ func (mr *MockcopilotDirGetterMockRecorder) CopilotDirPath() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopilotDirPath", reflect.TypeOf((*MockcopilotDirGetter)(nil).CopilotDirPath))
 }
 
+// MockwsPipelineLister is a mock of wsPipelineLister interface.
+type MockwsPipelineLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineListerMockRecorder
+}
+
+// MockwsPipelineListerMockRecorder is the mock recorder for MockwsPipelineLister.
+type MockwsPipelineListerMockRecorder struct {
+	mock *MockwsPipelineLister
+}
+
+// NewMockwsPipelineLister creates a new mock instance.
+func NewMockwsPipelineLister(ctrl *gomock.Controller) *MockwsPipelineLister {
+	mock := &MockwsPipelineLister{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineLister) EXPECT() *MockwsPipelineListerMockRecorder {
+	return m.recorder
+}
+
+// ListPipelines mocks base method.
+func (m *MockwsPipelineLister) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineListerMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineLister)(nil).ListPipelines))
+}
+
 // MockwsPipelineManifestReader is a mock of wsPipelineManifestReader interface.
 type MockwsPipelineManifestReader struct {
 	ctrl     *gomock.Controller
@@ -2287,19 +2439,34 @@ func (m *MockwsPipelineManifestReader) EXPECT() *MockwsPipelineManifestReaderMoc
 	return m.recorder
 }
 
+// ListPipelines mocks base method.
+func (m *MockwsPipelineManifestReader) ListPipelines() ([]workspace.PipelineManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineManifestReaderMockRecorder) ListPipelines() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ListPipelines))
+}
+
 // ReadPipelineManifest mocks base method.
-func (m *MockwsPipelineManifestReader) ReadPipelineManifest() ([]byte, error) {
+func (m *MockwsPipelineManifestReader) ReadPipelineManifest(path string) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
-func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+func (mr *MockwsPipelineManifestReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineManifestReader)(nil).ReadPipelineManifest), path)
 }
 
 // MockwsPipelineWriter is a mock of wsPipelineWriter interface.
@@ -2326,33 +2493,207 @@ func (m *MockwsPipelineWriter) EXPECT() *MockwsPipelineWriterMockRecorder {
 }
 
 // WritePipelineBuildspec mocks base method.
-func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineWriter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec.
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler, name)
+}
+
+// WritePipelineManifest mocks base method.
+func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WritePipelineManifest indicates an expected call of WritePipelineManifest.
+func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler, name)
+}
+
+// MockwsPipelineIniter is a mock of wsPipelineIniter interface.
+type MockwsPipelineIniter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsPipelineIniterMockRecorder
+}
+
+// MockwsPipelineIniterMockRecorder is the mock recorder for MockwsPipelineIniter.
+type MockwsPipelineIniterMockRecorder struct {
+	mock *MockwsPipelineIniter
+}
+
+// NewMockwsPipelineIniter creates a new mock instance.
+func NewMockwsPipelineIniter(ctrl *gomock.Controller) *MockwsPipelineIniter {
+	mock := &MockwsPipelineIniter{ctrl: ctrl}
+	mock.recorder = &MockwsPipelineIniterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsPipelineIniter) EXPECT() *MockwsPipelineIniterMockRecorder {
+	return m.recorder
+}
+
+// WorkloadNames mocks base method.
+func (m *MockwsPipelineIniter) WorkloadNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WorkloadNames indicates an expected call of WorkloadNames.
+func (mr *MockwsPipelineIniterMockRecorder) WorkloadNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsPipelineIniter)(nil).WorkloadNames))
+}
+
+// WriteGitHubActionsWorkflow mocks base method.
+func (m *MockwsPipelineIniter) WriteGitHubActionsWorkflow(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteGitHubActionsWorkflow", marshaler, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteGitHubActionsWorkflow indicates an expected call of WriteGitHubActionsWorkflow.
+func (mr *MockwsPipelineIniterMockRecorder) WriteGitHubActionsWorkflow(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteGitHubActionsWorkflow", reflect.TypeOf((*MockwsPipelineIniter)(nil).WriteGitHubActionsWorkflow), marshaler, name)
+}
+
+// WritePipelineBuildspec mocks base method.
+func (m *MockwsPipelineIniter) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineBuildspec", marshaler, name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineBuildspec indicates an expected call of WritePipelineBuildspec.
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineBuildspec(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineIniterMockRecorder) WritePipelineBuildspec(marshaler, name interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineBuildspec), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineBuildspec", reflect.TypeOf((*MockwsPipelineIniter)(nil).WritePipelineBuildspec), marshaler, name)
 }
 
 // WritePipelineManifest mocks base method.
-func (m *MockwsPipelineWriter) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (m *MockwsPipelineIniter) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler)
+	ret := m.ctrl.Call(m, "WritePipelineManifest", marshaler, name)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // WritePipelineManifest indicates an expected call of WritePipelineManifest.
-func (mr *MockwsPipelineWriterMockRecorder) WritePipelineManifest(marshaler interface{}) *gomock.Call {
+func (mr *MockwsPipelineIniterMockRecorder) WritePipelineManifest(marshaler, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineIniter)(nil).WritePipelineManifest), marshaler, name)
+}
+
+// MockwsEnvironmentWriter is a mock of wsEnvironmentWriter interface.
+type MockwsEnvironmentWriter struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentWriterMockRecorder
+}
+
+// MockwsEnvironmentWriterMockRecorder is the mock recorder for MockwsEnvironmentWriter.
+type MockwsEnvironmentWriterMockRecorder struct {
+	mock *MockwsEnvironmentWriter
+}
+
+// NewMockwsEnvironmentWriter creates a new mock instance.
+func NewMockwsEnvironmentWriter(ctrl *gomock.Controller) *MockwsEnvironmentWriter {
+	mock := &MockwsEnvironmentWriter{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentWriterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentWriter) EXPECT() *MockwsEnvironmentWriterMockRecorder {
+	return m.recorder
+}
+
+// WriteEnvironmentManifest mocks base method.
+func (m *MockwsEnvironmentWriter) WriteEnvironmentManifest(marshaler encoding.BinaryMarshaler, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEnvironmentManifest", marshaler, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteEnvironmentManifest indicates an expected call of WriteEnvironmentManifest.
+func (mr *MockwsEnvironmentWriterMockRecorder) WriteEnvironmentManifest(marshaler, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentWriter)(nil).WriteEnvironmentManifest), marshaler, envName)
+}
+
+// MockwsEnvironmentReader is a mock of wsEnvironmentReader interface.
+type MockwsEnvironmentReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsEnvironmentReaderMockRecorder
+}
+
+// MockwsEnvironmentReaderMockRecorder is the mock recorder for MockwsEnvironmentReader.
+type MockwsEnvironmentReaderMockRecorder struct {
+	mock *MockwsEnvironmentReader
+}
+
+// NewMockwsEnvironmentReader creates a new mock instance.
+func NewMockwsEnvironmentReader(ctrl *gomock.Controller) *MockwsEnvironmentReader {
+	mock := &MockwsEnvironmentReader{ctrl: ctrl}
+	mock.recorder = &MockwsEnvironmentReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsEnvironmentReader) EXPECT() *MockwsEnvironmentReaderMockRecorder {
+	return m.recorder
+}
+
+// EnvNames mocks base method.
+func (m *MockwsEnvironmentReader) EnvNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvNames indicates an expected call of EnvNames.
+func (mr *MockwsEnvironmentReaderMockRecorder) EnvNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvNames", reflect.TypeOf((*MockwsEnvironmentReader)(nil).EnvNames))
+}
+
+// ReadEnvironmentManifest mocks base method.
+func (m *MockwsEnvironmentReader) ReadEnvironmentManifest(envName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadEnvironmentManifest", envName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadEnvironmentManifest indicates an expected call of ReadEnvironmentManifest.
+func (mr *MockwsEnvironmentReaderMockRecorder) ReadEnvironmentManifest(envName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePipelineManifest", reflect.TypeOf((*MockwsPipelineWriter)(nil).WritePipelineManifest), marshaler)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadEnvironmentManifest", reflect.TypeOf((*MockwsEnvironmentReader)(nil).ReadEnvironmentManifest), envName)
 }
 
 // MockwsServiceLister is a mock of wsServiceLister interface.
@@ -2643,6 +2984,89 @@ func (mr *MockwsWlReaderMockRecorder) WorkloadNames() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadNames", reflect.TypeOf((*MockwsWlReader)(nil).WorkloadNames))
 }
 
+// MockwsWlManifestReader is a mock of wsWlManifestReader interface.
+type MockwsWlManifestReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockwsWlManifestReaderMockRecorder
+}
+
+// MockwsWlManifestReaderMockRecorder is the mock recorder for MockwsWlManifestReader.
+type MockwsWlManifestReaderMockRecorder struct {
+	mock *MockwsWlManifestReader
+}
+
+// NewMockwsWlManifestReader creates a new mock instance.
+func NewMockwsWlManifestReader(ctrl *gomock.Controller) *MockwsWlManifestReader {
+	mock := &MockwsWlManifestReader{ctrl: ctrl}
+	mock.recorder = &MockwsWlManifestReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwsWlManifestReader) EXPECT() *MockwsWlManifestReaderMockRecorder {
+	return m.recorder
+}
+
+// JobNames mocks base method.
+func (m *MockwsWlManifestReader) JobNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobNames indicates an expected call of JobNames.
+func (mr *MockwsWlManifestReaderMockRecorder) JobNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobNames", reflect.TypeOf((*MockwsWlManifestReader)(nil).JobNames))
+}
+
+// ReadJobManifest mocks base method.
+func (m *MockwsWlManifestReader) ReadJobManifest(jobName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJobManifest", jobName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJobManifest indicates an expected call of ReadJobManifest.
+func (mr *MockwsWlManifestReaderMockRecorder) ReadJobManifest(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJobManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadJobManifest), jobName)
+}
+
+// ReadServiceManifest mocks base method.
+func (m *MockwsWlManifestReader) ReadServiceManifest(svcName string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadServiceManifest", svcName)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadServiceManifest indicates an expected call of ReadServiceManifest.
+func (mr *MockwsWlManifestReaderMockRecorder) ReadServiceManifest(svcName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadServiceManifest", reflect.TypeOf((*MockwsWlManifestReader)(nil).ReadServiceManifest), svcName)
+}
+
+// ServiceNames mocks base method.
+func (m *MockwsWlManifestReader) ServiceNames() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceNames")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceNames indicates an expected call of ServiceNames.
+func (mr *MockwsWlManifestReaderMockRecorder) ServiceNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceNames", reflect.TypeOf((*MockwsWlManifestReader)(nil).ServiceNames))
+}
+
 // MockwsJobDirReader is a mock of wsJobDirReader interface.
 type MockwsJobDirReader struct {
 	ctrl     *gomock.Controller
@@ -2877,19 +3301,34 @@ func (m *MockwsPipelineReader) EXPECT() *MockwsPipelineReaderMockRecorder {
 	return m.recorder
 }
 
-// ReadPipelineManifest mocks base method.
-func (m *MockwsPipelineReader) ReadPipelineManifest() ([]byte, error) {
+// ListPipelines mocks base method.
+func (m *MockwsPipelineReader) ListPipelines() ([]workspace.PipelineManifest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadPipelineManifest")
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "ListPipelines")
+	ret0, _ := ret[0].([]workspace.PipelineManifest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
-func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest() *gomock.Call {
+// ListPipelines indicates an expected call of ListPipelines.
+func (mr *MockwsPipelineReaderMockRecorder) ListPipelines() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPipelines", reflect.TypeOf((*MockwsPipelineReader)(nil).ListPipelines))
+}
+
+// ReadPipelineManifest mocks base method.
+func (m *MockwsPipelineReader) ReadPipelineManifest(path string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPipelineManifest", path)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPipelineManifest indicates an expected call of ReadPipelineManifest.
+func (mr *MockwsPipelineReaderMockRecorder) ReadPipelineManifest(path interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPipelineManifest", reflect.TypeOf((*MockwsPipelineReader)(nil).ReadPipelineManifest), path)
 }
 
 // WorkloadNames mocks base method.
@@ -3012,6 +3451,21 @@ func (mr *MockwsAddonManagerMockRecorder) WriteAddon(f, svc, name interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteAddon), f, svc, name)
 }
 
+// WriteEnvAddon mocks base method.
+func (m *MockwsAddonManager) WriteEnvAddon(f encoding.BinaryMarshaler, name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteEnvAddon", f, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteEnvAddon indicates an expected call of WriteEnvAddon.
+func (mr *MockwsAddonManagerMockRecorder) WriteEnvAddon(f, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteEnvAddon", reflect.TypeOf((*MockwsAddonManager)(nil).WriteEnvAddon), f, name)
+}
+
 // MockartifactUploader is a mock of artifactUploader interface.
 type MockartifactUploader struct {
 	ctrl     *gomock.Controller
@@ -3093,6 +3547,64 @@ func (mr *MockzipAndUploaderMockRecorder) ZipAndUpload(bucket, key interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZipAndUpload", reflect.TypeOf((*MockzipAndUploader)(nil).ZipAndUpload), varargs...)
 }
 
+// MockzipAndArtifactUploader is a mock of zipAndArtifactUploader interface.
+type MockzipAndArtifactUploader struct {
+	ctrl     *gomock.Controller
+	recorder *MockzipAndArtifactUploaderMockRecorder
+}
+
+// MockzipAndArtifactUploaderMockRecorder is the mock recorder for MockzipAndArtifactUploader.
+type MockzipAndArtifactUploaderMockRecorder struct {
+	mock *MockzipAndArtifactUploader
+}
+
+// NewMockzipAndArtifactUploader creates a new mock instance.
+func NewMockzipAndArtifactUploader(ctrl *gomock.Controller) *MockzipAndArtifactUploader {
+	mock := &MockzipAndArtifactUploader{ctrl: ctrl}
+	mock.recorder = &MockzipAndArtifactUploaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockzipAndArtifactUploader) EXPECT() *MockzipAndArtifactUploaderMockRecorder {
+	return m.recorder
+}
+
+// PutArtifact mocks base method.
+func (m *MockzipAndArtifactUploader) PutArtifact(bucket, fileName string, data io.Reader) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutArtifact", bucket, fileName, data)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutArtifact indicates an expected call of PutArtifact.
+func (mr *MockzipAndArtifactUploaderMockRecorder) PutArtifact(bucket, fileName, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutArtifact", reflect.TypeOf((*MockzipAndArtifactUploader)(nil).PutArtifact), bucket, fileName, data)
+}
+
+// ZipAndUpload mocks base method.
+func (m *MockzipAndArtifactUploader) ZipAndUpload(bucket, key string, files ...s3.NamedBinary) (string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{bucket, key}
+	for _, a := range files {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ZipAndUpload", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ZipAndUpload indicates an expected call of ZipAndUpload.
+func (mr *MockzipAndArtifactUploaderMockRecorder) ZipAndUpload(bucket, key interface{}, files ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{bucket, key}, files...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ZipAndUpload", reflect.TypeOf((*MockzipAndArtifactUploader)(nil).ZipAndUpload), varargs...)
+}
+
 // MockUploader is a mock of Uploader interface.
 type MockUploader struct {
 	ctrl     *gomock.Controller
@@ -3647,7 +4159,7 @@ func (m *MockappDeployer) EXPECT() *MockappDeployerMockRecorder {
 }
 
 // AddEnvToApp mocks base method.
-func (m *MockappDeployer) AddEnvToApp(opts *cloudformation0.AddEnvToAppOpts) error {
+func (m *MockappDeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
 	ret0, _ := ret[0].(error)
@@ -3730,6 +4242,244 @@ func (mr *MockappDeployerMockRecorder) DeployApp(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappDeployer)(nil).DeployApp), in)
 }
 
+// MocksidecarRepoAdder is a mock of sidecarRepoAdder interface.
+type MocksidecarRepoAdder struct {
+	ctrl     *gomock.Controller
+	recorder *MocksidecarRepoAdderMockRecorder
+}
+
+// MocksidecarRepoAdderMockRecorder is the mock recorder for MocksidecarRepoAdder.
+type MocksidecarRepoAdderMockRecorder struct {
+	mock *MocksidecarRepoAdder
+}
+
+// NewMocksidecarRepoAdder creates a new mock instance.
+func NewMocksidecarRepoAdder(ctrl *gomock.Controller) *MocksidecarRepoAdder {
+	mock := &MocksidecarRepoAdder{ctrl: ctrl}
+	mock.recorder = &MocksidecarRepoAdderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksidecarRepoAdder) EXPECT() *MocksidecarRepoAdderMockRecorder {
+	return m.recorder
+}
+
+// AddSidecarsToApp mocks base method.
+func (m *MocksidecarRepoAdder) AddSidecarsToApp(app *config.Application, wlName string, sidecars []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSidecarsToApp", app, wlName, sidecars)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSidecarsToApp indicates an expected call of AddSidecarsToApp.
+func (mr *MocksidecarRepoAdderMockRecorder) AddSidecarsToApp(app, wlName, sidecars interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSidecarsToApp", reflect.TypeOf((*MocksidecarRepoAdder)(nil).AddSidecarsToApp), app, wlName, sidecars)
+}
+
+// MockimageRepositoryUpdater is a mock of imageRepositoryUpdater interface.
+type MockimageRepositoryUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageRepositoryUpdaterMockRecorder
+}
+
+// MockimageRepositoryUpdaterMockRecorder is the mock recorder for MockimageRepositoryUpdater.
+type MockimageRepositoryUpdaterMockRecorder struct {
+	mock *MockimageRepositoryUpdater
+}
+
+// NewMockimageRepositoryUpdater creates a new mock instance.
+func NewMockimageRepositoryUpdater(ctrl *gomock.Controller) *MockimageRepositoryUpdater {
+	mock := &MockimageRepositoryUpdater{ctrl: ctrl}
+	mock.recorder = &MockimageRepositoryUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageRepositoryUpdater) EXPECT() *MockimageRepositoryUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateImageRepository mocks base method.
+func (m *MockimageRepositoryUpdater) UpdateImageRepository(app *config.Application, wlName string, repo *stack.ImageRepositoryConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImageRepository", app, wlName, repo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateImageRepository indicates an expected call of UpdateImageRepository.
+func (mr *MockimageRepositoryUpdaterMockRecorder) UpdateImageRepository(app, wlName, repo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageRepository", reflect.TypeOf((*MockimageRepositoryUpdater)(nil).UpdateImageRepository), app, wlName, repo)
+}
+
+// MockimageScanner is a mock of imageScanner interface.
+type MockimageScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageScannerMockRecorder
+}
+
+// MockimageScannerMockRecorder is the mock recorder for MockimageScanner.
+type MockimageScannerMockRecorder struct {
+	mock *MockimageScanner
+}
+
+// NewMockimageScanner creates a new mock instance.
+func NewMockimageScanner(ctrl *gomock.Controller) *MockimageScanner {
+	mock := &MockimageScanner{ctrl: ctrl}
+	mock.recorder = &MockimageScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageScanner) EXPECT() *MockimageScannerMockRecorder {
+	return m.recorder
+}
+
+// ImageScanFindings mocks base method.
+func (m *MockimageScanner) ImageScanFindings(repoName, digest string) ([]ecr.ScanFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageScanFindings", repoName, digest)
+	ret0, _ := ret[0].([]ecr.ScanFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageScanFindings indicates an expected call of ImageScanFindings.
+func (mr *MockimageScannerMockRecorder) ImageScanFindings(repoName, digest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageScanFindings", reflect.TypeOf((*MockimageScanner)(nil).ImageScanFindings), repoName, digest)
+}
+
+// MockappResourcesConfigGetter is a mock of appResourcesConfigGetter interface.
+type MockappResourcesConfigGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockappResourcesConfigGetterMockRecorder
+}
+
+// MockappResourcesConfigGetterMockRecorder is the mock recorder for MockappResourcesConfigGetter.
+type MockappResourcesConfigGetterMockRecorder struct {
+	mock *MockappResourcesConfigGetter
+}
+
+// NewMockappResourcesConfigGetter creates a new mock instance.
+func NewMockappResourcesConfigGetter(ctrl *gomock.Controller) *MockappResourcesConfigGetter {
+	mock := &MockappResourcesConfigGetter{ctrl: ctrl}
+	mock.recorder = &MockappResourcesConfigGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappResourcesConfigGetter) EXPECT() *MockappResourcesConfigGetterMockRecorder {
+	return m.recorder
+}
+
+// AppResourcesConfig mocks base method.
+func (m *MockappResourcesConfigGetter) AppResourcesConfig(app *config.Application) (*stack.AppResourcesConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppResourcesConfig", app)
+	ret0, _ := ret[0].(*stack.AppResourcesConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppResourcesConfig indicates an expected call of AppResourcesConfig.
+func (mr *MockappResourcesConfigGetterMockRecorder) AppResourcesConfig(app interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppResourcesConfig", reflect.TypeOf((*MockappResourcesConfigGetter)(nil).AppResourcesConfig), app)
+}
+
+// MockappResourcesImporter is a mock of appResourcesImporter interface.
+type MockappResourcesImporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockappResourcesImporterMockRecorder
+}
+
+// MockappResourcesImporterMockRecorder is the mock recorder for MockappResourcesImporter.
+type MockappResourcesImporterMockRecorder struct {
+	mock *MockappResourcesImporter
+}
+
+// NewMockappResourcesImporter creates a new mock instance.
+func NewMockappResourcesImporter(ctrl *gomock.Controller) *MockappResourcesImporter {
+	mock := &MockappResourcesImporter{ctrl: ctrl}
+	mock.recorder = &MockappResourcesImporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockappResourcesImporter) EXPECT() *MockappResourcesImporterMockRecorder {
+	return m.recorder
+}
+
+// DeployApp mocks base method.
+func (m *MockappResourcesImporter) DeployApp(in *deploy.CreateAppInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployApp", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployApp indicates an expected call of DeployApp.
+func (mr *MockappResourcesImporterMockRecorder) DeployApp(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployApp", reflect.TypeOf((*MockappResourcesImporter)(nil).DeployApp), in)
+}
+
+// ImportAppResources mocks base method.
+func (m *MockappResourcesImporter) ImportAppResources(app *config.Application, resources *stack.AppResourcesConfig, regions []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAppResources", app, resources, regions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportAppResources indicates an expected call of ImportAppResources.
+func (mr *MockappResourcesImporterMockRecorder) ImportAppResources(app, resources, regions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAppResources", reflect.TypeOf((*MockappResourcesImporter)(nil).ImportAppResources), app, resources, regions)
+}
+
+// MockenvTrustUpdater is a mock of envTrustUpdater interface.
+type MockenvTrustUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvTrustUpdaterMockRecorder
+}
+
+// MockenvTrustUpdaterMockRecorder is the mock recorder for MockenvTrustUpdater.
+type MockenvTrustUpdaterMockRecorder struct {
+	mock *MockenvTrustUpdater
+}
+
+// NewMockenvTrustUpdater creates a new mock instance.
+func NewMockenvTrustUpdater(ctrl *gomock.Controller) *MockenvTrustUpdater {
+	mock := &MockenvTrustUpdater{ctrl: ctrl}
+	mock.recorder = &MockenvTrustUpdaterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvTrustUpdater) EXPECT() *MockenvTrustUpdaterMockRecorder {
+	return m.recorder
+}
+
+// UpdateEnvironmentTrust mocks base method.
+func (m *MockenvTrustUpdater) UpdateEnvironmentTrust(app *deploy.AppInformation, envName, cfnExecRoleARN string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEnvironmentTrust", app, envName, cfnExecRoleARN)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEnvironmentTrust indicates an expected call of UpdateEnvironmentTrust.
+func (mr *MockenvTrustUpdaterMockRecorder) UpdateEnvironmentTrust(app, envName, cfnExecRoleARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEnvironmentTrust", reflect.TypeOf((*MockenvTrustUpdater)(nil).UpdateEnvironmentTrust), app, envName, cfnExecRoleARN)
+}
+
 // MockappResourcesGetter is a mock of appResourcesGetter interface.
 type MockappResourcesGetter struct {
 	ctrl     *gomock.Controller
@@ -3807,7 +4557,7 @@ func (m *MocktaskDeployer) EXPECT() *MocktaskDeployerMockRecorder {
 }
 
 // DeployTask mocks base method.
-func (m *MocktaskDeployer) DeployTask(out progress.FileWriter, input *deploy.CreateTaskResourcesInput, opts ...cloudformation.StackOption) error {
+func (m *MocktaskDeployer) DeployTask(out progress.FileWriter, input *deploy.CreateTaskResourcesInput, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{out, input}
 	for _, a := range opts {
@@ -3977,7 +4727,7 @@ func (m *Mockdeployer) EXPECT() *MockdeployerMockRecorder {
 }
 
 // AddEnvToApp mocks base method.
-func (m *Mockdeployer) AddEnvToApp(opts *cloudformation0.AddEnvToAppOpts) error {
+func (m *Mockdeployer) AddEnvToApp(opts *cloudformation1.AddEnvToAppOpts) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddEnvToApp", opts)
 	ret0, _ := ret[0].(error)
@@ -4491,6 +5241,120 @@ func (mr *MockendpointGetterMockRecorder) ServiceDiscoveryEndpoint() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceDiscoveryEndpoint", reflect.TypeOf((*MockendpointGetter)(nil).ServiceDiscoveryEndpoint))
 }
 
+// MockpublicCIDRBlocksGetter is a mock of publicCIDRBlocksGetter interface.
+type MockpublicCIDRBlocksGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockpublicCIDRBlocksGetterMockRecorder
+}
+
+// MockpublicCIDRBlocksGetterMockRecorder is the mock recorder for MockpublicCIDRBlocksGetter.
+type MockpublicCIDRBlocksGetterMockRecorder struct {
+	mock *MockpublicCIDRBlocksGetter
+}
+
+// NewMockpublicCIDRBlocksGetter creates a new mock instance.
+func NewMockpublicCIDRBlocksGetter(ctrl *gomock.Controller) *MockpublicCIDRBlocksGetter {
+	mock := &MockpublicCIDRBlocksGetter{ctrl: ctrl}
+	mock.recorder = &MockpublicCIDRBlocksGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpublicCIDRBlocksGetter) EXPECT() *MockpublicCIDRBlocksGetterMockRecorder {
+	return m.recorder
+}
+
+// PublicCIDRBlocks mocks base method.
+func (m *MockpublicCIDRBlocksGetter) PublicCIDRBlocks() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicCIDRBlocks")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicCIDRBlocks indicates an expected call of PublicCIDRBlocks.
+func (mr *MockpublicCIDRBlocksGetterMockRecorder) PublicCIDRBlocks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicCIDRBlocks", reflect.TypeOf((*MockpublicCIDRBlocksGetter)(nil).PublicCIDRBlocks))
+}
+
+// MockenvOutputsGetter is a mock of envOutputsGetter interface.
+type MockenvOutputsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvOutputsGetterMockRecorder
+}
+
+// MockenvOutputsGetterMockRecorder is the mock recorder for MockenvOutputsGetter.
+type MockenvOutputsGetterMockRecorder struct {
+	mock *MockenvOutputsGetter
+}
+
+// NewMockenvOutputsGetter creates a new mock instance.
+func NewMockenvOutputsGetter(ctrl *gomock.Controller) *MockenvOutputsGetter {
+	mock := &MockenvOutputsGetter{ctrl: ctrl}
+	mock.recorder = &MockenvOutputsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvOutputsGetter) EXPECT() *MockenvOutputsGetterMockRecorder {
+	return m.recorder
+}
+
+// Outputs mocks base method.
+func (m *MockenvOutputsGetter) Outputs() (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outputs")
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Outputs indicates an expected call of Outputs.
+func (mr *MockenvOutputsGetterMockRecorder) Outputs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outputs", reflect.TypeOf((*MockenvOutputsGetter)(nil).Outputs))
+}
+
+// MocklistenerRulesDescriber is a mock of listenerRulesDescriber interface.
+type MocklistenerRulesDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistenerRulesDescriberMockRecorder
+}
+
+// MocklistenerRulesDescriberMockRecorder is the mock recorder for MocklistenerRulesDescriber.
+type MocklistenerRulesDescriberMockRecorder struct {
+	mock *MocklistenerRulesDescriber
+}
+
+// NewMocklistenerRulesDescriber creates a new mock instance.
+func NewMocklistenerRulesDescriber(ctrl *gomock.Controller) *MocklistenerRulesDescriber {
+	mock := &MocklistenerRulesDescriber{ctrl: ctrl}
+	mock.recorder = &MocklistenerRulesDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistenerRulesDescriber) EXPECT() *MocklistenerRulesDescriberMockRecorder {
+	return m.recorder
+}
+
+// ListenerRules mocks base method.
+func (m *MocklistenerRulesDescriber) ListenerRules(listenerARN string) ([]*elbv2.ListenerRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListenerRules", listenerARN)
+	ret0, _ := ret[0].([]*elbv2.ListenerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListenerRules indicates an expected call of ListenerRules.
+func (mr *MocklistenerRulesDescriberMockRecorder) ListenerRules(listenerARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenerRules", reflect.TypeOf((*MocklistenerRulesDescriber)(nil).ListenerRules), listenerARN)
+}
+
 // MockenvTemplater is a mock of envTemplater interface.
 type MockenvTemplater struct {
 	ctrl     *gomock.Controller
@@ -4566,8 +5430,97 @@ func (mr *MockenvUpgraderMockRecorder) UpgradeEnvironment(in interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeEnvironment", reflect.TypeOf((*MockenvUpgrader)(nil).UpgradeEnvironment), in)
 }
 
-// MocklegacyEnvUpgrader is a mock of legacyEnvUpgrader interface.
-type MocklegacyEnvUpgrader struct {
+// MockenvDeployer is a mock of envDeployer interface.
+type MockenvDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvDeployerMockRecorder
+}
+
+// MockenvDeployerMockRecorder is the mock recorder for MockenvDeployer.
+type MockenvDeployerMockRecorder struct {
+	mock *MockenvDeployer
+}
+
+// NewMockenvDeployer creates a new mock instance.
+func NewMockenvDeployer(ctrl *gomock.Controller) *MockenvDeployer {
+	mock := &MockenvDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvDeployer) EXPECT() *MockenvDeployerMockRecorder {
+	return m.recorder
+}
+
+// DeployEnvironment mocks base method.
+func (m *MockenvDeployer) DeployEnvironment(in *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironment", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvironment indicates an expected call of DeployEnvironment.
+func (mr *MockenvDeployerMockRecorder) DeployEnvironment(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvDeployer)(nil).DeployEnvironment), in)
+}
+
+// MockenvTemplateDeployer is a mock of envTemplateDeployer interface.
+type MockenvTemplateDeployer struct {
+	ctrl     *gomock.Controller
+	recorder *MockenvTemplateDeployerMockRecorder
+}
+
+// MockenvTemplateDeployerMockRecorder is the mock recorder for MockenvTemplateDeployer.
+type MockenvTemplateDeployerMockRecorder struct {
+	mock *MockenvTemplateDeployer
+}
+
+// NewMockenvTemplateDeployer creates a new mock instance.
+func NewMockenvTemplateDeployer(ctrl *gomock.Controller) *MockenvTemplateDeployer {
+	mock := &MockenvTemplateDeployer{ctrl: ctrl}
+	mock.recorder = &MockenvTemplateDeployerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockenvTemplateDeployer) EXPECT() *MockenvTemplateDeployerMockRecorder {
+	return m.recorder
+}
+
+// EnvironmentTemplate mocks base method.
+func (m *MockenvTemplateDeployer) EnvironmentTemplate(appName, envName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnvironmentTemplate", appName, envName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnvironmentTemplate indicates an expected call of EnvironmentTemplate.
+func (mr *MockenvTemplateDeployerMockRecorder) EnvironmentTemplate(appName, envName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentTemplate", reflect.TypeOf((*MockenvTemplateDeployer)(nil).EnvironmentTemplate), appName, envName)
+}
+
+// DeployEnvironment mocks base method.
+func (m *MockenvTemplateDeployer) DeployEnvironment(in *deploy.CreateEnvironmentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployEnvironment", in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployEnvironment indicates an expected call of DeployEnvironment.
+func (mr *MockenvTemplateDeployerMockRecorder) DeployEnvironment(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployEnvironment", reflect.TypeOf((*MockenvTemplateDeployer)(nil).DeployEnvironment), in)
+}
+
+// MocklegacyEnvUpgrader is a mock of legacyEnvUpgrader interface.
+type MocklegacyEnvUpgrader struct {
 	ctrl     *gomock.Controller
 	recorder *MocklegacyEnvUpgraderMockRecorder
 }
@@ -5129,6 +6082,26 @@ func (mr *MockdeploySelectorMockRecorder) Application(prompt, help interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Application", reflect.TypeOf((*MockdeploySelector)(nil).Application), varargs...)
 }
 
+// DeployedJob mocks base method.
+func (m *MockdeploySelector) DeployedJob(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{prompt, help, app}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeployedJob", varargs...)
+	ret0, _ := ret[0].(*selector.DeployedJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployedJob indicates an expected call of DeployedJob.
+func (mr *MockdeploySelectorMockRecorder) DeployedJob(prompt, help, app interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{prompt, help, app}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployedJob", reflect.TypeOf((*MockdeploySelector)(nil).DeployedJob), varargs...)
+}
+
 // DeployedService mocks base method.
 func (m *MockdeploySelector) DeployedService(prompt, help, app string, opts ...selector.GetDeployedServiceOpts) (*selector.DeployedService, error) {
 	m.ctrl.T.Helper()
@@ -5844,8 +6817,37 @@ func (m *MockserviceDeployer) EXPECT() *MockserviceDeployerMockRecorder {
 	return m.recorder
 }
 
+// BlueGreenTrafficTargetGroup mocks base method.
+func (m *MockserviceDeployer) BlueGreenTrafficTargetGroup(stackName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlueGreenTrafficTargetGroup", stackName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlueGreenTrafficTargetGroup indicates an expected call of BlueGreenTrafficTargetGroup.
+func (mr *MockserviceDeployerMockRecorder) BlueGreenTrafficTargetGroup(stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlueGreenTrafficTargetGroup", reflect.TypeOf((*MockserviceDeployer)(nil).BlueGreenTrafficTargetGroup), stackName)
+}
+
+// DeployBlueGreen mocks base method.
+func (m *MockserviceDeployer) DeployBlueGreen(out progress.FileWriter, in cloudformation1.BlueGreenDeploymentInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployBlueGreen", out, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeployBlueGreen indicates an expected call of DeployBlueGreen.
+func (mr *MockserviceDeployerMockRecorder) DeployBlueGreen(out, in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployBlueGreen", reflect.TypeOf((*MockserviceDeployer)(nil).DeployBlueGreen), out, in)
+}
+
 // DeployService mocks base method.
-func (m *MockserviceDeployer) DeployService(out progress.FileWriter, conf cloudformation0.StackConfiguration, opts ...cloudformation.StackOption) error {
+func (m *MockserviceDeployer) DeployService(out progress.FileWriter, conf cloudformation1.StackConfiguration, opts ...cloudformation0.StackOption) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{out, conf}
 	for _, a := range opts {
@@ -5863,6 +6865,231 @@ func (mr *MockserviceDeployerMockRecorder) DeployService(out, conf interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployService", reflect.TypeOf((*MockserviceDeployer)(nil).DeployService), varargs...)
 }
 
+// MockserviceTaskDefinitionGetter is a mock of serviceTaskDefinitionGetter interface.
+type MockserviceTaskDefinitionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceTaskDefinitionGetterMockRecorder
+}
+
+// MockserviceTaskDefinitionGetterMockRecorder is the mock recorder for MockserviceTaskDefinitionGetter.
+type MockserviceTaskDefinitionGetterMockRecorder struct {
+	mock *MockserviceTaskDefinitionGetter
+}
+
+// NewMockserviceTaskDefinitionGetter creates a new mock instance.
+func NewMockserviceTaskDefinitionGetter(ctrl *gomock.Controller) *MockserviceTaskDefinitionGetter {
+	mock := &MockserviceTaskDefinitionGetter{ctrl: ctrl}
+	mock.recorder = &MockserviceTaskDefinitionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceTaskDefinitionGetter) EXPECT() *MockserviceTaskDefinitionGetterMockRecorder {
+	return m.recorder
+}
+
+// ServiceTaskDefinitionARN mocks base method.
+func (m *MockserviceTaskDefinitionGetter) ServiceTaskDefinitionARN(app, env, svc string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTaskDefinitionARN", app, env, svc)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTaskDefinitionARN indicates an expected call of ServiceTaskDefinitionARN.
+func (mr *MockserviceTaskDefinitionGetterMockRecorder) ServiceTaskDefinitionARN(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTaskDefinitionARN", reflect.TypeOf((*MockserviceTaskDefinitionGetter)(nil).ServiceTaskDefinitionARN), app, env, svc)
+}
+
+// MockserviceTaskDefinitionDescriber is a mock of serviceTaskDefinitionDescriber interface.
+type MockserviceTaskDefinitionDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockserviceTaskDefinitionDescriberMockRecorder
+}
+
+// MockserviceTaskDefinitionDescriberMockRecorder is the mock recorder for MockserviceTaskDefinitionDescriber.
+type MockserviceTaskDefinitionDescriberMockRecorder struct {
+	mock *MockserviceTaskDefinitionDescriber
+}
+
+// NewMockserviceTaskDefinitionDescriber creates a new mock instance.
+func NewMockserviceTaskDefinitionDescriber(ctrl *gomock.Controller) *MockserviceTaskDefinitionDescriber {
+	mock := &MockserviceTaskDefinitionDescriber{ctrl: ctrl}
+	mock.recorder = &MockserviceTaskDefinitionDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockserviceTaskDefinitionDescriber) EXPECT() *MockserviceTaskDefinitionDescriberMockRecorder {
+	return m.recorder
+}
+
+// ServiceTaskDefinition mocks base method.
+func (m *MockserviceTaskDefinitionDescriber) ServiceTaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceTaskDefinition", app, env, svc)
+	ret0, _ := ret[0].(*ecs.TaskDefinition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServiceTaskDefinition indicates an expected call of ServiceTaskDefinition.
+func (mr *MockserviceTaskDefinitionDescriberMockRecorder) ServiceTaskDefinition(app, env, svc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceTaskDefinition", reflect.TypeOf((*MockserviceTaskDefinitionDescriber)(nil).ServiceTaskDefinition), app, env, svc)
+}
+
+// MockimageDigestGetter is a mock of imageDigestGetter interface.
+type MockimageDigestGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockimageDigestGetterMockRecorder
+}
+
+// MockimageDigestGetterMockRecorder is the mock recorder for MockimageDigestGetter.
+type MockimageDigestGetterMockRecorder struct {
+	mock *MockimageDigestGetter
+}
+
+// NewMockimageDigestGetter creates a new mock instance.
+func NewMockimageDigestGetter(ctrl *gomock.Controller) *MockimageDigestGetter {
+	mock := &MockimageDigestGetter{ctrl: ctrl}
+	mock.recorder = &MockimageDigestGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockimageDigestGetter) EXPECT() *MockimageDigestGetterMockRecorder {
+	return m.recorder
+}
+
+// ImageDigest mocks base method.
+func (m *MockimageDigestGetter) ImageDigest(repoName, tag string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageDigest", repoName, tag)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageDigest indicates an expected call of ImageDigest.
+func (mr *MockimageDigestGetterMockRecorder) ImageDigest(repoName, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageDigest", reflect.TypeOf((*MockimageDigestGetter)(nil).ImageDigest), repoName, tag)
+}
+
+// MockworkloadChangeSetCreator is a mock of workloadChangeSetCreator interface.
+type MockworkloadChangeSetCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockworkloadChangeSetCreatorMockRecorder
+}
+
+// MockworkloadChangeSetCreatorMockRecorder is the mock recorder for MockworkloadChangeSetCreator.
+type MockworkloadChangeSetCreatorMockRecorder struct {
+	mock *MockworkloadChangeSetCreator
+}
+
+// NewMockworkloadChangeSetCreator creates a new mock instance.
+func NewMockworkloadChangeSetCreator(ctrl *gomock.Controller) *MockworkloadChangeSetCreator {
+	mock := &MockworkloadChangeSetCreator{ctrl: ctrl}
+	mock.recorder = &MockworkloadChangeSetCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockworkloadChangeSetCreator) EXPECT() *MockworkloadChangeSetCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateWorkloadChangeSet mocks base method.
+func (m *MockworkloadChangeSetCreator) CreateWorkloadChangeSet(conf cloudformation1.StackConfiguration, opts ...cloudformation0.StackOption) (string, *cloudformation0.ChangeSetDescription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{conf}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateWorkloadChangeSet", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*cloudformation0.ChangeSetDescription)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateWorkloadChangeSet indicates an expected call of CreateWorkloadChangeSet.
+func (mr *MockworkloadChangeSetCreatorMockRecorder) CreateWorkloadChangeSet(conf interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{conf}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkloadChangeSet", reflect.TypeOf((*MockworkloadChangeSetCreator)(nil).CreateWorkloadChangeSet), varargs...)
+}
+
+// DeleteWorkloadChangeSet mocks base method.
+func (m *MockworkloadChangeSetCreator) DeleteWorkloadChangeSet(changeSetID, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkloadChangeSet", changeSetID, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkloadChangeSet indicates an expected call of DeleteWorkloadChangeSet.
+func (mr *MockworkloadChangeSetCreatorMockRecorder) DeleteWorkloadChangeSet(changeSetID, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkloadChangeSet", reflect.TypeOf((*MockworkloadChangeSetCreator)(nil).DeleteWorkloadChangeSet), changeSetID, stackName)
+}
+
+// MockdeployedStackDescriber is a mock of deployedStackDescriber interface.
+type MockdeployedStackDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockdeployedStackDescriberMockRecorder
+}
+
+// MockdeployedStackDescriberMockRecorder is the mock recorder for MockdeployedStackDescriber.
+type MockdeployedStackDescriberMockRecorder struct {
+	mock *MockdeployedStackDescriber
+}
+
+// NewMockdeployedStackDescriber creates a new mock instance.
+func NewMockdeployedStackDescriber(ctrl *gomock.Controller) *MockdeployedStackDescriber {
+	mock := &MockdeployedStackDescriber{ctrl: ctrl}
+	mock.recorder = &MockdeployedStackDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockdeployedStackDescriber) EXPECT() *MockdeployedStackDescriberMockRecorder {
+	return m.recorder
+}
+
+// Describe mocks base method.
+func (m *MockdeployedStackDescriber) Describe() (stack0.StackDescription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(stack0.StackDescription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockdeployedStackDescriberMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockdeployedStackDescriber)(nil).Describe))
+}
+
+// Template mocks base method.
+func (m *MockdeployedStackDescriber) Template() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Template")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Template indicates an expected call of Template.
+func (mr *MockdeployedStackDescriberMockRecorder) Template() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Template", reflect.TypeOf((*MockdeployedStackDescriber)(nil).Template))
+}
+
 // MockapprunnerServiceDescriber is a mock of apprunnerServiceDescriber interface.
 type MockapprunnerServiceDescriber struct {
 	ctrl     *gomock.Controller
@@ -5938,84 +7165,172 @@ func (mr *MockecsCommandExecutorMockRecorder) ExecuteCommand(in interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCommand", reflect.TypeOf((*MockecsCommandExecutor)(nil).ExecuteCommand), in)
 }
 
-// MockssmPluginManager is a mock of ssmPluginManager interface.
-type MockssmPluginManager struct {
+// MockssmPortForwarder is a mock of ssmPortForwarder interface.
+type MockssmPortForwarder struct {
 	ctrl     *gomock.Controller
-	recorder *MockssmPluginManagerMockRecorder
+	recorder *MockssmPortForwarderMockRecorder
 }
 
-// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
-type MockssmPluginManagerMockRecorder struct {
-	mock *MockssmPluginManager
+// MockssmPortForwarderMockRecorder is the mock recorder for MockssmPortForwarder.
+type MockssmPortForwarderMockRecorder struct {
+	mock *MockssmPortForwarder
 }
 
-// NewMockssmPluginManager creates a new mock instance.
-func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
-	mock := &MockssmPluginManager{ctrl: ctrl}
-	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
+// NewMockssmPortForwarder creates a new mock instance.
+func NewMockssmPortForwarder(ctrl *gomock.Controller) *MockssmPortForwarder {
+	mock := &MockssmPortForwarder{ctrl: ctrl}
+	mock.recorder = &MockssmPortForwarderMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
+func (m *MockssmPortForwarder) EXPECT() *MockssmPortForwarderMockRecorder {
 	return m.recorder
 }
 
-// InstallLatestBinary mocks base method.
-func (m *MockssmPluginManager) InstallLatestBinary() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallLatestBinary")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// InstallLatestBinary indicates an expected call of InstallLatestBinary.
-func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
-}
-
-// ValidateBinary mocks base method.
-func (m *MockssmPluginManager) ValidateBinary() error {
+// StartPortForwardingSession mocks base method.
+func (m *MockssmPortForwarder) StartPortForwardingSession(in ssm.PortForwardingSessionInput) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ValidateBinary")
+	ret := m.ctrl.Call(m, "StartPortForwardingSession", in)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// ValidateBinary indicates an expected call of ValidateBinary.
-func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
+// StartPortForwardingSession indicates an expected call of StartPortForwardingSession.
+func (mr *MockssmPortForwarderMockRecorder) StartPortForwardingSession(in interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPortForwardingSession", reflect.TypeOf((*MockssmPortForwarder)(nil).StartPortForwardingSession), in)
 }
 
-// MocktaskStopper is a mock of taskStopper interface.
-type MocktaskStopper struct {
+// MockfileCopier is a mock of fileCopier interface.
+type MockfileCopier struct {
 	ctrl     *gomock.Controller
-	recorder *MocktaskStopperMockRecorder
+	recorder *MockfileCopierMockRecorder
 }
 
-// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
-type MocktaskStopperMockRecorder struct {
-	mock *MocktaskStopper
+// MockfileCopierMockRecorder is the mock recorder for MockfileCopier.
+type MockfileCopierMockRecorder struct {
+	mock *MockfileCopier
 }
 
-// NewMocktaskStopper creates a new mock instance.
-func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
-	mock := &MocktaskStopper{ctrl: ctrl}
-	mock.recorder = &MocktaskStopperMockRecorder{mock}
+// NewMockfileCopier creates a new mock instance.
+func NewMockfileCopier(ctrl *gomock.Controller) *MockfileCopier {
+	mock := &MockfileCopier{ctrl: ctrl}
+	mock.recorder = &MockfileCopierMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
+func (m *MockfileCopier) EXPECT() *MockfileCopierMockRecorder {
 	return m.recorder
 }
 
-// StopDefaultClusterTasks mocks base method.
-func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
+// Download mocks base method.
+func (m *MockfileCopier) Download(remotePath, localPath string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
+	ret := m.ctrl.Call(m, "Download", remotePath, localPath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockfileCopierMockRecorder) Download(remotePath, localPath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockfileCopier)(nil).Download), remotePath, localPath)
+}
+
+// Upload mocks base method.
+func (m *MockfileCopier) Upload(localPath, remotePath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", localPath, remotePath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockfileCopierMockRecorder) Upload(localPath, remotePath interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockfileCopier)(nil).Upload), localPath, remotePath)
+}
+
+// MockssmPluginManager is a mock of ssmPluginManager interface.
+type MockssmPluginManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmPluginManagerMockRecorder
+}
+
+// MockssmPluginManagerMockRecorder is the mock recorder for MockssmPluginManager.
+type MockssmPluginManagerMockRecorder struct {
+	mock *MockssmPluginManager
+}
+
+// NewMockssmPluginManager creates a new mock instance.
+func NewMockssmPluginManager(ctrl *gomock.Controller) *MockssmPluginManager {
+	mock := &MockssmPluginManager{ctrl: ctrl}
+	mock.recorder = &MockssmPluginManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmPluginManager) EXPECT() *MockssmPluginManagerMockRecorder {
+	return m.recorder
+}
+
+// InstallLatestBinary mocks base method.
+func (m *MockssmPluginManager) InstallLatestBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallLatestBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallLatestBinary indicates an expected call of InstallLatestBinary.
+func (mr *MockssmPluginManagerMockRecorder) InstallLatestBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallLatestBinary", reflect.TypeOf((*MockssmPluginManager)(nil).InstallLatestBinary))
+}
+
+// ValidateBinary mocks base method.
+func (m *MockssmPluginManager) ValidateBinary() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateBinary")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateBinary indicates an expected call of ValidateBinary.
+func (mr *MockssmPluginManagerMockRecorder) ValidateBinary() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateBinary", reflect.TypeOf((*MockssmPluginManager)(nil).ValidateBinary))
+}
+
+// MocktaskStopper is a mock of taskStopper interface.
+type MocktaskStopper struct {
+	ctrl     *gomock.Controller
+	recorder *MocktaskStopperMockRecorder
+}
+
+// MocktaskStopperMockRecorder is the mock recorder for MocktaskStopper.
+type MocktaskStopperMockRecorder struct {
+	mock *MocktaskStopper
+}
+
+// NewMocktaskStopper creates a new mock instance.
+func NewMocktaskStopper(ctrl *gomock.Controller) *MocktaskStopper {
+	mock := &MocktaskStopper{ctrl: ctrl}
+	mock.recorder = &MocktaskStopperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktaskStopper) EXPECT() *MocktaskStopperMockRecorder {
+	return m.recorder
+}
+
+// StopDefaultClusterTasks mocks base method.
+func (m *MocktaskStopper) StopDefaultClusterTasks(familyName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopDefaultClusterTasks", familyName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
@@ -6328,6 +7643,166 @@ func (mr *MockdockerEngineMockRecorder) RedirectPlatform(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedirectPlatform", reflect.TypeOf((*MockdockerEngine)(nil).RedirectPlatform), arg0)
 }
 
+// MocklocalContainerRunner is a mock of localContainerRunner interface.
+type MocklocalContainerRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MocklocalContainerRunnerMockRecorder
+}
+
+// MocklocalContainerRunnerMockRecorder is the mock recorder for MocklocalContainerRunner.
+type MocklocalContainerRunnerMockRecorder struct {
+	mock *MocklocalContainerRunner
+}
+
+// NewMocklocalContainerRunner creates a new mock instance.
+func NewMocklocalContainerRunner(ctrl *gomock.Controller) *MocklocalContainerRunner {
+	mock := &MocklocalContainerRunner{ctrl: ctrl}
+	mock.recorder = &MocklocalContainerRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklocalContainerRunner) EXPECT() *MocklocalContainerRunnerMockRecorder {
+	return m.recorder
+}
+
+// Build mocks base method.
+func (m *MocklocalContainerRunner) Build(args *dockerengine.BuildArguments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Build", args)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Build indicates an expected call of Build.
+func (mr *MocklocalContainerRunnerMockRecorder) Build(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MocklocalContainerRunner)(nil).Build), args)
+}
+
+// CheckDockerEngineRunning mocks base method.
+func (m *MocklocalContainerRunner) CheckDockerEngineRunning() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDockerEngineRunning")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckDockerEngineRunning indicates an expected call of CheckDockerEngineRunning.
+func (mr *MocklocalContainerRunnerMockRecorder) CheckDockerEngineRunning() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDockerEngineRunning", reflect.TypeOf((*MocklocalContainerRunner)(nil).CheckDockerEngineRunning))
+}
+
+// CreateNetwork mocks base method.
+func (m *MocklocalContainerRunner) CreateNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) CreateNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).CreateNetwork), name)
+}
+
+// IsContainerHealthy mocks base method.
+func (m *MocklocalContainerRunner) IsContainerHealthy(name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsContainerHealthy", name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsContainerHealthy indicates an expected call of IsContainerHealthy.
+func (mr *MocklocalContainerRunnerMockRecorder) IsContainerHealthy(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsContainerHealthy", reflect.TypeOf((*MocklocalContainerRunner)(nil).IsContainerHealthy), name)
+}
+
+// RemoveNetwork mocks base method.
+func (m *MocklocalContainerRunner) RemoveNetwork(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetwork", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveNetwork indicates an expected call of RemoveNetwork.
+func (mr *MocklocalContainerRunnerMockRecorder) RemoveNetwork(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetwork", reflect.TypeOf((*MocklocalContainerRunner)(nil).RemoveNetwork), name)
+}
+
+// Run mocks base method.
+func (m *MocklocalContainerRunner) Run(options *dockerengine.RunOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Run indicates an expected call of Run.
+func (mr *MocklocalContainerRunnerMockRecorder) Run(options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocklocalContainerRunner)(nil).Run), options)
+}
+
+// Stop mocks base method.
+func (m *MocklocalContainerRunner) Stop(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MocklocalContainerRunnerMockRecorder) Stop(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MocklocalContainerRunner)(nil).Stop), name)
+}
+
+// MocksecretGetter is a mock of secretGetter interface.
+type MocksecretGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretGetterMockRecorder
+}
+
+// MocksecretGetterMockRecorder is the mock recorder for MocksecretGetter.
+type MocksecretGetterMockRecorder struct {
+	mock *MocksecretGetter
+}
+
+// NewMocksecretGetter creates a new mock instance.
+func NewMocksecretGetter(ctrl *gomock.Controller) *MocksecretGetter {
+	mock := &MocksecretGetter{ctrl: ctrl}
+	mock.recorder = &MocksecretGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretGetter) EXPECT() *MocksecretGetterMockRecorder {
+	return m.recorder
+}
+
+// GetSecretValue mocks base method.
+func (m *MocksecretGetter) GetSecretValue(name string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretValue", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretValue indicates an expected call of GetSecretValue.
+func (mr *MocksecretGetterMockRecorder) GetSecretValue(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretValue", reflect.TypeOf((*MocksecretGetter)(nil).GetSecretValue), name)
+}
+
 // Mockcodestar is a mock of codestar interface.
 type Mockcodestar struct {
 	ctrl     *gomock.Controller
@@ -6479,6 +7954,178 @@ func (mr *MocksecretPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretPutter)(nil).PutSecret), in)
 }
 
+// MocksecretsManagerPutter is a mock of secretsManagerPutter interface.
+type MocksecretsManagerPutter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerPutterMockRecorder
+}
+
+// MocksecretsManagerPutterMockRecorder is the mock recorder for MocksecretsManagerPutter.
+type MocksecretsManagerPutterMockRecorder struct {
+	mock *MocksecretsManagerPutter
+}
+
+// NewMocksecretsManagerPutter creates a new mock instance.
+func NewMocksecretsManagerPutter(ctrl *gomock.Controller) *MocksecretsManagerPutter {
+	mock := &MocksecretsManagerPutter{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerPutterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerPutter) EXPECT() *MocksecretsManagerPutterMockRecorder {
+	return m.recorder
+}
+
+// PutSecret mocks base method.
+func (m *MocksecretsManagerPutter) PutSecret(in secretsmanager.PutSecretInput) (*secretsmanager.PutSecretOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutSecret", in)
+	ret0, _ := ret[0].(*secretsmanager.PutSecretOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutSecret indicates an expected call of PutSecret.
+func (mr *MocksecretsManagerPutterMockRecorder) PutSecret(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutSecret", reflect.TypeOf((*MocksecretsManagerPutter)(nil).PutSecret), in)
+}
+
+// MockssmSecretStore is a mock of ssmSecretStore interface.
+type MockssmSecretStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockssmSecretStoreMockRecorder
+}
+
+// MockssmSecretStoreMockRecorder is the mock recorder for MockssmSecretStore.
+type MockssmSecretStoreMockRecorder struct {
+	mock *MockssmSecretStore
+}
+
+// NewMockssmSecretStore creates a new mock instance.
+func NewMockssmSecretStore(ctrl *gomock.Controller) *MockssmSecretStore {
+	mock := &MockssmSecretStore{ctrl: ctrl}
+	mock.recorder = &MockssmSecretStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockssmSecretStore) EXPECT() *MockssmSecretStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MockssmSecretStore) DeleteSecret(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MockssmSecretStoreMockRecorder) DeleteSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockssmSecretStore)(nil).DeleteSecret), name)
+}
+
+// DescribeSecret mocks base method.
+func (m *MockssmSecretStore) DescribeSecret(name string) (*ssm.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSecret", name)
+	ret0, _ := ret[0].(*ssm.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSecret indicates an expected call of DescribeSecret.
+func (mr *MockssmSecretStoreMockRecorder) DescribeSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecret", reflect.TypeOf((*MockssmSecretStore)(nil).DescribeSecret), name)
+}
+
+// ListSecrets mocks base method.
+func (m *MockssmSecretStore) ListSecrets(tags map[string]string) ([]ssm.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]ssm.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockssmSecretStoreMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockssmSecretStore)(nil).ListSecrets), tags)
+}
+
+// MocksecretsManagerSecretStore is a mock of secretsManagerSecretStore interface.
+type MocksecretsManagerSecretStore struct {
+	ctrl     *gomock.Controller
+	recorder *MocksecretsManagerSecretStoreMockRecorder
+}
+
+// MocksecretsManagerSecretStoreMockRecorder is the mock recorder for MocksecretsManagerSecretStore.
+type MocksecretsManagerSecretStoreMockRecorder struct {
+	mock *MocksecretsManagerSecretStore
+}
+
+// NewMocksecretsManagerSecretStore creates a new mock instance.
+func NewMocksecretsManagerSecretStore(ctrl *gomock.Controller) *MocksecretsManagerSecretStore {
+	mock := &MocksecretsManagerSecretStore{ctrl: ctrl}
+	mock.recorder = &MocksecretsManagerSecretStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksecretsManagerSecretStore) EXPECT() *MocksecretsManagerSecretStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecret mocks base method.
+func (m *MocksecretsManagerSecretStore) DeleteSecret(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret.
+func (mr *MocksecretsManagerSecretStoreMockRecorder) DeleteSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MocksecretsManagerSecretStore)(nil).DeleteSecret), name)
+}
+
+// DescribeSecret mocks base method.
+func (m *MocksecretsManagerSecretStore) DescribeSecret(name string) (*secretsmanager.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeSecret", name)
+	ret0, _ := ret[0].(*secretsmanager.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSecret indicates an expected call of DescribeSecret.
+func (mr *MocksecretsManagerSecretStoreMockRecorder) DescribeSecret(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecret", reflect.TypeOf((*MocksecretsManagerSecretStore)(nil).DescribeSecret), name)
+}
+
+// ListSecrets mocks base method.
+func (m *MocksecretsManagerSecretStore) ListSecrets(tags map[string]string) ([]secretsmanager.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", tags)
+	ret0, _ := ret[0].([]secretsmanager.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MocksecretsManagerSecretStoreMockRecorder) ListSecrets(tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MocksecretsManagerSecretStore)(nil).ListSecrets), tags)
+}
+
 // MockservicePauser is a mock of servicePauser interface.
 type MockservicePauser struct {
 	ctrl     *gomock.Controller
@@ -6516,6 +8163,165 @@ func (mr *MockservicePauserMockRecorder) PauseService(svcARN interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseService", reflect.TypeOf((*MockservicePauser)(nil).PauseService), svcARN)
 }
 
+// MockjobRunner is a mock of jobRunner interface.
+type MockjobRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobRunnerMockRecorder
+}
+
+// MockjobRunnerMockRecorder is the mock recorder for MockjobRunner.
+type MockjobRunnerMockRecorder struct {
+	mock *MockjobRunner
+}
+
+// NewMockjobRunner creates a new mock instance.
+func NewMockjobRunner(ctrl *gomock.Controller) *MockjobRunner {
+	mock := &MockjobRunner{ctrl: ctrl}
+	mock.recorder = &MockjobRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobRunner) EXPECT() *MockjobRunnerMockRecorder {
+	return m.recorder
+}
+
+// JobExecutionTasks mocks base method.
+func (m *MockjobRunner) JobExecutionTasks(executionARN string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobExecutionTasks", executionARN)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobExecutionTasks indicates an expected call of JobExecutionTasks.
+func (mr *MockjobRunnerMockRecorder) JobExecutionTasks(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobExecutionTasks", reflect.TypeOf((*MockjobRunner)(nil).JobExecutionTasks), executionARN)
+}
+
+// RunJob mocks base method.
+func (m *MockjobRunner) RunJob(app, env, job string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunJob", app, env, job)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunJob indicates an expected call of RunJob.
+func (mr *MockjobRunnerMockRecorder) RunJob(app, env, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunJob", reflect.TypeOf((*MockjobRunner)(nil).RunJob), app, env, job)
+}
+
+// MockjobExecutionDescriber is a mock of jobExecutionDescriber interface.
+type MockjobExecutionDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockjobExecutionDescriberMockRecorder
+}
+
+// MockjobExecutionDescriberMockRecorder is the mock recorder for MockjobExecutionDescriber.
+type MockjobExecutionDescriberMockRecorder struct {
+	mock *MockjobExecutionDescriber
+}
+
+// NewMockjobExecutionDescriber creates a new mock instance.
+func NewMockjobExecutionDescriber(ctrl *gomock.Controller) *MockjobExecutionDescriber {
+	mock := &MockjobExecutionDescriber{ctrl: ctrl}
+	mock.recorder = &MockjobExecutionDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockjobExecutionDescriber) EXPECT() *MockjobExecutionDescriberMockRecorder {
+	return m.recorder
+}
+
+// JobExecution mocks base method.
+func (m *MockjobExecutionDescriber) JobExecution(app, env, job, name string) (*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobExecution", app, env, job, name)
+	ret0, _ := ret[0].(*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobExecution indicates an expected call of JobExecution.
+func (mr *MockjobExecutionDescriberMockRecorder) JobExecution(app, env, job, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobExecution", reflect.TypeOf((*MockjobExecutionDescriber)(nil).JobExecution), app, env, job, name)
+}
+
+// JobExecutionTasks mocks base method.
+func (m *MockjobExecutionDescriber) JobExecutionTasks(executionARN string) ([]*ecs.Task, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobExecutionTasks", executionARN)
+	ret0, _ := ret[0].([]*ecs.Task)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobExecutionTasks indicates an expected call of JobExecutionTasks.
+func (mr *MockjobExecutionDescriberMockRecorder) JobExecutionTasks(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobExecutionTasks", reflect.TypeOf((*MockjobExecutionDescriber)(nil).JobExecutionTasks), executionARN)
+}
+
+// JobExecutions mocks base method.
+func (m *MockjobExecutionDescriber) JobExecutions(app, env, job string, maxResults int) ([]*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobExecutions", app, env, job, maxResults)
+	ret0, _ := ret[0].([]*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JobExecutions indicates an expected call of JobExecutions.
+func (mr *MockjobExecutionDescriberMockRecorder) JobExecutions(app, env, job, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobExecutions", reflect.TypeOf((*MockjobExecutionDescriber)(nil).JobExecutions), app, env, job, maxResults)
+}
+
+// MockexecutionDescriber is a mock of executionDescriber interface.
+type MockexecutionDescriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockexecutionDescriberMockRecorder
+}
+
+// MockexecutionDescriberMockRecorder is the mock recorder for MockexecutionDescriber.
+type MockexecutionDescriberMockRecorder struct {
+	mock *MockexecutionDescriber
+}
+
+// NewMockexecutionDescriber creates a new mock instance.
+func NewMockexecutionDescriber(ctrl *gomock.Controller) *MockexecutionDescriber {
+	mock := &MockexecutionDescriber{ctrl: ctrl}
+	mock.recorder = &MockexecutionDescriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockexecutionDescriber) EXPECT() *MockexecutionDescriberMockRecorder {
+	return m.recorder
+}
+
+// DescribeExecution mocks base method.
+func (m *MockexecutionDescriber) DescribeExecution(executionARN string) (*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeExecution", executionARN)
+	ret0, _ := ret[0].(*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeExecution indicates an expected call of DescribeExecution.
+func (mr *MockexecutionDescriberMockRecorder) DescribeExecution(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExecution", reflect.TypeOf((*MockexecutionDescriber)(nil).DescribeExecution), executionARN)
+}
+
 // MocktimeoutError is a mock of timeoutError interface.
 type MocktimeoutError struct {
 	ctrl     *gomock.Controller
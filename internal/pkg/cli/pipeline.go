@@ -4,11 +4,22 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/aws/copilot-cli/cmd/copilot/template"
 	"github.com/aws/copilot-cli/internal/pkg/cli/group"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	pipelineSelectPrompt     = "Which pipeline in your workspace would you like to use?"
+	pipelineSelectHelpPrompt = "A workspace can have several pipelines, each deploying a subset of your services and jobs."
+)
+
 // BuildPipelineCmd is the top level command for pipelines
 func BuildPipelineCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -33,3 +44,67 @@ Continuous delivery pipelines to release services.`,
 
 	return cmd
 }
+
+// selectWorkspacePipeline returns the pipeline in the workspace with the given name.
+// If name is empty, it returns the only pipeline in the workspace or prompts the user to select one.
+func selectWorkspacePipeline(ws wsPipelineManifestReader, p prompter, name string) (workspace.PipelineManifest, error) {
+	pipelines, err := ws.ListPipelines()
+	if err != nil {
+		return workspace.PipelineManifest{}, err
+	}
+	if name != "" {
+		for _, pipeline := range pipelines {
+			if pipeline.Name == name {
+				return pipeline, nil
+			}
+		}
+		return workspace.PipelineManifest{}, fmt.Errorf("pipeline %s not found in the workspace", name)
+	}
+	if len(pipelines) == 1 {
+		return pipelines[0], nil
+	}
+	var names []string
+	for _, pipeline := range pipelines {
+		names = append(names, pipeline.Name)
+	}
+	selected, err := p.SelectOne(pipelineSelectPrompt, pipelineSelectHelpPrompt, names, prompt.WithFinalMessage("Pipeline:"))
+	if err != nil {
+		return workspace.PipelineManifest{}, fmt.Errorf("select pipeline: %w", err)
+	}
+	for _, pipeline := range pipelines {
+		if pipeline.Name == selected {
+			return pipeline, nil
+		}
+	}
+	return workspace.PipelineManifest{}, fmt.Errorf("pipeline %s not found in the workspace", selected)
+}
+
+// readPipelineManifest reads and unmarshals the workspace pipeline manifest.
+func readPipelineManifest(ws wsPipelineManifestReader, pipeline workspace.PipelineManifest) (*manifest.PipelineManifest, error) {
+	data, err := ws.ReadPipelineManifest(pipeline.Path)
+	if err != nil {
+		return nil, fmt.Errorf("read pipeline manifest: %w", err)
+	}
+	mft, err := manifest.UnmarshalPipeline(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal pipeline manifest: %w", err)
+	}
+	return mft, nil
+}
+
+// deployedPipelineName returns the name of the stack and CodePipeline of the application's pipeline with the manifest name.
+// Pipelines deployed before their names were namespaced with the application keep their manifest name,
+// in which case isLegacy is true.
+func deployedPipelineName(lister pipelineGetter, appName, name string) (stackName string, isLegacy bool, err error) {
+	deployed, err := lister.ListPipelineNamesByTags(map[string]string{
+		deploy.AppTagKey: appName,
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("list pipelines of application %s: %w", appName, err)
+	}
+	stackName = deploy.PipelineStackName(appName, name)
+	if !contains(stackName, deployed) && contains(name, deployed) {
+		return name, true, nil
+	}
+	return stackName, false, nil
+}
@@ -7,10 +7,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
-	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -36,6 +36,7 @@ var (
 
 type deletePipelineVars struct {
 	appName            string
+	name               string
 	skipConfirmation   bool
 	shouldDeleteSecret bool
 }
@@ -46,8 +47,11 @@ type deletePipelineOpts struct {
 	PipelineName   string
 	PipelineSecret string
 
+	pipelineStackName string
+
 	// Interfaces to dependencies
 	pipelineDeployer pipelineDeployer
+	pipelineSvc      pipelineGetter
 	prog             progress
 	prompt           prompter
 	secretsmanager   secretsManager
@@ -76,6 +80,7 @@ func newDeletePipelineOpts(vars deletePipelineVars) (*deletePipelineOpts, error)
 		prompt:             prompt.New(),
 		secretsmanager:     secretsmanager,
 		pipelineDeployer:   cloudformation.New(defaultSess),
+		pipelineSvc:        codepipeline.New(defaultSess),
 		ws:                 ws,
 	}
 
@@ -131,20 +136,20 @@ func (o *deletePipelineOpts) Execute() error {
 }
 
 func (o *deletePipelineOpts) readPipelineManifest() error {
-	data, err := o.ws.ReadPipelineManifest()
+	wsPipeline, err := selectWorkspacePipeline(o.ws, o.prompt, o.name)
 	if err != nil {
-		if err == workspace.ErrNoPipelineInWorkspace {
-			return err
-		}
-		return fmt.Errorf("read pipeline manifest: %w", err)
+		return err
 	}
-
-	pipeline, err := manifest.UnmarshalPipeline(data)
+	pipeline, err := readPipelineManifest(o.ws, wsPipeline)
 	if err != nil {
-		return fmt.Errorf("unmarshal pipeline manifest: %w", err)
+		return err
 	}
 
 	o.PipelineName = pipeline.Name
+	o.pipelineStackName, _, err = deployedPipelineName(o.pipelineSvc, o.appName, pipeline.Name)
+	if err != nil {
+		return err
+	}
 
 	if secret, ok := (pipeline.Source.Properties["access_token_secret"]).(string); ok {
 		o.PipelineSecret = secret
@@ -184,7 +189,7 @@ func (o *deletePipelineOpts) deleteSecret() error {
 
 func (o *deletePipelineOpts) deleteStack() error {
 	o.prog.Start(fmt.Sprintf(fmtDeletePipelineStart, o.PipelineName, o.appName))
-	if err := o.pipelineDeployer.DeletePipeline(o.PipelineName); err != nil {
+	if err := o.pipelineDeployer.DeletePipeline(o.pipelineStackName); err != nil {
 		o.prog.Stop(log.Serrorf(fmtDeletePipelineFailed, o.PipelineName, o.appName, err))
 		return err
 	}
@@ -202,10 +207,12 @@ func buildPipelineDeleteCmd() *cobra.Command {
 	vars := deletePipelineVars{}
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Deletes the pipeline associated with your workspace.",
+		Short: "Deletes a pipeline associated with your workspace.",
 		Example: `
   Delete the pipeline associated with your workspace.
-  /code $ copilot pipeline delete`,
+  /code $ copilot pipeline delete
+  Delete the pipeline named "api" in a workspace with several pipelines.
+  /code $ copilot pipeline delete --name api`,
 
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newDeletePipelineOpts(vars)
@@ -216,6 +223,7 @@ func buildPipelineDeleteCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	cmd.Flags().BoolVar(&vars.shouldDeleteSecret, deleteSecretFlag, false, deleteSecretFlagDescription)
 	return cmd
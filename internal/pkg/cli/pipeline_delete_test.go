@@ -6,6 +6,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
@@ -17,9 +18,10 @@ import (
 )
 
 const (
-	testAppName        = "badgoose"
-	testPipelineName   = "honkpipes"
-	testPipelineSecret = "honkhonkhonk"
+	testAppName           = "badgoose"
+	testPipelineName      = "honkpipes"
+	testPipelineStackName = "pipeline-badgoose-honkpipes"
+	testPipelineSecret    = "honkhonkhonk"
 )
 
 type deletePipelineMocks struct {
@@ -27,6 +29,7 @@ type deletePipelineMocks struct {
 	prog           *mocks.Mockprogress
 	secretsmanager *mocks.MocksecretsManager
 	deployer       *mocks.MockpipelineDeployer
+	pipelineSvc    *mocks.MockpipelineGetter
 	ws             *mocks.MockwsPipelineReader
 }
 
@@ -51,22 +54,105 @@ stages:
 
 	testCases := map[string]struct {
 		inAppName string
+		inName    string
 		callMocks func(m deletePipelineMocks)
 
-		wantedError error
+		wantedPipelineName      string
+		wantedPipelineStackName string
+		wantedPipelineSecret    string
+		wantedError             error
 	}{
 		"happy path": {
 			inAppName: testAppName,
 			callMocks: func(m deletePipelineMocks) {
-				m.ws.EXPECT().ReadPipelineManifest().Return([]byte(pipelineData), nil)
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "pipeline-badgoose-honker-repo", Path: "copilot/pipeline.yml"},
+				}, nil)
+				m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(pipelineData), nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": testAppName}).
+					Return([]string{"pipeline-badgoose-honker-repo"}, nil)
 			},
-			wantedError: nil,
+			wantedPipelineName:      "pipeline-badgoose-honker-repo",
+			wantedPipelineStackName: "pipeline-badgoose-honker-repo",
+			wantedPipelineSecret:    "github-token-badgoose-repo",
+		},
+		"selects the pipeline with the given name": {
+			inAppName: testAppName,
+			inName:    "pipeline-badgoose-honker-repo",
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "api", Path: "copilot/pipelines/api/manifest.yml"},
+					{Name: "pipeline-badgoose-honker-repo", Path: "copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml"},
+				}, nil)
+				m.ws.EXPECT().ReadPipelineManifest("copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml").Return([]byte(pipelineData), nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": testAppName}).
+					Return([]string{"pipeline-badgoose-honker-repo"}, nil)
+			},
+			wantedPipelineName:      "pipeline-badgoose-honker-repo",
+			wantedPipelineStackName: "pipeline-badgoose-honker-repo",
+			wantedPipelineSecret:    "github-token-badgoose-repo",
+		},
+		"prompts for the pipeline if there are several in the workspace": {
+			inAppName: testAppName,
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "api", Path: "copilot/pipelines/api/manifest.yml"},
+					{Name: "pipeline-badgoose-honker-repo", Path: "copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml"},
+				}, nil)
+				m.prompt.EXPECT().SelectOne(pipelineSelectPrompt, pipelineSelectHelpPrompt, []string{"api", "pipeline-badgoose-honker-repo"}, gomock.Any()).
+					Return("pipeline-badgoose-honker-repo", nil)
+				m.ws.EXPECT().ReadPipelineManifest("copilot/pipelines/pipeline-badgoose-honker-repo/manifest.yml").Return([]byte(pipelineData), nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": testAppName}).
+					Return([]string{"pipeline-badgoose-honker-repo"}, nil)
+			},
+			wantedPipelineName:      "pipeline-badgoose-honker-repo",
+			wantedPipelineStackName: "pipeline-badgoose-honker-repo",
+			wantedPipelineSecret:    "github-token-badgoose-repo",
+		},
+		"namespaces the stack name of the pipeline with the application": {
+			inAppName: testAppName,
+			inName:    "api",
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "api", Path: "copilot/pipelines/api/manifest.yml"},
+				}, nil)
+				m.ws.EXPECT().ReadPipelineManifest("copilot/pipelines/api/manifest.yml").Return([]byte(strings.Replace(pipelineData, "pipeline-badgoose-honker-repo", "api", 1)), nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": testAppName}).
+					Return([]string{"pipeline-badgoose-api"}, nil)
+			},
+			wantedPipelineName:      "api",
+			wantedPipelineStackName: "pipeline-badgoose-api",
+			wantedPipelineSecret:    "github-token-badgoose-repo",
+		},
+		"keeps the stack name of a pipeline deployed before names were namespaced": {
+			inAppName: testAppName,
+			inName:    "api",
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "api", Path: "copilot/pipelines/api/manifest.yml"},
+				}, nil)
+				m.ws.EXPECT().ReadPipelineManifest("copilot/pipelines/api/manifest.yml").Return([]byte(strings.Replace(pipelineData, "pipeline-badgoose-honker-repo", "api", 1)), nil)
+				m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": testAppName}).
+					Return([]string{"api"}, nil)
+			},
+			wantedPipelineName:      "api",
+			wantedPipelineStackName: "api",
+			wantedPipelineSecret:    "github-token-badgoose-repo",
+		},
+		"pipeline with the given name does not exist": {
+			inAppName: testAppName,
+			inName:    "worker",
+			callMocks: func(m deletePipelineMocks) {
+				m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{
+					{Name: "api", Path: "copilot/pipelines/api/manifest.yml"},
+				}, nil)
+			},
+			wantedError: errors.New("pipeline worker not found in the workspace"),
 		},
-
 		"pipeline manifest does not exist": {
 			inAppName: testAppName,
 			callMocks: func(m deletePipelineMocks) {
-				m.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace)
+				m.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace)
 			},
 
 			wantedError: workspace.ErrNoPipelineInWorkspace,
@@ -87,8 +173,12 @@ stages:
 			defer ctrl.Finish()
 
 			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
+			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockPipelineSvc := mocks.NewMockpipelineGetter(ctrl)
 			mocks := deletePipelineMocks{
-				ws: mockWorkspace,
+				ws:          mockWorkspace,
+				prompt:      mockPrompt,
+				pipelineSvc: mockPipelineSvc,
 			}
 
 			tc.callMocks(mocks)
@@ -96,8 +186,11 @@ stages:
 			opts := &deletePipelineOpts{
 				deletePipelineVars: deletePipelineVars{
 					appName: tc.inAppName,
+					name:    tc.inName,
 				},
-				ws: mockWorkspace,
+				ws:          mockWorkspace,
+				prompt:      mockPrompt,
+				pipelineSvc: mockPipelineSvc,
 			}
 
 			// WHEN
@@ -106,7 +199,12 @@ stages:
 			// THEN
 			if tc.wantedError != nil {
 				require.EqualError(t, err, tc.wantedError.Error())
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPipelineName, opts.PipelineName)
+			require.Equal(t, tc.wantedPipelineStackName, opts.pipelineStackName)
+			require.Equal(t, tc.wantedPipelineSecret, opts.PipelineSecret)
 		})
 	}
 }
@@ -199,7 +297,7 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					mocks.secretsmanager.EXPECT().DeleteSecret(gomock.Any()).Times(0),
 					mocks.prog.EXPECT().Start(fmt.Sprintf(fmtDeletePipelineStart, testPipelineName, testAppName)),
-					mocks.deployer.EXPECT().DeletePipeline(testPipelineName).Return(nil),
+					mocks.deployer.EXPECT().DeletePipeline(testPipelineStackName).Return(nil),
 					mocks.prog.EXPECT().Stop(log.Ssuccessf(fmtDeletePipelineComplete, testPipelineName, testAppName)),
 				)
 			},
@@ -217,7 +315,7 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 					// no confirmation prompt for deleting secret
 					mocks.secretsmanager.EXPECT().DeleteSecret(testPipelineSecret).Return(nil),
 					mocks.prog.EXPECT().Start(fmt.Sprintf(fmtDeletePipelineStart, testPipelineName, testAppName)),
-					mocks.deployer.EXPECT().DeletePipeline(testPipelineName).Return(nil),
+					mocks.deployer.EXPECT().DeletePipeline(testPipelineStackName).Return(nil),
 					mocks.prog.EXPECT().Stop(log.Ssuccessf(fmtDeletePipelineComplete, testPipelineName, testAppName)),
 				)
 			},
@@ -238,7 +336,7 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 					).Times(1).Return(true, nil),
 					mocks.secretsmanager.EXPECT().DeleteSecret(testPipelineSecret).Return(nil),
 					mocks.prog.EXPECT().Start(fmt.Sprintf(fmtDeletePipelineStart, testPipelineName, testAppName)),
-					mocks.deployer.EXPECT().DeletePipeline(testPipelineName).Return(nil),
+					mocks.deployer.EXPECT().DeletePipeline(testPipelineStackName).Return(nil),
 					mocks.prog.EXPECT().Stop(log.Ssuccessf(fmtDeletePipelineComplete, testPipelineName, testAppName)),
 				)
 			},
@@ -261,7 +359,7 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 					// does not delete secret
 					mocks.secretsmanager.EXPECT().DeleteSecret(testPipelineSecret).Times(0),
 					mocks.prog.EXPECT().Start(fmt.Sprintf(fmtDeletePipelineStart, testPipelineName, testAppName)),
-					mocks.deployer.EXPECT().DeletePipeline(testPipelineName).Times(1).Return(nil),
+					mocks.deployer.EXPECT().DeletePipeline(testPipelineStackName).Times(1).Return(nil),
 					mocks.prog.EXPECT().Stop(log.Ssuccessf(fmtDeletePipelineComplete, testPipelineName, testAppName)),
 				)
 			},
@@ -278,7 +376,7 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 				gomock.InOrder(
 					mocks.secretsmanager.EXPECT().DeleteSecret(testPipelineSecret).Return(nil),
 					mocks.prog.EXPECT().Start(fmt.Sprintf(fmtDeletePipelineStart, testPipelineName, testAppName)),
-					mocks.deployer.EXPECT().DeletePipeline(testPipelineName).Times(1).Return(testError),
+					mocks.deployer.EXPECT().DeletePipeline(testPipelineStackName).Times(1).Return(testError),
 					mocks.prog.EXPECT().Stop(log.Serrorf(fmtDeletePipelineFailed, testPipelineName, testAppName, testError)),
 				)
 			},
@@ -313,13 +411,14 @@ func TestDeletePipelineOpts_Execute(t *testing.T) {
 					shouldDeleteSecret: tc.deleteSecret,
 					appName:            tc.inAppName,
 				},
-				PipelineName:     tc.inPipelineName,
-				PipelineSecret:   tc.inPipelineSecret,
-				secretsmanager:   mockSecretsManager,
-				pipelineDeployer: mockDeployer,
-				ws:               mockWorkspace,
-				prog:             mockProg,
-				prompt:           mockPrompter,
+				PipelineName:      tc.inPipelineName,
+				PipelineSecret:    tc.inPipelineSecret,
+				pipelineStackName: testPipelineStackName,
+				secretsmanager:    mockSecretsManager,
+				pipelineDeployer:  mockDeployer,
+				ws:                mockWorkspace,
+				prog:              mockProg,
+				prompt:            mockPrompter,
 			}
 
 			// WHEN
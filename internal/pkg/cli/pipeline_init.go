@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/dustin/go-humanize"
+	"github.com/dustin/go-humanize/english"
 
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 
@@ -47,8 +49,17 @@ Please enter full repository URL, e.g. "https://github.com/myCompany/myRepo", or
 )
 
 const (
-	buildspecTemplatePath = "cicd/buildspec.yml"
-	fmtPipelineName       = "pipeline-%s-%s" // Ex: "pipeline-appName-repoName"
+	// Systems that run the pipeline.
+	pipelineCIProviderCodePipeline  = "codepipeline"
+	pipelineCIProviderGitHubActions = "github-actions"
+)
+
+var pipelineCIProviders = []string{pipelineCIProviderCodePipeline, pipelineCIProviderGitHubActions}
+
+const (
+	buildspecTemplatePath             = "cicd/buildspec.yml"
+	githubActionsWorkflowTemplatePath = "cicd/github-actions.yml"
+	fmtPipelineName                   = "pipeline-%s-%s" // Ex: "pipeline-appName-repoName"
 	// For a GitHub repository.
 	githubURL       = "github.com"
 	defaultGHBranch = deploy.DefaultPipelineBranch
@@ -72,16 +83,20 @@ var (
 
 type initPipelineVars struct {
 	appName           string
+	name              string
+	workloads         []string
+	paths             []string
 	environments      []string
 	repoURL           string
 	repoBranch        string
 	githubAccessToken string
+	ciProvider        string
 }
 
 type initPipelineOpts struct {
 	initPipelineVars
 	// Interfaces to interact with dependencies.
-	workspace      wsPipelineWriter
+	workspace      wsPipelineIniter
 	secretsmanager secretsManager
 	parser         template.Parser
 	runner         runner
@@ -158,12 +173,34 @@ func (o *initPipelineOpts) Validate() error {
 		return err
 	}
 
+	if o.name != "" {
+		if err := validatePipelineName(o.name); err != nil {
+			return err
+		}
+	}
+
+	if err := o.validateCIProvider(); err != nil {
+		return err
+	}
+
 	if o.repoURL != "" {
 		if err := o.validateURL(o.repoURL); err != nil {
 			return err
 		}
 	}
 
+	if len(o.workloads) != 0 {
+		localWorkloads, err := o.workspace.WorkloadNames()
+		if err != nil {
+			return fmt.Errorf("get workload names from workspace: %w", err)
+		}
+		for _, wl := range o.workloads {
+			if !contains(wl, localWorkloads) {
+				return fmt.Errorf("workload %s is not in the workspace", wl)
+			}
+		}
+	}
+
 	if o.environments != nil {
 		for _, env := range o.environments {
 			_, err := o.store.GetEnvironment(o.appName, env)
@@ -189,13 +226,19 @@ func (o *initPipelineOpts) Ask() error {
 
 // Execute writes the pipeline manifest file.
 func (o *initPipelineOpts) Execute() error {
+	if o.ciProvider == pipelineCIProviderGitHubActions {
+		if err := o.createPipelineManifest(); err != nil {
+			return err
+		}
+		return o.createGitHubActionsWorkflow()
+	}
 	if o.provider == manifest.GithubV1ProviderName {
 		if err := o.storeGitHubAccessToken(); err != nil {
 			return err
 		}
 	}
 
-	// write pipeline.yml file, populate with:
+	// write the pipeline manifest file, populate with:
 	//   - git repo as source
 	//   - stage names (environments)
 	//   - enable/disable transition to prod envs
@@ -210,10 +253,32 @@ func (o *initPipelineOpts) Execute() error {
 
 // RequiredActions returns follow-up actions the user must take after successfully executing the command.
 func (o *initPipelineOpts) RequiredActions() []string {
+	if o.ciProvider == pipelineCIProviderGitHubActions {
+		return o.githubActionsRequiredActions()
+	}
 	return []string{
-		fmt.Sprintf("Commit and push the %s, %s, and %s files of your %s directory to your repository.", color.HighlightResource("buildspec.yml"), color.HighlightResource("pipeline.yml"), color.HighlightResource(".workspace"), color.HighlightResource("copilot")),
-		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode("copilot pipeline update")),
+		fmt.Sprintf("Commit and push the %s directory and the %s file of your %s directory to your repository.", color.HighlightResource(path.Join("pipelines", o.pipelineName())), color.HighlightResource(".workspace"), color.HighlightResource("copilot")),
+		fmt.Sprintf("Run %s to create your pipeline.", color.HighlightCode(fmt.Sprintf("copilot pipeline update --name %s", o.pipelineName()))),
+	}
+}
+
+func (o *initPipelineOpts) validateCIProvider() error {
+	if o.ciProvider == "" {
+		o.ciProvider = pipelineCIProviderCodePipeline
+	}
+	if !contains(o.ciProvider, pipelineCIProviders) {
+		return fmt.Errorf("provider %s must be one of: %s", o.ciProvider, strings.Join(pipelineCIProviders, ", "))
+	}
+	if o.ciProvider != pipelineCIProviderGitHubActions {
+		return nil
+	}
+	if o.githubAccessToken != "" {
+		return fmt.Errorf("--%s cannot be used with the %s provider", githubAccessTokenFlag, pipelineCIProviderGitHubActions)
+	}
+	if o.repoURL != "" && !strings.Contains(o.repoURL, githubURL) {
+		return fmt.Errorf("the %s provider requires a GitHub repository", pipelineCIProviderGitHubActions)
 	}
+	return nil
 }
 
 func (o *initPipelineOpts) validateURL(url string) error {
@@ -257,6 +322,9 @@ func (o *initPipelineOpts) askRepository() error {
 		}
 	}
 
+	if o.ciProvider == pipelineCIProviderGitHubActions && !strings.Contains(o.repoURL, githubURL) {
+		return fmt.Errorf("the %s provider requires a GitHub repository", pipelineCIProviderGitHubActions)
+	}
 	switch {
 	case strings.Contains(o.repoURL, githubURL):
 		return o.askGitHubRepoDetails()
@@ -495,6 +563,9 @@ func (o *initPipelineOpts) storeGitHubAccessToken() error {
 
 func (o *initPipelineOpts) createPipelineManifest() error {
 	pipelineName := o.pipelineName()
+	if len(o.paths) != 0 && o.provider != manifest.GithubProviderName && o.provider != manifest.BitbucketProviderName {
+		return fmt.Errorf("--%s is only supported for %s and %s repositories", pipelinePathsFlag, manifest.GithubProviderName, manifest.BitbucketProviderName)
+	}
 
 	provider, err := o.pipelineProvider()
 	if err != nil {
@@ -515,9 +586,11 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 	if err != nil {
 		return fmt.Errorf("generate a pipeline manifest: %w", err)
 	}
+	manifest.Workloads = o.workloads
+	manifest.Source.Paths = o.paths
 
 	var manifestExists bool
-	manifestPath, err := o.workspace.WritePipelineManifest(manifest)
+	manifestPath, err := o.workspace.WritePipelineManifest(manifest, pipelineName)
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -537,12 +610,106 @@ func (o *initPipelineOpts) createPipelineManifest() error {
 		manifestMsgFmt = "Pipeline manifest file for %s already exists at %s, skipping writing it.\n"
 	}
 	log.Successf(manifestMsgFmt, color.HighlightUserInput(o.repoName), color.HighlightResource(manifestPath))
+	if o.ciProvider == pipelineCIProviderGitHubActions {
+		log.Infof(`The manifest lists the services and jobs that your workflow deploys, and the test commands of each stage.
+`)
+		return nil
+	}
 	log.Infof(`The manifest contains configurations for your CodePipeline resources, such as your pipeline stages and build steps.
 Update the file to add additional stages, change the branch to be tracked, or add test commands or manual approval actions.
 `)
 	return nil
 }
 
+type githubActionsStage struct {
+	Name             string
+	Region           string
+	AccountID        string
+	RequiresApproval bool
+	Needs            string // Name of the previous stage.
+}
+
+func (o *initPipelineOpts) createGitHubActionsWorkflow() error {
+	var stages []githubActionsStage
+	for i, env := range o.envConfigs {
+		stage := githubActionsStage{
+			Name:             env.Name,
+			Region:           env.Region,
+			AccountID:        env.AccountID,
+			RequiresApproval: env.Prod,
+		}
+		if i > 0 {
+			stage.Needs = o.envConfigs[i-1].Name
+		}
+		stages = append(stages, stage)
+	}
+	content, err := o.parser.Parse(githubActionsWorkflowTemplatePath, struct {
+		Name               string
+		AppName            string
+		Branch             string
+		Paths              []string
+		ManifestPath       string
+		BinaryS3BucketPath string
+		Version            string
+		Stages             []githubActionsStage
+	}{
+		Name:               o.pipelineName(),
+		AppName:            o.appName,
+		Branch:             o.repoBranch,
+		Paths:              o.paths,
+		ManifestPath:       workspace.PipelineManifestPath(o.pipelineName()),
+		BinaryS3BucketPath: binaryS3BucketPath,
+		Version:            version.Version,
+		Stages:             stages,
+	})
+	if err != nil {
+		return fmt.Errorf("parse github actions workflow template: %w", err)
+	}
+	workflowPath, err := o.workspace.WriteGitHubActionsWorkflow(content, o.pipelineName())
+	var workflowExists bool
+	if err != nil {
+		e, ok := err.(*workspace.ErrFileExists)
+		if !ok {
+			return fmt.Errorf("write github actions workflow to workspace: %w", err)
+		}
+		workflowExists = true
+		workflowPath = e.FileName
+	}
+	workflowMsgFmt := "Wrote the GitHub Actions workflow at '%s'\n"
+	if workflowExists {
+		workflowMsgFmt = "GitHub Actions workflow already exists at %s, skipping writing it.\n"
+	}
+	workflowPath, err = relPath(workflowPath)
+	if err != nil {
+		return err
+	}
+	log.Successf(workflowMsgFmt, color.HighlightResource(workflowPath))
+	log.Infof(`The workflow has a job per environment that deploys your services and jobs with the environment's %s role.
+`, color.HighlightResource("GitHubActionsRole"))
+	return nil
+}
+
+func (o *initPipelineOpts) githubActionsRequiredActions() []string {
+	repo := fmt.Sprintf("%s/%s", o.repoOwner, o.repoName)
+	var approvalEnvs []string
+	for _, env := range o.envConfigs {
+		if env.Prod {
+			approvalEnvs = append(approvalEnvs, env.Name)
+		}
+	}
+	actions := []string{
+		fmt.Sprintf("Set %s in the manifest of each environment, then run %s for each of them to create their GitHub Actions role.",
+			color.HighlightCode(fmt.Sprintf("cicd.github_actions.repository: %s", repo)), color.HighlightCode("copilot env deploy --name <env>")),
+	}
+	if len(approvalEnvs) > 0 {
+		actions = append(actions, fmt.Sprintf("Add required reviewers to the %s GitHub %s of your repository to approve deployments manually.",
+			english.WordSeries(approvalEnvs, "and"), english.PluralWord(len(approvalEnvs), "environment", "environments")))
+	}
+	return append(actions, fmt.Sprintf("Commit and push the %s directory of your %s directory and the %s file to your repository to trigger the workflow.",
+		color.HighlightResource(path.Join("pipelines", o.pipelineName())), color.HighlightResource("copilot"),
+		color.HighlightResource(path.Join(".github", "workflows", fmt.Sprintf("copilot-%s.yml", o.pipelineName())))))
+}
+
 func (o *initPipelineOpts) createBuildspec() error {
 	artifactBuckets, err := o.artifactBuckets()
 	if err != nil {
@@ -551,16 +718,18 @@ func (o *initPipelineOpts) createBuildspec() error {
 	content, err := o.parser.Parse(buildspecTemplatePath, struct {
 		BinaryS3BucketPath string
 		Version            string
+		ManifestPath       string
 		ArtifactBuckets    []artifactBucket
 	}{
 		BinaryS3BucketPath: binaryS3BucketPath,
 		Version:            version.Version,
+		ManifestPath:       workspace.PipelineManifestPath(o.pipelineName()),
 		ArtifactBuckets:    artifactBuckets,
 	})
 	if err != nil {
 		return err
 	}
-	buildspecPath, err := o.workspace.WritePipelineBuildspec(content)
+	buildspecPath, err := o.workspace.WritePipelineBuildspec(content, o.pipelineName())
 	var buildspecExists bool
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
@@ -591,6 +760,9 @@ func (o *initPipelineOpts) secretName() string {
 }
 
 func (o *initPipelineOpts) pipelineName() string {
+	if o.name != "" {
+		return o.name
+	}
 	name := fmt.Sprintf(fmtPipelineName, o.appName, o.repoName)
 	if len(name) <= 100 {
 		return name
@@ -667,6 +839,15 @@ func buildPipelineInitCmd() *cobra.Command {
   Create a pipeline for the services in your workspace.
   /code $ copilot pipeline init \
   /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
+  /code  --environments "stage,prod"
+  Create a pipeline named "api" that only deploys the "api" and "worker" workloads when their files change.
+  /code $ copilot pipeline init --name api \
+  /code  --url https://github.com/gitHubUserName/myMonorepo.git \
+  /code  --environments "stage,prod" --workloads "api,worker" \
+  /code  --paths "api/**,worker/**,copilot/api/**,copilot/worker/**"
+  Create a GitHub Actions workflow that deploys to the "stage" and "prod" environments.
+  /code $ copilot pipeline init --provider github-actions \
+  /code  --url https://github.com/gitHubUserName/myFrontendApp.git \
   /code  --environments "stage,prod"`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newInitPipelineOpts(vars)
@@ -691,6 +872,7 @@ func buildPipelineInitCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().StringVar(&vars.repoURL, githubURLFlag, "", githubURLFlagDescription)
 	_ = cmd.Flags().MarkHidden(githubURLFlag)
 	cmd.Flags().StringVarP(&vars.repoURL, repoURLFlag, repoURLFlagShort, "", repoURLFlagDescription)
@@ -698,6 +880,9 @@ func buildPipelineInitCmd() *cobra.Command {
 	_ = cmd.Flags().MarkHidden(githubAccessTokenFlag)
 	cmd.Flags().StringVarP(&vars.repoBranch, gitBranchFlag, gitBranchFlagShort, "", gitBranchFlagDescription)
 	cmd.Flags().StringSliceVarP(&vars.environments, envsFlag, envsFlagShort, []string{}, pipelineEnvsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.workloads, workloadsFlag, nil, pipelineWorkloadsFlagDescription)
+	cmd.Flags().StringSliceVar(&vars.paths, pipelinePathsFlag, nil, pipelinePathsFlagDescription)
+	cmd.Flags().StringVar(&vars.ciProvider, pipelineProviderFlag, pipelineCIProviderCodePipeline, pipelineProviderFlagDescription)
 
 	return cmd
 }
@@ -5,8 +5,10 @@ package cli
 
 import (
 	"bytes"
+	"encoding"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -16,6 +18,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	templatemocks "github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
@@ -27,9 +30,14 @@ import (
 func TestInitPipelineOpts_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName     string
+		inName        string
 		inrepoURL     string
 		inEnvs        []string
+		inWorkloads   []string
+		inCIProvider  string
+		inGitHubToken string
 		setupMocks    func(m *mocks.Mockstore)
+		mockWs        func(m *mocks.MockwsPipelineIniter)
 		expectedError error
 	}{
 		"empty app name": {
@@ -55,6 +63,68 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 
 			expectedError: errors.New("must be a URL to a supported provider (GitHub, CodeCommit, Bitbucket)"),
 		},
+		"invalid pipeline name": {
+			inAppName: "my-app",
+			inName:    "My_Pipeline",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: fmt.Errorf("pipeline name My_Pipeline is invalid: %w", errValueBadFormat),
+		},
+		"workload not in the workspace": {
+			inAppName:   "my-app",
+			inWorkloads: []string{"api", "worker"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			mockWs: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WorkloadNames().Return([]string{"api", "frontend"}, nil)
+			},
+
+			expectedError: errors.New("workload worker is not in the workspace"),
+		},
+		"success with a pipeline name and workloads": {
+			inAppName:   "my-app",
+			inName:      "api",
+			inrepoURL:   "https://github.com/badGoose/chaOS",
+			inWorkloads: []string{"api"},
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+			mockWs: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WorkloadNames().Return([]string{"api", "frontend"}, nil)
+			},
+		},
+		"unknown pipeline provider": {
+			inAppName:    "my-app",
+			inCIProvider: "jenkins",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: errors.New("provider jenkins must be one of: codepipeline, github-actions"),
+		},
+		"github-actions provider with a CodeCommit repository": {
+			inAppName:    "my-app",
+			inCIProvider: "github-actions",
+			inrepoURL:    "https://git-codecommit.us-west-2.amazonaws.com/v1/repos/repo-man",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: errors.New("the github-actions provider requires a GitHub repository"),
+		},
+		"github-actions provider with a GitHub access token": {
+			inAppName:     "my-app",
+			inCIProvider:  "github-actions",
+			inGitHubToken: "hunter2",
+			setupMocks: func(m *mocks.Mockstore) {
+				m.EXPECT().GetApplication("my-app").Return(&config.Application{Name: "my-app"}, nil)
+			},
+
+			expectedError: errors.New("--github-access-token cannot be used with the github-actions provider"),
+		},
 		"invalid environments": {
 			inAppName: "my-app",
 			inrepoURL: "https://github.com/badGoose/chaOS",
@@ -114,16 +184,25 @@ func TestInitPipelineOpts_Validate(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockStore := mocks.NewMockstore(ctrl)
+			mockWs := mocks.NewMockwsPipelineIniter(ctrl)
 
 			tc.setupMocks(mockStore)
+			if tc.mockWs != nil {
+				tc.mockWs(mockWs)
+			}
 
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
-					appName:      tc.inAppName,
-					repoURL:      tc.inrepoURL,
-					environments: tc.inEnvs,
+					appName:           tc.inAppName,
+					name:              tc.inName,
+					repoURL:           tc.inrepoURL,
+					environments:      tc.inEnvs,
+					workloads:         tc.inWorkloads,
+					ciProvider:        tc.inCIProvider,
+					githubAccessToken: tc.inGitHubToken,
 				},
-				store: mockStore,
+				store:     mockStore,
+				workspace: mockWs,
 			}
 
 			// WHEN
@@ -543,15 +622,17 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 	manifestExistsErr := &workspace.ErrFileExists{FileName: "/pipeline.yml"}
 	testCases := map[string]struct {
 		inProvider     string
+		inCIProvider   string
 		inEnvironments []string
 		inEnvConfigs   []*config.Environment
 		inGitHubToken  string
 		inRepoName     string
 		inBranch       string
 		inAppName      string
+		inPaths        []string
 
 		mockSecretsManager          func(m *mocks.MocksecretsManager)
-		mockWsWriter                func(m *mocks.MockwsPipelineWriter)
+		mockWsWriter                func(m *mocks.MockwsPipelineIniter)
 		mockParser                  func(m *templatemocks.MockParser)
 		mockFileSystem              func(mockFS afero.Fs)
 		mockRegionalResourcesGetter func(m *mocks.MockappResourcesGetter)
@@ -575,9 +656,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), "pipeline-badgoose-goose").Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -614,9 +695,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inAppName:  "badgoose",
 
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -640,6 +721,119 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"writes manifest and workflow for the github-actions provider": {
+			inProvider:   "GitHub",
+			inCIProvider: "github-actions",
+			inEnvConfigs: []*config.Environment{
+				{
+					Name:      "test",
+					Region:    "us-west-2",
+					AccountID: "1111",
+				},
+				{
+					Name:      "prod",
+					Region:    "us-east-1",
+					AccountID: "2222",
+					Prod:      true,
+				},
+			},
+			inRepoName: "goose",
+			inBranch:   "main",
+			inAppName:  "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").Return("/copilot/pipelines/pipeline-badgoose-goose/manifest.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Times(0)
+				m.EXPECT().WriteGitHubActionsWorkflow(gomock.Any(), "pipeline-badgoose-goose").Return("/.github/workflows/copilot-pipeline-badgoose-goose.yml", nil)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(githubActionsWorkflowTemplatePath, gomock.Any()).DoAndReturn(func(_ string, data interface{}, _ ...template.ParseOption) (*template.Content, error) {
+					stages := reflect.ValueOf(data).FieldByName("Stages").Interface().([]githubActionsStage)
+					require.Equal(t, []githubActionsStage{
+						{Name: "test", Region: "us-west-2", AccountID: "1111"},
+						{Name: "prod", Region: "us-east-1", AccountID: "2222", RequiresApproval: true, Needs: "test"},
+					}, stages)
+					return &template.Content{Buffer: bytes.NewBufferString("hello")}, nil
+				})
+			},
+			mockStoreSvc:                func(m *mocks.Mockstore) {},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+		},
+		"writes the source paths to the manifest and the workflow for the github-actions provider": {
+			inProvider:   "GitHub",
+			inCIProvider: "github-actions",
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoName: "goose",
+			inBranch:   "main",
+			inAppName:  "badgoose",
+			inPaths:    []string{"api/**", "copilot/api/**"},
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), "pipeline-badgoose-goose").DoAndReturn(func(mft encoding.BinaryMarshaler, _ string) (string, error) {
+					require.Equal(t, []string{"api/**", "copilot/api/**"}, mft.(*manifest.PipelineManifest).Source.Paths)
+					return "/copilot/pipelines/pipeline-badgoose-goose/manifest.yml", nil
+				})
+				m.EXPECT().WriteGitHubActionsWorkflow(gomock.Any(), "pipeline-badgoose-goose").Return("/.github/workflows/copilot-pipeline-badgoose-goose.yml", nil)
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(githubActionsWorkflowTemplatePath, gomock.Any()).DoAndReturn(func(_ string, data interface{}, _ ...template.ParseOption) (*template.Content, error) {
+					paths := reflect.ValueOf(data).FieldByName("Paths").Interface().([]string)
+					require.Equal(t, []string{"api/**", "copilot/api/**"}, paths)
+					return &template.Content{Buffer: bytes.NewBufferString("hello")}, nil
+				})
+			},
+			mockStoreSvc:                func(m *mocks.Mockstore) {},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+		},
+		"returns an error if source paths are set for a CodeCommit repository": {
+			inProvider: "CodeCommit",
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoName: "goose",
+			inAppName:  "badgoose",
+			inPaths:    []string{"api/**"},
+
+			mockSecretsManager:          func(m *mocks.MocksecretsManager) {},
+			mockWsWriter:                func(m *mocks.MockwsPipelineIniter) {},
+			mockParser:                  func(m *templatemocks.MockParser) {},
+			mockStoreSvc:                func(m *mocks.Mockstore) {},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			expectedError:               errors.New("--paths is only supported for GitHub and Bitbucket repositories"),
+		},
+		"returns an error if can't write the github actions workflow": {
+			inProvider:   "GitHub",
+			inCIProvider: "github-actions",
+			inEnvConfigs: []*config.Environment{
+				{
+					Name: "test",
+				},
+			},
+			inRepoName: "goose",
+			inAppName:  "badgoose",
+
+			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/copilot/pipelines/pipeline-badgoose-goose/manifest.yml", nil)
+				m.EXPECT().WriteGitHubActionsWorkflow(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			mockParser: func(m *templatemocks.MockParser) {
+				m.EXPECT().Parse(githubActionsWorkflowTemplatePath, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("hello"),
+				}, nil)
+			},
+			mockStoreSvc:                func(m *mocks.Mockstore) {},
+			mockRegionalResourcesGetter: func(m *mocks.MockappResourcesGetter) {},
+			expectedError:               errors.New("write github actions workflow to workspace: some error"),
+		},
 		"writes manifest and buildspec for CC provider": {
 			inProvider: "CodeCommit",
 			inEnvConfigs: []*config.Environment{
@@ -653,9 +847,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inAppName:  "badgoose",
 
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -692,9 +886,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			inAppName:  "badgoose",
 
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -735,9 +929,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				existsErr := &secretsmanager.ErrSecretAlreadyExists{}
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("", existsErr)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("/buildspec.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Return("/buildspec.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -778,8 +972,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("", errors.New("some error"))
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 			},
 			mockParser:                  func(m *templatemocks.MockParser) {},
 			mockStoreSvc:                func(m *mocks.Mockstore) {},
@@ -802,8 +996,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {},
 			mockStoreSvc: func(m *mocks.Mockstore) {
@@ -828,8 +1022,8 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
 			},
 			mockParser: func(m *templatemocks.MockParser) {},
 			mockStoreSvc: func(m *mocks.Mockstore) {
@@ -860,9 +1054,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Times(0)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Times(0)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(nil, errors.New("some error"))
@@ -900,9 +1094,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("", manifestExistsErr)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", buildspecExistsErr)
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("", manifestExistsErr)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Return("", buildspecExistsErr)
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -942,9 +1136,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			mockSecretsManager: func(m *mocks.MocksecretsManager) {
 				m.EXPECT().CreateSecret("github-token-badgoose-goose", "hunter2").Return("some-arn", nil)
 			},
-			mockWsWriter: func(m *mocks.MockwsPipelineWriter) {
-				m.EXPECT().WritePipelineManifest(gomock.Any()).Return("/pipeline.yml", nil)
-				m.EXPECT().WritePipelineBuildspec(gomock.Any()).Return("", errors.New("some error"))
+			mockWsWriter: func(m *mocks.MockwsPipelineIniter) {
+				m.EXPECT().WritePipelineManifest(gomock.Any(), gomock.Any()).Return("/pipeline.yml", nil)
+				m.EXPECT().WritePipelineBuildspec(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
 			},
 			mockParser: func(m *templatemocks.MockParser) {
 				m.EXPECT().Parse(buildspecTemplatePath, gomock.Any()).Return(&template.Content{
@@ -977,7 +1171,7 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockSecretsManager := mocks.NewMocksecretsManager(ctrl)
-			mockWriter := mocks.NewMockwsPipelineWriter(ctrl)
+			mockWriter := mocks.NewMockwsPipelineIniter(ctrl)
 			mockParser := templatemocks.NewMockParser(ctrl)
 			mockRegionalResourcesGetter := mocks.NewMockappResourcesGetter(ctrl)
 			mockstore := mocks.NewMockstore(ctrl)
@@ -993,6 +1187,9 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 				initPipelineVars: initPipelineVars{
 					githubAccessToken: tc.inGitHubToken,
 					appName:           tc.inAppName,
+					repoBranch:        tc.inBranch,
+					ciProvider:        tc.inCIProvider,
+					paths:             tc.inPaths,
 				},
 
 				secretsmanager: mockSecretsManager,
@@ -1019,10 +1216,54 @@ func TestInitPipelineOpts_Execute(t *testing.T) {
 	}
 }
 
+func TestInitPipelineOpts_RequiredActions(t *testing.T) {
+	testCases := map[string]struct {
+		inCIProvider string
+		inEnvConfigs []*config.Environment
+
+		wantedActions []string
+	}{
+		"codepipeline provider": {
+			wantedActions: []string{
+				"Commit and push the pipelines/pipeline-badgoose-goose directory and the .workspace file of your copilot directory to your repository.",
+				"Run `copilot pipeline update --name pipeline-badgoose-goose` to create your pipeline.",
+			},
+		},
+		"github-actions provider": {
+			inCIProvider: pipelineCIProviderGitHubActions,
+			inEnvConfigs: []*config.Environment{
+				{Name: "test"},
+				{Name: "prod", Prod: true},
+			},
+			wantedActions: []string{
+				"Set `cicd.github_actions.repository: badGoose/goose` in the manifest of each environment, then run `copilot env deploy --name <env>` for each of them to create their GitHub Actions role.",
+				"Add required reviewers to the prod GitHub environment of your repository to approve deployments manually.",
+				"Commit and push the pipelines/pipeline-badgoose-goose directory of your copilot directory and the .github/workflows/copilot-pipeline-badgoose-goose.yml file to your repository to trigger the workflow.",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &initPipelineOpts{
+				initPipelineVars: initPipelineVars{
+					appName:    "badgoose",
+					ciProvider: tc.inCIProvider,
+				},
+				repoOwner:  "badGoose",
+				repoName:   "goose",
+				envConfigs: tc.inEnvConfigs,
+			}
+
+			require.Equal(t, tc.wantedActions, opts.RequiredActions())
+		})
+	}
+}
+
 func TestInitPipelineOpts_pipelineName(t *testing.T) {
 	testCases := map[string]struct {
 		inRepoName string
 		inAppName  string
+		inName     string
 
 		expected    string
 		expectedErr error
@@ -1039,6 +1280,13 @@ func TestInitPipelineOpts_pipelineName(t *testing.T) {
 
 			expected: "pipeline-goodmoose01234567820123456783012345678401234567850-repo-man10123456782012345678301234567840",
 		},
+		"uses the pipeline name from the flag": {
+			inAppName:  "goodmoose",
+			inRepoName: "repo-man",
+			inName:     "api",
+
+			expected: "api",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -1047,6 +1295,7 @@ func TestInitPipelineOpts_pipelineName(t *testing.T) {
 			opts := &initPipelineOpts{
 				initPipelineVars: initPipelineVars{
 					appName: tc.inAppName,
+					name:    tc.inName,
 				},
 				repoName: tc.inRepoName,
 			}
@@ -13,7 +13,6 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/describe"
-	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
@@ -96,6 +95,14 @@ func (o *showPipelineOpts) Validate() error {
 			return err
 		}
 	}
+	if o.pipelineName != "" && o.appName != "" {
+		// Accept the name of the pipeline in the manifest as well as the name of the deployed pipeline.
+		name, _, err := deployedPipelineName(o.pipelineSvc, o.appName, o.pipelineName)
+		if err != nil {
+			return err
+		}
+		o.pipelineName = name
+	}
 	if o.pipelineName != "" {
 		if _, err := o.pipelineSvc.GetPipeline(o.pipelineName); err != nil {
 			return err
@@ -187,17 +194,15 @@ func (o *showPipelineOpts) retrieveAllPipelines() ([]string, error) {
 }
 
 func (o *showPipelineOpts) getPipelineNameFromManifest() (string, error) {
-	data, err := o.ws.ReadPipelineManifest()
+	pipeline, err := selectWorkspacePipeline(o.ws, o.prompt, "")
 	if err != nil {
 		return "", err
 	}
-
-	pipeline, err := manifest.UnmarshalPipeline(data)
+	name, _, err := deployedPipelineName(o.pipelineSvc, o.appName, pipeline.Name)
 	if err != nil {
-		return "", fmt.Errorf("unmarshal pipeline manifest: %w", err)
+		return "", err
 	}
-
-	return pipeline.Name, nil
+	return name, nil
 }
 
 // Execute shows details about the pipeline.
@@ -37,7 +37,8 @@ func TestPipelineShow_Validate(t *testing.T) {
 		inPipelineName string
 		setupMocks     func(mocks showPipelineMocks)
 
-		expectedErr error
+		wantedPipelineName string
+		expectedErr        error
 	}{
 		"with valid application name and pipeline name": {
 			inAppName:      mockAppName,
@@ -47,11 +48,26 @@ func TestPipelineShow_Validate(t *testing.T) {
 					mocks.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{
 						Name: "dinder",
 					}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{mockPipelineName}, nil),
 					mocks.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, nil),
 				)
 			},
 			expectedErr: nil,
 		},
+		"with the name of the pipeline in the manifest": {
+			inAppName:      mockAppName,
+			inPipelineName: "badgoose-repo",
+			setupMocks: func(mocks showPipelineMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{
+						Name: "dinder",
+					}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{mockPipelineName}, nil),
+					mocks.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, nil),
+				)
+			},
+			wantedPipelineName: mockPipelineName,
+		},
 		"with invalid app name": {
 			inAppName:      mockAppName,
 			inPipelineName: "",
@@ -70,6 +86,7 @@ func TestPipelineShow_Validate(t *testing.T) {
 					mocks.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{
 						Name: "dinder",
 					}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{"bad-pipeline"}, nil),
 					mocks.pipelineSvc.EXPECT().GetPipeline("bad-pipeline").Return(nil, mockError),
 				)
 			},
@@ -110,6 +127,9 @@ func TestPipelineShow_Validate(t *testing.T) {
 				require.EqualError(t, err, tc.expectedErr.Error())
 			} else {
 				require.NoError(t, err)
+				if tc.wantedPipelineName != "" {
+					require.Equal(t, tc.wantedPipelineName, opts.pipelineName)
+				}
 			}
 		})
 	}
@@ -122,23 +142,6 @@ func TestPipelineShow_Ask(t *testing.T) {
 	)
 	mockError := errors.New("mock error")
 	mockPipelines := []string{mockPipelineName, "pipeline-the-other-one"}
-	pipelineData := `
-name: pipeline-dinder-badgoose-repo
-version: 1
-
-source:
-  provider: GitHub
-  properties:
-    repository: badgoose/repo
-    access_token_secret: "github-token-badgoose-repo"
-    branch: main
-
-stages:
-    -
-      name: test
-    -
-      name: prod
-`
 	testTags := map[string]string{
 		"copilot-application": mockAppName,
 	}
@@ -168,18 +171,31 @@ stages:
 			inAppName: mockAppName,
 			setupMocks: func(mocks showPipelineMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return([]byte(pipelineData), nil),
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: mockPipelineName, Path: "copilot/pipeline.yml"}}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 				)
 			},
 			expectedApp:      mockAppName,
 			expectedPipeline: mockPipelineName,
 			expectedErr:      nil,
 		},
+		"namespaces the name of the pipeline in the manifest with the application": {
+			inAppName: mockAppName,
+			setupMocks: func(mocks showPipelineMocks) {
+				gomock.InOrder(
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "api", Path: "copilot/pipelines/api/manifest.yml"}}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{"pipeline-dinder-api"}, nil),
+				)
+			},
+			expectedApp:      mockAppName,
+			expectedPipeline: "pipeline-dinder-api",
+			expectedErr:      nil,
+		},
 		"retrieves pipeline name from remote if no manifest found": {
 			inAppName: mockAppName,
 			setupMocks: func(mocks showPipelineMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineShowPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineShowPipelineNameHelpPrompt, mockPipelines, gomock.Any()).Return(mockPipelineName, nil),
 				)
@@ -193,7 +209,7 @@ stages:
 			inPipelineName: "",
 			setupMocks: func(mocks showPipelineMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{mockPipelineName}, nil),
 				)
 			},
@@ -206,7 +222,7 @@ stages:
 			inPipelineName: "",
 			setupMocks: func(mocks showPipelineMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{}, nil),
 				)
 			},
@@ -231,7 +247,7 @@ stages:
 			inPipelineName: "",
 			setupMocks: func(mocks showPipelineMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(nil, mockError),
 				)
 			},
@@ -241,7 +257,7 @@ stages:
 			inAppName: mockAppName,
 			setupMocks: func(mocks showPipelineMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineShowPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineShowPipelineNameHelpPrompt, mockPipelines, gomock.Any()).Return("", mockError),
 				)
@@ -56,6 +56,7 @@ func TestPipelineStatus_Validate(t *testing.T) {
 					mocks.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{
 						Name: "my-app",
 					}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{"no-good-pipeline"}, nil),
 					mocks.pipelineSvc.EXPECT().GetPipeline("no-good-pipeline").Return(nil, mockError),
 				)
 			},
@@ -69,6 +70,21 @@ func TestPipelineStatus_Validate(t *testing.T) {
 					mocks.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{
 						Name: "my-app",
 					}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{mockPipelineName}, nil),
+					mocks.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, nil),
+				)
+			},
+			expectedErr: nil,
+		},
+		"success with the name of the pipeline in the manifest": {
+			testAppName:      mockAppName,
+			testPipelineName: "badgoose-repo",
+			setupMocks: func(mocks pipelineStatusMocks) {
+				gomock.InOrder(
+					mocks.store.EXPECT().GetApplication(mockAppName).Return(&config.Application{
+						Name: "my-app",
+					}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": mockAppName}).Return([]string{mockPipelineName}, nil),
 					mocks.pipelineSvc.EXPECT().GetPipeline(mockPipelineName).Return(nil, nil),
 				)
 			},
@@ -125,24 +141,6 @@ func TestPipelineStatus_Ask(t *testing.T) {
 	}
 	mockPipelines := []string{mockPipelineName, "pipeline-the-other-one"}
 	mockTestCommands := []string{"make test", "echo 'honk'"}
-	pipelineData := `
-name: pipeline-dinder-badgoose-repo
-version: 1
-
-source:
-  provider: GitHub
-  properties:
-    repository: badgoose/repo
-    access_token_secret: "github-token-badgoose-repo"
-    branch: main
-
-stages:
-    -
-      name: test
-	  test_commands: [make test, echo 'honk']
-    -
-      name: prod
-`
 
 	testCases := map[string]struct {
 		testAppName      string
@@ -159,7 +157,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{mockPipelineName}, nil),
 				)
 			},
@@ -171,8 +169,8 @@ stages:
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return([]byte(pipelineData), nil),
-					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{mockPipelineName}, nil),
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: mockPipelineName, Path: "copilot/pipeline.yml"}}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 				)
 			},
 			expectedApp:          mockAppName,
@@ -180,11 +178,23 @@ stages:
 			expectedTestCommands: mockTestCommands,
 			expectedErr:          nil,
 		},
+		"namespaces the name of the pipeline in the manifest with the application": {
+			testAppName: mockAppName,
+			setupMocks: func(mocks pipelineStatusMocks) {
+				gomock.InOrder(
+					mocks.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "api", Path: "copilot/pipelines/api/manifest.yml"}}, nil),
+					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{"pipeline-dinder-api"}, nil),
+				)
+			},
+			expectedApp:      mockAppName,
+			expectedPipeline: "pipeline-dinder-api",
+			expectedErr:      nil,
+		},
 		"retrieves pipeline name from remote if no manifest found": {
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineStatusPipelineNameHelpPrompt, mockPipelines, gomock.Any()).Return(mockPipelineName, nil),
 				)
@@ -198,7 +208,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return([]string{}, nil),
 				)
 			},
@@ -220,7 +230,7 @@ stages:
 			testPipelineName: "",
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(nil, mockError),
 				)
 			},
@@ -230,7 +240,7 @@ stages:
 			testAppName: mockAppName,
 			setupMocks: func(mocks pipelineStatusMocks) {
 				gomock.InOrder(
-					mocks.ws.EXPECT().ReadPipelineManifest().Return(nil, workspace.ErrNoPipelineInWorkspace),
+					mocks.ws.EXPECT().ListPipelines().Return(nil, workspace.ErrNoPipelineInWorkspace),
 					mocks.pipelineSvc.EXPECT().ListPipelineNamesByTags(testTags).Return(mockPipelines, nil),
 					mocks.prompt.EXPECT().SelectOne(fmt.Sprintf(fmtPipelineStatusPipelineNamePrompt, color.HighlightUserInput(mockAppName)), pipelineStatusPipelineNameHelpPrompt, mockPipelines, gomock.Any()).Return("", mockError),
 				)
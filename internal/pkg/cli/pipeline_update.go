@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codepipeline"
 	cs "github.com/aws/copilot-cli/internal/pkg/aws/codestar"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -45,6 +46,7 @@ const connectionsURL = "https://console.aws.amazon.com/codesuite/settings/connec
 
 type updatePipelineVars struct {
 	appName          string
+	name             string
 	skipConfirmation bool
 }
 
@@ -52,6 +54,7 @@ type updatePipelineOpts struct {
 	updatePipelineVars
 
 	pipelineDeployer pipelineDeployer
+	pipelineSvc      pipelineGetter
 	app              *config.Application
 	prog             progress
 	prompt           prompter
@@ -88,6 +91,7 @@ func newUpdatePipelineOpts(vars updatePipelineVars) (*updatePipelineOpts, error)
 	return &updatePipelineOpts{
 		app:                app,
 		pipelineDeployer:   deploycfn.New(defaultSession),
+		pipelineSvc:        codepipeline.New(defaultSession),
 		region:             aws.StringValue(defaultSession.Config.Region),
 		updatePipelineVars: vars,
 		envStore:           store,
@@ -115,18 +119,22 @@ func (o *updatePipelineOpts) Execute() error {
 	o.prog.Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, color.HighlightUserInput(o.appName)))
 
 	// read pipeline manifest
-	data, err := o.ws.ReadPipelineManifest()
+	wsPipeline, err := selectWorkspacePipeline(o.ws, o.prompt, o.name)
 	if err != nil {
-		return fmt.Errorf("read pipeline manifest: %w", err)
+		return err
 	}
-	pipeline, err := manifest.UnmarshalPipeline(data)
+	pipeline, err := readPipelineManifest(o.ws, wsPipeline)
 	if err != nil {
-		return fmt.Errorf("unmarshal pipeline manifest: %w", err)
+		return err
 	}
 	if len(pipeline.Name) > 100 {
 		return fmt.Errorf(`pipeline name '%s' must be shorter than 100 characters`, pipeline.Name)
 	}
 	o.pipelineName = pipeline.Name
+	_, isLegacy, err := deployedPipelineName(o.pipelineSvc, o.appName, pipeline.Name)
+	if err != nil {
+		return err
+	}
 
 	// If the source has an existing connection, get the correlating ConnectionARN .
 	connection, ok := pipeline.Source.Properties["connection_name"]
@@ -145,7 +153,7 @@ func (o *updatePipelineOpts) Execute() error {
 	o.shouldPromptUpdateConnection = bool
 
 	// convert environments to deployment stages
-	stages, err := o.convertStages(pipeline.Stages)
+	stages, err := o.convertStages(pipeline.Stages, pipeline.Workloads)
 	if err != nil {
 		return fmt.Errorf("convert environments to deployment stage: %w", err)
 	}
@@ -156,14 +164,19 @@ func (o *updatePipelineOpts) Execute() error {
 		return fmt.Errorf("get cross-regional resources: %w", err)
 	}
 
+	build := deploy.PipelineBuildFromManifest(pipeline.Build)
+	build.BuildspecPath = wsPipeline.BuildspecPath()
+
 	deployPipelineInput := &deploy.CreatePipelineInput{
 		AppName:         o.appName,
 		Name:            pipeline.Name,
+		IsLegacy:        isLegacy,
 		Source:          source,
-		Build:           deploy.PipelineBuildFromManifest(pipeline.Build),
+		Build:           build,
 		Stages:          stages,
 		ArtifactBuckets: artifactBuckets,
 		AdditionalTags:  o.app.Tags,
+		Notifications:   deploy.PipelineNotificationsFromManifest(pipeline.Notifications),
 	}
 
 	if err := o.deployPipeline(deployPipelineInput); err != nil {
@@ -173,18 +186,34 @@ func (o *updatePipelineOpts) Execute() error {
 	return nil
 }
 
-func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineStage) ([]deploy.PipelineStage, error) {
+func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineStage, pipelineWorkloads []string) ([]deploy.PipelineStage, error) {
 	var stages []deploy.PipelineStage
 	workloads, err := o.ws.WorkloadNames()
 	if err != nil {
 		return nil, fmt.Errorf("get workload names from workspace: %w", err)
 	}
+	if len(pipelineWorkloads) != 0 {
+		for _, wl := range pipelineWorkloads {
+			if !contains(wl, workloads) {
+				return nil, fmt.Errorf("workload %s in the pipeline manifest is not in the workspace", wl)
+			}
+		}
+		workloads = pipelineWorkloads
+	}
 
 	for _, stage := range manifestStages {
 		env, err := o.envStore.GetEnvironment(o.appName, stage.Name)
 		if err != nil {
 			return nil, fmt.Errorf("get environment %s in application %s: %w", stage.Name, o.appName, err)
 		}
+		preDeployments, err := deploy.PrePostDeployActionsFromManifest(stage.PreDeployments)
+		if err != nil {
+			return nil, fmt.Errorf("convert pre-deployments of stage %s: %w", stage.Name, err)
+		}
+		postDeployments, err := deploy.PrePostDeployActionsFromManifest(stage.PostDeployments)
+		if err != nil {
+			return nil, fmt.Errorf("convert post-deployments of stage %s: %w", stage.Name, err)
+		}
 
 		pipelineStage := deploy.PipelineStage{
 			LocalWorkloads: workloads,
@@ -195,6 +224,8 @@ func (o *updatePipelineOpts) convertStages(manifestStages []manifest.PipelineSta
 			},
 			RequiresApproval: stage.RequiresApproval,
 			TestCommands:     stage.TestCommands,
+			PreDeployments:   preDeployments,
+			PostDeployments:  postDeployments,
 		}
 		stages = append(stages, pipelineStage)
 	}
@@ -315,7 +346,9 @@ func buildPipelineUpdateCmd() *cobra.Command {
 		Long:  `Deploys a pipeline for the services in your workspace, using the environments associated with the application.`,
 		Example: `
   Deploys an updated pipeline for the services in your workspace.
-  /code $ copilot pipeline update`,
+  /code $ copilot pipeline update
+  Deploys the pipeline named "api" in a workspace with several pipelines.
+  /code $ copilot pipeline update --name api`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newUpdatePipelineOpts(vars)
 			if err != nil {
@@ -336,6 +369,7 @@ func buildPipelineUpdateCmd() *cobra.Command {
 		}),
 	}
 	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", pipelineFlagDescription)
 	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
 	return cmd
 }
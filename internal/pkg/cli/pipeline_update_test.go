@@ -14,23 +14,26 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 type updatePipelineMocks struct {
-	envStore *mocks.MockenvironmentStore
-	prompt   *mocks.Mockprompter
-	prog     *mocks.Mockprogress
-	deployer *mocks.MockpipelineDeployer
-	ws       *mocks.MockwsPipelineReader
+	envStore    *mocks.MockenvironmentStore
+	prompt      *mocks.Mockprompter
+	prog        *mocks.Mockprogress
+	deployer    *mocks.MockpipelineDeployer
+	pipelineSvc *mocks.MockpipelineGetter
+	ws          *mocks.MockwsPipelineReader
 }
 
 func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 	testCases := map[string]struct {
-		stages    []manifest.PipelineStage
-		inAppName string
-		callMocks func(m updatePipelineMocks)
+		stages      []manifest.PipelineStage
+		inWorkloads []string
+		inAppName   string
+		callMocks   func(m updatePipelineMocks)
 
 		expectedStages []deploy.PipelineStage
 		expectedError  error
@@ -142,6 +145,126 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		"deploys only the workloads of the pipeline": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+				},
+			},
+			inWorkloads: []string{"backend"},
+			inAppName:   "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalWorkloads: []string{"backend"},
+				},
+			},
+		},
+		"converts stages with pre and post deployments": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					PreDeployments: map[string]*manifest.PrePostDeployment{
+						"migrate": {
+							BuildspecPath: "copilot/pipelines/migrate/buildspec.yml",
+						},
+					},
+					PostDeployments: map[string]*manifest.PrePostDeployment{
+						"smoke": {
+							BuildspecPath: "copilot/pipelines/smoke/buildspec.yml",
+						},
+						"load": {
+							BuildspecPath: "copilot/pipelines/load/buildspec.yml",
+							DependsOn:     []string{"smoke"},
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				mockEnv := &config.Environment{
+					Name:      "test",
+					App:       "badgoose",
+					Region:    "us-west-2",
+					AccountID: "123456789012",
+				}
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(mockEnv, nil).Times(1),
+				)
+			},
+
+			expectedStages: []deploy.PipelineStage{
+				{
+					AssociatedEnvironment: &deploy.AssociatedEnvironment{
+						Name:      "test",
+						Region:    "us-west-2",
+						AccountID: "123456789012",
+					},
+					LocalWorkloads: []string{"frontend"},
+					PreDeployments: []deploy.PrePostDeployAction{
+						{Name: "migrate", BuildspecPath: "copilot/pipelines/migrate/buildspec.yml", Order: 1},
+					},
+					PostDeployments: []deploy.PrePostDeployAction{
+						{Name: "smoke", BuildspecPath: "copilot/pipelines/smoke/buildspec.yml", Order: 1},
+						{Name: "load", BuildspecPath: "copilot/pipelines/load/buildspec.yml", Order: 2},
+					},
+				},
+			},
+		},
+		"errors if deployments have circular dependencies": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+					PostDeployments: map[string]*manifest.PrePostDeployment{
+						"smoke": {
+							BuildspecPath: "copilot/pipelines/smoke/buildspec.yml",
+							DependsOn:     []string{"smoke"},
+						},
+					},
+				},
+			},
+			inAppName: "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend"}, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment("badgoose", "test").Return(&config.Environment{}, nil).Times(1),
+				)
+			},
+
+			expectedError: errors.New("convert post-deployments of stage test: circular dependency on action smoke"),
+		},
+		"errors if a workload of the pipeline is not in the workspace": {
+			stages: []manifest.PipelineStage{
+				{
+					Name: "test",
+				},
+			},
+			inWorkloads: []string{"worker"},
+			inAppName:   "badgoose",
+			callMocks: func(m updatePipelineMocks) {
+				m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1)
+			},
+
+			expectedError: errors.New("workload worker in the pipeline manifest is not in the workspace"),
+		},
 	}
 
 	for name, tc := range testCases {
@@ -168,11 +291,11 @@ func TestUpdatePipelineOpts_convertStages(t *testing.T) {
 			}
 
 			// WHEN
-			actualStages, err := opts.convertStages(tc.stages)
+			actualStages, err := opts.convertStages(tc.stages, tc.inWorkloads)
 
 			// THEN
 			if tc.expectedError != nil {
-				require.Equal(t, tc.expectedError, err)
+				require.EqualError(t, err, tc.expectedError.Error())
 			} else {
 				require.NoError(t, err)
 				require.ElementsMatch(t, tc.expectedStages, actualStages)
@@ -308,7 +431,8 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -338,7 +462,8 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -359,6 +484,44 @@ stages:
 			},
 			expectedError: nil,
 		},
+		"update a pipeline deployed before pipeline names were namespaced": {
+			inApp:     &app,
+			inAppName: appName,
+			inRegion:  region,
+			callMocks: func(m updatePipelineMocks) {
+				gomock.InOrder(
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
+					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
+
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
+					m.pipelineSvc.EXPECT().ListPipelineNamesByTags(map[string]string{"copilot-application": appName}).Return([]string{"pipepiper"}, nil),
+					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
+
+					// convertStages
+					m.envStore.EXPECT().GetEnvironment(appName, "chicken").Return(mockEnv, nil).Times(1),
+					m.envStore.EXPECT().GetEnvironment(appName, "wings").Return(mockEnv, nil).Times(1),
+
+					// getArtifactBuckets
+					m.deployer.EXPECT().GetRegionalAppResources(gomock.Any()).Return(mockResources, nil),
+
+					// deployPipeline
+					m.deployer.EXPECT().PipelineExists(gomock.Any()).DoAndReturn(func(in *deploy.CreatePipelineInput) (bool, error) {
+						if !in.IsLegacy {
+							return false, errors.New("the pipeline should keep its legacy stack name")
+						}
+						return true, nil
+					}),
+					m.deployer.EXPECT().GetAppResourcesByRegion(&app, region).Return(mockResource, nil),
+					m.prompt.EXPECT().Confirm(fmt.Sprintf(fmtPipelineUpdateExistPrompt, pipelineName), "").Return(true, nil),
+					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateProposalStart, pipelineName)).Times(1),
+					m.deployer.EXPECT().UpdatePipeline(gomock.Any(), gomock.Any()).Return(nil),
+					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateProposalComplete, pipelineName)).Times(1),
+				)
+			},
+			expectedError: nil,
+		},
 		"do not deploy pipeline if decline to update an existing pipeline": {
 			inApp:     &app,
 			inAppName: appName,
@@ -369,7 +532,8 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -397,7 +561,8 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -438,7 +603,8 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), errors.New("some error")),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), errors.New("some error")),
 				)
 			},
 			expectedError: fmt.Errorf("read pipeline manifest: some error"),
@@ -454,7 +620,8 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 				)
 			},
 			expectedError: fmt.Errorf("unmarshal pipeline manifest: pipeline.yml contains invalid schema version: 0"),
@@ -472,7 +639,8 @@ version: 1
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 				)
 			},
 			expectedError: fmt.Errorf("pipeline name '12345678101234567820123456783012345678401234567850123456786012345678701234567880123456789012345671001' must be shorter than 100 characters"),
@@ -497,7 +665,8 @@ source:
 					m.prog.EXPECT().Start(fmt.Sprintf(fmtPipelineUpdateResourcesStart, appName)).Times(1),
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 				)
 			},
 			expectedError: fmt.Errorf("read source from manifest: invalid repo source provider: NotGitHub"),
@@ -512,7 +681,8 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return(nil, errors.New("some error")).Times(1),
 				)
 			},
@@ -528,7 +698,8 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -551,7 +722,8 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -577,7 +749,8 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -607,7 +780,8 @@ source:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -664,7 +838,8 @@ stages:
 					m.deployer.EXPECT().AddPipelineResourcesToApp(&app, region).Return(nil),
 					m.prog.EXPECT().Stop(log.Ssuccessf(fmtPipelineUpdateResourcesComplete, appName)).Times(1),
 
-					m.ws.EXPECT().ReadPipelineManifest().Return([]byte(content), nil),
+					m.ws.EXPECT().ListPipelines().Return([]workspace.PipelineManifest{{Name: "pipepiper", Path: "copilot/pipeline.yml"}}, nil),
+					m.ws.EXPECT().ReadPipelineManifest("copilot/pipeline.yml").Return([]byte(content), nil),
 					m.ws.EXPECT().WorkloadNames().Return([]string{"frontend", "backend"}, nil).Times(1),
 
 					// convertStages
@@ -697,22 +872,26 @@ stages:
 			mockWorkspace := mocks.NewMockwsPipelineReader(ctrl)
 			mockProgress := mocks.NewMockprogress(ctrl)
 			mockPrompt := mocks.NewMockprompter(ctrl)
+			mockPipelineSvc := mocks.NewMockpipelineGetter(ctrl)
 
 			mocks := updatePipelineMocks{
-				envStore: mockEnvStore,
-				prompt:   mockPrompt,
-				prog:     mockProgress,
-				deployer: mockPipelineDeployer,
-				ws:       mockWorkspace,
+				envStore:    mockEnvStore,
+				prompt:      mockPrompt,
+				prog:        mockProgress,
+				deployer:    mockPipelineDeployer,
+				pipelineSvc: mockPipelineSvc,
+				ws:          mockWorkspace,
 			}
 
 			tc.callMocks(mocks)
+			mockPipelineSvc.EXPECT().ListPipelineNamesByTags(gomock.Any()).Return(nil, nil).AnyTimes()
 
 			opts := &updatePipelineOpts{
 				updatePipelineVars: updatePipelineVars{
 					appName: tc.inAppName,
 				},
 				pipelineDeployer: mockPipelineDeployer,
+				pipelineSvc:      mockPipelineSvc,
 				ws:               mockWorkspace,
 				app:              tc.inApp,
 				region:           tc.inRegion,
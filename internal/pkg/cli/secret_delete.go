@@ -0,0 +1,181 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretDeleteAppNamePrompt     = "Which application is the secret in?"
+	secretDeleteAppNameHelpPrompt = "An application groups all of your environments and their secrets."
+	secretDeleteNamePrompt        = "What is the name of the secret you want to delete?"
+	secretDeleteNameHelpPrompt    = `The name of the secret given to "copilot secret init", such as 'db_password'.`
+
+	fmtSecretDeleteConfirmPrompt        = "Are you sure you want to delete secret %s from application %s?"
+	fmtSecretDeleteFromEnvConfirmPrompt = "Are you sure you want to delete secret %s from environment %s?"
+	secretDeleteConfirmHelp             = "This will delete the secret from all environments. Workloads that reference it will fail to start new tasks."
+	fmtSecretDeleteFromEnvConfirmHelp   = "This will delete the secret from just the %s environment. Workloads that reference it will fail to start new tasks."
+)
+
+var (
+	errSecretDeleteCancelled = errors.New("secret delete cancelled - no changes made")
+)
+
+type secretDeleteVars struct {
+	appName          string
+	envName          string
+	name             string
+	skipConfirmation bool
+}
+
+type secretDeleteOpts struct {
+	secretDeleteVars
+
+	store           store
+	prompt          prompter
+	sel             appSelector
+	newSecretStores func(env *config.Environment) (*secretStores, error)
+}
+
+func newSecretDeleteOpts(vars secretDeleteVars) (*secretDeleteOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	prompter := prompt.New()
+	return &secretDeleteOpts{
+		secretDeleteVars: vars,
+		store:            store,
+		prompt:           prompter,
+		sel:              selector.NewSelect(prompter, store),
+		newSecretStores:  newSecretStores,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretDeleteOpts) Validate() error {
+	if o.name != "" {
+		if err := validateSecretName(o.name); err != nil {
+			return err
+		}
+	}
+	return validateSecretAppEnv(o.store, o.appName, o.envName)
+}
+
+// Ask asks for fields that are required but not passed in, and confirms the deletion.
+func (o *secretDeleteOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(secretDeleteAppNamePrompt, secretDeleteAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		name, err := o.prompt.Get(secretDeleteNamePrompt, secretDeleteNameHelpPrompt, validateSecretName, prompt.WithFinalMessage("Secret name:"))
+		if err != nil {
+			return fmt.Errorf("ask for the secret name: %w", err)
+		}
+		o.name = name
+	}
+
+	if o.skipConfirmation {
+		return nil
+	}
+	deletePrompt := fmt.Sprintf(fmtSecretDeleteConfirmPrompt, o.name, o.appName)
+	deleteConfirmHelp := secretDeleteConfirmHelp
+	if o.envName != "" {
+		deletePrompt = fmt.Sprintf(fmtSecretDeleteFromEnvConfirmPrompt, o.name, o.envName)
+		deleteConfirmHelp = fmt.Sprintf(fmtSecretDeleteFromEnvConfirmHelp, o.envName)
+	}
+	deleteConfirmed, err := o.prompt.Confirm(deletePrompt, deleteConfirmHelp, prompt.WithConfirmFinalMessage())
+	if err != nil {
+		return fmt.Errorf("secret delete confirmation prompt: %w", err)
+	}
+	if !deleteConfirmed {
+		return errSecretDeleteCancelled
+	}
+	return nil
+}
+
+// Execute deletes the secret from the SSM Parameter Store or AWS Secrets Manager of each environment.
+func (o *secretDeleteOpts) Execute() error {
+	envs, err := secretEnvs(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	var deleted bool
+	for _, env := range envs {
+		stores, err := o.newSecretStores(env)
+		if err != nil {
+			return err
+		}
+		secret, err := describeSecretInEnv(stores, o.appName, env.Name, o.name)
+		if err != nil {
+			return err
+		}
+		if secret == nil {
+			continue
+		}
+		if secret.Backend == secretBackendSecretsManager {
+			err = stores.secretsManager.DeleteSecret(secret.ValueFrom)
+		} else {
+			err = stores.ssm.DeleteSecret(secret.ValueFrom)
+		}
+		if err != nil {
+			return fmt.Errorf("delete secret %s from environment %s: %w", o.name, env.Name, err)
+		}
+		log.Successf("Deleted secret %s from environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(env.Name))
+		deleted = true
+	}
+	if !deleted {
+		log.Infof("Secret %s doesn't exist in application %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.appName))
+	}
+	return nil
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *secretDeleteOpts) RecommendActions() error {
+	logRecommendedActions([]string{
+		fmt.Sprintf("Remove %s from the %s section of your manifests and redeploy your workloads.", color.HighlightUserInput(o.name), color.HighlightCode("secrets")),
+	})
+	return nil
+}
+
+// buildSecretDeleteCmd builds the command for deleting a secret.
+func buildSecretDeleteCmd() *cobra.Command {
+	vars := secretDeleteVars{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Deletes a secret from an application.",
+		Long: `Deletes a secret from SSM Parameter Store or AWS Secrets Manager in each environment of an application.
+Secrets deleted from AWS Secrets Manager can't be recovered.`,
+		Example: `
+  Delete the secret "db_password" from all the environments.
+  /code $ copilot secret delete -n db_password
+  Delete the secret "db_password" from the "test" environment without confirmation.
+  /code $ copilot secret delete -n db_password --env test --yes`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretDeleteOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretDeleteEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", existingSecretNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.skipConfirmation, yesFlag, false, yesFlagDescription)
+	return cmd
+}
@@ -0,0 +1,185 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretDeleteMocks struct {
+	store          *mocks.Mockstore
+	prompt         *mocks.Mockprompter
+	ssm            *mocks.MockssmSecretStore
+	secretsManager *mocks.MocksecretsManagerSecretStore
+}
+
+func TestSecretDeleteOpts_Ask(t *testing.T) {
+	mockError := errors.New("some error")
+	testCases := map[string]struct {
+		inEnv            string
+		skipConfirmation bool
+		setupMocks       func(m secretDeleteMocks)
+
+		wantedError error
+	}{
+		"skips the confirmation with the yes flag": {
+			skipConfirmation: true,
+			setupMocks: func(m secretDeleteMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"confirms the deletion from all environments": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.prompt.EXPECT().Confirm(
+					fmt.Sprintf(fmtSecretDeleteConfirmPrompt, "db_password", "phonetool"),
+					secretDeleteConfirmHelp,
+					gomock.Any(),
+				).Return(true, nil)
+			},
+		},
+		"confirms the deletion from a single environment": {
+			inEnv: "test",
+			setupMocks: func(m secretDeleteMocks) {
+				m.prompt.EXPECT().Confirm(
+					fmt.Sprintf(fmtSecretDeleteFromEnvConfirmPrompt, "db_password", "test"),
+					fmt.Sprintf(fmtSecretDeleteFromEnvConfirmHelp, "test"),
+					gomock.Any(),
+				).Return(true, nil)
+			},
+		},
+		"returns an error if the user does not confirm": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantedError: errSecretDeleteCancelled,
+		},
+		"wraps the error from the confirmation prompt": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.prompt.EXPECT().Confirm(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, mockError)
+			},
+			wantedError: fmt.Errorf("secret delete confirmation prompt: %w", mockError),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretDeleteMocks{
+				prompt: mocks.NewMockprompter(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &secretDeleteOpts{
+				secretDeleteVars: secretDeleteVars{
+					appName:          "phonetool",
+					envName:          tc.inEnv,
+					name:             "db_password",
+					skipConfirmation: tc.skipConfirmation,
+				},
+				prompt: m.prompt,
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecretDeleteOpts_Execute(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(m secretDeleteMocks)
+
+		wantedError error
+	}{
+		"deletes the secret from the backend of each environment": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+					{App: "phonetool", Name: "prod"},
+					{App: "phonetool", Name: "staging"},
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret("/copilot/phonetool/test/secrets/db_password").Return(&ssm.Secret{
+					Name: "/copilot/phonetool/test/secrets/db_password",
+				}, nil)
+				m.ssm.EXPECT().DeleteSecret("/copilot/phonetool/test/secrets/db_password").Return(nil)
+				m.ssm.EXPECT().DescribeSecret("/copilot/phonetool/prod/secrets/db_password").Return(nil, &ssm.ErrParameterNotFound{})
+				m.secretsManager.EXPECT().DescribeSecret("copilot/phonetool/prod/secrets/db_password").Return(&secretsmanager.Secret{
+					Name: "copilot/phonetool/prod/secrets/db_password",
+				}, nil)
+				m.secretsManager.EXPECT().DeleteSecret("copilot/phonetool/prod/secrets/db_password").Return(nil)
+				m.ssm.EXPECT().DescribeSecret("/copilot/phonetool/staging/secrets/db_password").Return(nil, &ssm.ErrParameterNotFound{})
+				m.secretsManager.EXPECT().DescribeSecret("copilot/phonetool/staging/secrets/db_password").Return(nil, &secretsmanager.ErrSecretNotFound{})
+			},
+		},
+		"does nothing if the secret doesn't exist": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret(gomock.Any()).Return(nil, &ssm.ErrParameterNotFound{})
+				m.secretsManager.EXPECT().DescribeSecret(gomock.Any()).Return(nil, &secretsmanager.ErrSecretNotFound{})
+				m.ssm.EXPECT().DeleteSecret(gomock.Any()).Times(0)
+				m.secretsManager.EXPECT().DeleteSecret(gomock.Any()).Times(0)
+			},
+		},
+		"wraps the error if the secret can't be deleted": {
+			setupMocks: func(m secretDeleteMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret(gomock.Any()).Return(&ssm.Secret{
+					Name: "/copilot/phonetool/test/secrets/db_password",
+				}, nil)
+				m.ssm.EXPECT().DeleteSecret(gomock.Any()).Return(errors.New("some error"))
+			},
+			wantedError: errors.New("delete secret db_password from environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretDeleteMocks{
+				store:          mocks.NewMockstore(ctrl),
+				ssm:            mocks.NewMockssmSecretStore(ctrl),
+				secretsManager: mocks.NewMocksecretsManagerSecretStore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &secretDeleteOpts{
+				secretDeleteVars: secretDeleteVars{
+					appName: "phonetool",
+					name:    "db_password",
+				},
+				store: m.store,
+				newSecretStores: func(env *config.Environment) (*secretStores, error) {
+					return &secretStores{
+						ssm:            m.ssm,
+						secretsManager: m.secretsManager,
+					}, nil
+				},
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
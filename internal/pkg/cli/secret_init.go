@@ -20,6 +20,7 @@ import (
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/config"
@@ -32,9 +33,23 @@ import (
 )
 
 const (
-	fmtSecretParameterName = "/copilot/%s/%s/secrets/%s"
+	fmtSecretParameterName      = "/copilot/%s/%s/secrets/%s"
+	fmtSecretsManagerSecretName = "copilot/%s/%s/secrets/%s"
 )
 
+const (
+	secretBackendSSM            = "ssm"
+	secretBackendSecretsManager = "secretsmanager"
+
+	defaultSecretRotationDays = 30
+	maxSecretRotationDays     = 1000
+)
+
+var secretBackends = []string{
+	secretBackendSSM,
+	secretBackendSecretsManager,
+}
+
 const (
 	secretInitAppPrompt     = "Which application do you want to add the secret to?"
 	secretInitAppPromptHelp = "The secret can then be versioned by your existing environments inside the application."
@@ -53,6 +68,10 @@ type secretInitVars struct {
 	values        map[string]string
 	inputFilePath string
 	overwrite     bool
+
+	backend         string
+	rotationLambdas map[string]string
+	rotationDays    int
 }
 
 type secretInitOpts struct {
@@ -68,8 +87,9 @@ type secretInitOpts struct {
 
 	shouldShowOverwriteHint bool
 
-	envUpgradeCMDs map[string]actionCommand
-	secretPutters  map[string]secretPutter
+	envUpgradeCMDs        map[string]actionCommand
+	secretPutters         map[string]secretPutter
+	secretsManagerPutters map[string]secretsManagerPutter
 
 	configureClientsForEnv func(envName string) error
 	readFile               func() ([]byte, error)
@@ -87,8 +107,9 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 		store:          store,
 		fs:             &afero.Afero{Fs: afero.NewOsFs()},
 
-		envUpgradeCMDs: make(map[string]actionCommand),
-		secretPutters:  make(map[string]secretPutter),
+		envUpgradeCMDs:        make(map[string]actionCommand),
+		secretPutters:         make(map[string]secretPutter),
+		secretsManagerPutters: make(map[string]secretsManagerPutter),
 
 		prompter: prompter,
 		selector: selector.NewSelect(prompter, store),
@@ -113,6 +134,7 @@ func newSecretInitOpts(vars secretInitVars) (*secretInitOpts, error) {
 			return fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
 		}
 		opts.secretPutters[envName] = ssm.New(sess)
+		opts.secretsManagerPutters[envName] = secretsmanager.NewWithSession(sess)
 
 		return nil
 	}
@@ -143,6 +165,10 @@ func (o *secretInitOpts) Validate() error {
 		return errors.New("cannot specify `--cli-input-yaml` with `--values`")
 	}
 
+	if err := o.validateBackend(); err != nil {
+		return err
+	}
+
 	if o.appName != "" {
 		_, err := o.store.GetApplication(o.appName)
 		if err != nil {
@@ -155,6 +181,11 @@ func (o *secretInitOpts) Validate() error {
 				}
 			}
 		}
+		for env := range o.rotationLambdas {
+			if _, err := o.targetEnv(env); err != nil {
+				return err
+			}
+		}
 	}
 
 	if o.name != "" {
@@ -171,6 +202,22 @@ func (o *secretInitOpts) Validate() error {
 	return nil
 }
 
+func (o *secretInitOpts) validateBackend() error {
+	if o.backend != "" && !contains(o.backend, secretBackends) {
+		return fmt.Errorf("invalid backend %s: must be one of %s", o.backend, english.WordSeries(template.QuoteSliceFunc(secretBackends), "or"))
+	}
+	if len(o.rotationLambdas) == 0 {
+		return nil
+	}
+	if o.backend != secretBackendSecretsManager {
+		return fmt.Errorf("--%s requires --%s %s", rotationLambdasFlag, secretBackendFlag, secretBackendSecretsManager)
+	}
+	if o.rotationDays < 1 || o.rotationDays > maxSecretRotationDays {
+		return fmt.Errorf("--%s must be between 1 and %d", rotationDaysFlag, maxSecretRotationDays)
+	}
+	return nil
+}
+
 // Ask prompts the user for any required or important fields that are not provided.
 func (o *secretInitOpts) Ask() error {
 	if o.overwrite {
@@ -287,6 +334,9 @@ func (o *secretInitOpts) putSecret(secretName string, values map[string]string)
 }
 
 func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error {
+	if o.backend == secretBackendSecretsManager {
+		return o.putSecretsManagerSecretInEnv(secretName, envName, value)
+	}
 	name := fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
 	in := ssm.PutSecretInput{
 		Name:      name,
@@ -319,6 +369,42 @@ func (o *secretInitOpts) putSecretInEnv(secretName, envName, value string) error
 	return nil
 }
 
+func (o *secretInitOpts) putSecretsManagerSecretInEnv(secretName, envName, value string) error {
+	name := fmt.Sprintf(fmtSecretsManagerSecretName, o.appName, envName, secretName)
+	in := secretsmanager.PutSecretInput{
+		Name:      name,
+		Value:     value,
+		Overwrite: o.overwrite,
+		Tags: map[string]string{
+			deploy.AppTagKey: o.appName,
+			deploy.EnvTagKey: envName,
+		},
+	}
+	if lambdaARN, ok := o.rotationLambdas[envName]; ok {
+		in.RotationLambdaARN = lambdaARN
+		in.RotationDays = int64(o.rotationDays)
+	}
+
+	out, err := o.secretsManagerPutters[envName].PutSecret(in)
+	if err != nil {
+		var targetErr *secretsmanager.ErrSecretAlreadyExists
+		if errors.As(err, &targetErr) {
+			o.shouldShowOverwriteHint = true
+			log.Successf("Secret %s already exists in environment %s as %s. Did not overwrite. \n", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name))
+			return nil
+		}
+		return err
+	}
+
+	if !out.Created {
+		log.Successln(fmt.Sprintf("Secret %s already exists in environment %s. Overwritten.", name, color.HighlightUserInput(envName)))
+		return nil
+	}
+
+	log.Successln(fmt.Sprintf("Successfully put secret %s in environment %s as %s.", color.HighlightUserInput(secretName), color.HighlightUserInput(envName), color.HighlightResource(name)))
+	return nil
+}
+
 func (o *secretInitOpts) parseSecretsInputFile() (map[string]map[string]string, error) {
 	raw, err := o.readFile()
 	if err != nil {
@@ -414,7 +500,7 @@ func (o *secretInitOpts) RecommendActions() error {
 			if _, ok := secretsPerEnv[envName]; !ok {
 				secretsPerEnv[envName] = make(map[string]string)
 			}
-			secretsPerEnv[envName][template.ToSnakeCaseFunc(secretName)] = fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
+			secretsPerEnv[envName][template.ToSnakeCaseFunc(secretName)] = o.secretName(envName, secretName)
 		}
 	}
 
@@ -424,6 +510,15 @@ func (o *secretInitOpts) RecommendActions() error {
     {{$secretName}}: {{$secretValueFrom}}
   {{- end}}
 {{end}}`
+	if o.backend == secretBackendSecretsManager {
+		templateRaw = `{{range $env, $secrets := .SecretsPerEnv -}}
+{{$env}}
+  secrets: {{range $secretName, $secretsManagerName := $secrets}}
+    {{$secretName}}:
+      secretsmanager: {{$secretsManagerName}}
+  {{- end}}
+{{end}}`
+	}
 	tmpl, _ := txttemplate.New("secretInitOutput").Parse(templateRaw)
 
 	log.Infoln("You can refer to these secrets from your manifest file by editing the `secrets` section.")
@@ -473,6 +568,14 @@ func (e *errBatchPutSecretsFailed) Error() string {
 	return strings.Join(out, "\n")
 }
 
+// secretName returns the name of the secret in the backend.
+func (o *secretInitOpts) secretName(envName, secretName string) string {
+	if o.backend == secretBackendSecretsManager {
+		return fmt.Sprintf(fmtSecretsManagerSecretName, o.appName, envName, secretName)
+	}
+	return fmt.Sprintf(fmtSecretParameterName, o.appName, envName, secretName)
+}
+
 func (o *secretInitOpts) targetEnv(envName string) (*config.Environment, error) {
 	env, err := o.store.GetEnvironment(o.appName, envName)
 	if err != nil {
@@ -486,14 +589,17 @@ func buildSecretInitCmd() *cobra.Command {
 	vars := secretInitVars{}
 	cmd := &cobra.Command{
 		Use:   "init",
-		Short: "Create or update secrets in SSM Parameter Store.",
+		Short: "Create or update secrets in SSM Parameter Store or AWS Secrets Manager.",
 		Example: `
 Create a secret with prompts. 
 /code $ copilot secret init
 Create a secret named db-password in multiple environments.
 /code $ copilot secret init --name db-password
 Create secrets from input.yml. For the format of the YAML file, please see https://aws.github.io/copilot-cli/docs/commands/secret-init/.
-/code $ copilot secret init --cli-input-yaml input.yml`,
+/code $ copilot secret init --cli-input-yaml input.yml
+Create a secret in AWS Secrets Manager that is rotated every 30 days in the prod environment.
+/code $ copilot secret init --name db-password --backend secretsmanager \
+  --rotation-lambdas prod=arn:aws:lambda:us-west-2:123456789012:function:rotate-db-password`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
 			opts, err := newSecretInitOpts(vars)
 			if err != nil {
@@ -526,5 +632,8 @@ Create secrets from input.yml. For the format of the YAML file, please see https
 	cmd.Flags().StringToStringVar(&vars.values, valuesFlag, nil, secretValuesFlagDescription)
 	cmd.Flags().BoolVar(&vars.overwrite, overwriteFlag, false, secretOverwriteFlagDescription)
 	cmd.Flags().StringVar(&vars.inputFilePath, inputFilePathFlag, "", secretInputFilePathFlagDescription)
+	cmd.Flags().StringVar(&vars.backend, secretBackendFlag, secretBackendSSM, secretBackendFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.rotationLambdas, rotationLambdasFlag, nil, secretRotationLambdasFlagDescription)
+	cmd.Flags().IntVar(&vars.rotationDays, rotationDaysFlag, defaultSecretRotationDays, secretRotationDaysFlagDescription)
 	return cmd
 }
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/copilot-cli/internal/pkg/config"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
 	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
 	"github.com/golang/mock/gomock"
@@ -35,6 +36,10 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 		inOverwrite     bool
 		inInputFilePath string
 
+		inBackend         string
+		inRotationLambdas map[string]string
+		inRotationDays    int
+
 		setupMocks func(m secretInitMocks)
 
 		wantedError error
@@ -100,6 +105,42 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 			setupMocks:      func(m secretInitMocks) {},
 			wantedError:     errors.New("cannot specify `--cli-input-yaml` with `--name`"),
 		},
+		"valid with rotation in secrets manager": {
+			inName:    "db-password",
+			inApp:     "dragon_slaying",
+			inBackend: "secretsmanager",
+			inRotationLambdas: map[string]string{
+				"good_village": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+			inRotationDays: 30,
+			setupMocks: func(m secretInitMocks) {
+				m.mockStore.EXPECT().GetApplication("dragon_slaying").Return(&config.Application{}, nil)
+				m.mockStore.EXPECT().GetEnvironment("dragon_slaying", "good_village").Return(&config.Environment{}, nil)
+			},
+		},
+		"error if the backend is invalid": {
+			inBackend:   "vault",
+			setupMocks:  func(m secretInitMocks) {},
+			wantedError: errors.New(`invalid backend vault: must be one of "ssm" or "secretsmanager"`),
+		},
+		"error if rotation is specified with ssm": {
+			inBackend: "ssm",
+			inRotationLambdas: map[string]string{
+				"good_village": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+			inRotationDays: 30,
+			setupMocks:     func(m secretInitMocks) {},
+			wantedError:    errors.New("--rotation-lambdas requires --backend secretsmanager"),
+		},
+		"error if the number of days between rotations is out of range": {
+			inBackend: "secretsmanager",
+			inRotationLambdas: map[string]string{
+				"good_village": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+			inRotationDays: 0,
+			setupMocks:     func(m secretInitMocks) {},
+			wantedError:    errors.New("--rotation-days must be between 1 and 1000"),
+		},
 		"error if input file name is specified with values": {
 			inValues: map[string]string{
 				"test": "test-db",
@@ -125,6 +166,10 @@ func TestSecretInitOpts_Validate(t *testing.T) {
 					values:        tc.inValues,
 					inputFilePath: tc.inInputFilePath,
 					overwrite:     tc.inOverwrite,
+
+					backend:         tc.inBackend,
+					rotationLambdas: tc.inRotationLambdas,
+					rotationDays:    tc.inRotationDays,
 				},
 				fs:    &afero.Afero{Fs: afero.NewMemMapFs()},
 				store: mockStore,
@@ -341,9 +386,10 @@ func TestSecretInitOpts_Ask(t *testing.T) {
 }
 
 type secretInitExecuteMocks struct {
-	mockStore        *mocks.Mockstore
-	mockSecretPutter *mocks.MocksecretPutter
-	mockEnvUpgrader  *mocks.MockactionCommand
+	mockStore                *mocks.Mockstore
+	mockSecretPutter         *mocks.MocksecretPutter
+	mockSecretsManagerPutter *mocks.MocksecretsManagerPutter
+	mockEnvUpgrader          *mocks.MockactionCommand
 }
 
 func TestSecretInitOpts_Execute(t *testing.T) {
@@ -365,6 +411,9 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 
 		inOverwrite bool
 
+		inBackend         string
+		inRotationLambdas map[string]string
+
 		mockInputFileContent []byte
 		setupMocks           func(m secretInitExecuteMocks)
 
@@ -401,6 +450,55 @@ func TestSecretInitOpts_Execute(t *testing.T) {
 				m.mockEnvUpgrader.EXPECT().Execute().Return(nil).Times(2)
 			},
 		},
+		"successfully create secrets in secrets manager with rotation in one environment": {
+			inAppName: testApp,
+			inName:    testName,
+			inValues:  testValues,
+			inBackend: secretBackendSecretsManager,
+			inRotationLambdas: map[string]string{
+				"prod": "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+			},
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretsManagerPutter.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:  "copilot/test-app/test/secrets/db-password",
+					Value: "test-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "test",
+					},
+				}).Return(&secretsmanager.PutSecretOutput{
+					Created: true,
+				}, nil)
+				m.mockSecretsManagerPutter.EXPECT().PutSecret(secretsmanager.PutSecretInput{
+					Name:  "copilot/test-app/prod/secrets/db-password",
+					Value: "prod-password",
+					Tags: map[string]string{
+						deploy.AppTagKey: "test-app",
+						deploy.EnvTagKey: "prod",
+					},
+					RotationLambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+					RotationDays:      30,
+				}).Return(&secretsmanager.PutSecretOutput{
+					Created: true,
+				}, nil)
+				m.mockSecretPutter.EXPECT().PutSecret(gomock.Any()).Times(0)
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil).Times(2)
+			},
+		},
+		"should not overwrite an existing secret in secrets manager": {
+			inAppName: testApp,
+			inName:    testName,
+			inValues: map[string]string{
+				"test": "test-password",
+			},
+			inBackend: secretBackendSecretsManager,
+
+			setupMocks: func(m secretInitExecuteMocks) {
+				m.mockSecretsManagerPutter.EXPECT().PutSecret(gomock.Any()).Return(nil, &secretsmanager.ErrSecretAlreadyExists{})
+				m.mockEnvUpgrader.EXPECT().Execute().Return(nil)
+			},
+		},
 		"should make calls to overwrite if overwrite is specified": {
 			inAppName:   testApp,
 			inName:      testName,
@@ -565,9 +663,10 @@ db-host:
 			defer ctrl.Finish()
 
 			m := secretInitExecuteMocks{
-				mockStore:        mocks.NewMockstore(ctrl),
-				mockSecretPutter: mocks.NewMocksecretPutter(ctrl),
-				mockEnvUpgrader:  mocks.NewMockactionCommand(ctrl),
+				mockStore:                mocks.NewMockstore(ctrl),
+				mockSecretPutter:         mocks.NewMocksecretPutter(ctrl),
+				mockSecretsManagerPutter: mocks.NewMocksecretsManagerPutter(ctrl),
+				mockEnvUpgrader:          mocks.NewMockactionCommand(ctrl),
 			}
 			tc.setupMocks(m)
 
@@ -578,11 +677,16 @@ db-host:
 					values:        tc.inValues,
 					overwrite:     tc.inOverwrite,
 					inputFilePath: tc.inInputFilePath,
+
+					backend:         tc.inBackend,
+					rotationLambdas: tc.inRotationLambdas,
+					rotationDays:    defaultSecretRotationDays,
 				},
 				store: m.mockStore,
 
-				secretPutters:  make(map[string]secretPutter),
-				envUpgradeCMDs: make(map[string]actionCommand),
+				secretPutters:         make(map[string]secretPutter),
+				secretsManagerPutters: make(map[string]secretsManagerPutter),
+				envUpgradeCMDs:        make(map[string]actionCommand),
 				readFile: func() ([]byte, error) {
 					return tc.mockInputFileContent, nil
 				},
@@ -590,6 +694,7 @@ db-host:
 
 			opts.configureClientsForEnv = func(envName string) error {
 				opts.secretPutters[envName] = m.mockSecretPutter
+				opts.secretsManagerPutters[envName] = m.mockSecretsManagerPutter
 				opts.envUpgradeCMDs[envName] = m.mockEnvUpgrader
 				return nil
 			}
@@ -0,0 +1,229 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretLsAppNamePrompt     = "Which application's secrets would you like to list?"
+	secretLsAppNameHelpPrompt = "An application groups all of your environments and their secrets."
+)
+
+// secretStores holds the clients to the secrets of an environment in each backend.
+type secretStores struct {
+	ssm            ssmSecretStore
+	secretsManager secretsManagerSecretStore
+}
+
+// newSecretStores returns the clients to the secrets of an environment, assuming its manager role.
+func newSecretStores(env *config.Environment) (*secretStores, error) {
+	sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+	if err != nil {
+		return nil, fmt.Errorf("create session from environment manager role %s in region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return &secretStores{
+		ssm:            ssm.New(sess),
+		secretsManager: secretsmanager.NewWithSession(sess),
+	}, nil
+}
+
+type secretLsVars struct {
+	appName          string
+	envName          string
+	shouldOutputJSON bool
+}
+
+type secretLsOpts struct {
+	secretLsVars
+
+	store           store
+	sel             appSelector
+	newSecretStores func(env *config.Environment) (*secretStores, error)
+	w               io.Writer
+}
+
+func newSecretLsOpts(vars secretLsVars) (*secretLsOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	return &secretLsOpts{
+		secretLsVars:    vars,
+		store:           store,
+		sel:             selector.NewSelect(prompt.New(), store),
+		newSecretStores: newSecretStores,
+		w:               os.Stdout,
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretLsOpts) Validate() error {
+	return validateSecretAppEnv(o.store, o.appName, o.envName)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *secretLsOpts) Ask() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(secretLsAppNamePrompt, secretLsAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+// Execute lists the secrets tagged with the application in each environment, whether they are stored
+// in SSM Parameter Store or in AWS Secrets Manager.
+func (o *secretLsOpts) Execute() error {
+	envs, err := secretEnvs(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	out := &describe.Secrets{
+		App:     o.appName,
+		Secrets: []*describe.Secret{},
+	}
+	for _, env := range envs {
+		stores, err := o.newSecretStores(env)
+		if err != nil {
+			return err
+		}
+		tags := map[string]string{
+			deploy.AppTagKey: o.appName,
+			deploy.EnvTagKey: env.Name,
+		}
+		params, err := stores.ssm.ListSecrets(tags)
+		if err != nil {
+			return fmt.Errorf("list secrets in SSM Parameter Store for environment %s: %w", env.Name, err)
+		}
+		for _, param := range params {
+			out.Secrets = append(out.Secrets, ssmSecretDescription(o.appName, env.Name, param))
+		}
+		secrets, err := stores.secretsManager.ListSecrets(tags)
+		if err != nil {
+			return fmt.Errorf("list secrets in AWS Secrets Manager for environment %s: %w", env.Name, err)
+		}
+		for _, secret := range secrets {
+			out.Secrets = append(out.Secrets, secretsManagerSecretDescription(o.appName, env.Name, secret))
+		}
+	}
+	// Group the secrets by name while keeping the order of the environments.
+	sort.SliceStable(out.Secrets, func(i, j int) bool {
+		return out.Secrets[i].Name < out.Secrets[j].Name
+	})
+
+	if o.shouldOutputJSON {
+		data, err := out.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, out.HumanString())
+	return nil
+}
+
+// validateSecretAppEnv returns an error if the application, or the environment if specified, doesn't exist.
+func validateSecretAppEnv(store store, appName, envName string) error {
+	if appName == "" {
+		return nil
+	}
+	if _, err := store.GetApplication(appName); err != nil {
+		return fmt.Errorf("get application %s: %w", appName, err)
+	}
+	if envName == "" {
+		return nil
+	}
+	if _, err := store.GetEnvironment(appName, envName); err != nil {
+		return fmt.Errorf("get environment %s in application %s: %w", envName, appName, err)
+	}
+	return nil
+}
+
+// secretEnvs returns the environment if its name is specified, or all the environments of the application.
+func secretEnvs(store store, appName, envName string) ([]*config.Environment, error) {
+	if envName != "" {
+		env, err := store.GetEnvironment(appName, envName)
+		if err != nil {
+			return nil, fmt.Errorf("get environment %s in application %s: %w", envName, appName, err)
+		}
+		return []*config.Environment{env}, nil
+	}
+	envs, err := store.ListEnvironments(appName)
+	if err != nil {
+		return nil, fmt.Errorf("list environments in application %s: %w", appName, err)
+	}
+	return envs, nil
+}
+
+func ssmSecretDescription(app, env string, param ssm.Secret) *describe.Secret {
+	return &describe.Secret{
+		Name:         strings.TrimPrefix(param.Name, fmt.Sprintf(fmtSecretParameterName, app, env, "")),
+		Environment:  env,
+		Backend:      secretBackendSSM,
+		ValueFrom:    param.Name,
+		LastModified: param.LastModified,
+	}
+}
+
+func secretsManagerSecretDescription(app, env string, secret secretsmanager.Secret) *describe.Secret {
+	out := &describe.Secret{
+		Name:         strings.TrimPrefix(secret.Name, fmt.Sprintf(fmtSecretsManagerSecretName, app, env, "")),
+		Environment:  env,
+		Backend:      secretBackendSecretsManager,
+		ValueFrom:    secret.Name,
+		LastModified: secret.LastModified,
+	}
+	if secret.RotationEnabled {
+		out.RotationLambdaARN = secret.RotationLambdaARN
+		out.RotationDays = secret.RotationDays
+	}
+	return out
+}
+
+// buildSecretLsCmd builds the command for listing the secrets of an application.
+func buildSecretLsCmd() *cobra.Command {
+	vars := secretLsVars{}
+	cmd := &cobra.Command{
+		Use:   "ls",
+		Short: "Lists the secrets of an application.",
+		Long: `Lists the secrets of an application in each environment.
+Secrets stored in SSM Parameter Store and AWS Secrets Manager are listed if they are tagged with the application and environment.`,
+		Example: `
+  Lists all the secrets of the "my-app" application.
+  /code $ copilot secret ls -a my-app
+  Lists the secrets of the "test" environment in JSON.
+  /code $ copilot secret ls --env test --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretLsOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretLsEnvFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
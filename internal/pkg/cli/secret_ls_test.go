@@ -0,0 +1,175 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretLsMocks struct {
+	store          *mocks.Mockstore
+	ssm            *mocks.MockssmSecretStore
+	secretsManager *mocks.MocksecretsManagerSecretStore
+}
+
+func TestSecretLsOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inApp      string
+		inEnv      string
+		setupMocks func(m secretLsMocks)
+
+		wantedError error
+	}{
+		"skip validation if app flag is not set": {
+			setupMocks: func(m secretLsMocks) {},
+		},
+		"returns an error if the environment doesn't exist": {
+			inApp: "phonetool",
+			inEnv: "test",
+			setupMocks: func(m secretLsMocks) {
+				m.store.EXPECT().GetApplication("phonetool").Return(&config.Application{}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get environment test in application phonetool: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretLsMocks{
+				store: mocks.NewMockstore(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &secretLsOpts{
+				secretLsVars: secretLsVars{
+					appName: tc.inApp,
+					envName: tc.inEnv,
+				},
+				store: m.store,
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSecretLsOpts_Execute(t *testing.T) {
+	lastModified := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		inEnv      string
+		setupMocks func(m secretLsMocks)
+
+		wantedJSON  string
+		wantedError error
+	}{
+		"lists the secrets of each environment in both backends": {
+			setupMocks: func(m secretLsMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+					{App: "phonetool", Name: "prod"},
+				}, nil)
+				m.ssm.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+				}).Return([]ssm.Secret{
+					{Name: "/copilot/phonetool/test/secrets/db_password", LastModified: lastModified},
+					{Name: "/copilot/phonetool/test/secrets/api_key", LastModified: lastModified},
+				}, nil)
+				m.secretsManager.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "test",
+				}).Return(nil, nil)
+				m.ssm.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "prod",
+				}).Return(nil, nil)
+				m.secretsManager.EXPECT().ListSecrets(map[string]string{
+					"copilot-application": "phonetool",
+					"copilot-environment": "prod",
+				}).Return([]secretsmanager.Secret{
+					{
+						Name:              "copilot/phonetool/prod/secrets/db_password",
+						LastModified:      lastModified,
+						RotationEnabled:   true,
+						RotationLambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+						RotationDays:      30,
+					},
+				}, nil)
+			},
+			wantedJSON: `{"application":"phonetool","secrets":[{"name":"api_key","environment":"test","backend":"ssm","valueFrom":"/copilot/phonetool/test/secrets/api_key","lastModified":"2021-10-01T00:00:00Z"},{"name":"db_password","environment":"test","backend":"ssm","valueFrom":"/copilot/phonetool/test/secrets/db_password","lastModified":"2021-10-01T00:00:00Z"},{"name":"db_password","environment":"prod","backend":"secretsmanager","valueFrom":"copilot/phonetool/prod/secrets/db_password","lastModified":"2021-10-01T00:00:00Z","rotationLambda":"arn:aws:lambda:us-west-2:123456789012:function:rotate","rotationDays":30}]}` + "\n",
+		},
+		"lists the secrets of a single environment": {
+			inEnv: "test",
+			setupMocks: func(m secretLsMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{App: "phonetool", Name: "test"}, nil)
+				m.store.EXPECT().ListEnvironments(gomock.Any()).Times(0)
+				m.ssm.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+				m.secretsManager.EXPECT().ListSecrets(gomock.Any()).Return(nil, nil)
+			},
+			wantedJSON: `{"application":"phonetool","secrets":[]}` + "\n",
+		},
+		"wraps the error if the secrets can't be listed": {
+			inEnv: "test",
+			setupMocks: func(m secretLsMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{App: "phonetool", Name: "test"}, nil)
+				m.ssm.EXPECT().ListSecrets(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list secrets in SSM Parameter Store for environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretLsMocks{
+				store:          mocks.NewMockstore(ctrl),
+				ssm:            mocks.NewMockssmSecretStore(ctrl),
+				secretsManager: mocks.NewMocksecretsManagerSecretStore(ctrl),
+			}
+			tc.setupMocks(m)
+			out := &bytes.Buffer{}
+			opts := &secretLsOpts{
+				secretLsVars: secretLsVars{
+					appName:          "phonetool",
+					envName:          tc.inEnv,
+					shouldOutputJSON: true,
+				},
+				store: m.store,
+				newSecretStores: func(env *config.Environment) (*secretStores, error) {
+					return &secretStores{
+						ssm:            m.ssm,
+						secretsManager: m.secretsManager,
+					}, nil
+				},
+				w: out,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, out.String())
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	secretShowAppNamePrompt     = "Which application is the secret in?"
+	secretShowAppNameHelpPrompt = "An application groups all of your environments and their secrets."
+	secretShowNamePrompt        = "What is the name of the secret?"
+	secretShowNameHelpPrompt    = `The name of the secret given to "copilot secret init", such as 'db_password'.`
+)
+
+type secretShowVars struct {
+	appName          string
+	envName          string
+	name             string
+	shouldOutputJSON bool
+}
+
+type secretShowOpts struct {
+	secretShowVars
+
+	store           store
+	ws              wsWlManifestReader // Nil if the command isn't run from a workspace.
+	unmarshal       func([]byte) (manifest.WorkloadManifest, error)
+	prompt          prompter
+	sel             appSelector
+	newSecretStores func(env *config.Environment) (*secretStores, error)
+	w               io.Writer
+}
+
+func newSecretShowOpts(vars secretShowVars) (*secretShowOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	prompter := prompt.New()
+	opts := &secretShowOpts{
+		secretShowVars:  vars,
+		store:           store,
+		unmarshal:       manifest.UnmarshalWorkload,
+		prompt:          prompter,
+		sel:             selector.NewSelect(prompter, store),
+		newSecretStores: newSecretStores,
+		w:               os.Stdout,
+	}
+	if ws, err := workspace.New(); err == nil {
+		opts.ws = ws
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *secretShowOpts) Validate() error {
+	if o.name != "" {
+		if err := validateSecretName(o.name); err != nil {
+			return err
+		}
+	}
+	return validateSecretAppEnv(o.store, o.appName, o.envName)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *secretShowOpts) Ask() error {
+	if o.appName == "" {
+		app, err := o.sel.Application(secretShowAppNamePrompt, secretShowAppNameHelpPrompt)
+		if err != nil {
+			return fmt.Errorf("select application: %w", err)
+		}
+		o.appName = app
+	}
+	if o.name == "" {
+		name, err := o.prompt.Get(secretShowNamePrompt, secretShowNameHelpPrompt, validateSecretName, prompt.WithFinalMessage("Secret name:"))
+		if err != nil {
+			return fmt.Errorf("ask for the secret name: %w", err)
+		}
+		o.name = name
+	}
+	return nil
+}
+
+// Execute shows where the secret is stored in each environment, and the workloads of the workspace that reference it.
+func (o *secretShowOpts) Execute() error {
+	envs, err := secretEnvs(o.store, o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	out := &describe.SecretDescription{
+		Name: o.name,
+		App:  o.appName,
+	}
+	for _, env := range envs {
+		stores, err := o.newSecretStores(env)
+		if err != nil {
+			return err
+		}
+		secret, err := describeSecretInEnv(stores, o.appName, env.Name, o.name)
+		if err != nil {
+			return err
+		}
+		if secret == nil {
+			continue
+		}
+		out.Environments = append(out.Environments, secret)
+	}
+	if len(out.Environments) == 0 {
+		return fmt.Errorf("secret %s not found in application %s", o.name, o.appName)
+	}
+	refs, err := o.references(out.Environments)
+	if err != nil {
+		return err
+	}
+	out.References = refs
+
+	if o.shouldOutputJSON {
+		data, err := out.JSONString()
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(o.w, data)
+		return nil
+	}
+	fmt.Fprint(o.w, out.HumanString())
+	return nil
+}
+
+// references returns the environment variables of the workloads in the workspace that are set from the secrets.
+func (o *secretShowOpts) references(secrets []*describe.Secret) ([]*describe.SecretReference, error) {
+	if o.ws == nil {
+		return nil, nil
+	}
+	svcs, err := o.ws.ServiceNames()
+	if err != nil {
+		return nil, fmt.Errorf("list services in the workspace: %w", err)
+	}
+	jobs, err := o.ws.JobNames()
+	if err != nil {
+		return nil, fmt.Errorf("list jobs in the workspace: %w", err)
+	}
+	var refs []*describe.SecretReference
+	for _, wl := range append(svcs, jobs...) {
+		var raw []byte
+		if contains(wl, jobs) {
+			raw, err = o.ws.ReadJobManifest(wl)
+		} else {
+			raw, err = o.ws.ReadServiceManifest(wl)
+		}
+		if err != nil {
+			return nil, err
+		}
+		mft, err := o.unmarshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal manifest of %s: %w", wl, err)
+		}
+		for _, secret := range secrets {
+			envMft, err := mft.ApplyEnv(secret.Environment)
+			if err != nil {
+				return nil, fmt.Errorf("apply environment %s override to manifest of %s: %w", secret.Environment, wl, err)
+			}
+			containers := containerSecrets(wl, envMft)
+			containerNames := make([]string, 0, len(containers))
+			for container := range containers {
+				containerNames = append(containerNames, container)
+			}
+			sort.Strings(containerNames)
+			for _, container := range containerNames {
+				vars := containers[container]
+				names := make([]string, 0, len(vars))
+				for name := range vars {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+				for _, name := range names {
+					if !referencesSecret(vars[name], secret) {
+						continue
+					}
+					refs = append(refs, &describe.SecretReference{
+						Workload:    wl,
+						Environment: secret.Environment,
+						Container:   container,
+						Variable:    name,
+					})
+				}
+			}
+		}
+	}
+	return refs, nil
+}
+
+// describeSecretInEnv returns the secret in the SSM Parameter Store or AWS Secrets Manager of the environment,
+// or nil if the secret doesn't exist in the environment.
+func describeSecretInEnv(stores *secretStores, app, env, name string) (*describe.Secret, error) {
+	param, err := stores.ssm.DescribeSecret(fmt.Sprintf(fmtSecretParameterName, app, env, name))
+	if err == nil {
+		return ssmSecretDescription(app, env, *param), nil
+	}
+	var errParamNotFound *ssm.ErrParameterNotFound
+	if !errors.As(err, &errParamNotFound) {
+		return nil, fmt.Errorf("describe secret %s in environment %s: %w", name, env, err)
+	}
+	secret, err := stores.secretsManager.DescribeSecret(fmt.Sprintf(fmtSecretsManagerSecretName, app, env, name))
+	if err == nil {
+		return secretsManagerSecretDescription(app, env, *secret), nil
+	}
+	var errSecretNotFound *secretsmanager.ErrSecretNotFound
+	if !errors.As(err, &errSecretNotFound) {
+		return nil, fmt.Errorf("describe secret %s in environment %s: %w", name, env, err)
+	}
+	return nil, nil
+}
+
+// containerSecrets returns the secrets of each container of a workload keyed by container name.
+func containerSecrets(name string, mft interface{}) map[string]map[string]manifest.Secret {
+	var (
+		task     manifest.TaskConfig
+		sidecars map[string]*manifest.SidecarConfig
+	)
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		task, sidecars = t.TaskConfig, t.Sidecars
+	case *manifest.BackendService:
+		task, sidecars = t.TaskConfig, t.Sidecars
+	case *manifest.WorkerService:
+		task, sidecars = t.TaskConfig, t.Sidecars
+	case *manifest.ScheduledJob:
+		task, sidecars = t.TaskConfig, t.Sidecars
+	default:
+		return nil
+	}
+	out := map[string]map[string]manifest.Secret{
+		name: task.Secrets,
+	}
+	for sidecar, config := range sidecars {
+		if config == nil {
+			continue
+		}
+		out[sidecar] = config.Secrets
+	}
+	return out
+}
+
+// referencesSecret returns true if the manifest secret references the secret by name or by ARN.
+func referencesSecret(from manifest.Secret, secret *describe.Secret) bool {
+	value := from.Value()
+	if value == secret.ValueFrom {
+		return true
+	}
+	if from.IsSecretsManagerName() {
+		return false
+	}
+	switch secret.Backend {
+	case secretBackendSSM:
+		return strings.HasSuffix(value, ":parameter"+secret.ValueFrom)
+	case secretBackendSecretsManager:
+		// The ARN of a secret ends with a random suffix, which is optional when referencing the secret.
+		return strings.HasSuffix(value, ":secret:"+secret.ValueFrom) || strings.Contains(value, ":secret:"+secret.ValueFrom+"-")
+	}
+	return false
+}
+
+// buildSecretShowCmd builds the command for showing a secret.
+func buildSecretShowCmd() *cobra.Command {
+	vars := secretShowVars{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Shows info about a secret.",
+		Long: `Shows where a secret is stored in each environment of an application,
+and the workloads of the workspace that reference it.`,
+		Example: `
+  Shows info about the secret "db_password".
+  /code $ copilot secret show -n db_password
+  Shows info about the secret "db_password" in the "prod" environment in JSON.
+  /code $ copilot secret show -n db_password --env prod --json`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSecretShowOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", secretShowEnvFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", existingSecretNameFlagDescription)
+	cmd.Flags().BoolVar(&vars.shouldOutputJSON, jsonFlag, false, jsonFlagDescription)
+	return cmd
+}
@@ -0,0 +1,210 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/describe"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type secretShowMocks struct {
+	store          *mocks.Mockstore
+	ws             *mocks.MockwsWlManifestReader
+	ssm            *mocks.MockssmSecretStore
+	secretsManager *mocks.MocksecretsManagerSecretStore
+}
+
+func TestSecretShowOpts_Execute(t *testing.T) {
+	lastModified := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	frontendManifest := `name: frontend
+type: Backend Service
+image:
+  location: nginx
+secrets:
+  DB_PASSWORD: /copilot/phonetool/test/secrets/db_password
+sidecars:
+  proxy:
+    image: envoyproxy/envoy
+    secrets:
+      PASSWORD: arn:aws:ssm:us-west-2:123456789012:parameter/copilot/phonetool/test/secrets/db_password
+environments:
+  prod:
+    secrets:
+      DB_PASSWORD:
+        secretsmanager: copilot/phonetool/prod/secrets/db_password
+`
+	reportManifest := `name: report
+type: Scheduled Job
+image:
+  location: busybox
+on:
+  schedule: "@daily"
+secrets:
+  API_KEY: /copilot/phonetool/test/secrets/api_key
+`
+	testCases := map[string]struct {
+		inNoWorkspace bool
+		setupMocks    func(m secretShowMocks)
+
+		wantedJSON  string
+		wantedError error
+	}{
+		"shows the secret in each environment and the workloads that reference it": {
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+					{App: "phonetool", Name: "prod"},
+					{App: "phonetool", Name: "staging"},
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret("/copilot/phonetool/test/secrets/db_password").Return(&ssm.Secret{
+					Name:         "/copilot/phonetool/test/secrets/db_password",
+					LastModified: lastModified,
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret("/copilot/phonetool/prod/secrets/db_password").Return(nil, &ssm.ErrParameterNotFound{})
+				m.secretsManager.EXPECT().DescribeSecret("copilot/phonetool/prod/secrets/db_password").Return(&secretsmanager.Secret{
+					Name:         "copilot/phonetool/prod/secrets/db_password",
+					LastModified: lastModified,
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret("/copilot/phonetool/staging/secrets/db_password").Return(nil, &ssm.ErrParameterNotFound{})
+				m.secretsManager.EXPECT().DescribeSecret("copilot/phonetool/staging/secrets/db_password").Return(nil, &secretsmanager.ErrSecretNotFound{})
+				m.ws.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+				m.ws.EXPECT().JobNames().Return([]string{"report"}, nil)
+				m.ws.EXPECT().ReadServiceManifest("frontend").Return([]byte(frontendManifest), nil)
+				m.ws.EXPECT().ReadJobManifest("report").Return([]byte(reportManifest), nil)
+			},
+			wantedJSON: `{"name":"db_password","application":"phonetool","environments":[{"name":"db_password","environment":"test","backend":"ssm","valueFrom":"/copilot/phonetool/test/secrets/db_password","lastModified":"2021-10-01T00:00:00Z"},{"name":"db_password","environment":"prod","backend":"secretsmanager","valueFrom":"copilot/phonetool/prod/secrets/db_password","lastModified":"2021-10-01T00:00:00Z"}],"references":[{"workload":"frontend","environment":"test","container":"frontend","variable":"DB_PASSWORD"},{"workload":"frontend","environment":"test","container":"proxy","variable":"PASSWORD"},{"workload":"frontend","environment":"prod","container":"frontend","variable":"DB_PASSWORD"}]}` + "\n",
+		},
+		"does not look for references outside of a workspace": {
+			inNoWorkspace: true,
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret("/copilot/phonetool/test/secrets/db_password").Return(&ssm.Secret{
+					Name:         "/copilot/phonetool/test/secrets/db_password",
+					LastModified: lastModified,
+				}, nil)
+			},
+			wantedJSON: `{"name":"db_password","application":"phonetool","environments":[{"name":"db_password","environment":"test","backend":"ssm","valueFrom":"/copilot/phonetool/test/secrets/db_password","lastModified":"2021-10-01T00:00:00Z"}],"references":null}` + "\n",
+		},
+		"returns an error if the secret doesn't exist in any environment": {
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret(gomock.Any()).Return(nil, &ssm.ErrParameterNotFound{})
+				m.secretsManager.EXPECT().DescribeSecret(gomock.Any()).Return(nil, &secretsmanager.ErrSecretNotFound{})
+			},
+			wantedError: errors.New("secret db_password not found in application phonetool"),
+		},
+		"wraps the error if the secret can't be described": {
+			setupMocks: func(m secretShowMocks) {
+				m.store.EXPECT().ListEnvironments("phonetool").Return([]*config.Environment{
+					{App: "phonetool", Name: "test"},
+				}, nil)
+				m.ssm.EXPECT().DescribeSecret(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("describe secret db_password in environment test: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := secretShowMocks{
+				store:          mocks.NewMockstore(ctrl),
+				ws:             mocks.NewMockwsWlManifestReader(ctrl),
+				ssm:            mocks.NewMockssmSecretStore(ctrl),
+				secretsManager: mocks.NewMocksecretsManagerSecretStore(ctrl),
+			}
+			tc.setupMocks(m)
+			out := &bytes.Buffer{}
+			opts := &secretShowOpts{
+				secretShowVars: secretShowVars{
+					appName:          "phonetool",
+					name:             "db_password",
+					shouldOutputJSON: true,
+				},
+				store:     m.store,
+				ws:        m.ws,
+				unmarshal: manifest.UnmarshalWorkload,
+				newSecretStores: func(env *config.Environment) (*secretStores, error) {
+					return &secretStores{
+						ssm:            m.ssm,
+						secretsManager: m.secretsManager,
+					}, nil
+				},
+				w: out,
+			}
+			if tc.inNoWorkspace {
+				opts.ws = nil
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedJSON, out.String())
+		})
+	}
+}
+
+func Test_referencesSecret(t *testing.T) {
+	testCases := map[string]struct {
+		inFrom   manifest.Secret
+		inSecret *describe.Secret
+
+		wanted bool
+	}{
+		"ssm parameter referenced by name": {
+			inFrom:   manifest.SecretFromString("/copilot/phonetool/test/secrets/db_password"),
+			inSecret: &describe.Secret{Backend: "ssm", ValueFrom: "/copilot/phonetool/test/secrets/db_password"},
+			wanted:   true,
+		},
+		"ssm parameter referenced by ARN": {
+			inFrom:   manifest.SecretFromString("arn:aws:ssm:us-west-2:123456789012:parameter/copilot/phonetool/test/secrets/db_password"),
+			inSecret: &describe.Secret{Backend: "ssm", ValueFrom: "/copilot/phonetool/test/secrets/db_password"},
+			wanted:   true,
+		},
+		"secrets manager secret referenced by name": {
+			inFrom:   manifest.SecretFromSecretsManager("copilot/phonetool/test/secrets/db_password"),
+			inSecret: &describe.Secret{Backend: "secretsmanager", ValueFrom: "copilot/phonetool/test/secrets/db_password"},
+			wanted:   true,
+		},
+		"secrets manager secret referenced by full ARN": {
+			inFrom:   manifest.SecretFromString("arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/phonetool/test/secrets/db_password-Ab12Cd"),
+			inSecret: &describe.Secret{Backend: "secretsmanager", ValueFrom: "copilot/phonetool/test/secrets/db_password"},
+			wanted:   true,
+		},
+		"secrets manager secret with a longer name": {
+			inFrom:   manifest.SecretFromString("arn:aws:secretsmanager:us-west-2:123456789012:secret:copilot/phonetool/test/secrets/db_password_v2"),
+			inSecret: &describe.Secret{Backend: "secretsmanager", ValueFrom: "copilot/phonetool/test/secrets/db_password"},
+			wanted:   false,
+		},
+		"ssm parameter of another environment": {
+			inFrom:   manifest.SecretFromString("/copilot/phonetool/prod/secrets/db_password"),
+			inSecret: &describe.Secret{Backend: "ssm", ValueFrom: "/copilot/phonetool/test/secrets/db_password"},
+			wanted:   false,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, referencesSecret(tc.inFrom, tc.inSecret))
+		})
+	}
+}
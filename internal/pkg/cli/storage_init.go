@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -23,28 +24,51 @@ import (
 )
 
 const (
-	dynamoDBStorageType = "DynamoDB"
-	s3StorageType       = "S3"
-	rdsStorageType      = "Aurora"
+	dynamoDBStorageType   = "DynamoDB"
+	s3StorageType         = "S3"
+	rdsStorageType        = "Aurora"
+	redisStorageType      = "Redis"
+	openSearchStorageType = "OpenSearch"
+	sqsStorageType        = "SQS"
 )
 
 var storageTypes = []string{
 	dynamoDBStorageType,
 	s3StorageType,
 	rdsStorageType,
+	redisStorageType,
+	openSearchStorageType,
+	sqsStorageType,
+}
+
+// Lifecycles of the storage resources.
+const (
+	lifecycleWorkloadLevel    = "workload"
+	lifecycleEnvironmentLevel = "environment"
+)
+
+var storageLifecycles = []string{
+	lifecycleWorkloadLevel,
+	lifecycleEnvironmentLevel,
 }
 
 // Displayed options for storage types
 const (
-	dynamoDBStorageTypeOption = "DynamoDB"
-	s3StorageTypeOption       = "S3"
-	rdsStorageTypeOption      = "Aurora Serverless"
+	dynamoDBStorageTypeOption   = "DynamoDB"
+	s3StorageTypeOption         = "S3"
+	rdsStorageTypeOption        = "Aurora Serverless"
+	redisStorageTypeOption      = "ElastiCache Redis"
+	openSearchStorageTypeOption = "OpenSearch"
+	sqsStorageTypeOption        = "SQS Queue"
 )
 
 var optionToStorageType = map[string]string{
-	dynamoDBStorageTypeOption: dynamoDBStorageType,
-	s3StorageTypeOption:       s3StorageType,
-	rdsStorageTypeOption:      rdsStorageType,
+	dynamoDBStorageTypeOption:   dynamoDBStorageType,
+	s3StorageTypeOption:         s3StorageType,
+	rdsStorageTypeOption:        rdsStorageType,
+	redisStorageTypeOption:      redisStorageType,
+	openSearchStorageTypeOption: openSearchStorageType,
+	sqsStorageTypeOption:        sqsStorageType,
 }
 
 var storageTypeOptions = map[string]prompt.Option{
@@ -60,12 +84,27 @@ var storageTypeOptions = map[string]prompt.Option{
 		Value: rdsStorageTypeOption,
 		Hint:  "SQL",
 	},
+	redisStorageType: {
+		Value: redisStorageTypeOption,
+		Hint:  "In-memory",
+	},
+	openSearchStorageType: {
+		Value: openSearchStorageTypeOption,
+		Hint:  "Search",
+	},
+	sqsStorageType: {
+		Value: sqsStorageTypeOption,
+		Hint:  "Queue",
+	},
 }
 
 const (
 	s3BucketFriendlyText      = "S3 Bucket"
 	dynamoDBTableFriendlyText = "DynamoDB Table"
 	rdsFriendlyText           = "Database Cluster"
+	redisFriendlyText         = "Redis Replication Group"
+	openSearchFriendlyText    = "OpenSearch Domain"
+	sqsFriendlyText           = "SQS Queue"
 )
 
 // General-purpose prompts, collected for all storage resources.
@@ -75,12 +114,17 @@ var (
 DynamoDB is a key-value and document database that delivers single-digit millisecond performance at any scale.
 S3 is a web object store built to store and retrieve any amount of data from anywhere on the Internet.
 Aurora Serverless is an on-demand autoscaling configuration for Amazon Aurora, a MySQL and PostgreSQL-compatible relational database.
+ElastiCache Redis is an in-memory data store that can be used as a cache or a message broker.
+OpenSearch is a search and analytics engine for full-text search, log analytics and more.
+SQS is a message queue with a dead-letter queue for the messages that couldn't be processed.
 `
 
 	fmtStorageInitNamePrompt = "What would you like to " + color.Emphasize("name") + " this %s?"
 	storageInitNameHelp      = "The name of this storage resource. You can use the following characters: a-zA-Z0-9-_"
 
 	storageInitSvcPrompt = "Which " + color.Emphasize("workload") + " would you like to associate with this storage resource?"
+
+	storageInitEnvTypePrompt = "What " + color.Emphasize("type") + " of storage would you like to share between the workloads of your environments?"
 )
 
 // DDB-specific questions and help prompts.
@@ -141,10 +185,25 @@ var engineTypes = []string{
 	engineTypePostgreSQL,
 }
 
+// ElastiCache Redis specific questions and help prompts.
+var (
+	storageInitRedisClusterModePrompt = "Would you like to enable " + color.Emphasize("cluster mode") + " for this replication group?"
+	storageInitRedisClusterModeHelp   = `Cluster mode partitions your data across multiple shards, each with its own primary node and replicas.
+Your client must support Redis Cluster to connect to the replication group in cluster mode.`
+)
+
+// SQS specific questions and help prompts.
+var (
+	storageInitSQSFIFOPrompt = "Would you like this queue to be a " + color.Emphasize("FIFO") + " queue?"
+	storageInitSQSFIFOHelp   = `FIFO queues deliver messages exactly once, in the order that they are sent.
+Standard queues have a higher throughput, but may deliver messages more than once and out of order.`
+)
+
 type initStorageVars struct {
 	storageType  string
 	storageName  string
 	workloadName string
+	lifecycle    string
 
 	// Dynamo DB specific values collected via flags or prompts
 	partitionKey string
@@ -157,6 +216,17 @@ type initStorageVars struct {
 	rdsEngine         string
 	rdsParameterGroup string
 	rdsInitialDBName  string
+
+	// ElastiCache Redis specific values collected via flags or prompts
+	redisClusterMode *bool // If nil, we will prompt to enable cluster mode.
+	redisNodeType    string
+
+	// OpenSearch specific values collected via flags
+	openSearchInstanceType string
+
+	// SQS specific values collected via flags or prompts
+	sqsFIFO            *bool // If nil, we will prompt to create a FIFO queue.
+	sqsMaxReceiveCount int
 }
 
 type initStorageOpts struct {
@@ -199,6 +269,14 @@ func (o *initStorageOpts) Validate() error {
 	if o.appName == "" {
 		return errNoAppInWorkspace
 	}
+	if o.lifecycle != "" {
+		if err := validateStorageLifecycle(o.lifecycle); err != nil {
+			return err
+		}
+	}
+	if o.isEnvScoped() && o.workloadName != "" {
+		return fmt.Errorf("cannot specify --%s with --%s %s: the storage is shared by the workloads of the environment", workloadFlag, storageLifecycleFlag, lifecycleEnvironmentLevel)
+	}
 	if o.workloadName != "" {
 		if err := o.validateWorkloadName(); err != nil {
 			return err
@@ -218,6 +296,8 @@ func (o *initStorageOpts) Validate() error {
 			err = s3BucketNameValidation(o.storageName)
 		case rdsStorageType:
 			err = rdsNameValidation(o.storageName)
+		case redisStorageType, openSearchStorageType, sqsStorageType:
+			err = storageLogicalIDNameValidation(o.storageName)
 		default:
 			// use dynamo since it's a superset of s3
 			err = dynamoTableNameValidation(o.storageName)
@@ -235,6 +315,11 @@ func (o *initStorageOpts) Validate() error {
 			return err
 		}
 	}
+	if o.sqsMaxReceiveCount != 0 {
+		if err := validateSQSMaxReceiveCount(o.sqsMaxReceiveCount); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -267,6 +352,12 @@ func (o *initStorageOpts) validateDDB() error {
 }
 
 func (o *initStorageOpts) Ask() error {
+	if o.isEnvScoped() {
+		if err := o.askStorageType(); err != nil {
+			return err
+		}
+		return o.askStorageProps()
+	}
 	if err := o.askStorageWl(); err != nil {
 		return err
 	}
@@ -288,7 +379,16 @@ You can simply create the policy in the Aurora addon template and create an outp
 https://aws.github.io/copilot-cli/docs/developing/additional-aws-resources/#what-does-an-addon-template-look-like
 `, rdsStorageTypeOption, manifest.RequestDrivenWebServiceType, manifest.RequestDrivenWebServiceType, manifest.RequestDrivenWebServiceType)
 	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType && (o.storageType == redisStorageType || o.storageType == openSearchStorageType) {
+		log.Warningf(`%s storage is launched in private subnets of your environment's VPC,
+which can't be reached from your %s.
+`, storageTypeOptions[o.storageType].Value, manifest.RequestDrivenWebServiceType)
+	}
+
+	return o.askStorageProps()
+}
 
+func (o *initStorageOpts) askStorageProps() error {
 	// Storage name needs to be asked after workload because for Aurora the default storage name uses the workload name.
 	if err := o.askStorageName(); err != nil {
 		return err
@@ -312,6 +412,14 @@ https://aws.github.io/copilot-cli/docs/developing/additional-aws-resources/#what
 		if err := o.askAuroraInitialDBName(); err != nil {
 			return err
 		}
+	case redisStorageType:
+		if err := o.askRedisClusterMode(); err != nil {
+			return err
+		}
+	case sqsStorageType:
+		if err := o.askSQSFIFO(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -325,8 +433,11 @@ func (o *initStorageOpts) askStorageType() error {
 	for _, st := range storageTypes {
 		options = append(options, storageTypeOptions[st])
 	}
-	storageTypeOption, err := o.prompt.SelectOption(fmt.Sprintf(
-		fmtStorageInitTypePrompt, color.HighlightUserInput(o.workloadName)),
+	typePrompt := fmt.Sprintf(fmtStorageInitTypePrompt, color.HighlightUserInput(o.workloadName))
+	if o.isEnvScoped() {
+		typePrompt = storageInitEnvTypePrompt
+	}
+	storageTypeOption, err := o.prompt.SelectOption(typePrompt,
 		storageInitTypeHelp,
 		options,
 		prompt.WithFinalMessage("Storage type:"))
@@ -366,7 +477,20 @@ func (o *initStorageOpts) askStorageName() error {
 		validator = dynamoTableNameValidation
 		friendlyText = dynamoDBTableFriendlyText
 	case rdsStorageType:
-		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, o.workloadName), rdsNameValidation)
+		owner := o.workloadName
+		if o.isEnvScoped() {
+			owner = o.appName
+		}
+		return o.askStorageNameWithDefault(rdsFriendlyText, fmt.Sprintf(fmtRDSStorageNameDefault, owner), rdsNameValidation)
+	case redisStorageType:
+		validator = storageLogicalIDNameValidation
+		friendlyText = redisFriendlyText
+	case openSearchStorageType:
+		validator = storageLogicalIDNameValidation
+		friendlyText = openSearchFriendlyText
+	case sqsStorageType:
+		validator = storageLogicalIDNameValidation
+		friendlyText = sqsFriendlyText
 	}
 
 	name, err := o.prompt.Get(fmt.Sprintf(fmtStorageInitNamePrompt,
@@ -575,6 +699,30 @@ func (o *initStorageOpts) askAuroraInitialDBName() error {
 	return nil
 }
 
+func (o *initStorageOpts) askRedisClusterMode() error {
+	if o.redisClusterMode != nil {
+		return nil
+	}
+	clusterMode, err := o.prompt.Confirm(storageInitRedisClusterModePrompt, storageInitRedisClusterModeHelp, prompt.WithFinalMessage("Cluster mode:"))
+	if err != nil {
+		return fmt.Errorf("confirm Redis cluster mode: %w", err)
+	}
+	o.redisClusterMode = &clusterMode
+	return nil
+}
+
+func (o *initStorageOpts) askSQSFIFO() error {
+	if o.sqsFIFO != nil {
+		return nil
+	}
+	fifo, err := o.prompt.Confirm(storageInitSQSFIFOPrompt, storageInitSQSFIFOHelp, prompt.WithFinalMessage("FIFO queue:"))
+	if err != nil {
+		return fmt.Errorf("confirm SQS FIFO queue: %w", err)
+	}
+	o.sqsFIFO = &fifo
+	return nil
+}
+
 func (o *initStorageOpts) validateWorkloadName() error {
 	names, err := o.ws.WorkloadNames()
 	if err != nil {
@@ -594,7 +742,12 @@ func (o *initStorageOpts) Execute() error {
 		return err
 	}
 
-	addonPath, err := o.ws.WriteAddon(addonCf, o.workloadName, o.storageName)
+	var addonPath string
+	if o.isEnvScoped() {
+		addonPath, err = o.ws.WriteEnvAddon(addonCf, o.storageName)
+	} else {
+		addonPath, err = o.ws.WriteAddon(addonCf, o.workloadName, o.storageName)
+	}
 	if err != nil {
 		e, ok := err.(*workspace.ErrFileExists)
 		if !ok {
@@ -616,6 +769,12 @@ func (o *initStorageOpts) Execute() error {
 		addonFriendlyText = s3BucketFriendlyText
 	case rdsStorageType:
 		addonFriendlyText = rdsFriendlyText
+	case redisStorageType:
+		addonFriendlyText = redisFriendlyText
+	case openSearchStorageType:
+		addonFriendlyText = openSearchFriendlyText
+	case sqsStorageType:
+		addonFriendlyText = sqsFriendlyText
 	default:
 		return fmt.Errorf(fmtErrInvalidStorageType, o.storageType, prettify(storageTypes))
 	}
@@ -637,6 +796,12 @@ func (o *initStorageOpts) newAddon() (encoding.BinaryMarshaler, error) {
 		return o.newS3Addon()
 	case rdsStorageType:
 		return o.newRDSAddon()
+	case redisStorageType:
+		return o.newRedisAddon(), nil
+	case openSearchStorageType:
+		return o.newOpenSearchAddon(), nil
+	case sqsStorageType:
+		return o.newSQSAddon(), nil
 	default:
 		return nil, fmt.Errorf("storage type %s doesn't have a CF template", o.storageType)
 	}
@@ -645,7 +810,8 @@ func (o *initStorageOpts) newAddon() (encoding.BinaryMarshaler, error) {
 func (o *initStorageOpts) newDynamoDBAddon() (*addon.DynamoDB, error) {
 	props := addon.DynamoDBProps{
 		StorageProps: &addon.StorageProps{
-			Name: o.storageName,
+			Name:      o.storageName,
+			EnvScoped: o.isEnvScoped(),
 		},
 	}
 
@@ -671,7 +837,8 @@ func (o *initStorageOpts) newDynamoDBAddon() (*addon.DynamoDB, error) {
 func (o *initStorageOpts) newS3Addon() (*addon.S3, error) {
 	props := &addon.S3Props{
 		StorageProps: &addon.StorageProps{
-			Name: o.storageName,
+			Name:      o.storageName,
+			EnvScoped: o.isEnvScoped(),
 		},
 	}
 	return addon.NewS3(props), nil
@@ -699,9 +866,47 @@ func (o *initStorageOpts) newRDSAddon() (*addon.RDS, error) {
 		InitialDBName:  o.rdsInitialDBName,
 		ParameterGroup: o.rdsParameterGroup,
 		Envs:           envs,
+		EnvScoped:      o.isEnvScoped(),
 	}), nil
 }
 
+func (o *initStorageOpts) newRedisAddon() *addon.Redis {
+	return addon.NewRedis(&addon.RedisProps{
+		StorageProps: &addon.StorageProps{
+			Name:      o.storageName,
+			EnvScoped: o.isEnvScoped(),
+		},
+		ClusterMode: aws.BoolValue(o.redisClusterMode),
+		NodeType:    o.redisNodeType,
+	})
+}
+
+func (o *initStorageOpts) newOpenSearchAddon() *addon.OpenSearch {
+	return addon.NewOpenSearch(&addon.OpenSearchProps{
+		StorageProps: &addon.StorageProps{
+			Name:      o.storageName,
+			EnvScoped: o.isEnvScoped(),
+		},
+		InstanceType: o.openSearchInstanceType,
+	})
+}
+
+func (o *initStorageOpts) newSQSAddon() *addon.SQS {
+	return addon.NewSQS(&addon.SQSProps{
+		StorageProps: &addon.StorageProps{
+			Name:      o.storageName,
+			EnvScoped: o.isEnvScoped(),
+		},
+		FIFO:            aws.BoolValue(o.sqsFIFO),
+		MaxReceiveCount: o.sqsMaxReceiveCount,
+	})
+}
+
+// isEnvScoped returns true if the storage is an environment addon shared by the workloads of the environment.
+func (o *initStorageOpts) isEnvScoped() bool {
+	return o.lifecycle == lifecycleEnvironmentLevel
+}
+
 func (o *initStorageOpts) environmentNames() ([]string, error) {
 	var envNames []string
 	envs, err := o.store.ListEnvironments(o.appName)
@@ -726,9 +931,30 @@ func (o *initStorageOpts) RecommendActions() error {
 	case rdsStorageType:
 		newVar = template.ToSnakeCaseFunc(template.EnvVarSecretFunc(o.storageName))
 		retrieveEnvVarCode = fmt.Sprintf("const {username, host, dbname, password, port} = JSON.parse(process.env.%s)", newVar)
-
+	case redisStorageType, openSearchStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "Endpoint")
+		retrieveEnvVarCode = fmt.Sprintf("const endpoint = process.env.%s", newVar)
+	case sqsStorageType:
+		newVar = template.ToSnakeCaseFunc(template.StripNonAlphaNumFunc(o.storageName) + "URL")
+		retrieveEnvVarCode = fmt.Sprintf("const queueURL = process.env.%s", newVar)
 	}
 
+	if o.isEnvScoped() {
+		actionShare := fmt.Sprintf(`Add %s under %s in the manifests of the workloads that use the storage.
+Their code can then leverage the injected environment variable %s.
+For example, in JavaScript you can write %s.`,
+			color.HighlightUserInput(o.storageName),
+			color.HighlightCode("storage.shared"),
+			newVar,
+			color.HighlightCode(retrieveEnvVarCode))
+		actionDeploy := fmt.Sprintf("Run %s to deploy your storage resources to an environment before you deploy the workloads.",
+			color.HighlightCode("copilot env deploy --name <env>"))
+		logRecommendedActions([]string{
+			actionShare,
+			actionDeploy,
+		})
+		return nil
+	}
 	actionRetrieveEnvVar := fmt.Sprintf(
 		`Update %s's code to leverage the injected environment variable %s.
 For example, in JavaScript you can write %s.`,
@@ -748,12 +974,15 @@ For example, in JavaScript you can write %s.`,
 // buildStorageInitCmd builds the command and adds it to the CLI.
 func buildStorageInitCmd() *cobra.Command {
 	vars := initStorageVars{}
+	var redisClusterMode, sqsFIFO bool
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Creates a new AWS CloudFormation template for a storage resource.",
 		Long: `Creates a new AWS CloudFormation template for a storage resource.
 Storage resources are stored in the Copilot addons directory (e.g. ./copilot/frontend/addons) for a given workload and deployed to your environments when you run ` + color.HighlightCode("copilot deploy") + `. 
-Resource names are injected into your containers as environment variables for easy access.`,
+Resource names are injected into your containers as environment variables for easy access.
+With "--lifecycle environment", the storage is stored in ./copilot/environments/addons instead, deployed with ` + color.HighlightCode("copilot env deploy") + `
+and shared by the workloads that list it under "storage.shared" in their manifests.`,
 		Example: `
   Create an S3 bucket named "my-bucket" attached to the "frontend" service.
   /code $ copilot storage init -n my-bucket -t S3 -w frontend
@@ -762,8 +991,20 @@ Resource names are injected into your containers as environment variables for ea
   Create a DynamoDB table with multiple alternate sort keys.
   /code $ copilot storage init -n my-table -t DynamoDB -w frontend --partition-key Email:S --sort-key UserId:N --lsi Points:N --lsi Goodness:N
   Create an RDS Aurora Serverless cluster using PostgreSQL as the database engine.
-  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL`,
+  /code $ copilot storage init -n my-cluster -t Aurora -w frontend --engine PostgreSQL
+  Create an ElastiCache Redis replication group in cluster mode.
+  /code $ copilot storage init -n my-cache -t Redis -w frontend --cluster-mode
+  Create a FIFO SQS queue whose messages are moved to the dead-letter queue after 5 receives.
+  /code $ copilot storage init -n my-queue -t SQS -w worker --fifo --max-receive-count 5
+  Create a DynamoDB table shared by the workloads of an environment.
+  /code $ copilot storage init -n orders -t DynamoDB --lifecycle environment --partition-key OrderId:S --no-sort`,
 		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed(storageRedisClusterModeFlag) {
+				vars.redisClusterMode = aws.Bool(redisClusterMode)
+			}
+			if cmd.Flags().Changed(storageSQSFIFOFlag) {
+				vars.sqsFIFO = aws.Bool(sqsFIFO)
+			}
 			opts, err := newStorageInitOpts(vars)
 			if err != nil {
 				return err
@@ -774,6 +1015,7 @@ Resource names are injected into your containers as environment variables for ea
 	cmd.Flags().StringVarP(&vars.storageName, nameFlag, nameFlagShort, "", storageFlagDescription)
 	cmd.Flags().StringVarP(&vars.storageType, storageTypeFlag, typeFlagShort, "", storageTypeFlagDescription)
 	cmd.Flags().StringVarP(&vars.workloadName, workloadFlag, workloadFlagShort, "", storageWorkloadFlagDescription)
+	cmd.Flags().StringVar(&vars.lifecycle, storageLifecycleFlag, lifecycleWorkloadLevel, storageLifecycleFlagDescription)
 
 	cmd.Flags().StringVar(&vars.partitionKey, storagePartitionKeyFlag, "", storagePartitionKeyFlagDescription)
 	cmd.Flags().StringVar(&vars.sortKey, storageSortKeyFlag, "", storageSortKeyFlagDescription)
@@ -785,10 +1027,19 @@ Resource names are injected into your containers as environment variables for ea
 	cmd.Flags().StringVar(&vars.rdsInitialDBName, storageRDSInitialDBFlag, "", storageRDSInitialDBFlagDescription)
 	cmd.Flags().StringVar(&vars.rdsParameterGroup, storageRDSParameterGroupFlag, "", storageRDSParameterGroupFlagDescription)
 
+	cmd.Flags().BoolVar(&redisClusterMode, storageRedisClusterModeFlag, false, storageRedisClusterModeFlagDescription)
+	cmd.Flags().StringVar(&vars.redisNodeType, storageRedisNodeTypeFlag, "", storageRedisNodeTypeFlagDescription)
+
+	cmd.Flags().StringVar(&vars.openSearchInstanceType, storageOpenSearchInstanceTypeFlag, "", storageOpenSearchInstanceTypeFlagDescription)
+
+	cmd.Flags().BoolVar(&sqsFIFO, storageSQSFIFOFlag, false, storageSQSFIFOFlagDescription)
+	cmd.Flags().IntVar(&vars.sqsMaxReceiveCount, storageSQSMaxReceiveCountFlag, 0, storageSQSMaxReceiveCountFlagDescription)
+
 	requiredFlags := pflag.NewFlagSet("Required", pflag.ContinueOnError)
 	requiredFlags.AddFlag(cmd.Flags().Lookup(nameFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(storageTypeFlag))
 	requiredFlags.AddFlag(cmd.Flags().Lookup(workloadFlag))
+	requiredFlags.AddFlag(cmd.Flags().Lookup(storageLifecycleFlag))
 
 	ddbFlags := pflag.NewFlagSet("DynamoDB", pflag.ContinueOnError)
 	ddbFlags.AddFlag(cmd.Flags().Lookup(storagePartitionKeyFlag))
@@ -802,12 +1053,26 @@ Resource names are injected into your containers as environment variables for ea
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSInitialDBFlag))
 	auroraFlags.AddFlag(cmd.Flags().Lookup(storageRDSParameterGroupFlag))
 
+	redisFlags := pflag.NewFlagSet("ElastiCache Redis", pflag.ContinueOnError)
+	redisFlags.AddFlag(cmd.Flags().Lookup(storageRedisClusterModeFlag))
+	redisFlags.AddFlag(cmd.Flags().Lookup(storageRedisNodeTypeFlag))
+
+	openSearchFlags := pflag.NewFlagSet("OpenSearch", pflag.ContinueOnError)
+	openSearchFlags.AddFlag(cmd.Flags().Lookup(storageOpenSearchInstanceTypeFlag))
+
+	sqsFlags := pflag.NewFlagSet("SQS", pflag.ContinueOnError)
+	sqsFlags.AddFlag(cmd.Flags().Lookup(storageSQSFIFOFlag))
+	sqsFlags.AddFlag(cmd.Flags().Lookup(storageSQSMaxReceiveCountFlag))
+
 	cmd.Annotations = map[string]string{
 		// The order of the sections we want to display.
-		"sections":          `Required,DynamoDB,Aurora Serverless`,
+		"sections":          `Required,DynamoDB,Aurora Serverless,ElastiCache Redis,OpenSearch,SQS`,
 		"Required":          requiredFlags.FlagUsages(),
 		"DynamoDB":          ddbFlags.FlagUsages(),
 		"Aurora Serverless": auroraFlags.FlagUsages(),
+		"ElastiCache Redis": redisFlags.FlagUsages(),
+		"OpenSearch":        openSearchFlags.FlagUsages(),
+		"SQS":               sqsFlags.FlagUsages(),
 	}
 	cmd.SetUsageTemplate(`{{h1 "Usage"}}{{if .Runnable}}
   {{.UseLine}}{{end}}{{$annotations := .Annotations}}{{$sections := split .Annotations.sections ","}}{{if gt (len $sections) 0}}
@@ -4,10 +4,12 @@
 package cli
 
 import (
+	"encoding"
 	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 
@@ -31,6 +33,9 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 		inNoSort      bool
 		inNoLSI       bool
 		inEngine      string
+		inLifecycle   string
+
+		inMaxReceiveCount int
 
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
@@ -67,6 +72,23 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 			inStorageName: "my-bucket",
 			wantedErr:     errors.New("retrieve local workload names: wanted err"),
 		},
+		"invalid lifecycle": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inLifecycle:   "application",
+			wantedErr:     fmt.Errorf(`invalid lifecycle application: must be one of "workload", "environment"`),
+		},
+		"workload with the environment lifecycle": {
+			mockWs:        func(m *mocks.MockwsAddonManager) {},
+			mockStore:     func(m *mocks.Mockstore) {},
+			inAppName:     "bowie",
+			inStorageType: s3StorageType,
+			inSvcName:     "frontend",
+			inLifecycle:   lifecycleEnvironmentLevel,
+			wantedErr:     errors.New("cannot specify --workload with --lifecycle environment: the storage is shared by the workloads of the environment"),
+		},
 		"successfully validates valid s3 bucket name": {
 			mockWs:        func(m *mocks.MockwsAddonManager) {},
 			mockStore:     func(m *mocks.Mockstore) {},
@@ -174,6 +196,35 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 
 			wantedErr: errors.New("invalid engine type mysql: must be one of \"MySQL\", \"PostgreSQL\""),
 		},
+		"redis bad character": {
+			inAppName:     "bowie",
+			inStorageType: redisStorageType,
+			inStorageName: "1-cache",
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errInvalidStorageNameCharacters,
+		},
+		"successfully validates valid SQS queue name": {
+			inAppName:         "bowie",
+			inStorageType:     sqsStorageType,
+			inStorageName:     "my-queue",
+			inMaxReceiveCount: 5,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+		},
+		"invalid SQS max receive count": {
+			inAppName:         "bowie",
+			inStorageType:     sqsStorageType,
+			inMaxReceiveCount: 1001,
+
+			mockWs:    func(m *mocks.MockwsAddonManager) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedErr: errors.New("--max-receive-count must be between 1 and 1000"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -195,6 +246,9 @@ func TestStorageInitOpts_Validate(t *testing.T) {
 					noLSI:        tc.inNoLSI,
 					noSort:       tc.inNoSort,
 					rdsEngine:    tc.inEngine,
+					lifecycle:    tc.inLifecycle,
+
+					sqsMaxReceiveCount: tc.inMaxReceiveCount,
 				},
 				appName: tc.inAppName,
 				ws:      mockWs,
@@ -246,6 +300,10 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 		inDBEngine      string
 		inInitialDBName string
 
+		inRedisClusterMode *bool
+		inSQSFIFO          *bool
+		inLifecycle        string
+
 		mockPrompt func(m *mocks.Mockprompter)
 		mockCfg    func(m *mocks.MockwsSelector)
 		mockStore  func(m *mocks.Mockstore)
@@ -288,6 +346,18 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 						Value: rdsStorageTypeOption,
 						Hint:  "SQL",
 					},
+					{
+						Value: redisStorageTypeOption,
+						Hint:  "In-memory",
+					},
+					{
+						Value: openSearchStorageTypeOption,
+						Hint:  "Search",
+					},
+					{
+						Value: sqsStorageTypeOption,
+						Hint:  "Queue",
+					},
 				}
 				m.EXPECT().SelectOption(gomock.Any(), gomock.Any(), gomock.Eq(options), gomock.Any()).Return(s3StorageType, nil)
 			},
@@ -391,6 +461,50 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 				rdsInitialDBName: wantedInitialDBName,
 			},
 		},
+		"asks for the storage type shared by the environment without a workload": {
+			inAppName:   wantedAppName,
+			inLifecycle: lifecycleEnvironmentLevel,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().SelectOption(gomock.Eq(storageInitEnvTypePrompt), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(s3StorageTypeOption, nil)
+				m.EXPECT().Get(gomock.Eq(fmt.Sprintf(fmtStorageInitNamePrompt, color.HighlightUserInput(s3BucketFriendlyText))),
+					gomock.Any(), gomock.Any(), gomock.Any()).Return(wantedBucketName, nil)
+			},
+			mockCfg:   func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedVars: &initStorageVars{
+				storageType: s3StorageType,
+				storageName: wantedBucketName,
+				lifecycle:   lifecycleEnvironmentLevel,
+			},
+		},
+		"defaults the cluster name of an environment RDS storage to the app name": {
+			inAppName:       wantedAppName,
+			inStorageType:   rdsStorageType,
+			inDBEngine:      wantedDBEngine,
+			inInitialDBName: wantedInitialDBName,
+			inLifecycle:     lifecycleEnvironmentLevel,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(gomock.Eq("What would you like to name this Database Cluster?"), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_, _ string, _ prompt.ValidatorFunc, opts ...prompt.PromptConfig) (string, error) {
+						require.Len(t, opts, 2)
+						return "ddos-cluster", nil
+					})
+			},
+			mockCfg:   func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {},
+
+			wantedVars: &initStorageVars{
+				storageType:      rdsStorageType,
+				storageName:      "ddos-cluster",
+				rdsEngine:        wantedDBEngine,
+				rdsInitialDBName: wantedInitialDBName,
+				lifecycle:        lifecycleEnvironmentLevel,
+			},
+		},
 		"error if storage name not returned": {
 			inAppName:     wantedAppName,
 			inSvcName:     wantedSvcName,
@@ -944,6 +1058,104 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 			wantedErr: fmt.Errorf("input initial database name: some error"),
 		},
+		"asks for Redis cluster mode if not specified": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "cache",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(storageInitRedisClusterModePrompt, storageInitRedisClusterModeHelp, gomock.Any()).Return(true, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload(wantedAppName, wantedSvcName).Return(&mockWl, nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:      redisStorageType,
+				storageName:      "cache",
+				workloadName:     wantedSvcName,
+				redisClusterMode: aws.Bool(true),
+			},
+		},
+		"error if Redis cluster mode not confirmed": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: redisStorageType,
+			inStorageName: "cache",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(storageInitRedisClusterModePrompt, gomock.Any(), gomock.Any()).Return(false, mockError)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload(wantedAppName, wantedSvcName).Return(&mockWl, nil)
+			},
+
+			wantedErr: errors.New("confirm Redis cluster mode: some error"),
+		},
+		"asks for SQS FIFO if not specified": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: sqsStorageType,
+			inStorageName: "queue",
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Confirm(storageInitSQSFIFOPrompt, storageInitSQSFIFOHelp, gomock.Any()).Return(false, nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload(wantedAppName, wantedSvcName).Return(&mockWl, nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:  sqsStorageType,
+				storageName:  "queue",
+				workloadName: wantedSvcName,
+				sqsFIFO:      aws.Bool(false),
+			},
+		},
+		"does not ask for SQS FIFO if specified": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: sqsStorageType,
+			inStorageName: "queue",
+			inSQSFIFO:     aws.Bool(true),
+
+			mockPrompt: func(m *mocks.Mockprompter) {},
+			mockCfg:    func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload(wantedAppName, wantedSvcName).Return(&mockWl, nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:  sqsStorageType,
+				storageName:  "queue",
+				workloadName: wantedSvcName,
+				sqsFIFO:      aws.Bool(true),
+			},
+		},
+		"asks for OpenSearch domain name": {
+			inAppName:     wantedAppName,
+			inSvcName:     wantedSvcName,
+			inStorageType: openSearchStorageType,
+
+			mockPrompt: func(m *mocks.Mockprompter) {
+				m.EXPECT().Get(fmt.Sprintf(fmtStorageInitNamePrompt, color.HighlightUserInput(openSearchFriendlyText)),
+					storageInitNameHelp, gomock.Any(), gomock.Any()).Return("search", nil)
+			},
+			mockCfg: func(m *mocks.MockwsSelector) {},
+			mockStore: func(m *mocks.Mockstore) {
+				m.EXPECT().GetWorkload(wantedAppName, wantedSvcName).Return(&mockWl, nil)
+			},
+
+			wantedVars: &initStorageVars{
+				storageType:  openSearchStorageType,
+				storageName:  "search",
+				workloadName: wantedSvcName,
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -967,6 +1179,10 @@ func TestStorageInitOpts_Ask(t *testing.T) {
 
 					rdsEngine:        tc.inDBEngine,
 					rdsInitialDBName: tc.inInitialDBName,
+
+					redisClusterMode: tc.inRedisClusterMode,
+					sqsFIFO:          tc.inSQSFIFO,
+					lifecycle:        tc.inLifecycle,
 				},
 				appName: tc.inAppName,
 				sel:     mockConfig,
@@ -1015,6 +1231,7 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 		inEngine         string
 		inInitialDBName  string
 		inParameterGroup string
+		inLifecycle      string
 
 		mockWs    func(m *mocks.MockwsAddonManager)
 		mockStore func(m *mocks.Mockstore)
@@ -1033,6 +1250,21 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 			wantedErr: nil,
 		},
+		"writes an environment addon for S3 shared by the workloads": {
+			inAppName:     wantedAppName,
+			inStorageType: s3StorageType,
+			inStorageName: "my-bucket",
+			inLifecycle:   lifecycleEnvironmentLevel,
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteEnvAddon(gomock.Any(), "my-bucket").DoAndReturn(func(f encoding.BinaryMarshaler, _ string) (string, error) {
+					content, err := f.MarshalBinary()
+					require.NoError(t, err)
+					require.NotContains(t, string(content), "${Name}")
+					return "/environments/addons/my-bucket.yml", nil
+				})
+			},
+		},
 		"happy calls for DDB": {
 			inAppName:     wantedAppName,
 			inStorageType: dynamoDBStorageType,
@@ -1079,6 +1311,36 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 			},
 			wantedErr: nil,
 		},
+		"happy calls for Redis": {
+			inAppName:     wantedAppName,
+			inStorageType: redisStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-cache",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-cache").Return("/frontend/addons/my-cache.yml", nil)
+			},
+		},
+		"happy calls for OpenSearch": {
+			inAppName:     wantedAppName,
+			inStorageType: openSearchStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-search",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-search").Return("/frontend/addons/my-search.yml", nil)
+			},
+		},
+		"happy calls for SQS": {
+			inAppName:     wantedAppName,
+			inStorageType: sqsStorageType,
+			inSvcName:     wantedSvcName,
+			inStorageName: "my-queue",
+
+			mockWs: func(m *mocks.MockwsAddonManager) {
+				m.EXPECT().WriteAddon(gomock.Any(), wantedSvcName, "my-queue").Return("/frontend/addons/my-queue.yml", nil)
+			},
+		},
 		"error addon exists": {
 			inAppName:     wantedAppName,
 			inStorageType: s3StorageType,
@@ -1128,6 +1390,7 @@ func TestStorageInitOpts_Execute(t *testing.T) {
 
 					rdsEngine:         tc.inEngine,
 					rdsParameterGroup: tc.inParameterGroup,
+					lifecycle:         tc.inLifecycle,
 				},
 				appName: tc.inAppName,
 				ws:      mockAddon,
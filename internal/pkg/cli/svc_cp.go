@@ -0,0 +1,312 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/transfer"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcCpNamePrompt     = "Which service's container would you like to copy files with?"
+	svcCpNameHelpPrompt = `Copilot copies the files through one of your chosen service's running tasks.
+The service must have "exec: true" set in its manifest.`
+)
+
+var (
+	svcCpTaskPrompt     = fmt.Sprintf("Which %s would you like to copy files with?", color.Emphasize("task"))
+	svcCpTaskHelpPrompt = fmt.Sprintf("By default we'll copy the files with the first %s of the task.", color.Emphasize("essential container"))
+)
+
+type svcCpVars struct {
+	appName          string
+	envName          string
+	name             string
+	containerName    string
+	src              string
+	dst              string
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcCpOpts struct {
+	svcCpVars
+
+	store              store
+	sel                deploySelector
+	prompter           prompter
+	newTaskSel         func(*session.Session) runningTaskSelector
+	newCopier          func(*session.Session, transfer.Container) fileCopier
+	ssmPluginManager   ssmPluginManager
+	sessFromEnvManager func(env *config.Environment) (*session.Session, error)
+
+	// Parsed from the source and destination arguments.
+	taskID     string
+	remotePath string
+	localPath  string
+	upload     bool
+
+	task *awsecs.Task
+}
+
+func newSvcCpOpts(vars svcCpVars) (*svcCpOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcCpOpts{
+		svcCpVars: vars,
+		store:     ssmStore,
+		sel:       selector.NewDeploySelect(prompter, ssmStore, deployStore),
+		prompter:  prompter,
+		newTaskSel: func(sess *session.Session) runningTaskSelector {
+			return selector.NewTaskSelect(prompter, ecs.New(sess))
+		},
+		newCopier: func(sess *session.Session, container transfer.Container) fileCopier {
+			return transfer.New(awsecs.New(sess), container, os.Stderr)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		sessFromEnvManager: func(env *config.Environment) (*session.Session, error) {
+			return sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcCpOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+		if o.name != "" {
+			if _, err := o.store.GetService(o.appName, o.name); err != nil {
+				return err
+			}
+		}
+	}
+	if err := o.parsePaths(); err != nil {
+		return err
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcCpOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askSvcEnvName(); err != nil {
+		return err
+	}
+	return o.selectTask()
+}
+
+// Execute copies the files between the local file system and a container in a running task of the service.
+func (o *svcCpOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("copying files is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(o.task.TaskArn))
+	if err != nil {
+		return fmt.Errorf("parse task ARN %s: %w", aws.StringValue(o.task.TaskArn), err)
+	}
+	container := o.selectContainer()
+	copier := o.newCopier(sess, transfer.Container{
+		Cluster: aws.StringValue(o.task.ClusterArn),
+		Task:    taskID,
+		Name:    container,
+	})
+	if o.upload {
+		log.Infof("Copying %s to %s in container %s in task %s.\n", color.HighlightUserInput(o.localPath),
+			color.HighlightUserInput(o.remotePath), color.HighlightUserInput(container), color.HighlightResource(taskID))
+		err = copier.Upload(o.localPath, o.remotePath)
+	} else {
+		log.Infof("Copying %s in container %s in task %s to %s.\n", color.HighlightUserInput(o.remotePath),
+			color.HighlightUserInput(container), color.HighlightResource(taskID), color.HighlightUserInput(o.localPath))
+		err = copier.Download(o.remotePath, o.localPath)
+	}
+	if err != nil {
+		var errExecCmd *awsecs.ErrExecuteCommand
+		if errors.As(err, &errExecCmd) {
+			log.Errorf("Failed to copy the files. Is %s set in your manifest?\n", color.HighlightCode("exec: true"))
+		}
+		return fmt.Errorf("copy %s to %s: %w", o.src, o.dst, err)
+	}
+	log.Successf("Copied %s to %s.\n", color.HighlightUserInput(o.src), color.HighlightUserInput(o.dst))
+	return nil
+}
+
+// parsePaths parses the source and destination arguments, exactly one of which is a path in a container
+// in the format "<task ID>:<path>". The task ID can be omitted to select a running task.
+func (o *svcCpOpts) parsePaths() error {
+	srcTask, srcPath, srcRemote := parseContainerPath(o.src)
+	dstTask, dstPath, dstRemote := parseContainerPath(o.dst)
+	switch {
+	case srcRemote && dstRemote:
+		return errors.New("copying files between containers is not supported")
+	case !srcRemote && !dstRemote:
+		return errors.New("either the source or the destination must be a path in a container in the format <task ID>:<path>")
+	case srcRemote:
+		o.taskID, o.remotePath, o.localPath = srcTask, srcPath, o.dst
+	default:
+		o.taskID, o.remotePath, o.localPath, o.upload = dstTask, dstPath, o.src, true
+	}
+	if o.remotePath == "" {
+		return errors.New("the path in the container must not be empty")
+	}
+	if o.localPath == "" {
+		return errors.New("the local path must not be empty")
+	}
+	return nil
+}
+
+// parseContainerPath splits an argument in the format "<task ID>:<path>".
+// It returns false if the argument is a local path.
+func parseContainerPath(arg string) (taskID, path string, ok bool) {
+	if filepath.VolumeName(arg) != "" {
+		// Windows paths such as "C:\logs" are local.
+		return "", "", false
+	}
+	i := strings.Index(arg, ":")
+	if i < 0 || strings.ContainsAny(arg[:i], `/\`) {
+		return "", "", false
+	}
+	return arg[:i], arg[i+1:], true
+}
+
+func (o *svcCpOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcCpOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcCpNamePrompt, svcCpNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcCpOpts) selectTask() error {
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	task, err := o.newTaskSel(sess).RunningTask(svcCpTaskPrompt, svcCpTaskHelpPrompt,
+		selector.WithAppEnv(o.appName, o.envName), selector.WithService(o.name), selector.WithTaskID(o.taskID))
+	if err != nil {
+		return fmt.Errorf("select running task of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	o.task = task
+	return nil
+}
+
+func (o *svcCpOpts) envSession() (*session.Session, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.sessFromEnvManager(env)
+	if err != nil {
+		return nil, fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return sess, nil
+}
+
+func (o *svcCpOpts) selectContainer() string {
+	if o.containerName != "" {
+		return o.containerName
+	}
+	// The first essential container is named with the workload name.
+	return o.name
+}
+
+// buildSvcCpCmd builds the command for copying files between the local file system and a running container of a service.
+func buildSvcCpCmd() *cobra.Command {
+	vars := svcCpVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "cp <src> <dst>",
+		Short: "Copy files and directories between your machine and a running container part of a service.",
+		Long: `Copy files and directories between your machine and a running container part of a service.
+A path in a container is written as <task ID>:<path>, where the task ID can be a prefix or omitted to select a running task.
+The files are archived with tar and the archive's checksum is verified after the copy.`,
+		Example: `
+  Download a heap dump from the task prefixed with ID "8c38184" within the "api" service.
+  /code $ copilot svc cp -a my-app -e test -n api 8c38184:/tmp/heap.hprof ./heap.hprof
+  Upload a config file into the "/etc/app/" directory of a task of the "api" service.
+  /code $ copilot svc cp -n api ./app.yml :/etc/app/
+  Download a directory from the "nginx" sidecar of a task of the "frontend" service.
+  /code $ copilot svc cp -n frontend --container nginx :/var/log/nginx ./logs`,
+		Args: cobra.ExactArgs(2),
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			vars.src, vars.dst = args[0], args[1]
+			opts, err := newSvcCpOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", cpContainerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
@@ -0,0 +1,280 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/transfer"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcCpMocks struct {
+	store   *mocks.Mockstore
+	sel     *mocks.MockdeploySelector
+	taskSel *mocks.MockrunningTaskSelector
+	copier  *mocks.MockfileCopier
+}
+
+func TestSvcCp_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inSrc string
+		inDst string
+
+		wantedTaskID     string
+		wantedRemotePath string
+		wantedLocalPath  string
+		wantedUpload     bool
+		wantedError      error
+	}{
+		"downloads from the task": {
+			inSrc:            "8c38184:/tmp/heap.hprof",
+			inDst:            "./heap.hprof",
+			wantedTaskID:     "8c38184",
+			wantedRemotePath: "/tmp/heap.hprof",
+			wantedLocalPath:  "./heap.hprof",
+		},
+		"uploads to a task to select": {
+			inSrc:            "config/app.yml",
+			inDst:            ":/etc/app/",
+			wantedRemotePath: "/etc/app/",
+			wantedLocalPath:  "config/app.yml",
+			wantedUpload:     true,
+		},
+		"local paths with a colon are not container paths": {
+			inSrc:            "./a:b",
+			inDst:            "8c38184:/tmp",
+			wantedTaskID:     "8c38184",
+			wantedRemotePath: "/tmp",
+			wantedLocalPath:  "./a:b",
+			wantedUpload:     true,
+		},
+		"returns an error if both paths are in containers": {
+			inSrc:       "8c38184:/tmp/a",
+			inDst:       ":/tmp/b",
+			wantedError: errors.New("copying files between containers is not supported"),
+		},
+		"returns an error if no path is in a container": {
+			inSrc:       "a",
+			inDst:       "b",
+			wantedError: errors.New("either the source or the destination must be a path in a container in the format <task ID>:<path>"),
+		},
+		"returns an error if the path in the container is empty": {
+			inSrc:       "a",
+			inDst:       "8c38184:",
+			wantedError: errors.New("the path in the container must not be empty"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcCpOpts{
+				svcCpVars: svcCpVars{
+					src:              tc.inSrc,
+					dst:              tc.inDst,
+					skipConfirmation: aws.Bool(false),
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTaskID, opts.taskID)
+			require.Equal(t, tc.wantedRemotePath, opts.remotePath)
+			require.Equal(t, tc.wantedLocalPath, opts.localPath)
+			require.Equal(t, tc.wantedUpload, opts.upload)
+		})
+	}
+}
+
+func TestSvcCp_Ask(t *testing.T) {
+	mockTask := &awsecs.Task{
+		TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"),
+	}
+	testCases := map[string]struct {
+		setupMocks func(m svcCpMocks)
+
+		wantedError error
+	}{
+		"selects the service and a task of the service": {
+			setupMocks: func(m svcCpMocks) {
+				gomock.InOrder(
+					m.sel.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil),
+					m.sel.EXPECT().DeployedService(svcCpNamePrompt, svcCpNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+						Return(&selector.DeployedService{
+							Env: "my-env",
+							Svc: "my-svc",
+						}, nil),
+				)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.taskSel.EXPECT().RunningTask(svcCpTaskPrompt, svcCpTaskHelpPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(mockTask, nil)
+			},
+		},
+		"wraps the error if no task can be selected": {
+			setupMocks: func(m svcCpMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("my-app", nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "my-env",
+						Svc: "my-svc",
+					}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.taskSel.EXPECT().RunningTask(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("no running tasks found"))
+			},
+			wantedError: errors.New("select running task of service my-svc in environment my-env: no running tasks found"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcCpMocks{
+				store:   mocks.NewMockstore(ctrl),
+				sel:     mocks.NewMockdeploySelector(ctrl),
+				taskSel: mocks.NewMockrunningTaskSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcCpOpts{
+				store: m.store,
+				sel:   m.sel,
+				newTaskSel: func(_ *session.Session) runningTaskSelector {
+					return m.taskSel
+				},
+				sessFromEnvManager: func(_ *config.Environment) (*session.Session, error) {
+					return &session.Session{}, nil
+				},
+				taskID: "mockTask",
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "my-app", opts.appName)
+			require.Equal(t, "my-env", opts.envName)
+			require.Equal(t, "my-svc", opts.name)
+			require.Equal(t, mockTask, opts.task)
+		})
+	}
+}
+
+func TestSvcCp_Execute(t *testing.T) {
+	mockTask := &awsecs.Task{
+		ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"),
+		TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"),
+	}
+	testCases := map[string]struct {
+		inUpload    bool
+		inContainer string
+		setupMocks  func(m svcCpMocks)
+
+		wantedContainer string
+		wantedError     error
+	}{
+		"uploads the file to the first essential container": {
+			inUpload: true,
+			setupMocks: func(m svcCpMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.copier.EXPECT().Upload("local", "/remote").Return(nil)
+			},
+			wantedContainer: "my-svc",
+		},
+		"downloads the file from the container": {
+			inContainer: "sidecar",
+			setupMocks: func(m svcCpMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.copier.EXPECT().Download("/remote", "local").Return(nil)
+			},
+			wantedContainer: "sidecar",
+		},
+		"returns an error for request-driven web services": {
+			setupMocks: func(m svcCpMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.RequestDrivenWebServiceType,
+				}, nil)
+			},
+			wantedError: fmt.Errorf("copying files is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType),
+		},
+		"wraps the error from the copy": {
+			setupMocks: func(m svcCpMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.copier.EXPECT().Download("/remote", "local").Return(errors.New("some error"))
+			},
+			wantedContainer: "my-svc",
+			wantedError:     errors.New("copy src to dst: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcCpMocks{
+				store:  mocks.NewMockstore(ctrl),
+				copier: mocks.NewMockfileCopier(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcCpOpts{
+				svcCpVars: svcCpVars{
+					appName:       "my-app",
+					envName:       "my-env",
+					name:          "my-svc",
+					containerName: tc.inContainer,
+					src:           "src",
+					dst:           "dst",
+				},
+				store: m.store,
+				newCopier: func(_ *session.Session, container transfer.Container) fileCopier {
+					require.Equal(t, transfer.Container{
+						Cluster: "arn:aws:ecs:us-west-2:123456789:cluster/mockCluster",
+						Task:    "mockTaskID",
+						Name:    tc.wantedContainer,
+					}, container)
+					return m.copier
+				},
+				sessFromEnvManager: func(_ *config.Environment) (*session.Session, error) {
+					return &session.Session{}, nil
+				},
+				remotePath: "/remote",
+				localPath:  "local",
+				upload:     tc.inUpload,
+				task:       mockTask,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
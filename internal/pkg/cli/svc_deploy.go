@@ -4,11 +4,15 @@
 package cli
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -25,6 +29,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/addon"
 	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/aws/tags"
@@ -42,6 +47,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
 	"github.com/aws/copilot-cli/internal/pkg/term/selector"
 	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/dustin/go-humanize/english"
 	"github.com/spf13/cobra"
 )
 
@@ -49,6 +55,33 @@ const (
 	fmtForceUpdateSvcStart    = "Forcing an update for service %s from environment %s"
 	fmtForceUpdateSvcFailed   = "Failed to force an update for service %s from environment %s: %v.\n"
 	fmtForceUpdateSvcComplete = "Forced an update for service %s from environment %s.\n"
+
+	fmtSvcDeployRolledBack = "Deployment of service %s in environment %s failed and ECS rolled it back to task definition %s.\n"
+
+	fmtAddSidecarReposStart    = "Creating ECR repositories for the sidecars of %s."
+	fmtAddSidecarReposFailed   = "Failed to create ECR repositories for the sidecars of %s.\n"
+	fmtAddSidecarReposComplete = "Created ECR repositories for the sidecars of %s.\n"
+
+	fmtUpdateImageRepoStart    = "Updating the image repositories of %s."
+	fmtUpdateImageRepoFailed   = "Failed to update the image repositories of %s.\n"
+	fmtUpdateImageRepoComplete = "Updated the image repositories of %s.\n"
+
+	fmtImageScanStart    = "Waiting for the scan of the image of %s to complete."
+	fmtImageScanFailed   = "Failed to retrieve the scan findings of the image of %s.\n"
+	fmtImageScanComplete = "Scanned the image of %s.\n"
+
+	fmtReusePushedImage = "The image %s is already pushed and its tag is immutable, deploying it instead of rebuilding it.\n"
+
+	defaultScanFindingsSeverity = "HIGH"
+)
+
+// Outputs of the environment stack for the listeners that Load Balanced Web Services add rules to.
+const (
+	envOutputHTTPListenerARN         = "HTTPListenerArn"
+	envOutputHTTPSListenerARN        = "HTTPSListenerArn"
+	envOutputInternalHTTPListenerARN = "InternalHTTPListenerArn"
+
+	rootListenerRulePriority = "50000" // Priority of the listener rule that routes the root path after all other rules.
 )
 
 type deployWkldVars struct {
@@ -82,8 +115,19 @@ type deploySvcOpts struct {
 	envUpgradeCmd       actionCommand
 	newAppVersionGetter func(string) (versionGetter, error)
 	endpointGetter      endpointGetter
+	publicCIDRBlocks    publicCIDRBlocksGetter
+	envOutputs          envOutputsGetter
+	listenerRules       listenerRulesDescriber
 	snsTopicGetter      deployedEnvironmentLister
+	deployStore         deployedEnvironmentLister
+	svcTaskDefGetter    serviceTaskDefinitionGetter
 	identity            identityService
+	sidecarRepos        sidecarRepoAdder
+	imageRepos          imageRepositoryUpdater
+	imageScanner        imageScanner
+	imageDigests        imageDigestGetter
+
+	newSidecarImageBuilderPusher func(sidecar string) (imageBuilderPusher, error)
 
 	spinner progress
 	sel     wsSelector
@@ -95,10 +139,18 @@ type deploySvcOpts struct {
 	targetSvc         *config.Workload
 	appliedManifest   interface{}
 	imageDigest       string
+	imageBuildInputs  string // Hash of the inputs that the image is built with, recorded in the task definition.
 	buildRequired     bool
 	appEnvResources   *stack.AppRegionalResources
 	rdSvcAlias        string
 	svcUpdater        serviceUpdater
+	blueGreen         bool   // Whether the service shifts traffic to new tasks with CodeDeploy.
+	deployedTaskDef   string // Task definition serving traffic before the deployment for blue/green services.
+
+	promotedImageDigest    string            // Digest of an image already pushed for another environment to deploy instead of building one.
+	promotedSidecarDigests map[string]string // Digests of sidecar images already pushed for another environment, keyed by sidecar name.
+	sidecarImageDigests    map[string]string // Digests of the sidecar images built from a Dockerfile, keyed by sidecar name.
+	sidecarBuildInputs     map[string]string // Hashes of the inputs that the sidecar images are built with, keyed by sidecar name.
 
 	subscriptions []manifest.TopicSubscription
 
@@ -138,6 +190,7 @@ func newSvcDeployOpts(vars deployWkldVars) (*deploySvcOpts, error) {
 		cmd:            exec.NewCmd(),
 		sessProvider:   sessions.NewProvider(),
 		snsTopicGetter: deployStore,
+		deployStore:    deployStore,
 	}
 	opts.uploadOpts = newUploadCustomResourcesOpts(opts)
 	return opts, err
@@ -200,10 +253,22 @@ func (o *deploySvcOpts) Execute() error {
 		return fmt.Errorf(`execute "env upgrade --app %s --name %s": %v`, o.appName, o.targetEnvironment.Name, err)
 	}
 
+	if err := o.configureImageRepository(); err != nil {
+		return err
+	}
+
 	if err := o.configureContainerImage(); err != nil {
 		return err
 	}
 
+	if err := o.checkImageScanFindings(); err != nil {
+		return err
+	}
+
+	if err := o.configureSidecarImages(); err != nil {
+		return err
+	}
+
 	addonsURL, err := o.pushAddonsTemplateToS3Bucket()
 	if err != nil {
 		return err
@@ -302,6 +367,11 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate image builder pusher: %w", err)
 	}
+	o.newSidecarImageBuilderPusher = func(sidecar string) (imageBuilderPusher, error) {
+		return repository.New(fmt.Sprintf("%s/%s", repoName, sidecar), registry)
+	}
+	o.imageScanner = registry
+	o.imageDigests = registry
 
 	o.s3 = s3.New(defaultSessEnvRegion)
 
@@ -311,8 +381,9 @@ func (o *deploySvcOpts) configureClients() error {
 
 	// CF client against env account profile AND target environment region.
 	o.svcCFN = cloudformation.New(envSession)
+	o.svcTaskDefGetter = ecs.New(envSession)
 
-	o.endpointGetter, err = describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+	envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
 		App:         o.appName,
 		Env:         o.envName,
 		ConfigStore: o.store,
@@ -320,6 +391,10 @@ func (o *deploySvcOpts) configureClients() error {
 	if err != nil {
 		return fmt.Errorf("initiate env describer: %w", err)
 	}
+	o.endpointGetter = envDescriber
+	o.publicCIDRBlocks = envDescriber
+	o.envOutputs = envDescriber
+	o.listenerRules = elbv2.New(envSession)
 	addonsSvc, err := addon.New(o.name)
 	if err != nil {
 		return fmt.Errorf("initiate addons service: %w", err)
@@ -332,6 +407,8 @@ func (o *deploySvcOpts) configureClients() error {
 		return fmt.Errorf("create default session: %w", err)
 	}
 	o.appCFN = cloudformation.New(defaultSess)
+	o.sidecarRepos = cloudformation.New(defaultSess)
+	o.imageRepos = cloudformation.New(defaultSess)
 
 	cmd, err := newEnvUpgradeOpts(envUpgradeVars{
 		appName: o.appName,
@@ -348,6 +425,20 @@ func (o *deploySvcOpts) configureClients() error {
 	return nil
 }
 
+func (o *deploySvcOpts) configureImageRepository() error {
+	svc, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	return updateImageRepository(updateImageRepositoryInput{
+		app:     o.targetApp,
+		wlName:  o.name,
+		repo:    imageRepository(svc),
+		updater: o.imageRepos,
+		spinner: o.spinner,
+	})
+}
+
 func (o *deploySvcOpts) configureContainerImage() error {
 	svc, err := o.manifest()
 	if err != nil {
@@ -360,27 +451,112 @@ func (o *deploySvcOpts) configureContainerImage() error {
 	if !required {
 		return nil
 	}
+	logMultiArchPlatforms(o.name, svc)
 	// If it is built from local Dockerfile, build and push to the ECR repo.
-	buildArg, err := o.dfBuildArgs(svc)
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	buildArg, err := buildArgs(o.name, o.imageTag, copilotDir, svc)
 	if err != nil {
 		return err
 	}
-
-	digest, err := o.imageBuilderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), buildArg)
+	if o.imageBuildInputs, err = buildInputsHash(filepath.Dir(copilotDir), buildArg); err != nil {
+		return err
+	}
+	if o.promotedImageDigest != "" {
+		// Deploy the exact image of another environment without rebuilding it.
+		o.imageTag = ""
+		o.imageDigest = o.promotedImageDigest
+		o.buildRequired = true
+		return nil
+	}
+	digest, err := buildAndPushImage(buildAndPushImageInput{
+		app:           o.targetApp,
+		appName:       o.appName,
+		wlName:        o.name,
+		repo:          imageRepository(svc),
+		args:          buildArg,
+		imageDigests:  o.imageDigests,
+		builderPusher: o.imageBuilderPusher,
+	})
 	if err != nil {
-		return fmt.Errorf("build and push image: %w", err)
+		return err
 	}
 	o.imageDigest = digest
 	o.buildRequired = true
 	return nil
 }
 
-func (o *deploySvcOpts) dfBuildArgs(svc interface{}) (*dockerengine.BuildArguments, error) {
+func (o *deploySvcOpts) checkImageScanFindings() error {
+	if o.imageDigest == "" || o.promotedImageDigest != "" {
+		return nil
+	}
+	svc, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	return checkWorkloadImageScanFindings(workloadImageScanInput{
+		app:     o.targetApp,
+		appName: o.appName,
+		wlName:  o.name,
+		mft:     svc,
+		digest:  o.imageDigest,
+		scanner: o.imageScanner,
+		spinner: o.spinner,
+	})
+}
+
+func (o *deploySvcOpts) configureSidecarImages() error {
+	svc, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	args, err := sidecarBuildArgs(o.imageTag, o.ws, svc)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return nil
+	}
 	copilotDir, err := o.ws.CopilotDirPath()
 	if err != nil {
-		return nil, fmt.Errorf("get copilot directory: %w", err)
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	o.sidecarBuildInputs = make(map[string]string, len(args))
+	for name, arg := range args {
+		if o.sidecarBuildInputs[name], err = buildInputsHash(filepath.Dir(copilotDir), arg); err != nil {
+			return err
+		}
+	}
+	if o.promotedImageDigest != "" {
+		// Deploy the exact sidecar images of another environment without rebuilding them.
+		o.sidecarImageDigests = o.promotedSidecarDigests
+		return nil
 	}
-	return buildArgs(o.name, o.imageTag, copilotDir, svc)
+	var pushedDigests imageDigestGetter
+	if immutableImageTags(o.targetApp, imageRepository(svc)) {
+		for _, arg := range args {
+			if err := excludeLatestTag(o.name, arg); err != nil {
+				return err
+			}
+		}
+		pushedDigests = o.imageDigests
+	}
+	digests, err := buildAndPushSidecars(buildAndPushSidecarsInput{
+		app:                   o.targetApp,
+		wlName:                o.name,
+		args:                  args,
+		repos:                 o.sidecarRepos,
+		newImageBuilderPusher: o.newSidecarImageBuilderPusher,
+		pushedDigests:         pushedDigests,
+		spinner:               o.spinner,
+	})
+	if err != nil {
+		return err
+	}
+	o.sidecarImageDigests = digests
+	return nil
 }
 
 func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{}) (*dockerengine.BuildArguments, error) {
@@ -408,10 +584,414 @@ func buildArgs(name, imageTag, copilotDir string, unmarshaledManifest interface{
 		CacheFrom:  args.CacheFrom,
 		Target:     aws.StringValue(args.Target),
 		Platform:   aws.StringValue(platform),
+		Platforms:  multiArchPlatforms(mf),
 		Tags:       tags,
 	}, nil
 }
 
+// buildInputsHash returns a hash of the arguments that an image is built with, except for its tags.
+// The Dockerfile and context are relative to the workspace root so that the hash doesn't depend on where the workspace is.
+func buildInputsHash(wsRoot string, args *dockerengine.BuildArguments) (string, error) {
+	rel := func(path string) (string, error) {
+		if path == "" {
+			return "", nil
+		}
+		relPath, err := filepath.Rel(wsRoot, path)
+		if err != nil {
+			return "", fmt.Errorf("get path of %s relative to the workspace: %w", path, err)
+		}
+		return filepath.ToSlash(relPath), nil
+	}
+	dockerfile, err := rel(args.Dockerfile)
+	if err != nil {
+		return "", err
+	}
+	contextDir, err := rel(args.Context)
+	if err != nil {
+		return "", err
+	}
+	inputs, err := json.Marshal(struct {
+		Dockerfile string
+		Context    string
+		Target     string
+		CacheFrom  []string
+		Platform   string
+		Platforms  []string
+		Args       map[string]string
+	}{
+		Dockerfile: dockerfile,
+		Context:    contextDir,
+		Target:     args.Target,
+		CacheFrom:  args.CacheFrom,
+		Platform:   args.Platform,
+		Platforms:  args.Platforms,
+		Args:       args.Args,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal build inputs: %w", err)
+	}
+	sum := sha256.Sum256(inputs)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// multiArchPlatforms returns the platforms to build a multi-architecture image for,
+// or nil if the workload is built for a single platform.
+func multiArchPlatforms(unmarshaledManifest interface{}) []string {
+	mf, ok := unmarshaledManifest.(interface {
+		BuildPlatforms() []string
+	})
+	if !ok {
+		return nil
+	}
+	if platforms := mf.BuildPlatforms(); len(platforms) > 1 {
+		return platforms
+	}
+	return nil
+}
+
+// logMultiArchPlatforms tells which platform the tasks of the workload run on if its image is built for several platforms.
+func logMultiArchPlatforms(wlName string, unmarshaledManifest interface{}) {
+	platforms := multiArchPlatforms(unmarshaledManifest)
+	if len(platforms) == 0 {
+		return
+	}
+	log.Infof("The tasks of %s run on %s, the first platform of %s.\n",
+		wlName, color.HighlightUserInput(platforms[0]), english.WordSeries(platforms, "and"))
+}
+
+type buildAndPushImageInput struct {
+	app           *config.Application
+	appName       string
+	wlName        string
+	repo          manifest.ImageRepository
+	args          *dockerengine.BuildArguments
+	imageDigests  imageDigestGetter
+	builderPusher imageBuilderPusher
+}
+
+// buildAndPushImage builds the image of the workload's main container, pushes it to the workload's ECR repository and
+// returns its digest. If the repository has immutable tags, the image already pushed with the same tag is reused instead.
+func buildAndPushImage(in buildAndPushImageInput) (string, error) {
+	if immutableImageTags(in.app, in.repo) {
+		if err := excludeLatestTag(in.wlName, in.args); err != nil {
+			return "", err
+		}
+		// The tag can't be pushed twice, so deploy the image pushed for another environment or an earlier deployment.
+		digest, err := pushedImageDigest(in.imageDigests, fmt.Sprintf("%s/%s", in.appName, in.wlName), in.args.Tags[0])
+		if err != nil {
+			return "", err
+		}
+		if digest != "" {
+			return digest, nil
+		}
+	}
+	digest, err := in.builderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), in.args)
+	if err != nil {
+		return "", fmt.Errorf("build and push image: %w", err)
+	}
+	return digest, nil
+}
+
+// sidecarBuildArgs returns the build arguments of the sidecars built from a Dockerfile, keyed by sidecar name.
+// The copilot directory is only looked up if at least one sidecar is built from a Dockerfile.
+func sidecarBuildArgs(imageTag string, ws copilotDirGetter, unmarshaledManifest interface{}) (map[string]*dockerengine.BuildArguments, error) {
+	type sidecarDfArgs interface {
+		SidecarBuildArgs(rootDirectory string) map[string]*manifest.DockerBuildArgs
+		TaskPlatform() (*string, error)
+	}
+	mf, ok := unmarshaledManifest.(sidecarDfArgs)
+	if !ok {
+		// The workload doesn't support sidecars.
+		return nil, nil
+	}
+	if len(mf.SidecarBuildArgs("")) == 0 {
+		return nil, nil
+	}
+	copilotDir, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, fmt.Errorf("get copilot directory: %w", err)
+	}
+	sidecarArgs := mf.SidecarBuildArgs(filepath.Dir(copilotDir))
+	var tags []string
+	if imageTag != "" {
+		tags = append(tags, imageTag)
+	}
+	platform, err := mf.TaskPlatform()
+	if err != nil {
+		return nil, fmt.Errorf("get platform for sidecars: %w", err)
+	}
+	out := make(map[string]*dockerengine.BuildArguments, len(sidecarArgs))
+	for name, args := range sidecarArgs {
+		out[name] = &dockerengine.BuildArguments{
+			Dockerfile: aws.StringValue(args.Dockerfile),
+			Context:    aws.StringValue(args.Context),
+			Args:       args.Args,
+			CacheFrom:  args.CacheFrom,
+			Target:     aws.StringValue(args.Target),
+			Platform:   aws.StringValue(platform),
+			Platforms:  multiArchPlatforms(mf),
+			Tags:       tags,
+		}
+	}
+	return out, nil
+}
+
+type buildAndPushSidecarsInput struct {
+	app                   *config.Application
+	wlName                string
+	args                  map[string]*dockerengine.BuildArguments // Build arguments keyed by sidecar name.
+	repos                 sidecarRepoAdder
+	newImageBuilderPusher func(sidecar string) (imageBuilderPusher, error)
+	pushedDigests         imageDigestGetter // Optional. Looks up the sidecar images already pushed with the same tag, if tags are immutable.
+	spinner               progress
+}
+
+// buildAndPushSidecars creates an ECR repository for each sidecar built from a Dockerfile if it doesn't exist yet,
+// then builds and pushes the sidecar images. It returns the pushed image digests keyed by sidecar name.
+// If pushedDigests is set, the sidecar images already pushed with the same tag are reused instead of rebuilt.
+func buildAndPushSidecars(in buildAndPushSidecarsInput) (map[string]string, error) {
+	var sidecars []string
+	for name := range in.args {
+		sidecars = append(sidecars, name)
+	}
+	sort.Strings(sidecars)
+
+	in.spinner.Start(fmt.Sprintf(fmtAddSidecarReposStart, color.HighlightUserInput(in.wlName)))
+	if err := in.repos.AddSidecarsToApp(in.app, in.wlName, sidecars); err != nil {
+		in.spinner.Stop(log.Serrorf(fmtAddSidecarReposFailed, color.HighlightUserInput(in.wlName)))
+		return nil, fmt.Errorf("add sidecar repositories to application %s: %w", in.app.Name, err)
+	}
+	in.spinner.Stop(log.Ssuccessf(fmtAddSidecarReposComplete, color.HighlightUserInput(in.wlName)))
+
+	digests := make(map[string]string, len(sidecars))
+	for _, sidecar := range sidecars {
+		if in.pushedDigests != nil {
+			repoName := fmt.Sprintf("%s/%s", in.app.Name, fmt.Sprintf(stack.SidecarRepoNameFormat, in.wlName, sidecar))
+			digest, err := pushedImageDigest(in.pushedDigests, repoName, in.args[sidecar].Tags[0])
+			if err != nil {
+				return nil, err
+			}
+			if digest != "" {
+				digests[sidecar] = digest
+				continue
+			}
+		}
+		builderPusher, err := in.newImageBuilderPusher(sidecar)
+		if err != nil {
+			return nil, fmt.Errorf("initiate image builder pusher for sidecar %s: %w", sidecar, err)
+		}
+		digest, err := builderPusher.BuildAndPush(dockerengine.New(exec.NewCmd()), in.args[sidecar])
+		if err != nil {
+			return nil, fmt.Errorf("build and push image of sidecar %s: %w", sidecar, err)
+		}
+		digests[sidecar] = digest
+	}
+	return digests, nil
+}
+
+// imageRepository returns the configuration of the workload's image repository in its manifest.
+func imageRepository(unmarshaledManifest interface{}) manifest.ImageRepository {
+	mft, ok := unmarshaledManifest.(interface {
+		ImageRepository() manifest.ImageRepository
+	})
+	if !ok {
+		return manifest.ImageRepository{}
+	}
+	return mft.ImageRepository()
+}
+
+// immutableImageTags returns true if the tags of the workload's images can't be overwritten.
+// The workload's setting takes precedence over the application's.
+func immutableImageTags(app *config.Application, repo manifest.ImageRepository) bool {
+	if repo.ImmutableTags != nil {
+		return aws.BoolValue(repo.ImmutableTags)
+	}
+	return app != nil && app.ImageRepository != nil && aws.BoolValue(app.ImageRepository.ImmutableTags)
+}
+
+// imageScanOnPush returns true if the workload's images are scanned for vulnerabilities once pushed.
+// The workload's setting takes precedence over the application's.
+func imageScanOnPush(app *config.Application, repo manifest.ImageRepository) bool {
+	if repo.ScanOnPush != nil {
+		return aws.BoolValue(repo.ScanOnPush)
+	}
+	return app != nil && app.ImageRepository != nil && aws.BoolValue(app.ImageRepository.ScanOnPush)
+}
+
+// excludeLatestTag makes sure that an image pushed to a repository with immutable tags is only tagged with unique tags,
+// since "latest" can't be overwritten by the next deployment.
+func excludeLatestTag(wlName string, args *dockerengine.BuildArguments) error {
+	if len(args.Tags) == 0 {
+		return fmt.Errorf(`the image repository of %s has immutable tags: specify a unique image tag with --%s`, wlName, imageTagFlag)
+	}
+	args.ExcludeLatest = true
+	return nil
+}
+
+// pushedImageDigest returns the digest of the image pushed to the repository with the tag,
+// or an empty string if the repository doesn't have an image with the tag yet.
+func pushedImageDigest(getter imageDigestGetter, repoName, tag string) (string, error) {
+	digest, err := getter.ImageDigest(repoName, tag)
+	if err != nil {
+		var errNotFound *ecr.ErrImageNotFound
+		if errors.As(err, &errNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get digest of image %s:%s: %w", repoName, tag, err)
+	}
+	log.Infof(fmtReusePushedImage, color.HighlightUserInput(fmt.Sprintf("%s:%s", repoName, tag)))
+	return digest, nil
+}
+
+type updateImageRepositoryInput struct {
+	app     *config.Application
+	wlName  string
+	repo    manifest.ImageRepository
+	updater imageRepositoryUpdater
+	spinner progress
+}
+
+// updateImageRepository applies the repository settings of the workload's manifest to its image repositories.
+// If the manifest doesn't configure any, the repositories revert to the application's settings.
+func updateImageRepository(in updateImageRepositoryInput) error {
+	if !in.repo.HasRepositorySettings() {
+		if err := in.updater.UpdateImageRepository(in.app, in.wlName, nil); err != nil {
+			return fmt.Errorf("revert the image repository settings of %s: %w", in.wlName, err)
+		}
+		return nil
+	}
+	in.spinner.Start(fmt.Sprintf(fmtUpdateImageRepoStart, color.HighlightUserInput(in.wlName)))
+	if err := in.updater.UpdateImageRepository(in.app, in.wlName, &stack.ImageRepositoryConfig{
+		KeepImages:         in.repo.KeepImages,
+		UntaggedExpiryDays: in.repo.UntaggedExpiryDays,
+		ScanOnPush:         in.repo.ScanOnPush,
+		ImmutableTags:      in.repo.ImmutableTags,
+	}); err != nil {
+		in.spinner.Stop(log.Serrorf(fmtUpdateImageRepoFailed, color.HighlightUserInput(in.wlName)))
+		return fmt.Errorf("apply the image repository settings of %s: %w", in.wlName, err)
+	}
+	in.spinner.Stop(log.Ssuccessf(fmtUpdateImageRepoComplete, color.HighlightUserInput(in.wlName)))
+	return nil
+}
+
+type workloadImageScanInput struct {
+	app     *config.Application
+	appName string
+	wlName  string
+	mft     interface{} // The unmarshaled manifest of the workload.
+	digest  string
+	scanner imageScanner
+	spinner progress
+}
+
+// checkWorkloadImageScanFindings checks the scan findings of the image pushed for the workload's main container
+// if its repository scans images on push.
+func checkWorkloadImageScanFindings(in workloadImageScanInput) error {
+	repo := imageRepository(in.mft)
+	if !imageScanOnPush(in.app, repo) {
+		return nil
+	}
+	if len(multiArchPlatforms(in.mft)) != 0 {
+		log.Warningf("Skip the scan findings of the image of %s: multi-architecture images are not scanned on push.\n", in.wlName)
+		return nil
+	}
+	return checkImageScanFindings(imageScanFindingsInput{
+		wlName:   in.wlName,
+		repoName: fmt.Sprintf("%s/%s", in.appName, in.wlName),
+		digest:   in.digest,
+		findings: repo.ScanFindings,
+		scanner:  in.scanner,
+		spinner:  in.spinner,
+	})
+}
+
+type imageScanFindingsInput struct {
+	wlName   string
+	repoName string
+	digest   string
+	findings manifest.ImageScanFindings
+	scanner  imageScanner
+	spinner  progress
+}
+
+// checkImageScanFindings waits for the scan of a pushed image to complete and reports the vulnerabilities
+// of the configured severity or higher. It returns an error if the findings must block the deployment.
+func checkImageScanFindings(in imageScanFindingsInput) error {
+	severity := defaultScanFindingsSeverity
+	if in.findings.Severity != nil {
+		severity = strings.ToUpper(aws.StringValue(in.findings.Severity))
+	}
+	block := aws.BoolValue(in.findings.Block)
+
+	in.spinner.Start(fmt.Sprintf(fmtImageScanStart, color.HighlightUserInput(in.wlName)))
+	findings, err := in.scanner.ImageScanFindings(in.repoName, in.digest)
+	if err != nil {
+		in.spinner.Stop(log.Serrorf(fmtImageScanFailed, color.HighlightUserInput(in.wlName)))
+		if block {
+			return fmt.Errorf("get scan findings of image %s: %w", in.digest, err)
+		}
+		log.Warningf("Skip the scan findings of the image of %s: %v\n", in.wlName, err)
+		return nil
+	}
+	in.spinner.Stop(log.Ssuccessf(fmtImageScanComplete, color.HighlightUserInput(in.wlName)))
+
+	var reported []ecr.ScanFinding
+	for _, finding := range findings {
+		if scanSeverityRank(finding.Severity) >= scanSeverityRank(severity) {
+			reported = append(reported, finding)
+		}
+	}
+	if len(reported) == 0 {
+		return nil
+	}
+	log.Warningf("The image of %s has %d %s of severity %s or higher:\n", in.wlName, len(reported),
+		english.PluralWord(len(reported), "vulnerability", "vulnerabilities"), severity)
+	for _, finding := range reported {
+		log.Infof("  - %s (%s) %s\n", finding.Name, finding.Severity, finding.URI)
+	}
+	if block {
+		return fmt.Errorf("deployment of %s blocked by %d image scan %s of severity %s or higher", in.wlName, len(reported),
+			english.PluralWord(len(reported), "finding", "findings"), severity)
+	}
+	return nil
+}
+
+// scanSeverityRank returns the rank of a scan finding's severity, the higher the more severe.
+// Undefined severities rank the lowest.
+func scanSeverityRank(severity string) int {
+	for i, s := range manifest.ImageScanSeverities {
+		if strings.EqualFold(s, severity) {
+			return i
+		}
+	}
+	return -1
+}
+
+// sidecarECRImages returns the pushed images of the sidecars built from a Dockerfile, keyed by sidecar name.
+// The images are referred to by digest.
+func sidecarECRImages(wlName string, digests map[string]string, resources *stack.AppRegionalResources, app *config.Application, region string) (map[string]stack.ECRImage, error) {
+	if len(digests) == 0 {
+		return nil, nil
+	}
+	images := make(map[string]stack.ECRImage, len(digests))
+	for sidecar, digest := range digests {
+		repo := fmt.Sprintf(stack.SidecarRepoNameFormat, wlName, sidecar)
+		repoURL, ok := resources.RepositoryURLs[repo]
+		if !ok {
+			return nil, &errRepoNotFound{
+				wlName:       repo,
+				envRegion:    region,
+				appAccountID: app.AccountID,
+			}
+		}
+		images[sidecar] = stack.ECRImage{
+			RepoURL: repoURL,
+			Digest:  digest,
+		}
+	}
+	return images, nil
+}
+
 // pushAddonsTemplateToS3Bucket generates the addons template for the service and pushes it to S3.
 // If the service doesn't have any addons, it returns the empty string and no errors.
 // If the service has addons, it returns the URL of the S3 object storing the addons template.
@@ -467,6 +1047,10 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 	if err != nil {
 		return nil, err
 	}
+	sidecarImages, err := o.sidecarImages()
+	if err != nil {
+		return nil, err
+	}
 	if !o.buildRequired {
 		return &stack.RuntimeConfig{
 			AddonsTemplateURL:        addonsURL,
@@ -474,6 +1058,7 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 			ServiceDiscoveryEndpoint: endpoint,
 			AccountID:                o.targetApp.AccountID,
 			Region:                   o.targetEnvironment.Region,
+			SidecarImages:            sidecarImages,
 		}, nil
 	}
 
@@ -493,16 +1078,36 @@ func (o *deploySvcOpts) runtimeConfig(addonsURL string) (*stack.RuntimeConfig, e
 		AddonsTemplateURL: addonsURL,
 		AdditionalTags:    tags.Merge(o.targetApp.Tags, o.resourceTags),
 		Image: &stack.ECRImage{
-			RepoURL:  repoURL,
-			ImageTag: o.imageTag,
-			Digest:   o.imageDigest,
+			RepoURL:     repoURL,
+			ImageTag:    o.imageTag,
+			Digest:      o.imageDigest,
+			BuildInputs: o.imageBuildInputs,
 		},
 		ServiceDiscoveryEndpoint: endpoint,
 		AccountID:                o.targetApp.AccountID,
 		Region:                   o.targetEnvironment.Region,
+		SidecarImages:            sidecarImages,
 	}, nil
 }
 
+func (o *deploySvcOpts) sidecarImages() (map[string]stack.ECRImage, error) {
+	if len(o.sidecarImageDigests) == 0 {
+		return nil, nil
+	}
+	if err := o.retrieveAppResourcesForEnvRegion(); err != nil {
+		return nil, err
+	}
+	images, err := sidecarECRImages(o.name, o.sidecarImageDigests, o.appEnvResources, o.targetApp, o.targetEnvironment.Region)
+	if err != nil {
+		return nil, err
+	}
+	for name, image := range images {
+		image.BuildInputs = o.sidecarBuildInputs[name]
+		images[name] = image
+	}
+	return images, nil
+}
+
 func uploadCustomResources(o *uploadCustomResourcesOpts, appEnvResources *stack.AppRegionalResources) (map[string]string, error) {
 	s3Client, err := o.newS3Uploader()
 	if err != nil {
@@ -524,6 +1129,11 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	if err != nil {
 		return nil, err
 	}
+	if o.targetEnvironment.IsPrivateOnly() {
+		if err := validateWorkloadForPrivateOnlyEnv(mft, o.envName); err != nil {
+			return nil, err
+		}
+	}
 	rc, err := o.runtimeConfig(addonsURL)
 	if err != nil {
 		return nil, err
@@ -534,17 +1144,51 @@ func (o *deploySvcOpts) stackConfiguration(addonsURL string) (cloudformation.Sta
 	var conf cloudformation.StackConfiguration
 	switch t := mft.(type) {
 	case *manifest.LoadBalancedWebService:
-		if o.targetApp.RequiresDNSDelegation() {
+		var opts []stack.LoadBalancedWebServiceOption
+		if !t.NLBConfig.IsEmpty() {
+			var cidrBlocks []string
+			if cidrBlocks, err = o.publicCIDRBlocks.PublicCIDRBlocks(); err != nil {
+				return nil, fmt.Errorf("get public CIDR blocks of environment %s: %w", o.envName, err)
+			}
+			opts = append(opts, stack.WithNLB(cidrBlocks))
+		}
+		https := o.targetEnvironment.HasImportedCerts() || o.targetApp.RequiresDNSDelegation()
+		if err = o.validateListenerRules(t, https); err != nil {
+			return nil, err
+		}
+		if !t.DeployConfig.BlueGreen.IsEmpty() {
+			if rc.DeployedTaskDefinition, err = o.serviceTaskDefinition(); err != nil {
+				return nil, err
+			}
+			if rc.DeployedTaskDefinition != "" {
+				stackName := stack.NameForService(o.appName, o.envName, o.name)
+				if rc.TrafficTargetGroup, err = o.svcCFN.BlueGreenTrafficTargetGroup(stackName); err != nil {
+					return nil, fmt.Errorf("get the target group serving traffic to service %s: %w", o.name, err)
+				}
+			}
+			o.blueGreen = true
+			o.deployedTaskDef = rc.DeployedTaskDefinition
+		}
+		switch {
+		case o.targetEnvironment.HasImportedCerts():
+			if err = validateLBSvcForImportedCerts(t, o.envName); err != nil {
+				return nil, err
+			}
+			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc, opts...)
+		case o.targetApp.RequiresDNSDelegation():
 			var appVersionGetter versionGetter
 			if appVersionGetter, err = o.newAppVersionGetter(o.appName); err != nil {
 				return nil, err
 			}
-			if err = validateLBSvcAliasAndAppVersion(aws.StringValue(t.Name), t.Alias, o.targetApp, o.envName, appVersionGetter); err != nil {
+			if err = validateLBSvcAliasAndAppVersion(aws.StringValue(t.Name), t.RoutingRule.Rules(), o.targetApp, o.envName, appVersionGetter); err != nil {
 				return nil, err
 			}
-			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
-		} else {
-			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc)
+			conf, err = stack.NewHTTPSLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc, opts...)
+		default:
+			if err = validateLBSvcForNoDomain(t, o.envName); err != nil {
+				return nil, err
+			}
+			conf, err = stack.NewLoadBalancedWebService(t, o.targetEnvironment.Name, o.targetEnvironment.App, *rc, opts...)
 		}
 	case *manifest.RequestDrivenWebService:
 		o.newSvcUpdater(func(s *session.Session) serviceUpdater {
@@ -631,13 +1275,50 @@ func (o *deploySvcOpts) deploySvc(addonsURL string) error {
 	if err := o.svcCFN.DeployService(os.Stderr, conf, awscloudformation.WithRoleARN(o.targetEnvironment.ExecutionRoleARN)); err != nil {
 		var errEmptyCS *awscloudformation.ErrChangeSetEmpty
 		if errors.As(err, &errEmptyCS) {
+			if o.forceNewUpdate && o.blueGreen {
+				// ECS can't force a new deployment of services whose deployments are controlled by CodeDeploy.
+				return o.deployBlueGreen(conf.StackName(), true)
+			}
 			if o.forceNewUpdate {
 				return o.forceDeploy()
 			}
 			log.Warningf("Set --%s to force an update for the service.\n", forceFlag)
 		}
+		var errRolledBack *cloudformation.ErrECSDeploymentRolledBack
+		if errors.As(err, &errRolledBack) {
+			log.Errorf(fmtSvcDeployRolledBack, color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName),
+				color.HighlightResource(errRolledBack.TaskDefinition))
+		}
 		return fmt.Errorf("deploy service: %w", err)
 	}
+	if o.blueGreen {
+		return o.deployBlueGreen(conf.StackName(), false)
+	}
+	return nil
+}
+
+// serviceTaskDefinition returns the ARN of the task definition that the service runs,
+// or an empty string if the service isn't deployed to the environment yet.
+func (o *deploySvcOpts) serviceTaskDefinition() (string, error) {
+	deployed, err := o.deployStore.IsServiceDeployed(o.appName, o.envName, o.name)
+	if err != nil {
+		return "", fmt.Errorf("check if service %s is deployed in environment %s: %w", o.name, o.envName, err)
+	}
+	if !deployed {
+		return "", nil
+	}
+	return o.svcTaskDefGetter.ServiceTaskDefinitionARN(o.appName, o.envName, o.name)
+}
+
+func (o *deploySvcOpts) deployBlueGreen(stackName string, force bool) error {
+	err := o.svcCFN.DeployBlueGreen(os.Stderr, cloudformation.BlueGreenDeploymentInput{
+		StackName:              stackName,
+		DeployedTaskDefinition: o.deployedTaskDef,
+		Force:                  force,
+	})
+	if err != nil {
+		return fmt.Errorf("shift traffic to the new tasks of service %s: %w", o.name, err)
+	}
 	return nil
 }
 
@@ -659,13 +1340,116 @@ func (o *deploySvcOpts) forceDeploy() error {
 	return nil
 }
 
-func validateLBSvcAliasAndAppVersion(svcName string, aliases manifest.Alias, app *config.Application, envName string, appVersionGetter versionGetter) error {
-	if aliases.IsEmpty() {
+// validateListenerRules returns an error if the routing rules of the service conflict with the
+// listener rules of other services in the environment, so that the deployment fails before
+// CloudFormation tries to create the rules.
+func (o *deploySvcOpts) validateListenerRules(mft *manifest.LoadBalancedWebService, https bool) error {
+	outputs, err := o.envOutputs.Outputs()
+	if err != nil {
+		return fmt.Errorf("get outputs of environment %s: %w", o.envName, err)
+	}
+	key := envOutputHTTPListenerARN
+	switch {
+	case mft.RoutingRule.IsInternal():
+		key = envOutputInternalHTTPListenerARN
+	case https:
+		key = envOutputHTTPSListenerARN
+	}
+	listenerARN, ok := outputs[key]
+	if !ok {
+		// The load balancer is created along with the first service that uses it, there are no rules yet.
 		return nil
 	}
-	aliasList, err := aliases.ToStringSlice()
+	rules, err := o.listenerRules.ListenerRules(listenerARN)
 	if err != nil {
-		return fmt.Errorf(`convert 'http.alias' to string slice: %w`, err)
+		return fmt.Errorf("get listener rules of environment %s: %w", o.envName, err)
+	}
+	return validateRoutingRules(o.name, mft.RoutingRule.Rules(), rules, key != envOutputHTTPSListenerARN)
+}
+
+// validateRoutingRules returns an error if another service's listener rule routes the same path as one of the routing rules,
+// or if the service routes the root path last while another service already does.
+func validateRoutingRules(svcName string, routes []manifest.RoutingRule, listenerRules []*elbv2.ListenerRule, rootPathLast bool) error {
+	var others []*elbv2.ListenerRule
+	for _, rule := range listenerRules {
+		if owner, ok := rule.TargetGroupTags[deploy.ServiceTagKey]; ok && owner != svcName {
+			others = append(others, rule)
+		}
+	}
+	for _, route := range routes {
+		path := aws.StringValue(route.Path)
+		if rootPathLast && strings.Trim(path, "/") == "" {
+			for _, other := range others {
+				if other.Priority == rootListenerRulePriority {
+					return fmt.Errorf(`path "/" of service %s conflicts with service %s, which already routes "/" in the environment`, svcName, other.TargetGroupTags[deploy.ServiceTagKey])
+				}
+			}
+		}
+		if len(route.AllowedSourceIps) > 0 || len(route.Headers) > 0 || len(route.QueryStrings) > 0 {
+			// Requests are also matched on other conditions, so the path alone doesn't conflict.
+			continue
+		}
+		var hosts []string
+		if !route.Alias.IsEmpty() {
+			aliases, err := route.Alias.ToStringSlice()
+			if err != nil {
+				return fmt.Errorf(`convert 'http.alias' to string slice: %w`, err)
+			}
+			hosts = aliases
+		} else if !rootPathLast {
+			// Without aliases, HTTPS rules match on the service's own domain name.
+			continue
+		}
+		patterns := template.HTTPRuleOpts{Path: path}.PathPatterns()
+		for _, other := range others {
+			if other.HasOtherConditions || !sameElements(patterns, other.PathPatterns) || !hostsOverlap(hosts, other.HostHeaders) {
+				continue
+			}
+			return fmt.Errorf(`path "%s" of service %s is already routed by service %s in the environment`, path, svcName, other.TargetGroupTags[deploy.ServiceTagKey])
+		}
+	}
+	return nil
+}
+
+func sameElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, el := range a {
+		if !contains(el, b) {
+			return false
+		}
+	}
+	return true
+}
+
+// hostsOverlap returns true if both rules match any host, or if they match a host in common.
+func hostsOverlap(a, b []string) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	for _, host := range a {
+		if contains(host, b) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateLBSvcAliasAndAppVersion(svcName string, rules []manifest.RoutingRule, app *config.Application, envName string, appVersionGetter versionGetter) error {
+	var aliasList []string
+	for _, rule := range rules {
+		if rule.Alias.IsEmpty() {
+			continue
+		}
+		aliases, err := rule.Alias.ToStringSlice()
+		if err != nil {
+			return fmt.Errorf(`convert 'http.alias' to string slice: %w`, err)
+		}
+		aliasList = append(aliasList, aliases...)
+	}
+	if len(aliasList) == 0 {
+		return nil
 	}
 	if err := validateAppVersion(app.Name, appVersionGetter); err != nil {
 		logAppVersionOutdatedError(svcName)
@@ -709,6 +1493,70 @@ func validateLBSvcAliasAndAppVersion(svcName string, aliases manifest.Alias, app
 	return nil
 }
 
+// validateLBSvcForImportedCerts returns an error if the service can't be served by an environment with imported certificates.
+// Copilot doesn't manage the DNS records of such environments, so every rule must route requests by alias.
+func validateLBSvcForImportedCerts(mft *manifest.LoadBalancedWebService, envName string) error {
+	svcName := aws.StringValue(mft.Name)
+	if !mft.NLBConfig.IsEmpty() {
+		return fmt.Errorf(`cannot deploy service %s with "nlb" to environment %s with imported certificates`, svcName, envName)
+	}
+	if mft.RoutingRule.IsInternal() {
+		return nil
+	}
+	for _, rule := range mft.RoutingRule.Rules() {
+		if rule.Alias.IsEmpty() {
+			return fmt.Errorf(`cannot deploy service %s without "http.alias" to environment %s with imported certificates`, svcName, envName)
+		}
+	}
+	return nil
+}
+
+// validateLBSvcForNoDomain returns an error if the service can't be served by an environment without a domain name.
+// The TLS listener of a network load balancer uses the certificate of the environment's domain.
+func validateLBSvcForNoDomain(mft *manifest.LoadBalancedWebService, envName string) error {
+	if mft.NLBConfig.IsEmpty() {
+		return nil
+	}
+	_, protocol, err := mft.NLBConfig.ListenerPortAndProtocol()
+	if err != nil {
+		return fmt.Errorf(`parse "nlb.port": %w`, err)
+	}
+	if protocol == manifest.NLBListenerProtocolTLS {
+		return fmt.Errorf(`cannot deploy service %s with a %s "nlb.port" to environment %s without a domain name`, aws.StringValue(mft.Name), protocol, envName)
+	}
+	return nil
+}
+
+// validateWorkloadForPrivateOnlyEnv returns an error if the workload can't run in an environment with a "private_only" VPC.
+// Such an environment has no public subnets and no public load balancer.
+func validateWorkloadForPrivateOnlyEnv(mft interface{}, envName string) error {
+	var name string
+	var network manifest.NetworkConfig
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		name, network = aws.StringValue(t.Name), t.Network
+		if !t.NLBConfig.IsEmpty() {
+			return fmt.Errorf(`cannot deploy service %s with "nlb" to environment %s with a "private_only" VPC`, name, envName)
+		}
+		if !t.RoutingRule.IsInternal() {
+			return fmt.Errorf(`cannot deploy service %s behind a public load balancer to environment %s with a "private_only" VPC: set "http.internal" to true`, name, envName)
+		}
+	case *manifest.BackendService:
+		name, network = aws.StringValue(t.Name), t.Network
+	case *manifest.WorkerService:
+		name, network = aws.StringValue(t.Name), t.Network
+	case *manifest.ScheduledJob:
+		name, network = aws.StringValue(t.Name), t.Network
+	default:
+		// Request-Driven Web Services run on App Runner, outside of the environment's VPC.
+		return nil
+	}
+	if network.IsPublic() {
+		return fmt.Errorf(`cannot deploy %s in public subnets to environment %s with a "private_only" VPC: set "network.vpc.placement" to "private"`, name, envName)
+	}
+	return nil
+}
+
 func checkUnsupportedRDSvcAlias(alias, envName string, app *config.Application) error {
 	var regEnvHostedZone, regAppHostedZone *regexp.Regexp
 	var err error
@@ -841,6 +1689,11 @@ func (o *deploySvcOpts) uriRecommendedActions() ([]string, error) {
 	recs := []string{
 		fmt.Sprintf("You can access your service at %s %s", color.HighlightResource(uri), network),
 	}
+	if lbws, ok := o.appliedManifest.(*manifest.LoadBalancedWebService); ok && o.targetEnvironment.HasImportedCerts() && !lbws.RoutingRule.IsInternal() {
+		recs = append(recs, fmt.Sprintf(`Copilot doesn't manage the DNS records of environment %s.
+    Run %s to see the load balancer DNS name that each alias needs a CNAME record pointing to.`,
+			o.targetEnvironment.Name, color.HighlightCode(fmt.Sprintf("copilot svc show --name %s", o.name))))
+	}
 	if o.rdSvcAlias != "" {
 		recs = append(recs, fmt.Sprintf(`The validation process for https://%s can take more than 15 minutes.
     Please visit %s to check the validation status.`, o.rdSvcAlias, color.Emphasize("https://console.aws.amazon.com/apprunner/home")))
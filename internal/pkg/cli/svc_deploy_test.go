@@ -14,7 +14,9 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
 	"github.com/aws/copilot-cli/internal/pkg/term/log"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -22,6 +24,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	deploycfn "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/golang/mock/gomock"
@@ -36,9 +39,15 @@ type deploySvcMocks struct {
 	mockAppResourcesGetter *mocks.MockappResourcesGetter
 	mockAppVersionGetter   *mocks.MockversionGetter
 	mockEndpointGetter     *mocks.MockendpointGetter
+	mockPublicCIDRBlocks   *mocks.MockpublicCIDRBlocksGetter
+	mockEnvOutputs         *mocks.MockenvOutputsGetter
+	mockListenerRules      *mocks.MocklistenerRulesDescriber
 	mockServiceDeployer    *mocks.MockserviceDeployer
 	mockSpinner            *mocks.Mockprogress
 	mockServiceUpdater     *mocks.MockserviceUpdater
+	mockDeployStore        *mocks.MockdeployedEnvironmentLister
+	mockTaskDefGetter      *mocks.MockserviceTaskDefinitionGetter
+	mockImageDigests       *mocks.MockimageDigestGetter
 }
 
 func TestSvcDeployOpts_Validate(t *testing.T) {
@@ -234,6 +243,15 @@ image:
 	mockManifestWithGoodPlatform := []byte(`name: serviceA
 type: 'Load Balanced Web Service'
 platform: linux/amd64
+image:
+  build:
+    dockerfile: path/to/Dockerfile
+    context: path
+  port: 80
+`)
+	mockManifestWithMultiplePlatforms := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+platform: [linux/arm64, linux/amd64]
 image:
   build:
     dockerfile: path/to/Dockerfile
@@ -259,13 +277,98 @@ image:
     dockerfile: path/to/Dockerfile
   port: 80`)
 
+	mockMftImmutableTags := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  port: 80
+  repository:
+    immutable_tags: true
+`)
+
 	tests := map[string]struct {
-		inputSvc   string
-		setupMocks func(mocks deploySvcMocks)
+		inputSvc      string
+		inputPromoted string
+		inputTag      string
+		inputApp      *config.Application
+		setupMocks    func(mocks deploySvcMocks)
 
 		wantErr      error
 		wantedDigest string
 	}{
+		"should not tag the image with latest if the application's repositories have immutable tags": {
+			inputSvc: "serviceA",
+			inputTag: "v1.0.0",
+			inputApp: &config.Application{
+				Name: "phonetool",
+				ImageRepository: &config.ImageRepository{
+					ImmutableTags: aws.Bool(true),
+				},
+			},
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftBuildString, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "v1.0.0").Return("", &ecr.ErrImageNotFound{}),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile:    filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:       filepath.Join("/ws", "root", "path", "to"),
+						Tags:          []string{"v1.0.0"},
+						ExcludeLatest: true,
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should deploy the image already pushed with an immutable tag without building and pushing": {
+			inputSvc: "serviceA",
+			inputTag: "v1.0.0",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftImmutableTags, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "v1.0.0").Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
+		"should return error if the image pushed with an immutable tag can't be looked up": {
+			inputSvc: "serviceA",
+			inputTag: "v1.0.0",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftImmutableTags, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "v1.0.0").Return("", mockError),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: fmt.Errorf("get digest of image phonetool/serviceA:v1.0.0: %w", mockError),
+		},
+		"should return error if the repository has immutable tags but the image isn't tagged": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockMftImmutableTags, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantErr: errors.New("the image repository of serviceA has immutable tags: specify a unique image tag with --tag"),
+		},
+		"should deploy a promoted image without building and pushing": {
+			inputSvc:      "serviceA",
+			inputPromoted: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifest, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
 		"should return error if ws ReadFile returns error": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -292,7 +395,7 @@ image:
 					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifestWithBadPlatform, nil),
 				)
 			},
-			wantErr: fmt.Errorf("unmarshal service serviceA manifest: unmarshal to load balanced web service: validate platform: platform %s is invalid; the valid platform is: %s", "linus/abc123", "linux/amd64"),
+			wantErr: fmt.Errorf("unmarshal service serviceA manifest: unmarshal to load balanced web service: validate platform: platform %s is invalid; valid platforms are: %s", "linus/abc123", "linux/amd64 and linux/arm64"),
 		},
 		"success with valid platform": {
 			inputSvc: "serviceA",
@@ -309,6 +412,22 @@ image:
 			},
 			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
 		},
+		"success with multiple platforms": {
+			inputSvc: "serviceA",
+			setupMocks: func(m deploySvcMocks) {
+				gomock.InOrder(
+					m.mockWs.EXPECT().ReadServiceManifest("serviceA").Return(mockManifestWithMultiplePlatforms, nil),
+					m.mockWs.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil),
+					m.mockimageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+						Dockerfile: filepath.Join("/ws", "root", "path", "to", "Dockerfile"),
+						Context:    filepath.Join("/ws", "root", "path"),
+						Platform:   "linux/arm64",
+						Platforms:  []string{"linux/arm64", "linux/amd64"},
+					}).Return("sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49", nil),
+				)
+			},
+			wantedDigest: "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49",
+		},
 		"success without building and pushing": {
 			inputSvc: "serviceA",
 			setupMocks: func(m deploySvcMocks) {
@@ -381,18 +500,25 @@ image:
 
 			mockWorkspace := mocks.NewMockwsSvcDirReader(ctrl)
 			mockimageBuilderPusher := mocks.NewMockimageBuilderPusher(ctrl)
+			mockImageDigests := mocks.NewMockimageDigestGetter(ctrl)
 			mocks := deploySvcMocks{
 				mockWs:                 mockWorkspace,
 				mockimageBuilderPusher: mockimageBuilderPusher,
+				mockImageDigests:       mockImageDigests,
 			}
 			test.setupMocks(mocks)
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
-					name: test.inputSvc,
+					appName:  "phonetool",
+					name:     test.inputSvc,
+					imageTag: test.inputTag,
 				},
-				unmarshal:          manifest.UnmarshalWorkload,
-				imageBuilderPusher: mockimageBuilderPusher,
-				ws:                 mockWorkspace,
+				unmarshal:           manifest.UnmarshalWorkload,
+				imageBuilderPusher:  mockimageBuilderPusher,
+				imageDigests:        mockImageDigests,
+				ws:                  mockWorkspace,
+				promotedImageDigest: test.inputPromoted,
+				targetApp:           test.inputApp,
 			}
 
 			gotErr := opts.configureContainerImage()
@@ -407,6 +533,581 @@ image:
 	}
 }
 
+func TestSvcDeployOpts_configureContainerImage_immutableTagToTwoEnvironments(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockWorkspace := mocks.NewMockwsSvcDirReader(ctrl)
+	mockImageBuilderPusher := mocks.NewMockimageBuilderPusher(ctrl)
+	mockImageDigests := mocks.NewMockimageDigestGetter(ctrl)
+	const digest = "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	mockWorkspace.EXPECT().ReadServiceManifest("serviceA").Return([]byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  build: path/to/Dockerfile
+  port: 80
+  repository:
+    immutable_tags: true
+`), nil).Times(2)
+	mockWorkspace.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil).Times(2)
+	gomock.InOrder(
+		// The first environment pushes the image with the tag.
+		mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "v1.0.0").Return("", &ecr.ErrImageNotFound{}),
+		mockImageBuilderPusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return(digest, nil),
+		// The second environment can't push the tag again and deploys the pushed image instead.
+		mockImageDigests.EXPECT().ImageDigest("phonetool/serviceA", "v1.0.0").Return(digest, nil),
+	)
+
+	for _, env := range []string{"test", "prod"} {
+		opts := deploySvcOpts{
+			deployWkldVars: deployWkldVars{
+				appName:  "phonetool",
+				name:     "serviceA",
+				envName:  env,
+				imageTag: "v1.0.0",
+			},
+			unmarshal:          manifest.UnmarshalWorkload,
+			imageBuilderPusher: mockImageBuilderPusher,
+			imageDigests:       mockImageDigests,
+			ws:                 mockWorkspace,
+		}
+
+		// WHEN
+		err := opts.configureContainerImage()
+
+		// THEN
+		require.NoError(t, err, "deploy to environment %s", env)
+		require.Equal(t, digest, opts.imageDigest, "deploy to environment %s", env)
+		require.True(t, opts.buildRequired, "deploy to environment %s", env)
+	}
+}
+
+func Test_buildAndPushImage(t *testing.T) {
+	const digest = "sha256:741d3e95eefa2c3b594f970a938ed6e497b50b3541a5fdc28af3ad8959e76b49"
+	immutableRepo := manifest.ImageRepository{ImmutableTags: aws.Bool(true)}
+	testCases := map[string]struct {
+		inRepo manifest.ImageRepository
+		inTags []string
+		mock   func(builder *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter)
+
+		wantedDigest string
+		wantedErr    error
+	}{
+		"builds and pushes the image if tags are mutable": {
+			inTags: []string{"v1.0.0"},
+			mock: func(builder *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter) {
+				builder.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{Tags: []string{"v1.0.0"}}).Return(digest, nil)
+			},
+			wantedDigest: digest,
+		},
+		"error if the repository has immutable tags and no image tag is specified": {
+			inRepo:    immutableRepo,
+			mock:      func(builder *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter) {},
+			wantedErr: errors.New("the image repository of frontend has immutable tags: specify a unique image tag with --tag"),
+		},
+		"reuses the image already pushed with an immutable tag": {
+			inRepo: immutableRepo,
+			inTags: []string{"v1.0.0"},
+			mock: func(builder *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter) {
+				digests.EXPECT().ImageDigest("phonetool/frontend", "v1.0.0").Return(digest, nil)
+			},
+			wantedDigest: digest,
+		},
+		"pushes the image without the latest tag if the immutable tag isn't pushed yet": {
+			inRepo: immutableRepo,
+			inTags: []string{"v1.0.0"},
+			mock: func(builder *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter) {
+				digests.EXPECT().ImageDigest("phonetool/frontend", "v1.0.0").Return("", &ecr.ErrImageNotFound{})
+				builder.EXPECT().BuildAndPush(gomock.Any(), &dockerengine.BuildArguments{
+					Tags:          []string{"v1.0.0"},
+					ExcludeLatest: true,
+				}).Return(digest, nil)
+			},
+			wantedDigest: digest,
+		},
+		"wraps the build error": {
+			inTags: []string{"v1.0.0"},
+			mock: func(builder *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter) {
+				builder.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedErr: errors.New("build and push image: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockBuilder := mocks.NewMockimageBuilderPusher(ctrl)
+			mockDigests := mocks.NewMockimageDigestGetter(ctrl)
+			tc.mock(mockBuilder, mockDigests)
+
+			// WHEN
+			got, err := buildAndPushImage(buildAndPushImageInput{
+				appName:       "phonetool",
+				wlName:        "frontend",
+				repo:          tc.inRepo,
+				args:          &dockerengine.BuildArguments{Tags: tc.inTags},
+				imageDigests:  mockDigests,
+				builderPusher: mockBuilder,
+			})
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, got)
+		})
+	}
+}
+
+func TestSvcDeployOpts_configureSidecarImages(t *testing.T) {
+	mockError := errors.New("some error")
+	mockApp := &config.Application{
+		Name: "phonetool",
+	}
+	mockManifest := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  location: foo/bar
+  port: 80
+sidecars:
+  xray:
+    image: amazon/aws-xray-daemon
+  proxy:
+    image:
+      build:
+        dockerfile: proxy/Dockerfile
+        context: proxy
+        target: prod
+`)
+	mockMftNoSidecarBuild := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  location: foo/bar
+  port: 80
+sidecars:
+  xray:
+    image: amazon/aws-xray-daemon
+`)
+	mockMftImmutableTags := []byte(`name: serviceA
+type: 'Load Balanced Web Service'
+image:
+  location: foo/bar
+  port: 80
+  repository:
+    immutable_tags: true
+sidecars:
+  proxy:
+    image:
+      build:
+        dockerfile: proxy/Dockerfile
+        target: prod
+`)
+	wantedArgs := &dockerengine.BuildArguments{
+		Dockerfile: filepath.Join("/ws", "root", "proxy", "Dockerfile"),
+		Context:    filepath.Join("/ws", "root", "proxy"),
+		Target:     "prod",
+		Tags:       []string{"v1.0.0"},
+	}
+
+	tests := map[string]struct {
+		inManifest         []byte
+		inPromotedDigest   string
+		inPromotedSidecars map[string]string
+		setupMocks         func(ws *mocks.MockwsSvcDirReader, repos *mocks.MocksidecarRepoAdder, pusher *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter, spinner *mocks.Mockprogress)
+
+		wantErr           error
+		wantedSidecarRepo string
+		wantedDigests     map[string]string
+	}{
+		"no-op if no sidecar is built from a Dockerfile": {
+			inManifest: mockMftNoSidecarBuild,
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, repos *mocks.MocksidecarRepoAdder, pusher *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter, spinner *mocks.Mockprogress) {
+				ws.EXPECT().CopilotDirPath().Times(0)
+				repos.EXPECT().AddSidecarsToApp(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		"should return error if fail to add sidecar repositories": {
+			inManifest: mockManifest,
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, repos *mocks.MocksidecarRepoAdder, pusher *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter, spinner *mocks.Mockprogress) {
+				ws.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil).Times(2)
+				spinner.EXPECT().Start(gomock.Any())
+				repos.EXPECT().AddSidecarsToApp(mockApp, "serviceA", []string{"proxy"}).Return(mockError)
+				spinner.EXPECT().Stop(gomock.Any())
+				pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantErr: errors.New("add sidecar repositories to application phonetool: some error"),
+		},
+		"should return error if fail to build and push a sidecar image": {
+			inManifest: mockManifest,
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, repos *mocks.MocksidecarRepoAdder, pusher *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter, spinner *mocks.Mockprogress) {
+				ws.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil).Times(2)
+				spinner.EXPECT().Start(gomock.Any())
+				repos.EXPECT().AddSidecarsToApp(mockApp, "serviceA", []string{"proxy"}).Return(nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				pusher.EXPECT().BuildAndPush(gomock.Any(), wantedArgs).Return("", mockError)
+			},
+			wantedSidecarRepo: "proxy",
+			wantErr:           errors.New("build and push image of sidecar proxy: some error"),
+		},
+		"reuses the sidecar images of the promoted environment without building them": {
+			inManifest:         mockManifest,
+			inPromotedDigest:   "sha256:5678",
+			inPromotedSidecars: map[string]string{"proxy": "sha256:abcd"},
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, repos *mocks.MocksidecarRepoAdder, pusher *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter, spinner *mocks.Mockprogress) {
+				ws.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil).Times(2)
+				repos.EXPECT().AddSidecarsToApp(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigests: map[string]string{
+				"proxy": "sha256:abcd",
+			},
+		},
+		"reuses the sidecar image already pushed with an immutable tag without building it": {
+			inManifest: mockMftImmutableTags,
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, repos *mocks.MocksidecarRepoAdder, pusher *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter, spinner *mocks.Mockprogress) {
+				ws.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil).Times(2)
+				spinner.EXPECT().Start(gomock.Any())
+				repos.EXPECT().AddSidecarsToApp(mockApp, "serviceA", []string{"proxy"}).Return(nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				digests.EXPECT().ImageDigest("phonetool/serviceA/proxy", "v1.0.0").Return("sha256:1234", nil)
+				pusher.EXPECT().BuildAndPush(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigests: map[string]string{
+				"proxy": "sha256:1234",
+			},
+		},
+		"success": {
+			inManifest: mockManifest,
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, repos *mocks.MocksidecarRepoAdder, pusher *mocks.MockimageBuilderPusher, digests *mocks.MockimageDigestGetter, spinner *mocks.Mockprogress) {
+				ws.EXPECT().CopilotDirPath().Return("/ws/root/copilot", nil).Times(2)
+				spinner.EXPECT().Start(gomock.Any())
+				repos.EXPECT().AddSidecarsToApp(mockApp, "serviceA", []string{"proxy"}).Return(nil)
+				spinner.EXPECT().Stop(gomock.Any())
+				pusher.EXPECT().BuildAndPush(gomock.Any(), wantedArgs).Return("sha256:1234", nil)
+			},
+			wantedSidecarRepo: "proxy",
+			wantedDigests: map[string]string{
+				"proxy": "sha256:1234",
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockWs := mocks.NewMockwsSvcDirReader(ctrl)
+			mockRepos := mocks.NewMocksidecarRepoAdder(ctrl)
+			mockPusher := mocks.NewMockimageBuilderPusher(ctrl)
+			mockDigests := mocks.NewMockimageDigestGetter(ctrl)
+			mockSpinner := mocks.NewMockprogress(ctrl)
+			mockWs.EXPECT().ReadServiceManifest("serviceA").Return(tc.inManifest, nil)
+			tc.setupMocks(mockWs, mockRepos, mockPusher, mockDigests, mockSpinner)
+
+			var gotSidecarRepo string
+			opts := deploySvcOpts{
+				deployWkldVars: deployWkldVars{
+					name:     "serviceA",
+					imageTag: "v1.0.0",
+				},
+				unmarshal:              manifest.UnmarshalWorkload,
+				ws:                     mockWs,
+				sidecarRepos:           mockRepos,
+				imageDigests:           mockDigests,
+				spinner:                mockSpinner,
+				targetApp:              mockApp,
+				promotedImageDigest:    tc.inPromotedDigest,
+				promotedSidecarDigests: tc.inPromotedSidecars,
+				newSidecarImageBuilderPusher: func(sidecar string) (imageBuilderPusher, error) {
+					gotSidecarRepo = sidecar
+					return mockPusher, nil
+				},
+			}
+
+			gotErr := opts.configureSidecarImages()
+
+			require.Equal(t, tc.wantedSidecarRepo, gotSidecarRepo)
+			if tc.wantErr != nil {
+				require.EqualError(t, gotErr, tc.wantErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+				require.Equal(t, tc.wantedDigests, opts.sidecarImageDigests)
+			}
+		})
+	}
+}
+
+func Test_updateImageRepository(t *testing.T) {
+	mockApp := &config.Application{
+		Name: "phonetool",
+	}
+	testCases := map[string]struct {
+		inRepo     manifest.ImageRepository
+		setupMocks func(updater *mocks.MockimageRepositoryUpdater, spinner *mocks.Mockprogress)
+
+		wantedErr error
+	}{
+		"reverts to the application settings if the manifest doesn't override any": {
+			inRepo: manifest.ImageRepository{
+				ScanFindings: manifest.ImageScanFindings{
+					Block: aws.Bool(true),
+				},
+			},
+			setupMocks: func(updater *mocks.MockimageRepositoryUpdater, spinner *mocks.Mockprogress) {
+				updater.EXPECT().UpdateImageRepository(mockApp, "frontend", nil).Return(nil)
+				spinner.EXPECT().Start(gomock.Any()).Times(0)
+			},
+		},
+		"applies the settings of the manifest": {
+			inRepo: manifest.ImageRepository{
+				KeepImages:    aws.Int(10),
+				ImmutableTags: aws.Bool(true),
+			},
+			setupMocks: func(updater *mocks.MockimageRepositoryUpdater, spinner *mocks.Mockprogress) {
+				gomock.InOrder(
+					spinner.EXPECT().Start(fmt.Sprintf(fmtUpdateImageRepoStart, "frontend")),
+					updater.EXPECT().UpdateImageRepository(mockApp, "frontend", &stack.ImageRepositoryConfig{
+						KeepImages:    aws.Int(10),
+						ImmutableTags: aws.Bool(true),
+					}).Return(nil),
+					spinner.EXPECT().Stop(log.Ssuccessf(fmtUpdateImageRepoComplete, "frontend")),
+				)
+			},
+		},
+		"wraps the error if the repositories fail to update": {
+			inRepo: manifest.ImageRepository{
+				ScanOnPush: aws.Bool(true),
+			},
+			setupMocks: func(updater *mocks.MockimageRepositoryUpdater, spinner *mocks.Mockprogress) {
+				gomock.InOrder(
+					spinner.EXPECT().Start(gomock.Any()),
+					updater.EXPECT().UpdateImageRepository(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error")),
+					spinner.EXPECT().Stop(log.Serrorf(fmtUpdateImageRepoFailed, "frontend")),
+				)
+			},
+			wantedErr: errors.New("apply the image repository settings of frontend: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			updater := mocks.NewMockimageRepositoryUpdater(ctrl)
+			spinner := mocks.NewMockprogress(ctrl)
+			tc.setupMocks(updater, spinner)
+
+			err := updateImageRepository(updateImageRepositoryInput{
+				app:     mockApp,
+				wlName:  "frontend",
+				repo:    tc.inRepo,
+				updater: updater,
+				spinner: spinner,
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_checkImageScanFindings(t *testing.T) {
+	findings := []ecr.ScanFinding{
+		{Name: "CVE-1", Severity: "CRITICAL"},
+		{Name: "CVE-2", Severity: "MEDIUM"},
+		{Name: "CVE-3", Severity: "UNDEFINED"},
+	}
+	testCases := map[string]struct {
+		inFindings manifest.ImageScanFindings
+		mockScan   func(m *mocks.MockimageScanner)
+
+		wantedErr error
+	}{
+		"does not block the deployment by default": {
+			mockScan: func(m *mocks.MockimageScanner) {
+				m.EXPECT().ImageScanFindings("phonetool/frontend", "sha256:abc").Return(findings, nil)
+			},
+		},
+		"blocks the deployment with findings of the configured severity or higher": {
+			inFindings: manifest.ImageScanFindings{
+				Severity: aws.String("medium"),
+				Block:    aws.Bool(true),
+			},
+			mockScan: func(m *mocks.MockimageScanner) {
+				m.EXPECT().ImageScanFindings("phonetool/frontend", "sha256:abc").Return(findings, nil)
+			},
+			wantedErr: errors.New("deployment of frontend blocked by 2 image scan findings of severity MEDIUM or higher"),
+		},
+		"does not block the deployment without findings of the configured severity": {
+			inFindings: manifest.ImageScanFindings{
+				Block: aws.Bool(true),
+			},
+			mockScan: func(m *mocks.MockimageScanner) {
+				m.EXPECT().ImageScanFindings(gomock.Any(), gomock.Any()).Return(findings[1:], nil)
+			},
+		},
+		"ignores scan errors unless the findings block the deployment": {
+			mockScan: func(m *mocks.MockimageScanner) {
+				m.EXPECT().ImageScanFindings(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+		},
+		"returns scan errors if the findings block the deployment": {
+			inFindings: manifest.ImageScanFindings{
+				Block: aws.Bool(true),
+			},
+			mockScan: func(m *mocks.MockimageScanner) {
+				m.EXPECT().ImageScanFindings(gomock.Any(), gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get scan findings of image sha256:abc: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			scanner := mocks.NewMockimageScanner(ctrl)
+			tc.mockScan(scanner)
+			spinner := mocks.NewMockprogress(ctrl)
+			spinner.EXPECT().Start(fmt.Sprintf(fmtImageScanStart, "frontend"))
+			spinner.EXPECT().Stop(gomock.Any())
+
+			err := checkImageScanFindings(imageScanFindingsInput{
+				wlName:   "frontend",
+				repoName: "phonetool/frontend",
+				digest:   "sha256:abc",
+				findings: tc.inFindings,
+				scanner:  scanner,
+				spinner:  spinner,
+			})
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_sidecarECRImages(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "phonetool",
+		AccountID: "123456789012",
+	}
+	testCases := map[string]struct {
+		inDigests   map[string]string
+		inResources *stack.AppRegionalResources
+
+		wanted    map[string]stack.ECRImage
+		wantedErr error
+	}{
+		"nil if no sidecar image was pushed": {
+			inResources: &stack.AppRegionalResources{},
+		},
+		"error if the sidecar repository is not found": {
+			inDigests: map[string]string{
+				"proxy": "sha256:1234",
+			},
+			inResources: &stack.AppRegionalResources{
+				RepositoryURLs: map[string]string{
+					"serviceA": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/serviceA",
+				},
+			},
+			wantedErr: &errRepoNotFound{
+				wlName:       "serviceA/proxy",
+				envRegion:    "us-west-2",
+				appAccountID: "123456789012",
+			},
+		},
+		"pins the pushed digests": {
+			inDigests: map[string]string{
+				"proxy": "sha256:1234",
+			},
+			inResources: &stack.AppRegionalResources{
+				RepositoryURLs: map[string]string{
+					"serviceA":       "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/serviceA",
+					"serviceA/proxy": "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/serviceA/proxy",
+				},
+			},
+			wanted: map[string]stack.ECRImage{
+				"proxy": {
+					RepoURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com/phonetool/serviceA/proxy",
+					Digest:  "sha256:1234",
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := sidecarECRImages("serviceA", tc.inDigests, tc.inResources, mockApp, "us-west-2")
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func Test_buildInputsHash(t *testing.T) {
+	hash := func(wsRoot string, args *dockerengine.BuildArguments) string {
+		h, err := buildInputsHash(wsRoot, args)
+		require.NoError(t, err)
+		return h
+	}
+	mockArgs := func(opts ...func(*dockerengine.BuildArguments)) *dockerengine.BuildArguments {
+		args := &dockerengine.BuildArguments{
+			Dockerfile: "/ws/api/Dockerfile",
+			Context:    "/ws/api",
+			Args:       map[string]string{"FLAVOR": "prod"},
+			Platform:   "linux/amd64",
+			Tags:       []string{"v1.2.0"},
+		}
+		for _, opt := range opts {
+			opt(args)
+		}
+		return args
+	}
+
+	t.Run("is the same for workspaces in different directories", func(t *testing.T) {
+		other := mockArgs(func(args *dockerengine.BuildArguments) {
+			args.Dockerfile = "/home/user/ws/api/Dockerfile"
+			args.Context = "/home/user/ws/api"
+		})
+		require.Equal(t, hash("/ws", mockArgs()), hash("/home/user/ws", other))
+	})
+	t.Run("ignores the image tags", func(t *testing.T) {
+		other := mockArgs(func(args *dockerengine.BuildArguments) {
+			args.Tags = []string{"v1.3.0"}
+		})
+		require.Equal(t, hash("/ws", mockArgs()), hash("/ws", other))
+	})
+	t.Run("changes with the build target", func(t *testing.T) {
+		other := mockArgs(func(args *dockerengine.BuildArguments) {
+			args.Target = "release"
+		})
+		require.NotEqual(t, hash("/ws", mockArgs()), hash("/ws", other))
+	})
+	t.Run("changes with the build args", func(t *testing.T) {
+		other := mockArgs(func(args *dockerengine.BuildArguments) {
+			args.Args = map[string]string{"FLAVOR": "test"}
+		})
+		require.NotEqual(t, hash("/ws", mockArgs()), hash("/ws", other))
+	})
+}
+
 func TestSvcDeployOpts_pushAddonsTemplateToS3Bucket(t *testing.T) {
 	mockError := errors.New("some error")
 	tests := map[string]struct {
@@ -570,6 +1271,8 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 	)
 	tests := map[string]struct {
 		inAliases      manifest.Alias
+		inNLB          manifest.NetworkLoadBalancerConfiguration
+		inBlueGreen    manifest.BlueGreenDeployment
 		inApp          *config.Application
 		inEnvironment  *config.Environment
 		inBuildRequire bool
@@ -677,6 +1380,66 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			},
 			wantErr: fmt.Errorf(`alias "v1.v2.mockDomain" is not supported in hosted zones managed by Copilot`),
 		},
+		"fail to get public CIDR blocks": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443/tcp"),
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockPublicCIDRBlocks.EXPECT().PublicCIDRBlocks().Return(nil, mockError)
+			},
+			wantErr: fmt.Errorf("get public CIDR blocks of environment mockEnv: some error"),
+		},
+		"fail if another service already routes the root path last": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockEnvOutputs.EXPECT().Outputs().Return(map[string]string{
+					"HTTPListenerArn": "mockListener",
+				}, nil)
+				m.mockListenerRules.EXPECT().ListenerRules("mockListener").Return([]*elbv2.ListenerRule{
+					{
+						Priority:        "50000",
+						PathPatterns:    []string{"/*"},
+						TargetGroupTags: map[string]string{deploy.ServiceTagKey: "frontend"},
+					},
+				}, nil)
+			},
+			wantErr: fmt.Errorf(`path "/" of service mockSvc conflicts with service frontend, which already routes "/" in the environment`),
+		},
+		"error if the network load balancer terminates tls without a domain": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443/tls"),
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockPublicCIDRBlocks.EXPECT().PublicCIDRBlocks().Return([]string{"10.0.0.0/24", "10.0.1.0/24"}, nil)
+			},
+			wantErr: fmt.Errorf(`cannot deploy service mockSvc with a TLS "nlb.port" to environment mockEnv without a domain name`),
+		},
 		"error if fail to deploy service": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -693,6 +1456,25 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 			},
 			wantErr: fmt.Errorf("deploy service: some error"),
 		},
+		"error if the deployment circuit breaker rolled back the service": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(&deploycfn.ErrECSDeploymentRolledBack{
+					StackName:      "mockApp-mockEnv-mockSvc",
+					TaskDefinition: "mockApp-mockEnv-mockSvc:3",
+				})
+			},
+			wantErr: fmt.Errorf("deploy service: deployment circuit breaker rolled back the ECS service in stack mockApp-mockEnv-mockSvc to task definition mockApp-mockEnv-mockSvc:3"),
+		},
 		"error if change set is empty but force flag is not set": {
 			inEnvironment: &config.Environment{
 				Name:   mockEnvName,
@@ -777,6 +1559,222 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
+		"success with network load balancer": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443/tcp"),
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockPublicCIDRBlocks.EXPECT().PublicCIDRBlocks().Return([]string{"10.0.0.0/24", "10.0.1.0/24"}, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"error if an environment with imported certificates has no alias": {
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+			},
+			wantErr: errors.New(`cannot deploy service mockSvc without "http.alias" to environment mockEnv with imported certificates`),
+		},
+		"error if an environment with imported certificates has a network load balancer": {
+			inAliases: manifest.Alias{String: aws.String("example.com")},
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443/tcp"),
+			},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockPublicCIDRBlocks.EXPECT().PublicCIDRBlocks().Return([]string{"10.0.0.0/24", "10.0.1.0/24"}, nil)
+			},
+			wantErr: errors.New(`cannot deploy service mockSvc with "nlb" to environment mockEnv with imported certificates`),
+		},
+		"success with imported certificates and an alias outside of the application's domain": {
+			inAliases: manifest.Alias{String: aws.String("example.com")},
+			inEnvironment: &config.Environment{
+				Name:   mockEnvName,
+				Region: "us-west-2",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{"mockCertARN"},
+				},
+			},
+			inApp: &config.Application{
+				Name:   mockAppName,
+				Domain: "mockDomain",
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		"error if fail to check if a blue/green service is deployed": {
+			inBlueGreen: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("all_at_once"),
+			},
+			inEnvironment: &config.Environment{
+				App:    mockAppName,
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(false, mockError)
+			},
+			wantErr: fmt.Errorf("check if service mockSvc is deployed in environment mockEnv: some error"),
+		},
+		"error if fail to shift traffic of a blue/green service": {
+			inBlueGreen: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("all_at_once"),
+			},
+			inEnvironment: &config.Environment{
+				App:    mockAppName,
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(true, nil)
+				m.mockTaskDefGetter.EXPECT().ServiceTaskDefinitionARN(mockAppName, mockEnvName, mockSvcName).Return("mockTaskDef:1", nil)
+				m.mockServiceDeployer.EXPECT().BlueGreenTrafficTargetGroup("mockApp-mockEnv-mockSvc").Return("TargetGroup", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.mockServiceDeployer.EXPECT().DeployBlueGreen(gomock.Any(), deploycfn.BlueGreenDeploymentInput{
+					StackName:              "mockApp-mockEnv-mockSvc",
+					DeployedTaskDefinition: "mockTaskDef:1",
+				}).Return(mockError)
+			},
+			wantErr: fmt.Errorf("shift traffic to the new tasks of service mockSvc: some error"),
+		},
+		"error if fail to get the target group serving traffic to a blue/green service": {
+			inBlueGreen: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("all_at_once"),
+			},
+			inEnvironment: &config.Environment{
+				App:    mockAppName,
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(true, nil)
+				m.mockTaskDefGetter.EXPECT().ServiceTaskDefinitionARN(mockAppName, mockEnvName, mockSvcName).Return("mockTaskDef:1", nil)
+				m.mockServiceDeployer.EXPECT().BlueGreenTrafficTargetGroup("mockApp-mockEnv-mockSvc").Return("", mockError)
+			},
+			wantErr: fmt.Errorf("get the target group serving traffic to service mockSvc: some error"),
+		},
+		"success with a second deployment after traffic is swapped to the green target group": {
+			inBlueGreen: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("all_at_once"),
+			},
+			inEnvironment: &config.Environment{
+				App:    mockAppName,
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(true, nil)
+				m.mockTaskDefGetter.EXPECT().ServiceTaskDefinitionARN(mockAppName, mockEnvName, mockSvcName).Return("mockTaskDef:2", nil)
+				m.mockServiceDeployer.EXPECT().BlueGreenTrafficTargetGroup("mockApp-mockEnv-mockSvc").Return("GreenTargetGroup", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.mockServiceDeployer.EXPECT().DeployBlueGreen(gomock.Any(), deploycfn.BlueGreenDeploymentInput{
+					StackName:              "mockApp-mockEnv-mockSvc",
+					DeployedTaskDefinition: "mockTaskDef:2",
+				}).Return(nil)
+			},
+		},
+		"success with a new blue/green service": {
+			inBlueGreen: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("all_at_once"),
+			},
+			inEnvironment: &config.Environment{
+				App:    mockAppName,
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(false, nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.mockServiceDeployer.EXPECT().DeployBlueGreen(gomock.Any(), deploycfn.BlueGreenDeploymentInput{
+					StackName: "mockApp-mockEnv-mockSvc",
+				}).Return(nil)
+			},
+		},
+		"success with force update of a blue/green service": {
+			inForceDeploy: true,
+			inBlueGreen: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("all_at_once"),
+			},
+			inEnvironment: &config.Environment{
+				App:    mockAppName,
+				Name:   mockEnvName,
+				Region: "us-west-2",
+			},
+			inApp: &config.Application{
+				Name: mockAppName,
+			},
+			mock: func(m *deploySvcMocks) {
+				m.mockWs.EXPECT().ReadServiceManifest(mockSvcName).Return([]byte{}, nil)
+				m.mockEndpointGetter.EXPECT().ServiceDiscoveryEndpoint().Return("mockApp.local", nil)
+				m.mockDeployStore.EXPECT().IsServiceDeployed(mockAppName, mockEnvName, mockSvcName).Return(true, nil)
+				m.mockTaskDefGetter.EXPECT().ServiceTaskDefinitionARN(mockAppName, mockEnvName, mockSvcName).Return("mockTaskDef:1", nil)
+				m.mockServiceDeployer.EXPECT().BlueGreenTrafficTargetGroup("mockApp-mockEnv-mockSvc").Return("TargetGroup", nil)
+				m.mockServiceDeployer.EXPECT().DeployService(gomock.Any(), gomock.Any(), gomock.Any()).Return(cloudformation.NewMockErrChangeSetEmpty())
+				m.mockServiceDeployer.EXPECT().DeployBlueGreen(gomock.Any(), deploycfn.BlueGreenDeploymentInput{
+					StackName:              "mockApp-mockEnv-mockSvc",
+					DeployedTaskDefinition: "mockTaskDef:1",
+					Force:                  true,
+				}).Return(nil)
+			},
+		},
 		"success with force update": {
 			inForceDeploy: true,
 			inEnvironment: &config.Environment{
@@ -808,11 +1806,17 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 				mockAppResourcesGetter: mocks.NewMockappResourcesGetter(ctrl),
 				mockAppVersionGetter:   mocks.NewMockversionGetter(ctrl),
 				mockEndpointGetter:     mocks.NewMockendpointGetter(ctrl),
+				mockPublicCIDRBlocks:   mocks.NewMockpublicCIDRBlocksGetter(ctrl),
+				mockEnvOutputs:         mocks.NewMockenvOutputsGetter(ctrl),
+				mockListenerRules:      mocks.NewMocklistenerRulesDescriber(ctrl),
 				mockServiceDeployer:    mocks.NewMockserviceDeployer(ctrl),
 				mockServiceUpdater:     mocks.NewMockserviceUpdater(ctrl),
 				mockSpinner:            mocks.NewMockprogress(ctrl),
+				mockDeployStore:        mocks.NewMockdeployedEnvironmentLister(ctrl),
+				mockTaskDefGetter:      mocks.NewMockserviceTaskDefinitionGetter(ctrl),
 			}
 			tc.mock(m)
+			m.mockEnvOutputs.EXPECT().Outputs().Return(map[string]string{}, nil).AnyTimes()
 
 			opts := deploySvcOpts{
 				deployWkldVars: deployWkldVars{
@@ -828,6 +1832,9 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 					return m.mockAppVersionGetter, nil
 				},
 				endpointGetter:    m.mockEndpointGetter,
+				publicCIDRBlocks:  m.mockPublicCIDRBlocks,
+				envOutputs:        m.mockEnvOutputs,
+				listenerRules:     m.mockListenerRules,
 				targetApp:         tc.inApp,
 				targetEnvironment: tc.inEnvironment,
 				unmarshal: func(b []byte) (manifest.WorkloadManifest, error) {
@@ -844,16 +1851,24 @@ func TestSvcDeployOpts_deploySvc(t *testing.T) {
 									Port: aws.Uint16(80),
 								},
 							},
-							RoutingRule: manifest.RoutingRule{
-								Alias: tc.inAliases,
+							RoutingRule: manifest.RoutingRuleConfiguration{
+								RoutingRule: manifest.RoutingRule{
+									Alias: tc.inAliases,
+								},
+							},
+							NLBConfig: tc.inNLB,
+							DeployConfig: manifest.LBWebServiceDeploymentConfiguration{
+								BlueGreen: tc.inBlueGreen,
 							},
 						},
 					}, nil
 				},
-				svcCFN:        m.mockServiceDeployer,
-				svcUpdater:    m.mockServiceUpdater,
-				newSvcUpdater: func(f func(*session.Session) serviceUpdater) {},
-				spinner:       m.mockSpinner,
+				svcCFN:           m.mockServiceDeployer,
+				svcUpdater:       m.mockServiceUpdater,
+				newSvcUpdater:    func(f func(*session.Session) serviceUpdater) {},
+				spinner:          m.mockSpinner,
+				deployStore:      m.mockDeployStore,
+				svcTaskDefGetter: m.mockTaskDefGetter,
 			}
 
 			gotErr := opts.deploySvc(mockAddonsURL)
@@ -1290,3 +2305,152 @@ func TestSvcDeployOpts_stackConfiguration_worker(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateRoutingRules(t *testing.T) {
+	testCases := map[string]struct {
+		inRoutes       []manifest.RoutingRule
+		inRules        []*elbv2.ListenerRule
+		inRootPathLast bool
+
+		wantedErr string
+	}{
+		"ignores the rules of the service itself": {
+			inRoutes:       []manifest.RoutingRule{{Path: aws.String("/")}, {Path: aws.String("api")}},
+			inRootPathLast: true,
+			inRules: []*elbv2.ListenerRule{
+				{Priority: "50000", PathPatterns: []string{"/*"}, TargetGroupTags: map[string]string{deploy.ServiceTagKey: "api"}},
+				{Priority: "3", PathPatterns: []string{"/api", "/api/*"}, TargetGroupTags: map[string]string{deploy.ServiceTagKey: "api"}},
+			},
+		},
+		"error if another service routes the same path": {
+			inRoutes:       []manifest.RoutingRule{{Path: aws.String("/")}, {Path: aws.String("/api")}},
+			inRootPathLast: true,
+			inRules: []*elbv2.ListenerRule{
+				{Priority: "3", PathPatterns: []string{"/api/*", "/api"}, TargetGroupTags: map[string]string{deploy.ServiceTagKey: "backend"}},
+			},
+			wantedErr: `path "/api" of service api is already routed by service backend in the environment`,
+		},
+		"no error if the other rule also matches on other conditions": {
+			inRoutes:       []manifest.RoutingRule{{Path: aws.String("api")}},
+			inRootPathLast: true,
+			inRules: []*elbv2.ListenerRule{
+				{Priority: "3", PathPatterns: []string{"/api", "/api/*"}, HasOtherConditions: true, TargetGroupTags: map[string]string{deploy.ServiceTagKey: "backend"}},
+			},
+		},
+		"no error on an HTTPS listener if the rules have different aliases": {
+			inRoutes: []manifest.RoutingRule{
+				{Path: aws.String("api"), Alias: manifest.Alias{String: aws.String("api.example.com")}},
+				{Path: aws.String("v2")},
+			},
+			inRules: []*elbv2.ListenerRule{
+				{Priority: "3", PathPatterns: []string{"/api", "/api/*"}, HostHeaders: []string{"example.com"}, TargetGroupTags: map[string]string{deploy.ServiceTagKey: "backend"}},
+				{Priority: "4", PathPatterns: []string{"/v2", "/v2/*"}, HostHeaders: []string{"backend.test.phonetool.example.com"}, TargetGroupTags: map[string]string{deploy.ServiceTagKey: "backend"}},
+			},
+		},
+		"error on an HTTPS listener if another service routes the same path and alias": {
+			inRoutes: []manifest.RoutingRule{
+				{Path: aws.String("api"), Alias: manifest.Alias{String: aws.String("example.com")}},
+			},
+			inRules: []*elbv2.ListenerRule{
+				{Priority: "3", PathPatterns: []string{"/api", "/api/*"}, HostHeaders: []string{"example.com"}, TargetGroupTags: map[string]string{deploy.ServiceTagKey: "backend"}},
+			},
+			wantedErr: `path "api" of service api is already routed by service backend in the environment`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateRoutingRules("api", tc.inRoutes, tc.inRules, tc.inRootPathLast)
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateWorkloadForPrivateOnlyEnv(t *testing.T) {
+	private := manifest.PrivateSubnetPlacement
+	public := manifest.PublicSubnetPlacement
+	testCases := map[string]struct {
+		inManifest interface{}
+
+		wantedErr string
+	}{
+		"error if a load balanced web service is behind the public load balancer": {
+			inManifest: &manifest.LoadBalancedWebService{
+				Workload: manifest.Workload{Name: aws.String("api")},
+			},
+			wantedErr: `cannot deploy service api behind a public load balancer to environment test with a "private_only" VPC: set "http.internal" to true`,
+		},
+		"error if a load balanced web service has a network load balancer": {
+			inManifest: &manifest.LoadBalancedWebService{
+				Workload: manifest.Workload{Name: aws.String("api")},
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRuleConfiguration{
+						RoutingRule: manifest.RoutingRule{Internal: aws.Bool(true)},
+					},
+					NLBConfig: manifest.NetworkLoadBalancerConfiguration{Port: aws.String("443/tcp")},
+				},
+			},
+			wantedErr: `cannot deploy service api with "nlb" to environment test with a "private_only" VPC`,
+		},
+		"error if an internal load balanced web service is placed in public subnets": {
+			inManifest: &manifest.LoadBalancedWebService{
+				Workload: manifest.Workload{Name: aws.String("api")},
+				LoadBalancedWebServiceConfig: manifest.LoadBalancedWebServiceConfig{
+					RoutingRule: manifest.RoutingRuleConfiguration{
+						RoutingRule: manifest.RoutingRule{Internal: aws.Bool(true)},
+					},
+				},
+			},
+			wantedErr: `cannot deploy api in public subnets to environment test with a "private_only" VPC: set "network.vpc.placement" to "private"`,
+		},
+		"valid internal load balanced web service in private subnets": {
+			inManifest: func() interface{} {
+				mft := &manifest.LoadBalancedWebService{
+					Workload: manifest.Workload{Name: aws.String("api")},
+				}
+				mft.RoutingRule.Internal = aws.Bool(true)
+				mft.Network.VPC.Placement = &private
+				return mft
+			}(),
+		},
+		"error if a scheduled job is placed in public subnets": {
+			inManifest: func() interface{} {
+				mft := &manifest.ScheduledJob{
+					Workload: manifest.Workload{Name: aws.String("report")},
+				}
+				mft.Network.VPC.Placement = &public
+				return mft
+			}(),
+			wantedErr: `cannot deploy report in public subnets to environment test with a "private_only" VPC: set "network.vpc.placement" to "private"`,
+		},
+		"valid backend service in private subnets": {
+			inManifest: func() interface{} {
+				mft := &manifest.BackendService{
+					Workload: manifest.Workload{Name: aws.String("db")},
+				}
+				mft.Network.VPC.Placement = &private
+				return mft
+			}(),
+		},
+		"request-driven web services run outside of the VPC": {
+			inManifest: &manifest.RequestDrivenWebService{
+				Workload: manifest.Workload{Name: aws.String("frontend")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateWorkloadForPrivateOnlyEnv(tc.inManifest, "test")
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
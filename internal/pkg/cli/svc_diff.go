@@ -0,0 +1,351 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/cli/diff"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	termprogress "github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcDiffSvcNamePrompt = "Which service would you like to compare with its deployed version?"
+	svcDiffEnvNamePrompt = "Which environment is the service deployed to?"
+
+	fmtDiffChangeSetStart    = "Creating a change set for stack %s."
+	fmtDiffChangeSetFailed   = "Failed to create a change set for stack %s.\n"
+	fmtDiffChangeSetComplete = "Created change set %s for stack %s.\n"
+	fmtDiffChangeSetEmpty    = "No resource changes to stack %s.\n"
+	fmtDiffChangeSetKept     = `Kept change set %s to review or execute it later.
+Run %s to apply it or %s to discard it.
+`
+)
+
+type diffSvcVars struct {
+	name      string
+	envName   string
+	appName   string
+	tag       string
+	changeSet bool
+}
+
+type diffSvcOpts struct {
+	diffSvcVars
+
+	store      store
+	ws         wsSvcReader
+	sel        wsSelector
+	runner     runner
+	prog       progress
+	diffWriter io.Writer
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overridden in tests to provide mocks.
+	newWorkloadStack    func(env *config.Environment) (stackSerializer, error)
+	newStackDescriber   func(stackName string, env *config.Environment) (deployedStackDescriber, error)
+	newChangeSetCreator func(env *config.Environment) (workloadChangeSetCreator, error)
+}
+
+func newDiffSvcOpts(vars diffSvcVars) (*diffSvcOpts, error) {
+	pkgOpts, err := newPackageSvcOpts(packageSvcVars{
+		appName: vars.appName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newDiffWorkloadOpts(vars, pkgOpts)
+}
+
+// newDiffWorkloadOpts returns the options to compare a workload that is rendered with the package command pkgOpts.
+func newDiffWorkloadOpts(vars diffSvcVars, pkgOpts *packageSvcOpts) (*diffSvcOpts, error) {
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	opts := &diffSvcOpts{
+		diffSvcVars: vars,
+		store:       store,
+		ws:          ws,
+		sel:         selector.NewWorkspaceSelect(prompt.New(), store, ws),
+		runner:      exec.NewCmd(),
+		prog:        termprogress.NewSpinner(log.DiagnosticWriter),
+		diffWriter:  os.Stdout,
+		newStackDescriber: func(stackName string, env *config.Environment) (deployedStackDescriber, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return describestack.NewStackDescriber(stackName, sess), nil
+		},
+		newChangeSetCreator: func(env *config.Environment) (workloadChangeSetCreator, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return cloudformation.New(sess), nil
+		},
+	}
+	opts.newWorkloadStack = func(env *config.Environment) (stackSerializer, error) {
+		pkgOpts.packageSvcVars = packageSvcVars{
+			name:    opts.name,
+			envName: opts.envName,
+			appName: opts.appName,
+			tag:     opts.tag,
+		}
+		return pkgOpts.getSvcStack(env)
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *diffSvcOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		names, err := o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !contains(o.name, names) {
+			return fmt.Errorf("service '%s' does not exist in the workspace", o.name)
+		}
+	}
+	if o.envName != "" {
+		if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *diffSvcOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcDiffSvcNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		name, err := o.sel.Environment(svcDiffEnvNamePrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = name
+	}
+	return nil
+}
+
+// Execute prints the differences between the local and the deployed template of the workload.
+// If requested, it also creates a change set without executing it and lists the resource changes.
+func (o *diffSvcOpts) Execute() error {
+	o.tag = imageTagFromGit(o.runner, o.tag) // Best effort assign git tag.
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return err
+	}
+	conf, err := o.newWorkloadStack(env)
+	if err != nil {
+		return err
+	}
+	local, err := conf.Template()
+	if err != nil {
+		return fmt.Errorf("generate stack template: %w", err)
+	}
+	describer, err := o.newStackDescriber(conf.StackName(), env)
+	if err != nil {
+		return err
+	}
+	deployed, err := describer.Template()
+	if err != nil {
+		return err
+	}
+	tree, err := diff.Parse([]byte(deployed), []byte(local))
+	if err != nil {
+		return fmt.Errorf("compare templates of stack %s: %w", conf.StackName(), err)
+	}
+	if tree.IsEmpty() {
+		log.Infof("No changes to the template of %s in environment %s.\n", color.HighlightUserInput(o.name), color.HighlightUserInput(o.envName))
+	} else if err := tree.Write(o.diffWriter); err != nil {
+		return fmt.Errorf("write differences of stack %s: %w", conf.StackName(), err)
+	}
+	if !o.changeSet {
+		return nil
+	}
+	return o.createChangeSet(env, conf, describer)
+}
+
+// RecommendActions is a no-op for this command.
+func (o *diffSvcOpts) RecommendActions() error {
+	return nil
+}
+
+func (o *diffSvcOpts) createChangeSet(env *config.Environment, conf cloudformation.StackConfiguration, describer deployedStackDescriber) error {
+	descr, err := describer.Describe()
+	if err != nil {
+		return err
+	}
+	creator, err := o.newChangeSetCreator(env)
+	if err != nil {
+		return err
+	}
+	// Parameters that are only known at deploy time, like the URL of the uploaded addons template,
+	// keep their deployed values so that the change set only reflects changes to the template and manifest.
+	previousParams := []string{stack.WorkloadAddonsTemplateURLParamKey}
+	if o.tag == "" {
+		previousParams = append(previousParams, stack.WorkloadContainerImageParamKey)
+	}
+	conf = &previousParamsStackConfig{
+		StackConfiguration: conf,
+		deployed:           descr.Parameters,
+		keys:               previousParams,
+	}
+
+	stackName := color.HighlightUserInput(conf.StackName())
+	o.prog.Start(fmt.Sprintf(fmtDiffChangeSetStart, stackName))
+	id, changeSet, err := creator.CreateWorkloadChangeSet(conf, awscloudformation.WithRoleARN(env.ExecutionRoleARN))
+	var errEmpty *awscloudformation.ErrChangeSetEmpty
+	if errors.As(err, &errEmpty) {
+		o.prog.Stop(log.Ssuccessf(fmtDiffChangeSetEmpty, stackName))
+		return nil
+	}
+	if err != nil {
+		o.prog.Stop(log.Serrorf(fmtDiffChangeSetFailed, stackName))
+		return err
+	}
+	o.prog.Stop(log.Ssuccessf(fmtDiffChangeSetComplete, color.HighlightResource(id), stackName))
+	if !hasResourceChanges(changeSet.Changes) {
+		// Nothing to review, don't leave the change set behind on the stack.
+		return o.deleteChangeSet(creator, id, conf.StackName())
+	}
+	if err := writeResourceChanges(o.diffWriter, changeSet.Changes); err != nil {
+		return err
+	}
+	// The change set is kept without asking so that the command can gate deployments in non-interactive CI jobs.
+	log.Infof(fmtDiffChangeSetKept, color.HighlightResource(id),
+		color.HighlightCode(fmt.Sprintf("aws cloudformation execute-change-set --change-set-name %s", id)),
+		color.HighlightCode(fmt.Sprintf("aws cloudformation delete-change-set --change-set-name %s", id)))
+	return nil
+}
+
+func (o *diffSvcOpts) deleteChangeSet(deleter workloadChangeSetCreator, id, stackName string) error {
+	if err := deleter.DeleteWorkloadChangeSet(id, stackName); err != nil {
+		return err
+	}
+	log.Infof("Deleted change set %s.\n", color.HighlightResource(id))
+	return nil
+}
+
+// hasResourceChanges returns true if any of the changes adds, modifies, or removes a resource.
+func hasResourceChanges(changes []*sdkcloudformation.Change) bool {
+	for _, change := range changes {
+		if change.ResourceChange != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// writeResourceChanges writes a table of the resources that the change set adds, modifies, or removes.
+// Resources that will be replaced are highlighted.
+func writeResourceChanges(w io.Writer, changes []*sdkcloudformation.Change) error {
+	tw := tabwriter.NewWriter(w, 4, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Action\tLogical ID\tType\tReplacement\n")
+	for _, change := range changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		replacement := aws.StringValue(rc.Replacement)
+		switch replacement {
+		case sdkcloudformation.ReplacementTrue:
+			replacement = color.Red.Sprint(replacement)
+		case sdkcloudformation.ReplacementConditional:
+			replacement = color.Yellow.Sprint(replacement)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", aws.StringValue(rc.Action), aws.StringValue(rc.LogicalResourceId), aws.StringValue(rc.ResourceType), replacement)
+	}
+	return tw.Flush()
+}
+
+// previousParamsStackConfig is a stack configuration that reuses the deployed values of the parameters in keys.
+type previousParamsStackConfig struct {
+	cloudformation.StackConfiguration
+
+	deployed map[string]string // Parameter values of the deployed stack.
+	keys     []string
+}
+
+// Parameters returns the stack parameters where the deployed values are kept for keys that exist in the deployed stack.
+func (c *previousParamsStackConfig) Parameters() ([]*sdkcloudformation.Parameter, error) {
+	params, err := c.StackConfiguration.Parameters()
+	if err != nil {
+		return nil, err
+	}
+	for _, param := range params {
+		key := aws.StringValue(param.ParameterKey)
+		if _, ok := c.deployed[key]; !ok || !contains(key, c.keys) {
+			continue
+		}
+		param.ParameterValue = nil
+		param.UsePreviousValue = aws.Bool(true)
+	}
+	return params, nil
+}
+
+// buildSvcDiffCmd builds the command for comparing a service's local and deployed CloudFormation templates.
+func buildSvcDiffCmd() *cobra.Command {
+	vars := diffSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares the local and deployed AWS CloudFormation template of a service.",
+		Long: `Compares the CloudFormation template generated from the service's manifest
+with the template deployed to an environment, without deploying.`,
+		Example: `
+  Show the changes to the "frontend" service in the "test" environment.
+  /code $ copilot svc diff -n frontend -e test
+
+  Also create a change set to list the resources that will be replaced.
+  /code $ copilot svc diff -n frontend -e test --changeset`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newDiffSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVar(&vars.tag, imageTagFlag, "", imageTagFlagDescription)
+	cmd.Flags().BoolVar(&vars.changeSet, changeSetFlag, false, changeSetFlagDescription)
+	return cmd
+}
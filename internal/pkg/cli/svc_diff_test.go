@@ -0,0 +1,431 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awscloudformation "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation"
+	describestack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
+	"github.com/fatih/color"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inSvcName string
+		inEnvName string
+
+		setupMocks func(ws *mocks.MockwsSvcReader, store *mocks.Mockstore)
+
+		wantedErr string
+	}{
+		"error if the application is not set": {
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.Mockstore) {},
+			wantedErr:  errNoAppInWorkspace.Error(),
+		},
+		"error if the service is not in the workspace": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.Mockstore) {
+				ws.EXPECT().ServiceNames().Return([]string{"backend"}, nil)
+			},
+			wantedErr: "service 'frontend' does not exist in the workspace",
+		},
+		"error if the environment does not exist": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.Mockstore) {
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"no error if the service and environment exist": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			inEnvName: "test",
+			setupMocks: func(ws *mocks.MockwsSvcReader, store *mocks.Mockstore) {
+				ws.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsSvcReader(ctrl)
+			store := mocks.NewMockstore(ctrl)
+			tc.setupMocks(ws, store)
+			opts := &diffSvcOpts{
+				diffSvcVars: diffSvcVars{
+					appName: tc.inAppName,
+					name:    tc.inSvcName,
+					envName: tc.inEnvName,
+				},
+				ws:    ws,
+				store: store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDiffSvcOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcName string
+		inEnvName string
+
+		setupMocks func(m *mocks.MockwsSelector)
+
+		wantedSvcName string
+		wantedEnvName string
+		wantedErr     string
+	}{
+		"does not prompt if the flags are set": {
+			inSvcName:     "frontend",
+			inEnvName:     "test",
+			setupMocks:    func(m *mocks.MockwsSelector) {},
+			wantedSvcName: "frontend",
+			wantedEnvName: "test",
+		},
+		"error if fail to select the service": {
+			setupMocks: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Service(svcDiffSvcNamePrompt, "").Return("", errors.New("some error"))
+			},
+			wantedErr: "select service: some error",
+		},
+		"prompts for the service and environment": {
+			setupMocks: func(m *mocks.MockwsSelector) {
+				m.EXPECT().Service(svcDiffSvcNamePrompt, "").Return("frontend", nil)
+				m.EXPECT().Environment(svcDiffEnvNamePrompt, "", "phonetool").Return("test", nil)
+			},
+			wantedSvcName: "frontend",
+			wantedEnvName: "test",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sel := mocks.NewMockwsSelector(ctrl)
+			tc.setupMocks(sel)
+			opts := &diffSvcOpts{
+				diffSvcVars: diffSvcVars{
+					appName: "phonetool",
+					name:    tc.inSvcName,
+					envName: tc.inEnvName,
+				},
+				sel: sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSvcName, opts.name)
+			require.Equal(t, tc.wantedEnvName, opts.envName)
+		})
+	}
+}
+
+type diffSvcMocks struct {
+	store     *mocks.Mockstore
+	stack     *mocks.MockstackSerializer
+	describer *mocks.MockdeployedStackDescriber
+	creator   *mocks.MockworkloadChangeSetCreator
+	prog      *mocks.Mockprogress
+}
+
+func TestDiffSvcOpts_Execute(t *testing.T) {
+	const (
+		deployedTemplate = `Resources:
+  Service:
+    Properties:
+      DesiredCount: 1
+`
+		localTemplate = `Resources:
+  Service:
+    Properties:
+      DesiredCount: 2
+`
+	)
+	mockEnv := &config.Environment{
+		App:              "phonetool",
+		Name:             "test",
+		ExecutionRoleARN: "execution-role",
+	}
+	mockTemplates := func(m diffSvcMocks, deployed, local string) {
+		m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+		m.stack.EXPECT().Template().Return(local, nil)
+		m.stack.EXPECT().StackName().Return("phonetool-test-frontend").AnyTimes()
+		m.describer.EXPECT().Template().Return(deployed, nil)
+	}
+
+	testCases := map[string]struct {
+		inChangeSet bool
+		setupMocks  func(m diffSvcMocks)
+
+		wantedOutput string
+		wantedErr    string
+	}{
+		"error if the local template cannot be generated": {
+			setupMocks: func(m diffSvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.stack.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			wantedErr: "generate stack template: some error",
+		},
+		"error if the deployed template cannot be retrieved": {
+			setupMocks: func(m diffSvcMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(mockEnv, nil)
+				m.stack.EXPECT().Template().Return(localTemplate, nil)
+				m.stack.EXPECT().StackName().Return("phonetool-test-frontend")
+				m.describer.EXPECT().Template().Return("", errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"writes nothing if there are no differences": {
+			setupMocks: func(m diffSvcMocks) {
+				mockTemplates(m, deployedTemplate, deployedTemplate)
+			},
+		},
+		"writes the differences between the templates": {
+			setupMocks: func(m diffSvcMocks) {
+				mockTemplates(m, deployedTemplate, localTemplate)
+			},
+			wantedOutput: `~ Resources:
+    ~ Service:
+        ~ Properties:
+            ~ DesiredCount: 1 -> 2
+`,
+		},
+		"error if fail to create the change set": {
+			inChangeSet: true,
+			setupMocks: func(m diffSvcMocks) {
+				mockTemplates(m, deployedTemplate, deployedTemplate)
+				m.describer.EXPECT().Describe().Return(describestack.StackDescription{}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.creator.EXPECT().CreateWorkloadChangeSet(gomock.Any(), gomock.Any()).Return("", nil, errors.New("some error"))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedErr: "some error",
+		},
+		"no error if the change set is empty": {
+			inChangeSet: true,
+			setupMocks: func(m diffSvcMocks) {
+				mockTemplates(m, deployedTemplate, deployedTemplate)
+				m.describer.EXPECT().Describe().Return(describestack.StackDescription{}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.creator.EXPECT().CreateWorkloadChangeSet(gomock.Any(), gomock.Any()).
+					Return("", nil, fmt.Errorf("create change set: %w", &awscloudformation.ErrChangeSetEmpty{}))
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+		},
+		"writes the resource changes and keeps the change set": {
+			inChangeSet: true,
+			setupMocks: func(m diffSvcMocks) {
+				mockTemplates(m, deployedTemplate, localTemplate)
+				m.describer.EXPECT().Describe().Return(describestack.StackDescription{
+					Parameters: map[string]string{
+						"AddonsTemplateURL": "https://addons",
+					},
+				}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.creator.EXPECT().CreateWorkloadChangeSet(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(conf cloudformation.StackConfiguration, opts ...awscloudformation.StackOption) (string, *awscloudformation.ChangeSetDescription, error) {
+						require.Len(t, opts, 1)
+						return "mockChangeSet", &awscloudformation.ChangeSetDescription{
+							Changes: []*sdkcloudformation.Change{
+								{
+									ResourceChange: &sdkcloudformation.ResourceChange{
+										Action:            aws.String("Modify"),
+										LogicalResourceId: aws.String("Service"),
+										ResourceType:      aws.String("AWS::ECS::Service"),
+										Replacement:       aws.String("False"),
+									},
+								},
+								{
+									ResourceChange: &sdkcloudformation.ResourceChange{
+										Action:            aws.String("Modify"),
+										LogicalResourceId: aws.String("TargetGroup"),
+										ResourceType:      aws.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+										Replacement:       aws.String("True"),
+									},
+								},
+							},
+						}, nil
+					})
+				m.prog.EXPECT().Stop(gomock.Any())
+			},
+			wantedOutput: `~ Resources:
+    ~ Service:
+        ~ Properties:
+            ~ DesiredCount: 1 -> 2
+Action  Logical ID   Type                                      Replacement
+Modify  Service      AWS::ECS::Service                         False
+Modify  TargetGroup  AWS::ElasticLoadBalancingV2::TargetGroup  True
+`,
+		},
+		"deletes the change set if it has no resource changes": {
+			inChangeSet: true,
+			setupMocks: func(m diffSvcMocks) {
+				mockTemplates(m, deployedTemplate, deployedTemplate)
+				m.describer.EXPECT().Describe().Return(describestack.StackDescription{}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.creator.EXPECT().CreateWorkloadChangeSet(gomock.Any(), gomock.Any()).
+					Return("mockChangeSet", &awscloudformation.ChangeSetDescription{
+						Changes: []*sdkcloudformation.Change{{}},
+					}, nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.creator.EXPECT().DeleteWorkloadChangeSet("mockChangeSet", "phonetool-test-frontend").Return(nil)
+			},
+		},
+		"error if the change set without resource changes cannot be deleted": {
+			inChangeSet: true,
+			setupMocks: func(m diffSvcMocks) {
+				mockTemplates(m, deployedTemplate, deployedTemplate)
+				m.describer.EXPECT().Describe().Return(describestack.StackDescription{}, nil)
+				m.prog.EXPECT().Start(gomock.Any())
+				m.creator.EXPECT().CreateWorkloadChangeSet(gomock.Any(), gomock.Any()).
+					Return("mockChangeSet", &awscloudformation.ChangeSetDescription{
+						Changes: []*sdkcloudformation.Change{{}},
+					}, nil)
+				m.prog.EXPECT().Stop(gomock.Any())
+				m.creator.EXPECT().DeleteWorkloadChangeSet("mockChangeSet", "phonetool-test-frontend").Return(errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			noColor := color.NoColor
+			color.NoColor = true
+			defer func() { color.NoColor = noColor }()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := diffSvcMocks{
+				store:     mocks.NewMockstore(ctrl),
+				stack:     mocks.NewMockstackSerializer(ctrl),
+				describer: mocks.NewMockdeployedStackDescriber(ctrl),
+				creator:   mocks.NewMockworkloadChangeSetCreator(ctrl),
+				prog:      mocks.NewMockprogress(ctrl),
+			}
+			tc.setupMocks(m)
+			buf := new(bytes.Buffer)
+			opts := &diffSvcOpts{
+				diffSvcVars: diffSvcVars{
+					appName:   "phonetool",
+					name:      "frontend",
+					envName:   "test",
+					tag:       "1234",
+					changeSet: tc.inChangeSet,
+				},
+				store:      m.store,
+				prog:       m.prog,
+				diffWriter: buf,
+				newWorkloadStack: func(env *config.Environment) (stackSerializer, error) {
+					return m.stack, nil
+				},
+				newStackDescriber: func(stackName string, env *config.Environment) (deployedStackDescriber, error) {
+					require.Equal(t, "phonetool-test-frontend", stackName)
+					return m.describer, nil
+				},
+				newChangeSetCreator: func(env *config.Environment) (workloadChangeSetCreator, error) {
+					return m.creator, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedOutput, buf.String())
+		})
+	}
+}
+
+func TestPreviousParamsStackConfig_Parameters(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockstackSerializer(ctrl)
+	m.EXPECT().Parameters().Return([]*sdkcloudformation.Parameter{
+		{
+			ParameterKey:   aws.String("AddonsTemplateURL"),
+			ParameterValue: aws.String(""),
+		},
+		{
+			ParameterKey:   aws.String("ContainerImage"),
+			ParameterValue: aws.String("nginx"),
+		},
+		{
+			ParameterKey:   aws.String("TaskCount"),
+			ParameterValue: aws.String("2"),
+		},
+	}, nil)
+	conf := &previousParamsStackConfig{
+		StackConfiguration: m,
+		deployed: map[string]string{
+			"AddonsTemplateURL": "https://addons",
+			"TaskCount":         "1",
+		},
+		keys: []string{"AddonsTemplateURL", "ContainerImage"},
+	}
+
+	// WHEN
+	params, err := conf.Parameters()
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, []*sdkcloudformation.Parameter{
+		{
+			ParameterKey:     aws.String("AddonsTemplateURL"),
+			UsePreviousValue: aws.Bool(true),
+		},
+		{
+			ParameterKey:   aws.String("ContainerImage"),
+			ParameterValue: aws.String("nginx"),
+		},
+		{
+			ParameterKey:   aws.String("TaskCount"),
+			ParameterValue: aws.String("2"),
+		},
+	}, params)
+}
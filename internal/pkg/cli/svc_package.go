@@ -10,6 +10,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/identity"
 
@@ -121,16 +122,43 @@ func newPackageSvcOpts(vars packageSvcVars) (*packageSvcOpts, error) {
 		var serializer stackSerializer
 		switch t := mft.(type) {
 		case *manifest.LoadBalancedWebService:
-			if app.RequiresDNSDelegation() {
-				if err := validateLBSvcAliasAndAppVersion(aws.StringValue(t.Name), t.Alias, app, env.Name, appVersionGetter); err != nil {
+			var options []stack.LoadBalancedWebServiceOption
+			if !t.NLBConfig.IsEmpty() {
+				envDescriber, err := describe.NewEnvDescriber(describe.NewEnvDescriberConfig{
+					App:         app.Name,
+					Env:         env.Name,
+					ConfigStore: store,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("new env describer for environment %s in app %s: %v", env.Name, app.Name, err)
+				}
+				cidrBlocks, err := envDescriber.PublicCIDRBlocks()
+				if err != nil {
+					return nil, fmt.Errorf("get public CIDR blocks of environment %s: %w", env.Name, err)
+				}
+				options = append(options, stack.WithNLB(cidrBlocks))
+			}
+			if env.HasImportedCerts() {
+				if err := validateLBSvcForImportedCerts(t, env.Name); err != nil {
+					return nil, err
+				}
+				serializer, err = stack.NewHTTPSLoadBalancedWebService(t, env.Name, app.Name, rc, options...)
+				if err != nil {
+					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
+				}
+			} else if app.RequiresDNSDelegation() {
+				if err := validateLBSvcAliasAndAppVersion(aws.StringValue(t.Name), t.RoutingRule.Rules(), app, env.Name, appVersionGetter); err != nil {
 					return nil, err
 				}
-				serializer, err = stack.NewHTTPSLoadBalancedWebService(t, env.Name, app.Name, rc)
+				serializer, err = stack.NewHTTPSLoadBalancedWebService(t, env.Name, app.Name, rc, options...)
 				if err != nil {
 					return nil, fmt.Errorf("init https load balanced web service stack serializer: %w", err)
 				}
 			} else {
-				serializer, err = stack.NewLoadBalancedWebService(t, env.Name, app.Name, rc)
+				if err := validateLBSvcForNoDomain(t, env.Name); err != nil {
+					return nil, err
+				}
+				serializer, err = stack.NewLoadBalancedWebService(t, env.Name, app.Name, rc, options...)
 				if err != nil {
 					return nil, fmt.Errorf("init load balanced web service stack serializer: %w", err)
 				}
@@ -350,6 +378,23 @@ type svcCfnTemplates struct {
 
 // getSvcTemplates returns the CloudFormation stack's template and its parameters for the service.
 func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTemplates, error) {
+	serializer, err := o.getSvcStack(env)
+	if err != nil {
+		return nil, err
+	}
+	tpl, err := serializer.Template()
+	if err != nil {
+		return nil, fmt.Errorf("generate stack template: %w", err)
+	}
+	params, err := serializer.SerializedParameters()
+	if err != nil {
+		return nil, fmt.Errorf("generate stack template configuration: %w", err)
+	}
+	return &svcCfnTemplates{stack: tpl, configuration: params}, nil
+}
+
+// getSvcStack returns the CloudFormation stack configuration of the service for the environment.
+func (o *packageSvcOpts) getSvcStack(env *config.Environment) (stackSerializer, error) {
 	raw, err := o.ws.ReadServiceManifest(o.name)
 	if err != nil {
 		return nil, err
@@ -388,11 +433,15 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 		Region:                   env.Region,
 	}
 
+	builtSidecars := sidecarsBuiltFromDockerfile(envMft)
+	if !imgNeedsBuild && len(builtSidecars) == 0 {
+		return o.stackSerializer(envMft, env, app, rc)
+	}
+	resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
+	if err != nil {
+		return nil, err
+	}
 	if imgNeedsBuild {
-		resources, err := o.appCFN.GetAppResourcesByRegion(app, env.Region)
-		if err != nil {
-			return nil, err
-		}
 		repoURL, ok := resources.RepositoryURLs[o.name]
 		if !ok {
 			return nil, &errRepoNotFound{
@@ -406,19 +455,42 @@ func (o *packageSvcOpts) getSvcTemplates(env *config.Environment) (*svcCfnTempla
 			ImageTag: o.tag,
 		}
 	}
-	serializer, err := o.stackSerializer(envMft, env, app, rc)
-	if err != nil {
-		return nil, err
+	for _, sidecar := range builtSidecars {
+		repo := fmt.Sprintf(stack.SidecarRepoNameFormat, o.name, sidecar)
+		repoURL, ok := resources.RepositoryURLs[repo]
+		if !ok {
+			return nil, &errRepoNotFound{
+				wlName:       repo,
+				envRegion:    env.Region,
+				appAccountID: app.AccountID,
+			}
+		}
+		if rc.SidecarImages == nil {
+			rc.SidecarImages = make(map[string]stack.ECRImage)
+		}
+		rc.SidecarImages[sidecar] = stack.ECRImage{
+			RepoURL:  repoURL,
+			ImageTag: o.tag,
+		}
 	}
-	tpl, err := serializer.Template()
-	if err != nil {
-		return nil, fmt.Errorf("generate stack template: %w", err)
+	return o.stackSerializer(envMft, env, app, rc)
+}
+
+// sidecarsBuiltFromDockerfile returns the sorted names of the workload's sidecars that are built from a Dockerfile.
+func sidecarsBuiltFromDockerfile(mft interface{}) []string {
+	type sidecarDfArgs interface {
+		SidecarBuildArgs(rootDirectory string) map[string]*manifest.DockerBuildArgs
 	}
-	params, err := serializer.SerializedParameters()
-	if err != nil {
-		return nil, fmt.Errorf("generate stack template configuration: %w", err)
+	mf, ok := mft.(sidecarDfArgs)
+	if !ok {
+		return nil
 	}
-	return &svcCfnTemplates{stack: tpl, configuration: params}, nil
+	var sidecars []string
+	for name := range mf.SidecarBuildArgs("") {
+		sidecars = append(sidecars, name)
+	}
+	sort.Strings(sidecars)
+	return sidecars
 }
 
 // setOutputFileWriters creates the output directory, and updates the template and param writers to file writers in the directory.
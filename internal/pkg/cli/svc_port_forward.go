@@ -0,0 +1,320 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/copilot-cli/cmd/copilot/template"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defaultRemoteHost = "localhost"
+	maxPortNumber     = 65535
+)
+
+const (
+	svcPortForwardNamePrompt     = "Through which service would you like to forward the port?"
+	svcPortForwardNameHelpPrompt = `Copilot forwards the port through one of your chosen service's running tasks.
+The service must have "exec: true" set in its manifest.`
+	svcPortForwardRemotePortPrompt     = "Which port of the remote host would you like to forward to?"
+	svcPortForwardRemotePortHelpPrompt = "The port that the remote host listens on, such as 5432 for a PostgreSQL database."
+)
+
+var (
+	svcPortForwardTaskPrompt     = fmt.Sprintf("Through which %s would you like to forward the port?", color.Emphasize("task"))
+	svcPortForwardTaskHelpPrompt = fmt.Sprintf("By default we'll forward the port through the first %s of the task.", color.Emphasize("essential container"))
+)
+
+type svcPortForwardVars struct {
+	appName          string
+	envName          string
+	name             string
+	taskID           string
+	containerName    string
+	localPort        int
+	remoteHost       string
+	remotePort       int
+	skipConfirmation *bool // If nil, we will prompt to upgrade the ssm plugin.
+}
+
+type svcPortForwardOpts struct {
+	svcPortForwardVars
+
+	store              store
+	sel                deploySelector
+	prompter           prompter
+	newTaskSel         func(*session.Session) runningTaskSelector
+	newPortForwarder   func(*session.Session) ssmPortForwarder
+	ssmPluginManager   ssmPluginManager
+	sessFromEnvManager func(env *config.Environment) (*session.Session, error)
+
+	task *awsecs.Task
+}
+
+func newSvcPortForwardOpts(vars svcPortForwardVars) (*svcPortForwardOpts, error) {
+	ssmStore, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("connect to config store: %w", err)
+	}
+	deployStore, err := deploy.NewStore(ssmStore)
+	if err != nil {
+		return nil, fmt.Errorf("connect to deploy store: %w", err)
+	}
+	prompter := prompt.New()
+	return &svcPortForwardOpts{
+		svcPortForwardVars: vars,
+		store:              ssmStore,
+		sel:                selector.NewDeploySelect(prompter, ssmStore, deployStore),
+		prompter:           prompter,
+		newTaskSel: func(sess *session.Session) runningTaskSelector {
+			return selector.NewTaskSelect(prompter, ecs.New(sess))
+		},
+		newPortForwarder: func(sess *session.Session) ssmPortForwarder {
+			return ssm.New(sess)
+		},
+		ssmPluginManager: exec.NewSSMPluginCommand(nil),
+		sessFromEnvManager: func(env *config.Environment) (*session.Session, error) {
+			return sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		},
+	}, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcPortForwardOpts) Validate() error {
+	if o.appName != "" {
+		if _, err := o.store.GetApplication(o.appName); err != nil {
+			return err
+		}
+		if o.envName != "" {
+			if _, err := o.store.GetEnvironment(o.appName, o.envName); err != nil {
+				return err
+			}
+		}
+		if o.name != "" {
+			if _, err := o.store.GetService(o.appName, o.name); err != nil {
+				return err
+			}
+		}
+	}
+	if err := validatePortNumber(localPortFlag, o.localPort); err != nil {
+		return err
+	}
+	if err := validatePortNumber(remotePortFlag, o.remotePort); err != nil {
+		return err
+	}
+	return validateSSMBinary(o.prompter, o.ssmPluginManager, o.skipConfirmation)
+}
+
+// Ask asks for fields that are required but not passed in.
+func (o *svcPortForwardOpts) Ask() error {
+	if err := o.askApp(); err != nil {
+		return err
+	}
+	if err := o.askSvcEnvName(); err != nil {
+		return err
+	}
+	if err := o.askRemotePort(); err != nil {
+		return err
+	}
+	return o.selectTask()
+}
+
+// Execute forwards the local port to the remote host through a running task of the service until it's interrupted.
+func (o *svcPortForwardOpts) Execute() error {
+	wkld, err := o.store.GetWorkload(o.appName, o.name)
+	if err != nil {
+		return fmt.Errorf("get workload: %w", err)
+	}
+	if wkld.Type == manifest.RequestDrivenWebServiceType {
+		return fmt.Errorf("port forwarding is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType)
+	}
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	container := o.selectContainer()
+	target, err := o.task.SSMTarget(container)
+	if err != nil {
+		return fmt.Errorf("get session target of container %s: %w", container, err)
+	}
+	taskID, err := awsecs.TaskID(aws.StringValue(o.task.TaskArn))
+	if err != nil {
+		return fmt.Errorf("parse task ARN %s: %w", aws.StringValue(o.task.TaskArn), err)
+	}
+	remoteHost, localPort := o.remoteHostOrDefault(), o.localPortOrDefault()
+	log.Infof("Forwarding %s to %s through container %s in task %s. Press Ctrl+C to stop.\n",
+		color.HighlightUserInput(fmt.Sprintf("localhost:%d", localPort)),
+		color.HighlightUserInput(fmt.Sprintf("%s:%d", remoteHost, o.remotePort)),
+		color.HighlightUserInput(container), color.HighlightResource(taskID))
+	if err := o.newPortForwarder(sess).StartPortForwardingSession(ssm.PortForwardingSessionInput{
+		Target:     target,
+		RemoteHost: remoteHost,
+		RemotePort: o.remotePort,
+		LocalPort:  localPort,
+	}); err != nil {
+		var errStartSession *ssm.ErrStartSession
+		if errors.As(err, &errStartSession) {
+			log.Errorf("Failed to forward the port. Is %s set in your manifest?\n", color.HighlightCode("exec: true"))
+		}
+		return fmt.Errorf("forward port %d to %s:%d: %w", localPort, remoteHost, o.remotePort, err)
+	}
+	return nil
+}
+
+func (o *svcPortForwardOpts) askApp() error {
+	if o.appName != "" {
+		return nil
+	}
+	app, err := o.sel.Application(svcAppNamePrompt, svcAppNameHelpPrompt)
+	if err != nil {
+		return fmt.Errorf("select application: %w", err)
+	}
+	o.appName = app
+	return nil
+}
+
+func (o *svcPortForwardOpts) askSvcEnvName() error {
+	deployedService, err := o.sel.DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, o.appName, selector.WithEnv(o.envName), selector.WithSvc(o.name))
+	if err != nil {
+		return fmt.Errorf("select deployed service for application %s: %w", o.appName, err)
+	}
+	o.name = deployedService.Svc
+	o.envName = deployedService.Env
+	return nil
+}
+
+func (o *svcPortForwardOpts) askRemotePort() error {
+	if o.remotePort != 0 {
+		return nil
+	}
+	port, err := o.prompter.Get(svcPortForwardRemotePortPrompt, svcPortForwardRemotePortHelpPrompt, func(val interface{}) error {
+		port, err := strconv.Atoi(val.(string))
+		if err != nil {
+			return errors.New("port must be a number")
+		}
+		return validatePortNumber(remotePortFlag, port)
+	}, prompt.WithFinalMessage("Remote port:"))
+	if err != nil {
+		return fmt.Errorf("get remote port: %w", err)
+	}
+	o.remotePort, _ = strconv.Atoi(port)
+	return nil
+}
+
+func (o *svcPortForwardOpts) selectTask() error {
+	sess, err := o.envSession()
+	if err != nil {
+		return err
+	}
+	task, err := o.newTaskSel(sess).RunningTask(svcPortForwardTaskPrompt, svcPortForwardTaskHelpPrompt,
+		selector.WithAppEnv(o.appName, o.envName), selector.WithService(o.name), selector.WithTaskID(o.taskID))
+	if err != nil {
+		return fmt.Errorf("select running task of service %s in environment %s: %w", o.name, o.envName, err)
+	}
+	o.task = task
+	return nil
+}
+
+func (o *svcPortForwardOpts) envSession() (*session.Session, error) {
+	env, err := o.store.GetEnvironment(o.appName, o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("get environment %s: %w", o.envName, err)
+	}
+	sess, err := o.sessFromEnvManager(env)
+	if err != nil {
+		return nil, fmt.Errorf("get session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+	}
+	return sess, nil
+}
+
+func (o *svcPortForwardOpts) selectContainer() string {
+	if o.containerName != "" {
+		return o.containerName
+	}
+	// The first essential container is named with the workload name.
+	return o.name
+}
+
+func (o *svcPortForwardOpts) remoteHostOrDefault() string {
+	if o.remoteHost != "" {
+		return o.remoteHost
+	}
+	return defaultRemoteHost
+}
+
+func (o *svcPortForwardOpts) localPortOrDefault() int {
+	if o.localPort != 0 {
+		return o.localPort
+	}
+	return o.remotePort
+}
+
+// validatePortNumber returns an error if the port set with the flag is out of range. An unset port is valid.
+func validatePortNumber(flag string, port int) error {
+	if port < 0 || port > maxPortNumber {
+		return fmt.Errorf("--%s must be between 1 and %d", flag, maxPortNumber)
+	}
+	return nil
+}
+
+// buildSvcPortForwardCmd builds the command for forwarding a local port to a remote host through a running task of a service.
+func buildSvcPortForwardCmd() *cobra.Command {
+	vars := svcPortForwardVars{}
+	var skipPrompt bool
+	cmd := &cobra.Command{
+		Use:   "port-forward",
+		Short: "Forward a local port to a remote host through a running task of a service.",
+		Long: `Forward a local port to a remote host through a running task of a service.
+The remote host can be any private resource that the service can reach, such as a database,
+and the session keeps running until it's interrupted.`,
+		Example: `
+  Forward port 5432 on your machine to an Aurora cluster through a task of the "api" service.
+  /code $ copilot svc port-forward -a my-app -e test -n api --remote-host my-cluster.cluster-abc.us-west-2.rds.amazonaws.com --remote-port 5432
+  Forward port 8080 on your machine to port 80 of the task prefixed with ID "8c38184" within the "frontend" service.
+  /code $ copilot svc port-forward -n frontend --task-id 8c38184 --local-port 8080 --remote-port 80`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcPortForwardOpts(vars)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(yesFlag) {
+				opts.skipConfirmation = aws.Bool(false)
+				if skipPrompt {
+					opts.skipConfirmation = aws.Bool(true)
+				}
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", nameFlagDescription)
+	cmd.Flags().IntVar(&vars.localPort, localPortFlag, 0, localPortFlagDescription)
+	cmd.Flags().StringVar(&vars.remoteHost, remoteHostFlag, "", remoteHostFlagDescription)
+	cmd.Flags().IntVar(&vars.remotePort, remotePortFlag, 0, remotePortFlagDescription)
+	cmd.Flags().StringVar(&vars.taskID, taskIDFlag, "", portForwardTaskIDFlagDescription)
+	cmd.Flags().StringVar(&vars.containerName, containerFlag, "", portForwardContainerFlagDescription)
+	cmd.Flags().BoolVar(&skipPrompt, yesFlag, false, execYesFlagDescription)
+
+	cmd.SetUsageTemplate(template.Usage)
+	return cmd
+}
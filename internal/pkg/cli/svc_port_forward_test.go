@@ -0,0 +1,297 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcPortForwardMocks struct {
+	store         *mocks.Mockstore
+	sel           *mocks.MockdeploySelector
+	prompter      *mocks.Mockprompter
+	taskSel       *mocks.MockrunningTaskSelector
+	portForwarder *mocks.MockssmPortForwarder
+}
+
+func TestSvcPortForward_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inLocalPort  int
+		inRemotePort int
+
+		wantedError error
+	}{
+		"valid ports": {
+			inLocalPort:  15432,
+			inRemotePort: 5432,
+		},
+		"invalid local port": {
+			inLocalPort:  70000,
+			inRemotePort: 5432,
+			wantedError:  errors.New("--local-port must be between 1 and 65535"),
+		},
+		"invalid remote port": {
+			inRemotePort: -1,
+			wantedError:  errors.New("--remote-port must be between 1 and 65535"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					localPort:        tc.inLocalPort,
+					remotePort:       tc.inRemotePort,
+					skipConfirmation: aws.Bool(false),
+				},
+			}
+
+			err := opts.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcPortForward_Ask(t *testing.T) {
+	mockTask := &awsecs.Task{
+		TaskArn: aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"),
+	}
+	testCases := map[string]struct {
+		inRemotePort int
+		inTaskID     string
+		setupMocks   func(m svcPortForwardMocks)
+
+		wantedRemotePort int
+		wantedError      error
+	}{
+		"selects the service, the remote port and a task of the service": {
+			inTaskID: "mockTask",
+			setupMocks: func(m svcPortForwardMocks) {
+				gomock.InOrder(
+					m.sel.EXPECT().Application(svcAppNamePrompt, svcAppNameHelpPrompt).Return("my-app", nil),
+					m.sel.EXPECT().DeployedService(svcPortForwardNamePrompt, svcPortForwardNameHelpPrompt, "my-app", gomock.Any(), gomock.Any()).
+						Return(&selector.DeployedService{
+							Env: "my-env",
+							Svc: "my-svc",
+						}, nil),
+					m.prompter.EXPECT().Get(svcPortForwardRemotePortPrompt, svcPortForwardRemotePortHelpPrompt, gomock.Any(), gomock.Any()).
+						Return("5432", nil),
+				)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.taskSel.EXPECT().RunningTask(svcPortForwardTaskPrompt, svcPortForwardTaskHelpPrompt, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(mockTask, nil)
+			},
+			wantedRemotePort: 5432,
+		},
+		"does not prompt for the remote port if it's set": {
+			inRemotePort: 80,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("my-app", nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "my-env",
+						Svc: "my-svc",
+					}, nil)
+				m.prompter.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.taskSel.EXPECT().RunningTask(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(mockTask, nil)
+			},
+			wantedRemotePort: 80,
+		},
+		"wraps the error if no task can be selected": {
+			inRemotePort: 80,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.sel.EXPECT().Application(gomock.Any(), gomock.Any()).Return("my-app", nil)
+				m.sel.EXPECT().DeployedService(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&selector.DeployedService{
+						Env: "my-env",
+						Svc: "my-svc",
+					}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.taskSel.EXPECT().RunningTask(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("no running tasks found"))
+			},
+			wantedError: errors.New("select running task of service my-svc in environment my-env: no running tasks found"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPortForwardMocks{
+				store:    mocks.NewMockstore(ctrl),
+				sel:      mocks.NewMockdeploySelector(ctrl),
+				prompter: mocks.NewMockprompter(ctrl),
+				taskSel:  mocks.NewMockrunningTaskSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					remotePort: tc.inRemotePort,
+					taskID:     tc.inTaskID,
+				},
+				store:    m.store,
+				sel:      m.sel,
+				prompter: m.prompter,
+				newTaskSel: func(_ *session.Session) runningTaskSelector {
+					return m.taskSel
+				},
+				sessFromEnvManager: func(_ *config.Environment) (*session.Session, error) {
+					return &session.Session{}, nil
+				},
+			}
+
+			err := opts.Ask()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "my-app", opts.appName)
+			require.Equal(t, "my-env", opts.envName)
+			require.Equal(t, "my-svc", opts.name)
+			require.Equal(t, tc.wantedRemotePort, opts.remotePort)
+			require.Equal(t, mockTask, opts.task)
+		})
+	}
+}
+
+func TestSvcPortForward_Execute(t *testing.T) {
+	mockTask := &awsecs.Task{
+		ClusterArn: aws.String("arn:aws:ecs:us-west-2:123456789:cluster/mockCluster"),
+		TaskArn:    aws.String("arn:aws:ecs:us-west-2:123456789:task/mockCluster/mockTaskID"),
+		Containers: []*ecs.Container{
+			{
+				Name:      aws.String("my-svc"),
+				RuntimeId: aws.String("mockRuntimeID"),
+			},
+		},
+	}
+	mockErr := errors.New("some error")
+	testCases := map[string]struct {
+		inRemoteHost string
+		inLocalPort  int
+		inContainer  string
+		setupMocks   func(m svcPortForwardMocks)
+
+		wantedError error
+	}{
+		"forwards the port to the remote host": {
+			inRemoteHost: "db.example.com",
+			inLocalPort:  15432,
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Target:     "ecs:mockCluster_mockTaskID_mockRuntimeID",
+					RemoteHost: "db.example.com",
+					RemotePort: 5432,
+					LocalPort:  15432,
+				}).Return(nil)
+			},
+		},
+		"forwards the remote port of the task itself by default": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(ssm.PortForwardingSessionInput{
+					Target:     "ecs:mockCluster_mockTaskID_mockRuntimeID",
+					RemoteHost: "localhost",
+					RemotePort: 5432,
+					LocalPort:  5432,
+				}).Return(nil)
+			},
+		},
+		"returns an error for request-driven web services": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.RequestDrivenWebServiceType,
+				}, nil)
+			},
+			wantedError: fmt.Errorf("port forwarding is not supported for services with type: '%s'", manifest.RequestDrivenWebServiceType),
+		},
+		"returns an error if the container doesn't exist": {
+			inContainer: "sidecar",
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+			},
+			wantedError: errors.New("get session target of container sidecar: container sidecar not found in task mockTaskID"),
+		},
+		"wraps the error from the session": {
+			setupMocks: func(m svcPortForwardMocks) {
+				m.store.EXPECT().GetWorkload("my-app", "my-svc").Return(&config.Workload{
+					Type: manifest.BackendServiceType,
+				}, nil)
+				m.store.EXPECT().GetEnvironment("my-app", "my-env").Return(&config.Environment{}, nil)
+				m.portForwarder.EXPECT().StartPortForwardingSession(gomock.Any()).Return(mockErr)
+			},
+			wantedError: errors.New("forward port 5432 to localhost:5432: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcPortForwardMocks{
+				store:         mocks.NewMockstore(ctrl),
+				portForwarder: mocks.NewMockssmPortForwarder(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := &svcPortForwardOpts{
+				svcPortForwardVars: svcPortForwardVars{
+					appName:       "my-app",
+					envName:       "my-env",
+					name:          "my-svc",
+					containerName: tc.inContainer,
+					localPort:     tc.inLocalPort,
+					remoteHost:    tc.inRemoteHost,
+					remotePort:    5432,
+				},
+				store: m.store,
+				newPortForwarder: func(_ *session.Session) ssmPortForwarder {
+					return m.portForwarder
+				},
+				sessFromEnvManager: func(_ *config.Environment) (*session.Session, error) {
+					return &session.Session{}, nil
+				},
+				task: mockTask,
+			}
+
+			err := opts.Execute()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
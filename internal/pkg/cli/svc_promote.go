@@ -0,0 +1,387 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecr"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcPromoteSvcNamePrompt     = "Which service would you like to promote?"
+	svcPromoteFromEnvNamePrompt = "Which environment would you like to promote the image from?"
+	svcPromoteToEnvNamePrompt   = "Which environment would you like to promote the image to?"
+)
+
+var errPromoteSameEnv = errors.New(`"--from" and "--to" must be different environments`)
+
+type promoteSvcVars struct {
+	appName      string
+	name         string
+	fromEnv      string
+	toEnv        string
+	resourceTags map[string]string
+}
+
+type promoteSvcOpts struct {
+	promoteSvcVars
+
+	store     store
+	ws        wsSvcDirReader
+	sel       wsSelector
+	unmarshal func([]byte) (manifest.WorkloadManifest, error)
+
+	// Constructors for clients that can be initialized only at runtime.
+	// These functions are overridden in tests to provide mocks.
+	newTaskDefDescriber  func(env *config.Environment) (serviceTaskDefinitionDescriber, error)
+	newImageDigestGetter func(region string) (imageDigestGetter, error)
+	newSvcDeployer       func(imageDigest string, sidecarDigests map[string]string) (actionCommand, error)
+
+	// cached variables
+	deployer           actionCommand
+	buildInputs        string            // Hash of the build inputs of the service's image in the target environment.
+	sidecarBuildInputs map[string]string // Hashes of the build inputs of the sidecar images in the target environment, keyed by sidecar name.
+	builtSidecars      []string          // Names of the sidecars whose images are built from a Dockerfile.
+}
+
+func newPromoteSvcOpts(vars promoteSvcVars) (*promoteSvcOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	opts := &promoteSvcOpts{
+		promoteSvcVars: vars,
+
+		store:     store,
+		ws:        ws,
+		sel:       selector.NewWorkspaceSelect(prompt.New(), store, ws),
+		unmarshal: manifest.UnmarshalWorkload,
+		newTaskDefDescriber: func(env *config.Environment) (serviceTaskDefinitionDescriber, error) {
+			sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+			if err != nil {
+				return nil, fmt.Errorf("create session from role %s and region %s: %w", env.ManagerRoleARN, env.Region, err)
+			}
+			return ecs.New(sess), nil
+		},
+		newImageDigestGetter: func(region string) (imageDigestGetter, error) {
+			sess, err := sessions.NewProvider().DefaultWithRegion(region)
+			if err != nil {
+				return nil, fmt.Errorf("create ECR session with region %s: %w", region, err)
+			}
+			return ecr.New(sess), nil
+		},
+	}
+	opts.newSvcDeployer = func(imageDigest string, sidecarDigests map[string]string) (actionCommand, error) {
+		deployOpts, err := newSvcDeployOpts(deployWkldVars{
+			appName:      opts.appName,
+			name:         opts.name,
+			envName:      opts.toEnv,
+			resourceTags: opts.resourceTags,
+		})
+		if err != nil {
+			return nil, err
+		}
+		deployOpts.promotedImageDigest = imageDigest
+		deployOpts.promotedSidecarDigests = sidecarDigests
+		return deployOpts, nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *promoteSvcOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		names, err := o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !contains(o.name, names) {
+			return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+		}
+	}
+	for _, env := range []string{o.fromEnv, o.toEnv} {
+		if env == "" {
+			continue
+		}
+		if _, err := targetEnv(o.store, o.appName, env); err != nil {
+			return err
+		}
+	}
+	if o.fromEnv != "" && o.fromEnv == o.toEnv {
+		return errPromoteSameEnv
+	}
+	return nil
+}
+
+// Ask prompts the user for any missing required fields.
+func (o *promoteSvcOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcPromoteSvcNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.fromEnv == "" {
+		name, err := o.sel.Environment(svcPromoteFromEnvNamePrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment to promote from: %w", err)
+		}
+		o.fromEnv = name
+	}
+	if o.toEnv == "" {
+		name, err := o.sel.Environment(svcPromoteToEnvNamePrompt, "", o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment to promote to: %w", err)
+		}
+		o.toEnv = name
+	}
+	if o.fromEnv == o.toEnv {
+		return errPromoteSameEnv
+	}
+	return nil
+}
+
+// Execute deploys the image running in the source environment to the target environment without rebuilding it.
+func (o *promoteSvcOpts) Execute() error {
+	from, err := targetEnv(o.store, o.appName, o.fromEnv)
+	if err != nil {
+		return err
+	}
+	to, err := targetEnv(o.store, o.appName, o.toEnv)
+	if err != nil {
+		return err
+	}
+	if from.Region != to.Region {
+		return fmt.Errorf("environments %s and %s are in different regions %s and %s: images are stored in an ECR repository per region, run %s to build the image for %s",
+			from.Name, to.Name, from.Region, to.Region, color.HighlightCode("copilot svc deploy"), to.Name)
+	}
+	if err := o.configureBuildInputs(); err != nil {
+		return err
+	}
+	digest, sidecarDigests, err := o.deployedImageDigests(from)
+	if err != nil {
+		return err
+	}
+	log.Infof("Promoting image %s of service %s from environment %s to environment %s.\n",
+		digest, color.HighlightUserInput(o.name), color.HighlightUserInput(from.Name), color.HighlightUserInput(to.Name))
+	deployer, err := o.newSvcDeployer(digest, sidecarDigests)
+	if err != nil {
+		return err
+	}
+	o.deployer = deployer
+	return deployer.Execute()
+}
+
+// RecommendActions returns follow-up actions the user can take after successfully executing the command.
+func (o *promoteSvcOpts) RecommendActions() error {
+	if o.deployer == nil {
+		return nil
+	}
+	return o.deployer.RecommendActions()
+}
+
+// configureBuildInputs computes the hashes of the build inputs of the images of the service and its sidecars
+// with the manifest of the target environment.
+func (o *promoteSvcOpts) configureBuildInputs() error {
+	raw, err := o.ws.ReadServiceManifest(o.name)
+	if err != nil {
+		return fmt.Errorf("read service %s manifest file: %w", o.name, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
+	}
+	envMft, err := mft.ApplyEnv(o.toEnv)
+	if err != nil {
+		return fmt.Errorf("apply environment %s override: %w", o.toEnv, err)
+	}
+	required, err := manifest.ServiceDockerfileBuildRequired(envMft)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return fmt.Errorf("service %s does not build its image in environment %s: only images built from a Dockerfile can be promoted", o.name, o.toEnv)
+	}
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	wsRoot := filepath.Dir(copilotDir)
+	buildArg, err := buildArgs(o.name, "", copilotDir, envMft)
+	if err != nil {
+		return err
+	}
+	if o.buildInputs, err = buildInputsHash(wsRoot, buildArg); err != nil {
+		return err
+	}
+	sidecarArgs, err := sidecarBuildArgs("", o.ws, envMft)
+	if err != nil {
+		return err
+	}
+	o.sidecarBuildInputs = make(map[string]string, len(sidecarArgs))
+	o.builtSidecars = nil
+	for name, arg := range sidecarArgs {
+		if o.sidecarBuildInputs[name], err = buildInputsHash(wsRoot, arg); err != nil {
+			return err
+		}
+		o.builtSidecars = append(o.builtSidecars, name)
+	}
+	sort.Strings(o.builtSidecars)
+	return nil
+}
+
+// validateSameBuild returns an error if the images deployed to the source environment were not built
+// with the same inputs as the manifest builds them with in the target environment.
+// The build inputs of the deployed images are recorded in the docker labels of their containers at deploy time,
+// so changes made to the manifest since the source environment was deployed are caught.
+func (o *promoteSvcOpts) validateSameBuild(env *config.Environment, taskDef *awsecs.TaskDefinition) error {
+	redeploy := color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.name, env.Name))
+	rebuild := color.HighlightCode(fmt.Sprintf("copilot svc deploy -n %s -e %s", o.name, o.toEnv))
+	validate := func(container, wanted string) error {
+		labels, err := taskDef.DockerLabels(container)
+		if err != nil {
+			return fmt.Errorf("get docker labels of container %s of service %s in environment %s: %w", container, o.name, env.Name, err)
+		}
+		deployed, ok := labels[stack.BuildInputsDockerLabel]
+		if !ok {
+			return fmt.Errorf("the image of container %s of service %s in environment %s was deployed without recording its build inputs: run %s before promoting it",
+				container, o.name, env.Name, redeploy)
+		}
+		if deployed != wanted {
+			return fmt.Errorf("manifest of service %s builds the image of container %s in environment %s differently than the image deployed to %s: run %s to rebuild the image",
+				o.name, container, o.toEnv, env.Name, rebuild)
+		}
+		return nil
+	}
+	if err := validate(o.name, o.buildInputs); err != nil {
+		return err
+	}
+	for _, sidecar := range o.builtSidecars {
+		if err := validate(sidecar, o.sidecarBuildInputs[sidecar]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deployedImageDigests returns the digests of the images that the service and its built sidecars run in the environment.
+func (o *promoteSvcOpts) deployedImageDigests(env *config.Environment) (string, map[string]string, error) {
+	describer, err := o.newTaskDefDescriber(env)
+	if err != nil {
+		return "", nil, err
+	}
+	taskDef, err := describer.ServiceTaskDefinition(o.appName, env.Name, o.name)
+	if err != nil {
+		return "", nil, fmt.Errorf("get task definition of service %s in environment %s: %w", o.name, env.Name, err)
+	}
+	if err := o.validateSameBuild(env, taskDef); err != nil {
+		return "", nil, err
+	}
+	image, err := taskDef.Image(o.name)
+	if err != nil {
+		return "", nil, fmt.Errorf("get image of service %s in environment %s: %w", o.name, env.Name, err)
+	}
+	digest, err := o.imageDigest(env, image, fmt.Sprintf("%s/%s", o.appName, o.name))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(o.builtSidecars) == 0 {
+		return digest, nil, nil
+	}
+	sidecarDigests := make(map[string]string, len(o.builtSidecars))
+	for _, sidecar := range o.builtSidecars {
+		image, err := taskDef.Image(sidecar)
+		if err != nil {
+			return "", nil, fmt.Errorf("get image of sidecar %s of service %s in environment %s: %w", sidecar, o.name, env.Name, err)
+		}
+		if sidecarDigests[sidecar], err = o.imageDigest(env, image, fmt.Sprintf("%s/%s/%s", o.appName, o.name, sidecar)); err != nil {
+			return "", nil, err
+		}
+	}
+	return digest, sidecarDigests, nil
+}
+
+// imageDigest returns the digest of an image that must be stored in the ECR repository repoName.
+func (o *promoteSvcOpts) imageDigest(env *config.Environment, image, repoName string) (string, error) {
+	repoURI, tag, digest := parseImageURI(image)
+	if !strings.HasSuffix(repoURI, "/"+repoName) {
+		return "", fmt.Errorf("image %s of service %s in environment %s is not stored in the ECR repository %s", image, o.name, env.Name, repoName)
+	}
+	if digest != "" {
+		return digest, nil
+	}
+	getter, err := o.newImageDigestGetter(env.Region)
+	if err != nil {
+		return "", err
+	}
+	digest, err = getter.ImageDigest(repoName, tag)
+	if err != nil {
+		return "", fmt.Errorf("get digest of image %s: %w", image, err)
+	}
+	return digest, nil
+}
+
+// parseImageURI splits an image URI into its repository URI and either its tag or its digest.
+// The tag defaults to "latest" if the image URI doesn't have a tag nor a digest.
+func parseImageURI(image string) (repoURI, tag, digest string) {
+	if i := strings.Index(image, "@"); i != -1 {
+		return image[:i], "", image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i], image[i+1:], ""
+	}
+	return image, "latest", ""
+}
+
+// buildSvcPromoteCmd builds the `svc promote` subcommand.
+func buildSvcPromoteCmd() *cobra.Command {
+	vars := promoteSvcVars{}
+	cmd := &cobra.Command{
+		Use:   "promote",
+		Short: "Deploys the image of a service in an environment to another environment without rebuilding it.",
+		Long: `Deploys the image of a service in an environment to another environment without rebuilding it.
+The image digest that the service runs in the source environment is deployed as is to the target environment.`,
+		Example: `
+  Promotes the image of the "api" service tested in "staging" to "prod".
+  /code $ copilot svc promote -n api --from staging --to prod`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newPromoteSvcOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVar(&vars.fromEnv, fromEnvFlag, "", fromEnvFlagDescription)
+	cmd.Flags().StringVar(&vars.toEnv, toEnvFlag, "", toEnvFlagDescription)
+	cmd.Flags().StringToStringVar(&vars.resourceTags, resourceTagsFlag, nil, resourceTagsFlagDescription)
+	return cmd
+}
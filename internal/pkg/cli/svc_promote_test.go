@@ -0,0 +1,536 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdkecs "github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestPromoteSvcOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName string
+		inSvcName string
+		inFromEnv string
+		inToEnv   string
+
+		setupMocks func(ws *mocks.MockwsSvcDirReader, store *mocks.Mockstore)
+
+		wantedErr string
+	}{
+		"error if the application is not set": {
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, store *mocks.Mockstore) {},
+			wantedErr:  errNoAppInWorkspace.Error(),
+		},
+		"error if the service is not in the workspace": {
+			inAppName: "phonetool",
+			inSvcName: "api",
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, store *mocks.Mockstore) {
+				ws.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+			},
+			wantedErr: "service api not found in the workspace",
+		},
+		"error if an environment does not exist": {
+			inAppName: "phonetool",
+			inFromEnv: "staging",
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, store *mocks.Mockstore) {
+				store.EXPECT().GetEnvironment("phonetool", "staging").Return(nil, errors.New("some error"))
+			},
+			wantedErr: "get environment staging configuration: some error",
+		},
+		"error if the environments are the same": {
+			inAppName: "phonetool",
+			inFromEnv: "staging",
+			inToEnv:   "staging",
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, store *mocks.Mockstore) {
+				store.EXPECT().GetEnvironment("phonetool", "staging").Return(&config.Environment{}, nil).Times(2)
+			},
+			wantedErr: errPromoteSameEnv.Error(),
+		},
+		"no error if the service and environments exist": {
+			inAppName: "phonetool",
+			inSvcName: "api",
+			inFromEnv: "staging",
+			inToEnv:   "prod",
+			setupMocks: func(ws *mocks.MockwsSvcDirReader, store *mocks.Mockstore) {
+				ws.EXPECT().ServiceNames().Return([]string{"api"}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "staging").Return(&config.Environment{}, nil)
+				store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ws := mocks.NewMockwsSvcDirReader(ctrl)
+			store := mocks.NewMockstore(ctrl)
+			tc.setupMocks(ws, store)
+			opts := &promoteSvcOpts{
+				promoteSvcVars: promoteSvcVars{
+					appName: tc.inAppName,
+					name:    tc.inSvcName,
+					fromEnv: tc.inFromEnv,
+					toEnv:   tc.inToEnv,
+				},
+				ws:    ws,
+				store: store,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPromoteSvcOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcName string
+		inFromEnv string
+		inToEnv   string
+
+		setupMocks func(sel *mocks.MockwsSelector)
+
+		wantedFromEnv string
+		wantedToEnv   string
+		wantedErr     string
+	}{
+		"prompts for the service and both environments": {
+			setupMocks: func(sel *mocks.MockwsSelector) {
+				sel.EXPECT().Service(svcPromoteSvcNamePrompt, "").Return("api", nil)
+				sel.EXPECT().Environment(svcPromoteFromEnvNamePrompt, "", "phonetool").Return("staging", nil)
+				sel.EXPECT().Environment(svcPromoteToEnvNamePrompt, "", "phonetool").Return("prod", nil)
+			},
+			wantedFromEnv: "staging",
+			wantedToEnv:   "prod",
+		},
+		"error if the selected environments are the same": {
+			inSvcName: "api",
+			inFromEnv: "staging",
+			setupMocks: func(sel *mocks.MockwsSelector) {
+				sel.EXPECT().Environment(svcPromoteToEnvNamePrompt, "", "phonetool").Return("staging", nil)
+			},
+			wantedErr: errPromoteSameEnv.Error(),
+		},
+		"wraps selector errors": {
+			inSvcName: "api",
+			setupMocks: func(sel *mocks.MockwsSelector) {
+				sel.EXPECT().Environment(svcPromoteFromEnvNamePrompt, "", "phonetool").Return("", errors.New("some error"))
+			},
+			wantedErr: "select environment to promote from: some error",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sel := mocks.NewMockwsSelector(ctrl)
+			tc.setupMocks(sel)
+			opts := &promoteSvcOpts{
+				promoteSvcVars: promoteSvcVars{
+					appName: "phonetool",
+					name:    tc.inSvcName,
+					fromEnv: tc.inFromEnv,
+					toEnv:   tc.inToEnv,
+				},
+				sel: sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedFromEnv, opts.fromEnv)
+			require.Equal(t, tc.wantedToEnv, opts.toEnv)
+		})
+	}
+}
+
+func TestPromoteSvcOpts_Execute(t *testing.T) {
+	const (
+		mockRepoURI = "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api"
+		mockDigest  = "sha256:18f7eb6cff6e63e5f5273fb53f672975fe6044580f66c354f55d2de8dd28aec7"
+	)
+	mockManifest := []byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+environments:
+  prod:
+    count: 3
+`)
+	testCases := map[string]struct {
+		inManifest         []byte
+		inDeployedManifest []byte // Manifest that the source environment was deployed with, defaults to inManifest.
+		inToRegion         string
+
+		setupMocks func(m promoteSvcMocks)
+
+		wantedDigest         string
+		wantedSidecarDigests map[string]string
+		wantedErr            string
+	}{
+		"error if the environments are in different regions": {
+			inManifest: mockManifest,
+			inToRegion: "us-east-1",
+			setupMocks: func(m promoteSvcMocks) {},
+			wantedErr:  "environments staging and prod are in different regions us-west-2 and us-east-1: images are stored in an ECR repository per region, run `copilot svc deploy` to build the image for prod",
+		},
+		"error if the service does not build its image": {
+			inManifest: []byte(`name: api
+type: Backend Service
+image:
+  location: nginx
+  port: 80
+`),
+			setupMocks: func(m promoteSvcMocks) {},
+			wantedErr:  "service api does not build its image in environment prod: only images built from a Dockerfile can be promoted",
+		},
+		"error if the source image was deployed without recording its build inputs": {
+			inManifest: mockManifest,
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(nil, mockRepoURI+"@"+mockDigest), nil)
+			},
+			wantedErr: "the image of container api of service api in environment staging was deployed without recording its build inputs: run `copilot svc deploy -n api -e staging` before promoting it",
+		},
+		"error if the manifest changed how the image is built since the source environment was deployed": {
+			inDeployedManifest: mockManifest,
+			inManifest: []byte(`name: api
+type: Backend Service
+image:
+  build:
+    dockerfile: api/Dockerfile
+    target: release
+  port: 8080
+`),
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI+"@"+mockDigest), nil)
+			},
+			wantedErr: "manifest of service api builds the image of container api in environment prod differently than the image deployed to staging: run `copilot svc deploy -n api -e prod` to rebuild the image",
+		},
+		"error if the target environment builds a different image": {
+			inManifest: []byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+environments:
+  prod:
+    image:
+      build:
+        dockerfile: api/Dockerfile
+        args:
+          FLAVOR: prod
+`),
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI+"@"+mockDigest), nil)
+			},
+			wantedErr: "manifest of service api builds the image of container api in environment prod differently than the image deployed to staging: run `copilot svc deploy -n api -e prod` to rebuild the image",
+		},
+		"error if the manifest changed how a sidecar image is built since the source environment was deployed": {
+			inDeployedManifest: []byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+sidecars:
+  proxy:
+    image:
+      build: proxy/Dockerfile
+`),
+			inManifest: []byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+sidecars:
+  proxy:
+    image:
+      build:
+        dockerfile: proxy/Dockerfile
+        args:
+          FLAVOR: prod
+`),
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI+"@"+mockDigest,
+					sidecarImage{name: "proxy", image: mockRepoURI + "/proxy:v1.2.0"}), nil)
+			},
+			wantedErr: "manifest of service api builds the image of container proxy in environment prod differently than the image deployed to staging: run `copilot svc deploy -n api -e prod` to rebuild the image",
+		},
+		"error if the deployed image is not in the service repository": {
+			inManifest: mockManifest,
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, "nginx:latest"), nil)
+			},
+			wantedErr: "image nginx:latest of service api in environment staging is not stored in the ECR repository phonetool/api",
+		},
+		"deploys the digest of the source environment": {
+			inManifest: mockManifest,
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI+"@"+mockDigest), nil)
+				m.deployer.EXPECT().Execute().Return(nil)
+			},
+			wantedDigest: mockDigest,
+		},
+		"resolves the digest of a tagged image": {
+			inManifest: mockManifest,
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI+":v1.2.0"), nil)
+				m.digest.EXPECT().ImageDigest("phonetool/api", "v1.2.0").Return(mockDigest, nil)
+				m.deployer.EXPECT().Execute().Return(nil)
+			},
+			wantedDigest: mockDigest,
+		},
+		"reuses the sidecar images of the source environment": {
+			inManifest: []byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+sidecars:
+  xray:
+    image: amazon/aws-xray-daemon
+  proxy:
+    image:
+      build: proxy/Dockerfile
+`),
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI+"@"+mockDigest,
+					sidecarImage{name: "xray", image: "amazon/aws-xray-daemon"},
+					sidecarImage{name: "proxy", image: mockRepoURI + "/proxy:v1.2.0"}), nil)
+				m.digest.EXPECT().ImageDigest("phonetool/api/proxy", "v1.2.0").Return("sha256:proxy", nil)
+				m.deployer.EXPECT().Execute().Return(nil)
+			},
+			wantedDigest: mockDigest,
+			wantedSidecarDigests: map[string]string{
+				"proxy": "sha256:proxy",
+			},
+		},
+		"error if a built sidecar image is not in the sidecar repository": {
+			inManifest: []byte(`name: api
+type: Backend Service
+image:
+  build: api/Dockerfile
+  port: 8080
+sidecars:
+  proxy:
+    image:
+      build: proxy/Dockerfile
+`),
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI+"@"+mockDigest,
+					sidecarImage{name: "proxy", image: "envoyproxy/envoy:v1.20"}), nil)
+			},
+			wantedErr: "image envoyproxy/envoy:v1.20 of service api in environment staging is not stored in the ECR repository phonetool/api/proxy",
+		},
+		"wraps error if the image digest can't be retrieved": {
+			inManifest: mockManifest,
+			setupMocks: func(m promoteSvcMocks) {
+				m.taskDef.EXPECT().ServiceTaskDefinition("phonetool", "staging", "api").Return(mockTaskDef(m.buildInputs, mockRepoURI), nil)
+				m.digest.EXPECT().ImageDigest("phonetool/api", "latest").Return("", errors.New("some error"))
+			},
+			wantedErr: fmt.Sprintf("get digest of image %s: some error", mockRepoURI),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := promoteSvcMocks{
+				store:    mocks.NewMockstore(ctrl),
+				ws:       mocks.NewMockwsSvcDirReader(ctrl),
+				taskDef:  mocks.NewMockserviceTaskDefinitionDescriber(ctrl),
+				digest:   mocks.NewMockimageDigestGetter(ctrl),
+				deployer: mocks.NewMockactionCommand(ctrl),
+			}
+			toRegion := "us-west-2"
+			if tc.inToRegion != "" {
+				toRegion = tc.inToRegion
+			}
+			m.store.EXPECT().GetEnvironment("phonetool", "staging").Return(&config.Environment{Name: "staging", Region: "us-west-2"}, nil)
+			m.store.EXPECT().GetEnvironment("phonetool", "prod").Return(&config.Environment{Name: "prod", Region: toRegion}, nil)
+			m.ws.EXPECT().ReadServiceManifest("api").Return(tc.inManifest, nil).AnyTimes()
+			m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil).AnyTimes()
+			deployedManifest := tc.inManifest
+			if tc.inDeployedManifest != nil {
+				deployedManifest = tc.inDeployedManifest
+			}
+			m.buildInputs = mockBuildInputs(t, m.ws, deployedManifest, "staging")
+			tc.setupMocks(m)
+
+			var gotDigest string
+			var gotSidecarDigests map[string]string
+			opts := &promoteSvcOpts{
+				promoteSvcVars: promoteSvcVars{
+					appName: "phonetool",
+					name:    "api",
+					fromEnv: "staging",
+					toEnv:   "prod",
+				},
+				store:     m.store,
+				ws:        m.ws,
+				unmarshal: manifest.UnmarshalWorkload,
+				newTaskDefDescriber: func(env *config.Environment) (serviceTaskDefinitionDescriber, error) {
+					return m.taskDef, nil
+				},
+				newImageDigestGetter: func(region string) (imageDigestGetter, error) {
+					return m.digest, nil
+				},
+				newSvcDeployer: func(imageDigest string, sidecarDigests map[string]string) (actionCommand, error) {
+					gotDigest = imageDigest
+					gotSidecarDigests = sidecarDigests
+					return m.deployer, nil
+				},
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, gotDigest)
+			require.Equal(t, tc.wantedSidecarDigests, gotSidecarDigests)
+		})
+	}
+}
+
+func TestParseImageURI(t *testing.T) {
+	testCases := map[string]struct {
+		in string
+
+		wantedRepoURI string
+		wantedTag     string
+		wantedDigest  string
+	}{
+		"image with a digest": {
+			in:            "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api@sha256:abc",
+			wantedRepoURI: "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+			wantedDigest:  "sha256:abc",
+		},
+		"image with a tag": {
+			in:            "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api:v1",
+			wantedRepoURI: "1234.dkr.ecr.us-west-2.amazonaws.com/phonetool/api",
+			wantedTag:     "v1",
+		},
+		"image without a tag on a registry with a port": {
+			in:            "localhost:5000/api",
+			wantedRepoURI: "localhost:5000/api",
+			wantedTag:     "latest",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			repoURI, tag, digest := parseImageURI(tc.in)
+
+			require.Equal(t, tc.wantedRepoURI, repoURI)
+			require.Equal(t, tc.wantedTag, tag)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
+type promoteSvcMocks struct {
+	store    *mocks.Mockstore
+	ws       *mocks.MockwsSvcDirReader
+	taskDef  *mocks.MockserviceTaskDefinitionDescriber
+	digest   *mocks.MockimageDigestGetter
+	deployer *mocks.MockactionCommand
+
+	buildInputs map[string]string // Build inputs recorded in the docker labels of the source environment, keyed by container name.
+}
+
+type sidecarImage struct {
+	name  string
+	image string
+}
+
+func mockTaskDef(buildInputs map[string]string, image string, sidecars ...sidecarImage) *awsecs.TaskDefinition {
+	labels := func(name string) map[string]*string {
+		if _, ok := buildInputs[name]; !ok {
+			return nil
+		}
+		return aws.StringMap(map[string]string{
+			stack.BuildInputsDockerLabel: buildInputs[name],
+		})
+	}
+	containers := []*sdkecs.ContainerDefinition{
+		{
+			Name:         aws.String("api"),
+			Image:        aws.String(image),
+			DockerLabels: labels("api"),
+		},
+	}
+	for _, sidecar := range sidecars {
+		containers = append(containers, &sdkecs.ContainerDefinition{
+			Name:         aws.String(sidecar.name),
+			Image:        aws.String(sidecar.image),
+			DockerLabels: labels(sidecar.name),
+		})
+	}
+	return &awsecs.TaskDefinition{
+		ContainerDefinitions: containers,
+	}
+}
+
+// mockBuildInputs returns the build inputs that svc deploy records for the images of a manifest deployed to env.
+func mockBuildInputs(t *testing.T, ws copilotDirGetter, mft []byte, env string) map[string]string {
+	wl, err := manifest.UnmarshalWorkload(mft)
+	require.NoError(t, err)
+	envMft, err := wl.ApplyEnv(env)
+	require.NoError(t, err)
+	if required, err := manifest.ServiceDockerfileBuildRequired(envMft); err != nil || !required {
+		return nil
+	}
+	args, err := buildArgs("api", "", "/ws/copilot", envMft)
+	require.NoError(t, err)
+	hash, err := buildInputsHash("/ws", args)
+	require.NoError(t, err)
+	buildInputs := map[string]string{
+		"api": hash,
+	}
+	sidecarArgs, err := sidecarBuildArgs("", ws, envMft)
+	require.NoError(t, err)
+	for name, args := range sidecarArgs {
+		hash, err := buildInputsHash("/ws", args)
+		require.NoError(t, err)
+		buildInputs[name] = hash
+	}
+	return buildInputs
+}
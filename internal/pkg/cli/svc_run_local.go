@@ -0,0 +1,738 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/aws/secretsmanager"
+	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ssm"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/exec"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+	"github.com/aws/copilot-cli/internal/pkg/term/log"
+	"github.com/aws/copilot-cli/internal/pkg/term/prompt"
+	"github.com/aws/copilot-cli/internal/pkg/term/selector"
+	"github.com/aws/copilot-cli/internal/pkg/workspace"
+	"github.com/spf13/cobra"
+)
+
+const (
+	svcRunLocalNamePrompt        = "Which service would you like to run locally?"
+	svcRunLocalEnvNamePrompt     = "Which environment's configuration would you like to use?"
+	svcRunLocalEnvNameHelpPrompt = "The overrides, variables, and secrets of the selected environment are applied to the containers."
+
+	fmtRunLocalNetworkName          = "copilot-%s-%s-%s"
+	fmtRunLocalContainerName        = "%s-%s-%s-%s"
+	fmtRunLocalImageURI             = "%s/%s:local"
+	fmtRunLocalDiscoveryEndpoint    = "%s.%s.local"
+	runLocalFirelensContainerName   = "firelens_log_router"
+	runLocalFirelensForwardPort     = "24224"
+	runLocalDefaultHealthPollPeriod = time.Second
+
+	// Conditions of a container dependency.
+	dependsOnStart    = "START"
+	dependsOnComplete = "COMPLETE"
+	dependsOnSuccess  = "SUCCESS"
+	dependsOnHealthy  = "HEALTHY"
+)
+
+var errSvcRunLocalInterrupted = errors.New("interrupted while waiting for container dependencies")
+
+type svcRunLocalVars struct {
+	appName string
+	name    string
+	envName string
+}
+
+type svcRunLocalOpts struct {
+	svcRunLocalVars
+
+	store          store
+	ws             wsSvcDirReader
+	sel            wsSelector
+	unmarshal      func([]byte) (manifest.WorkloadManifest, error)
+	docker         localContainerRunner
+	ssm            secretGetter
+	secretsManager secretGetter
+	out            io.Writer
+
+	interrupt          chan os.Signal
+	healthPollInterval time.Duration
+	initSecretGetters  func() error // Overridden in tests.
+}
+
+// localContainer holds the configuration of a container of the service's task to run locally.
+type localContainer struct {
+	name      string
+	essential bool
+	dependsOn map[string]string
+	secrets   map[string]manifest.Secret // Maps the name of the environment variable to the SSM parameter or Secrets Manager secret holding its value.
+	run       *dockerengine.RunOptions
+
+	// States of the running container.
+	done chan struct{} // Closed once the container exits.
+	err  error         // Error returned by the container, only read once done is closed.
+}
+
+func newSvcRunLocalOpts(vars svcRunLocalVars) (*svcRunLocalOpts, error) {
+	store, err := config.NewStore()
+	if err != nil {
+		return nil, fmt.Errorf("new config store: %w", err)
+	}
+	ws, err := workspace.New()
+	if err != nil {
+		return nil, fmt.Errorf("new workspace: %w", err)
+	}
+	opts := &svcRunLocalOpts{
+		svcRunLocalVars: vars,
+
+		store:              store,
+		ws:                 ws,
+		sel:                selector.NewWorkspaceSelect(prompt.New(), store, ws),
+		unmarshal:          manifest.UnmarshalWorkload,
+		docker:             dockerengine.New(exec.NewCmd()),
+		out:                log.OutputWriter,
+		interrupt:          make(chan os.Signal, 1),
+		healthPollInterval: runLocalDefaultHealthPollPeriod,
+	}
+	opts.initSecretGetters = func() error {
+		env, err := opts.store.GetEnvironment(opts.appName, opts.envName)
+		if err != nil {
+			return fmt.Errorf("get environment %s: %w", opts.envName, err)
+		}
+		sess, err := sessions.NewProvider().FromRole(env.ManagerRoleARN, env.Region)
+		if err != nil {
+			return err
+		}
+		opts.ssm = ssm.New(sess)
+		opts.secretsManager = secretsmanager.NewWithSession(sess)
+		return nil
+	}
+	return opts, nil
+}
+
+// Validate returns an error if the values provided by the user are invalid.
+func (o *svcRunLocalOpts) Validate() error {
+	if o.appName == "" {
+		return errNoAppInWorkspace
+	}
+	if o.name != "" {
+		names, err := o.ws.ServiceNames()
+		if err != nil {
+			return fmt.Errorf("list services in the workspace: %w", err)
+		}
+		if !contains(o.name, names) {
+			return fmt.Errorf("service %s not found in the workspace", color.HighlightUserInput(o.name))
+		}
+	}
+	if o.envName != "" {
+		if _, err := targetEnv(o.store, o.appName, o.envName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ask prompts the user for any required fields that are not provided.
+func (o *svcRunLocalOpts) Ask() error {
+	if o.name == "" {
+		name, err := o.sel.Service(svcRunLocalNamePrompt, "")
+		if err != nil {
+			return fmt.Errorf("select service: %w", err)
+		}
+		o.name = name
+	}
+	if o.envName == "" {
+		name, err := o.sel.Environment(svcRunLocalEnvNamePrompt, svcRunLocalEnvNameHelpPrompt, o.appName)
+		if err != nil {
+			return fmt.Errorf("select environment: %w", err)
+		}
+		o.envName = name
+	}
+	return nil
+}
+
+// Execute runs the containers of the service's task on a local Docker network
+// until an essential container exits or the user interrupts the command.
+func (o *svcRunLocalOpts) Execute() error {
+	if err := o.docker.CheckDockerEngineRunning(); err != nil {
+		return err
+	}
+	mft, err := o.manifest()
+	if err != nil {
+		return err
+	}
+	containers, err := o.containers(mft)
+	if err != nil {
+		return err
+	}
+	if err := o.resolveSecrets(containers); err != nil {
+		return err
+	}
+	if err := o.buildImage(mft); err != nil {
+		return err
+	}
+	if err := o.buildSidecarImages(mft); err != nil {
+		return err
+	}
+	ordered, err := orderContainers(containers)
+	if err != nil {
+		return err
+	}
+
+	signal.Notify(o.interrupt, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(o.interrupt)
+
+	network := fmt.Sprintf(fmtRunLocalNetworkName, o.appName, o.envName, o.name)
+	if err := o.docker.CreateNetwork(network); err != nil {
+		return err
+	}
+	defer func() {
+		if err := o.docker.RemoveNetwork(network); err != nil {
+			log.Warningf("Failed to remove network %s: %v\n", network, err)
+		}
+	}()
+	return o.runContainers(network, ordered)
+}
+
+func (o *svcRunLocalOpts) manifest() (interface{}, error) {
+	raw, err := o.ws.ReadServiceManifest(o.name)
+	if err != nil {
+		return nil, fmt.Errorf("read service %s manifest file: %w", o.name, err)
+	}
+	mft, err := o.unmarshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal service %s manifest: %w", o.name, err)
+	}
+	envMft, err := mft.ApplyEnv(o.envName)
+	if err != nil {
+		return nil, fmt.Errorf("apply environment %s override: %w", o.envName, err)
+	}
+	if err := envMft.Validate(); err != nil {
+		return nil, fmt.Errorf("validate manifest against environment %s: %w", o.envName, err)
+	}
+	return envMft, nil
+}
+
+// containers returns the containers of the service's task keyed by name.
+func (o *svcRunLocalOpts) containers(mft interface{}) (map[string]*localContainer, error) {
+	var (
+		image       manifest.Image
+		port        *uint16
+		healthCheck manifest.ContainerHealthCheck
+		override    manifest.ImageOverride
+		task        manifest.TaskConfig
+		logging     manifest.Logging
+		sidecars    map[string]*manifest.SidecarConfig
+	)
+	switch t := mft.(type) {
+	case *manifest.LoadBalancedWebService:
+		image, port, healthCheck = t.ImageConfig.Image, t.ImageConfig.Port, t.ImageConfig.HealthCheck
+		override, task, logging, sidecars = t.ImageOverride, t.TaskConfig, t.Logging, t.Sidecars
+	case *manifest.BackendService:
+		image, port, healthCheck = t.ImageConfig.Image, t.ImageConfig.Port, t.ImageConfig.HealthCheck
+		override, task, logging, sidecars = t.ImageOverride, t.TaskConfig, t.Logging, t.Sidecars
+	case *manifest.WorkerService:
+		image, healthCheck = t.ImageConfig.Image, t.ImageConfig.HealthCheck
+		override, task, logging, sidecars = t.ImageOverride, t.TaskConfig, t.Logging, t.Sidecars
+	default:
+		return nil, fmt.Errorf("service %s of type %T cannot be run locally", o.name, mft)
+	}
+
+	main, err := newLocalContainer(o.name, override)
+	if err != nil {
+		return nil, err
+	}
+	main.essential = true
+	main.dependsOn = image.DependsOn
+	main.secrets = task.Secrets
+	main.run.ImageURI = image.GetLocation()
+	if main.run.ImageURI == "" {
+		main.run.ImageURI = fmt.Sprintf(fmtRunLocalImageURI, o.appName, o.name)
+	}
+	main.run.HealthCheck = localHealthCheck(healthCheck)
+	main.run.EnvVars = map[string]string{
+		"COPILOT_APPLICATION_NAME":           o.appName,
+		"COPILOT_ENVIRONMENT_NAME":           o.envName,
+		"COPILOT_SERVICE_NAME":               o.name,
+		"COPILOT_SERVICE_DISCOVERY_ENDPOINT": fmt.Sprintf(fmtRunLocalDiscoveryEndpoint, o.envName, o.appName),
+	}
+	for k, v := range task.Variables {
+		main.run.EnvVars[k] = v
+	}
+	if port != nil {
+		p := strconv.Itoa(int(aws.Uint16Value(port)))
+		main.run.Ports = map[string]string{p: p}
+	}
+	containers := map[string]*localContainer{
+		o.name: main,
+	}
+
+	for name, sidecar := range sidecars {
+		c, err := newLocalContainer(name, sidecar.ImageOverride)
+		if err != nil {
+			return nil, err
+		}
+		c.essential = sidecar.Essential == nil || aws.BoolValue(sidecar.Essential)
+		c.dependsOn = sidecar.DependsOn
+		c.secrets = sidecar.Secrets
+		c.run.ImageURI = aws.StringValue(sidecar.Image.Location)
+		if sidecar.Image.BuildRequired() {
+			c.run.ImageURI = fmt.Sprintf(fmtRunLocalImageURI, o.appName, fmt.Sprintf("%s/%s", o.name, name))
+		}
+		c.run.EnvVars = sidecar.Variables
+		c.run.HealthCheck = localHealthCheck(sidecar.HealthCheck)
+		containers[name] = c
+	}
+
+	if !logging.IsEmpty() {
+		// The log router gets the logs of the main container through the fluentd log driver, the way FireLens forwards them in ECS.
+		router, err := newLocalContainer(runLocalFirelensContainerName, manifest.ImageOverride{})
+		if err != nil {
+			return nil, err
+		}
+		router.essential = true
+		if len(logging.SecretOptions) != 0 {
+			router.secrets = make(map[string]manifest.Secret, len(logging.SecretOptions))
+			for name, valueFrom := range logging.SecretOptions {
+				router.secrets[name] = manifest.SecretFromString(valueFrom)
+			}
+		}
+		router.run.ImageURI = aws.StringValue(logging.LogImage())
+		router.run.Ports = map[string]string{runLocalFirelensForwardPort: runLocalFirelensForwardPort}
+		containers[runLocalFirelensContainerName] = router
+
+		main.run.LogDriver = "fluentd"
+		main.run.LogOptions = map[string]string{
+			"fluentd-address": fmt.Sprintf("localhost:%s", runLocalFirelensForwardPort),
+			"fluentd-async":   "true",
+			"tag":             o.name,
+		}
+		dependsOn := map[string]string{runLocalFirelensContainerName: dependsOnStart}
+		for k, v := range main.dependsOn {
+			dependsOn[k] = v
+		}
+		main.dependsOn = dependsOn
+	}
+	return containers, nil
+}
+
+func newLocalContainer(name string, override manifest.ImageOverride) (*localContainer, error) {
+	entryPoint, err := override.EntryPoint.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert entrypoint of container %s: %w", name, err)
+	}
+	command, err := override.Command.ToStringSlice()
+	if err != nil {
+		return nil, fmt.Errorf("convert command of container %s: %w", name, err)
+	}
+	return &localContainer{
+		name: name,
+		run: &dockerengine.RunOptions{
+			ContainerName: name,
+			EntryPoint:    entryPoint,
+			Command:       command,
+		},
+		done: make(chan struct{}),
+	}, nil
+}
+
+// localHealthCheck converts the health check of an ECS container definition to a docker health check.
+func localHealthCheck(hc manifest.ContainerHealthCheck) *dockerengine.HealthCheck {
+	if len(hc.Command) == 0 {
+		return nil
+	}
+	command := hc.Command
+	switch command[0] {
+	case "NONE":
+		return nil
+	case "CMD", "CMD-SHELL":
+		command = command[1:]
+	}
+	return &dockerengine.HealthCheck{
+		Command:     strings.Join(command, " "),
+		Interval:    durationValue(hc.Interval),
+		Retries:     aws.IntValue(hc.Retries),
+		Timeout:     durationValue(hc.Timeout),
+		StartPeriod: durationValue(hc.StartPeriod),
+	}
+}
+
+func durationValue(d *time.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return *d
+}
+
+// resolveSecrets retrieves the values of the secrets of every container from SSM or Secrets Manager.
+func (o *svcRunLocalOpts) resolveSecrets(containers map[string]*localContainer) error {
+	var hasSecrets bool
+	for _, c := range containers {
+		if len(c.secrets) != 0 {
+			hasSecrets = true
+		}
+	}
+	if !hasSecrets {
+		return nil
+	}
+	if err := o.initSecretGetters(); err != nil {
+		return err
+	}
+	for _, c := range containers {
+		if len(c.secrets) == 0 {
+			continue
+		}
+		c.run.Secrets = make(map[string]string, len(c.secrets))
+		for name, secret := range c.secrets {
+			var value string
+			var err error
+			if secret.IsSecretsManagerName() {
+				value, err = o.secretsManager.GetSecretValue(secret.Value())
+			} else {
+				value, err = o.secretValue(secret.Value())
+			}
+			if err != nil {
+				return fmt.Errorf("get secret %s of container %s: %w", name, c.name, err)
+			}
+			c.run.Secrets[name] = value
+		}
+	}
+	return nil
+}
+
+// secretValue returns the value of a secret referenced the way an ECS container definition does.
+// Secrets Manager ARNs can select a key of a JSON secret with the format "arn:...:secret:name:json-key:version-stage:version-id".
+func (o *svcRunLocalOpts) secretValue(valueFrom string) (string, error) {
+	parsed, err := arn.Parse(valueFrom)
+	if err != nil || parsed.Service != "secretsmanager" {
+		return o.ssm.GetSecretValue(valueFrom)
+	}
+	parts := strings.Split(valueFrom, ":")
+	if len(parts) <= 7 {
+		return o.secretsManager.GetSecretValue(valueFrom)
+	}
+	secretID, jsonKey := strings.Join(parts[:7], ":"), parts[7]
+	value, err := o.secretsManager.GetSecretValue(secretID)
+	if err != nil {
+		return "", err
+	}
+	if jsonKey == "" {
+		return value, nil
+	}
+	var kvs map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &kvs); err != nil {
+		return "", fmt.Errorf("unmarshal secret %s as JSON: %w", secretID, err)
+	}
+	v, ok := kvs[jsonKey]
+	if !ok {
+		return "", fmt.Errorf("key %s not found in secret %s", jsonKey, secretID)
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+func (o *svcRunLocalOpts) buildImage(mft interface{}) error {
+	required, err := manifest.ServiceDockerfileBuildRequired(mft)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return nil
+	}
+	copilotDir, err := o.ws.CopilotDirPath()
+	if err != nil {
+		return fmt.Errorf("get copilot directory: %w", err)
+	}
+	args, err := buildArgs(o.name, "", copilotDir, mft)
+	if err != nil {
+		return err
+	}
+	args.URI = fmt.Sprintf(fmtRunLocalImageURI, o.appName, o.name)
+	if err := o.docker.Build(args); err != nil {
+		return fmt.Errorf("build image of service %s: %w", o.name, err)
+	}
+	return nil
+}
+
+func (o *svcRunLocalOpts) buildSidecarImages(mft interface{}) error {
+	sidecarArgs, err := sidecarBuildArgs("", o.ws, mft)
+	if err != nil {
+		return err
+	}
+	var sidecars []string
+	for name := range sidecarArgs {
+		sidecars = append(sidecars, name)
+	}
+	sort.Strings(sidecars)
+	for _, name := range sidecars {
+		args := sidecarArgs[name]
+		args.URI = fmt.Sprintf(fmtRunLocalImageURI, o.appName, fmt.Sprintf("%s/%s", o.name, name))
+		if err := o.docker.Build(args); err != nil {
+			return fmt.Errorf("build image of sidecar %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// orderContainers sorts the containers so that every container comes after the containers it depends on.
+func orderContainers(containers map[string]*localContainer) ([]*localContainer, error) {
+	names := make([]string, 0, len(containers))
+	for name := range containers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var ordered []*localContainer
+	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
+	var visit func(name string) error
+	visit = func(name string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("circular dependency on container %s", name)
+		}
+		c, ok := containers[name]
+		if !ok {
+			return fmt.Errorf("container %s does not exist", name)
+		}
+		visiting[name] = true
+		deps := make([]string, 0, len(c.dependsOn))
+		for dep := range c.dependsOn {
+			deps = append(deps, dep)
+		}
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		visited[name] = true
+		ordered = append(ordered, c)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// runContainers starts the containers in order and blocks until an essential container exits or the command is interrupted.
+// All the containers are stopped before returning.
+func (o *svcRunLocalOpts) runContainers(network string, containers []*localContainer) error {
+	var width int
+	for _, c := range containers {
+		if len(c.name) > width {
+			width = len(c.name)
+		}
+	}
+	mu := &sync.Mutex{}
+	exits := make(chan *localContainer, len(containers))
+	var started []*localContainer
+	defer o.stopContainers(&started)
+
+	for _, c := range containers {
+		// Containers of other services, apps or environments can run at the same time, so only their alias on the network is bare.
+		c.run.ContainerName = fmt.Sprintf(fmtRunLocalContainerName, o.appName, o.envName, o.name, c.name)
+		c.run.NetworkAlias = c.name
+		if err := o.waitForDependencies(c, containers); err != nil {
+			if errors.Is(err, errSvcRunLocalInterrupted) {
+				log.Infoln("Stopping the containers.")
+				return nil
+			}
+			return err
+		}
+		prefix := fmt.Sprintf("%-*s | ", width, c.name)
+		stdout, stderr := newPrefixWriter(o.out, prefix, mu), newPrefixWriter(o.out, prefix, mu)
+		c.run.NetworkName = network
+		c.run.Stdout, c.run.Stderr = stdout, stderr
+		log.Infof("Starting container %s.\n", color.HighlightUserInput(c.name))
+		go func(c *localContainer) {
+			c.err = o.docker.Run(c.run)
+			stdout.flush()
+			stderr.flush()
+			close(c.done)
+			exits <- c
+		}(c)
+		started = append(started, c)
+	}
+
+	for {
+		select {
+		case <-o.interrupt:
+			log.Infoln("Stopping the containers.")
+			return nil
+		case c := <-exits:
+			if !c.essential {
+				log.Infof("Container %s exited.\n", c.name)
+				continue
+			}
+			log.Infof("Essential container %s exited, stopping the other containers.\n", c.name)
+			return c.err
+		}
+	}
+}
+
+// waitForDependencies blocks until the conditions on the containers that c depends on are met.
+func (o *svcRunLocalOpts) waitForDependencies(c *localContainer, containers []*localContainer) error {
+	byName := make(map[string]*localContainer, len(containers))
+	for _, container := range containers {
+		byName[container.name] = container
+	}
+	deps := make([]string, 0, len(c.dependsOn))
+	for dep := range c.dependsOn {
+		deps = append(deps, dep)
+	}
+	sort.Strings(deps)
+	for _, name := range deps {
+		dep := byName[name]
+		switch condition := strings.ToUpper(c.dependsOn[name]); condition {
+		case dependsOnComplete, dependsOnSuccess:
+			log.Infof("Waiting for container %s to exit before starting %s.\n", dep.name, c.name)
+			select {
+			case <-o.interrupt:
+				return errSvcRunLocalInterrupted
+			case <-dep.done:
+			}
+			if condition == dependsOnSuccess && dep.err != nil {
+				return fmt.Errorf("container %s that %s depends on did not exit successfully: %w", dep.name, c.name, dep.err)
+			}
+		case dependsOnHealthy:
+			log.Infof("Waiting for container %s to be healthy before starting %s.\n", dep.name, c.name)
+			for {
+				// The container might not be created yet, so errors are retried until it exits.
+				if healthy, err := o.docker.IsContainerHealthy(dep.run.ContainerName); err == nil && healthy {
+					break
+				}
+				select {
+				case <-o.interrupt:
+					return errSvcRunLocalInterrupted
+				case <-dep.done:
+					return fmt.Errorf("container %s that %s depends on exited before becoming healthy", dep.name, c.name)
+				case <-time.After(o.healthPollInterval):
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// stopContainers stops the started containers in reverse order and waits for them to exit.
+func (o *svcRunLocalOpts) stopContainers(started *[]*localContainer) {
+	containers := *started
+	for i := len(containers) - 1; i >= 0; i-- {
+		c := containers[i]
+		select {
+		case <-c.done:
+			continue
+		default:
+		}
+		if err := o.docker.Stop(c.run.ContainerName); err != nil {
+			select {
+			case <-c.done:
+				// The container exited on its own while being stopped.
+			default:
+				log.Warningf("Failed to stop container %s: %v\n", c.name, err)
+			}
+		}
+	}
+	for _, c := range containers {
+		<-c.done
+	}
+}
+
+// prefixWriter writes every complete line with a prefix to a writer shared by multiple containers.
+type prefixWriter struct {
+	w      io.Writer
+	prefix string
+	mu     *sync.Mutex
+	buf    []byte
+}
+
+func newPrefixWriter(w io.Writer, prefix string, mu *sync.Mutex) *prefixWriter {
+	return &prefixWriter{
+		w:      w,
+		prefix: prefix,
+		mu:     mu,
+	}
+}
+
+// Write buffers p until a full line is available so that the lines of different containers are not interleaved.
+func (p *prefixWriter) Write(b []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.buf = append(p.buf, b...)
+	for {
+		i := bytes.IndexByte(p.buf, '\n')
+		if i < 0 {
+			break
+		}
+		if _, err := fmt.Fprintf(p.w, "%s%s", p.prefix, p.buf[:i+1]); err != nil {
+			return 0, err
+		}
+		p.buf = p.buf[i+1:]
+	}
+	return len(b), nil
+}
+
+func (p *prefixWriter) flush() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.buf) == 0 {
+		return
+	}
+	fmt.Fprintf(p.w, "%s%s\n", p.prefix, p.buf)
+	p.buf = nil
+}
+
+// buildSvcRunLocalCmd builds the command for running a service locally.
+func buildSvcRunLocalCmd() *cobra.Command {
+	vars := svcRunLocalVars{}
+	cmd := &cobra.Command{
+		Use:   "run-local",
+		Short: "Runs the containers of a service locally.",
+		Long: `Runs the containers of a service locally.
+The main container and its sidecars run on a shared Docker network with the variables and secrets
+of the selected environment, and are stopped once an essential container exits or on Ctrl-C.`,
+		Example: `
+  Run the service "frontend" locally with the configuration of the "test" environment.
+  /code $ copilot svc run-local -n frontend -e test`,
+		RunE: runCmdE(func(cmd *cobra.Command, args []string) error {
+			opts, err := newSvcRunLocalOpts(vars)
+			if err != nil {
+				return err
+			}
+			return run(opts)
+		}),
+	}
+	cmd.Flags().StringVarP(&vars.appName, appFlag, appFlagShort, tryReadingAppName(), appFlagDescription)
+	cmd.Flags().StringVarP(&vars.name, nameFlag, nameFlagShort, "", svcFlagDescription)
+	cmd.Flags().StringVarP(&vars.envName, envFlag, envFlagShort, "", envFlagDescription)
+	return cmd
+}
@@ -0,0 +1,521 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/cli/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type svcRunLocalMocks struct {
+	store          *mocks.Mockstore
+	ws             *mocks.MockwsSvcDirReader
+	sel            *mocks.MockwsSelector
+	docker         *mocks.MocklocalContainerRunner
+	ssm            *mocks.MocksecretGetter
+	secretsManager *mocks.MocksecretGetter
+}
+
+func TestSvcRunLocalOpts_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		inAppName  string
+		inSvcName  string
+		inEnvName  string
+		setupMocks func(m svcRunLocalMocks)
+
+		wantedErr error
+	}{
+		"no existing applications": {
+			setupMocks: func(m svcRunLocalMocks) {},
+
+			wantedErr: errNoAppInWorkspace,
+		},
+		"with workspace error": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunLocalMocks) {
+				m.ws.EXPECT().ServiceNames().Return(nil, errors.New("some error"))
+			},
+
+			wantedErr: errors.New("list services in the workspace: some error"),
+		},
+		"with service not in workspace": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunLocalMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"backend"}, nil)
+			},
+
+			wantedErr: errors.New("service frontend not found in the workspace"),
+		},
+		"with unknown environment": {
+			inAppName: "phonetool",
+			inEnvName: "test",
+			setupMocks: func(m svcRunLocalMocks) {
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(nil, errors.New("unknown env"))
+			},
+
+			wantedErr: errors.New("get environment test configuration: unknown env"),
+		},
+		"successful validation": {
+			inAppName: "phonetool",
+			inSvcName: "frontend",
+			inEnvName: "test",
+			setupMocks: func(m svcRunLocalMocks) {
+				m.ws.EXPECT().ServiceNames().Return([]string{"frontend"}, nil)
+				m.store.EXPECT().GetEnvironment("phonetool", "test").Return(&config.Environment{Name: "test"}, nil)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRunLocalMocks{
+				store: mocks.NewMockstore(ctrl),
+				ws:    mocks.NewMockwsSvcDirReader(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := svcRunLocalOpts{
+				svcRunLocalVars: svcRunLocalVars{
+					appName: tc.inAppName,
+					name:    tc.inSvcName,
+					envName: tc.inEnvName,
+				},
+				store: m.store,
+				ws:    m.ws,
+			}
+
+			// WHEN
+			err := opts.Validate()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSvcRunLocalOpts_Ask(t *testing.T) {
+	testCases := map[string]struct {
+		inSvcName  string
+		inEnvName  string
+		setupMocks func(m svcRunLocalMocks)
+
+		wantedSvcName string
+		wantedEnvName string
+		wantedErr     error
+	}{
+		"prompts for service and environment": {
+			setupMocks: func(m svcRunLocalMocks) {
+				m.sel.EXPECT().Service(svcRunLocalNamePrompt, "").Return("frontend", nil)
+				m.sel.EXPECT().Environment(svcRunLocalEnvNamePrompt, svcRunLocalEnvNameHelpPrompt, "phonetool").Return("test", nil)
+			},
+
+			wantedSvcName: "frontend",
+			wantedEnvName: "test",
+		},
+		"does not prompt if flags are provided": {
+			inSvcName:  "frontend",
+			inEnvName:  "test",
+			setupMocks: func(m svcRunLocalMocks) {},
+
+			wantedSvcName: "frontend",
+			wantedEnvName: "test",
+		},
+		"wraps error when selecting service": {
+			setupMocks: func(m svcRunLocalMocks) {
+				m.sel.EXPECT().Service(gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+
+			wantedErr: errors.New("select service: some error"),
+		},
+		"wraps error when selecting environment": {
+			inSvcName: "frontend",
+			setupMocks: func(m svcRunLocalMocks) {
+				m.sel.EXPECT().Environment(gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("some error"))
+			},
+
+			wantedErr: errors.New("select environment: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRunLocalMocks{
+				sel: mocks.NewMockwsSelector(ctrl),
+			}
+			tc.setupMocks(m)
+			opts := svcRunLocalOpts{
+				svcRunLocalVars: svcRunLocalVars{
+					appName: "phonetool",
+					name:    tc.inSvcName,
+					envName: tc.inEnvName,
+				},
+				sel: m.sel,
+			}
+
+			// WHEN
+			err := opts.Ask()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedSvcName, opts.name)
+				require.Equal(t, tc.wantedEnvName, opts.envName)
+			}
+		})
+	}
+}
+
+// fakeContainers mimics running containers that block until they are stopped.
+type fakeContainers struct {
+	mu      sync.Mutex
+	stopped map[string]chan struct{}
+	runs    map[string]*dockerengine.RunOptions
+}
+
+func newFakeContainers() *fakeContainers {
+	return &fakeContainers{
+		stopped: make(map[string]chan struct{}),
+		runs:    make(map[string]*dockerengine.RunOptions),
+	}
+}
+
+func (f *fakeContainers) stopCh(name string) chan struct{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.stopped[name]; !ok {
+		f.stopped[name] = make(chan struct{})
+	}
+	return f.stopped[name]
+}
+
+func (f *fakeContainers) run(in *dockerengine.RunOptions) error {
+	f.mu.Lock()
+	f.runs[in.ContainerName] = in
+	f.mu.Unlock()
+	<-f.stopCh(in.ContainerName)
+	return nil
+}
+
+func (f *fakeContainers) stop(name string) error {
+	close(f.stopCh(name))
+	return nil
+}
+
+func TestSvcRunLocalOpts_Execute(t *testing.T) {
+	const backendMft = `name: api
+type: Backend Service
+image:
+  location: aws/api
+  port: 8080
+  depends_on:
+    proxy: start
+variables:
+  LOG_LEVEL: info
+secrets:
+  DB_PASSWORD: /copilot/phonetool/test/secrets/db_password
+  API_KEY: "arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key-AbCdEf:key::"
+sidecars:
+  proxy:
+    image: envoy
+    port: 9000
+`
+	testCases := map[string]struct {
+		inMft        string
+		interrupted  bool
+		setupMocks   func(m svcRunLocalMocks, f *fakeContainers)
+		wantedErr    error
+		wantedChecks func(t *testing.T, f *fakeContainers)
+	}{
+		"docker engine not running": {
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(errors.New("docker not running"))
+			},
+			wantedErr: errors.New("docker not running"),
+		},
+		"fail to read manifest": {
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().ReadServiceManifest("api").Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("read service api manifest file: some error"),
+		},
+		"unsupported service type": {
+			inMft: `name: api
+type: Request-Driven Web Service
+image:
+  location: aws/api
+  port: 8080
+`,
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+			},
+			wantedErr: errors.New("service api of type *manifest.RequestDrivenWebService cannot be run locally"),
+		},
+		"fail to get secret": {
+			inMft: backendMft,
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ssm.EXPECT().GetSecretValue("/copilot/phonetool/test/secrets/db_password").Return("", errors.New("some error"))
+				m.secretsManager.EXPECT().GetSecretValue(gomock.Any()).Return(`{"key": "value"}`, nil).AnyTimes()
+			},
+			wantedErr: errors.New("get secret DB_PASSWORD of container api: some error"),
+		},
+		"fail to create network": {
+			inMft: backendMft,
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ssm.EXPECT().GetSecretValue(gomock.Any()).Return("password", nil)
+				m.secretsManager.EXPECT().GetSecretValue(gomock.Any()).Return(`{"key": "value"}`, nil)
+				m.docker.EXPECT().CreateNetwork("copilot-phonetool-test-api").Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("some error"),
+		},
+		"runs containers until interrupted": {
+			inMft:       backendMft,
+			interrupted: true,
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ssm.EXPECT().GetSecretValue("/copilot/phonetool/test/secrets/db_password").Return("password", nil)
+				m.secretsManager.EXPECT().GetSecretValue("arn:aws:secretsmanager:us-west-2:123456789012:secret:api-key-AbCdEf").Return(`{"key": "value"}`, nil)
+				m.docker.EXPECT().CreateNetwork("copilot-phonetool-test-api").Return(nil)
+				m.docker.EXPECT().Run(gomock.Any()).DoAndReturn(f.run).Times(2)
+				m.docker.EXPECT().Stop("phonetool-test-api-api").DoAndReturn(f.stop)
+				m.docker.EXPECT().Stop("phonetool-test-api-proxy").DoAndReturn(f.stop)
+				m.docker.EXPECT().RemoveNetwork("copilot-phonetool-test-api").Return(nil)
+			},
+			wantedChecks: func(t *testing.T, f *fakeContainers) {
+				api := f.runs["phonetool-test-api-api"]
+				require.Equal(t, "aws/api", api.ImageURI)
+				require.Equal(t, "copilot-phonetool-test-api", api.NetworkName)
+				require.Equal(t, "api", api.NetworkAlias)
+				require.Equal(t, map[string]string{"8080": "8080"}, api.Ports)
+				require.Equal(t, map[string]string{
+					"COPILOT_APPLICATION_NAME":           "phonetool",
+					"COPILOT_ENVIRONMENT_NAME":           "test",
+					"COPILOT_SERVICE_NAME":               "api",
+					"COPILOT_SERVICE_DISCOVERY_ENDPOINT": "test.phonetool.local",
+					"LOG_LEVEL":                          "info",
+				}, api.EnvVars)
+				require.Equal(t, map[string]string{
+					"DB_PASSWORD": "password",
+					"API_KEY":     "value",
+				}, api.Secrets)
+
+				proxy := f.runs["phonetool-test-api-proxy"]
+				require.Equal(t, "envoy", proxy.ImageURI)
+				require.Empty(t, proxy.Ports)
+			},
+		},
+		"builds sidecar images from a Dockerfile": {
+			inMft: `name: api
+type: Backend Service
+image:
+  location: aws/api
+sidecars:
+  proxy:
+    image:
+      build: proxy/Dockerfile
+`,
+			interrupted: true,
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.ws.EXPECT().CopilotDirPath().Return("/ws/copilot", nil)
+				m.docker.EXPECT().Build(&dockerengine.BuildArguments{
+					URI:        "phonetool/api/proxy:local",
+					Dockerfile: filepath.Join("/ws", "proxy", "Dockerfile"),
+					Context:    filepath.Join("/ws", "proxy"),
+				}).Return(nil)
+				m.docker.EXPECT().CreateNetwork("copilot-phonetool-test-api").Return(nil)
+				m.docker.EXPECT().Run(gomock.Any()).DoAndReturn(f.run).Times(2)
+				m.docker.EXPECT().Stop("phonetool-test-api-api").DoAndReturn(f.stop)
+				m.docker.EXPECT().Stop("phonetool-test-api-proxy").DoAndReturn(f.stop)
+				m.docker.EXPECT().RemoveNetwork("copilot-phonetool-test-api").Return(nil)
+			},
+			wantedChecks: func(t *testing.T, f *fakeContainers) {
+				require.Equal(t, "phonetool/api/proxy:local", f.runs["phonetool-test-api-proxy"].ImageURI)
+			},
+		},
+		"stops all containers when an essential container exits": {
+			inMft: `name: api
+type: Backend Service
+image:
+  location: aws/api
+  depends_on:
+    proxy: healthy
+sidecars:
+  proxy:
+    image: envoy
+`,
+			setupMocks: func(m svcRunLocalMocks, f *fakeContainers) {
+				m.docker.EXPECT().CheckDockerEngineRunning().Return(nil)
+				m.docker.EXPECT().CreateNetwork("copilot-phonetool-test-api").Return(nil)
+				m.docker.EXPECT().Run(gomock.Any()).DoAndReturn(func(in *dockerengine.RunOptions) error {
+					if in.ContainerName == "phonetool-test-api-api" {
+						return errors.New("exit status 1")
+					}
+					return f.run(in)
+				}).Times(2)
+				gomock.InOrder(
+					m.docker.EXPECT().IsContainerHealthy("phonetool-test-api-proxy").Return(false, errors.New("no such container")),
+					m.docker.EXPECT().IsContainerHealthy("phonetool-test-api-proxy").Return(true, nil),
+				)
+				m.docker.EXPECT().Stop("phonetool-test-api-proxy").DoAndReturn(f.stop)
+				m.docker.EXPECT().RemoveNetwork("copilot-phonetool-test-api").Return(nil)
+			},
+			wantedErr: errors.New("exit status 1"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := svcRunLocalMocks{
+				ws:             mocks.NewMockwsSvcDirReader(ctrl),
+				docker:         mocks.NewMocklocalContainerRunner(ctrl),
+				ssm:            mocks.NewMocksecretGetter(ctrl),
+				secretsManager: mocks.NewMocksecretGetter(ctrl),
+			}
+			if tc.inMft != "" {
+				m.ws.EXPECT().ReadServiceManifest("api").Return([]byte(tc.inMft), nil)
+			}
+			f := newFakeContainers()
+			tc.setupMocks(m, f)
+			opts := svcRunLocalOpts{
+				svcRunLocalVars: svcRunLocalVars{
+					appName: "phonetool",
+					name:    "api",
+					envName: "test",
+				},
+				ws:                 m.ws,
+				unmarshal:          manifest.UnmarshalWorkload,
+				docker:             m.docker,
+				out:                &bytes.Buffer{},
+				interrupt:          make(chan os.Signal, 1),
+				healthPollInterval: 0,
+				initSecretGetters: func() error {
+					return nil
+				},
+			}
+			opts.ssm = m.ssm
+			opts.secretsManager = m.secretsManager
+			if tc.interrupted {
+				opts.interrupt <- os.Interrupt
+			}
+
+			// WHEN
+			err := opts.Execute()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			if tc.wantedChecks != nil {
+				tc.wantedChecks(t, f)
+			}
+		})
+	}
+}
+
+func TestOrderContainers(t *testing.T) {
+	testCases := map[string]struct {
+		inDependsOn map[string]map[string]string
+
+		wantedOrder []string
+		wantedErr   error
+	}{
+		"orders dependencies first": {
+			inDependsOn: map[string]map[string]string{
+				"api":    {"proxy": "start", "init": "success"},
+				"proxy":  {"init": "complete"},
+				"init":   nil,
+				"logger": nil,
+			},
+			wantedOrder: []string{"init", "proxy", "api", "logger"},
+		},
+		"errors on circular dependency": {
+			inDependsOn: map[string]map[string]string{
+				"api":   {"proxy": "start"},
+				"proxy": {"api": "start"},
+			},
+			wantedErr: errors.New("circular dependency on container api"),
+		},
+		"errors on unknown dependency": {
+			inDependsOn: map[string]map[string]string{
+				"api": {"proxy": "start"},
+			},
+			wantedErr: errors.New("container proxy does not exist"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			containers := make(map[string]*localContainer)
+			for name, dependsOn := range tc.inDependsOn {
+				containers[name] = &localContainer{name: name, dependsOn: dependsOn}
+			}
+
+			ordered, err := orderContainers(containers)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, c := range ordered {
+				names = append(names, c.name)
+			}
+			require.Equal(t, tc.wantedOrder, names)
+		})
+	}
+}
+
+func TestPrefixWriter(t *testing.T) {
+	// GIVEN
+	buf := &bytes.Buffer{}
+	mu := &sync.Mutex{}
+	api, proxy := newPrefixWriter(buf, "api   | ", mu), newPrefixWriter(buf, "proxy | ", mu)
+
+	// WHEN
+	fmt.Fprint(api, "hello ")
+	fmt.Fprint(proxy, "starting\nlistening on 9000\n")
+	fmt.Fprint(api, "world\nbye")
+	api.flush()
+
+	// THEN
+	require.Equal(t, `proxy | starting
+proxy | listening on 9000
+api   | hello world
+api   | bye
+`, buf.String())
+}
@@ -38,11 +38,13 @@ var (
 	errDurationInvalid      = errors.New("value must be a valid Go duration string (example: 1h30m)")
 	errDurationBadUnits     = errors.New("duration cannot be in units smaller than a second")
 	errScheduleInvalid      = errors.New("value must be a valid cron expression (examples: @weekly; @every 30m; 0 0 * * 0)")
+	errEnvNameReserved      = errors.New(`value "addons" is reserved for the directory of the environment addons`)
 )
 
 // Addons validation errors.
 var (
-	fmtErrInvalidStorageType = "invalid storage type %s: must be one of %s"
+	fmtErrInvalidStorageType      = "invalid storage type %s: must be one of %s"
+	fmtErrInvalidStorageLifecycle = "invalid lifecycle %s: must be one of %s"
 
 	// S3 errors.
 	errS3ValueBadSize      = errors.New("value must be between 3 and 63 characters in length")
@@ -63,6 +65,9 @@ var (
 	fmtErrInvalidDBNameCharacters  = "invalid database name %s: must contain only alphanumeric characters and underscore; should start with a letter"
 	errInvalidSecretNameCharacters = errors.New("value must contain only letters, numbers, periods, hyphens and underscores")
 
+	// Redis, OpenSearch and SQS errors.
+	errInvalidStorageNameCharacters = errors.New("value must start with a letter and contain only alphanumeric characters and -_")
+
 	// Topic subscription errors.
 	errMissingPublishTopicField = errors.New("field `publish.topics[].name` cannot be empty")
 	errInvalidPubSubTopicName   = errors.New("topic names can only contain letters, numbers, underscores, and hyphens")
@@ -144,6 +149,15 @@ var (
 	)
 )
 
+// The storage name for the Redis, OpenSearch and SQS storage types is only used in the logical IDs of the resources,
+// and their physical names are generated by CFN.
+var storageLogicalIDNameRegExp = regexp.MustCompile("" +
+	"^" + // Start of string.
+	"[A-Za-z]" + // Starts with a letter.
+	`[a-zA-Z0-9\-\_]*` + // Followed by alphanumeric, _-.
+	"$", // End of string.
+)
+
 // SSM secret parameter name validation expression.
 // https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_PutParameter.html#systemsmanager-PutParameter-request-Name
 var secretParameterNameRegExp = regexp.MustCompile("^[a-zA-Z0-9_.-]+$")
@@ -293,6 +307,19 @@ func validateStorageType(val interface{}) error {
 	return fmt.Errorf(fmtErrInvalidStorageType, storageType, prettify(storageTypes))
 }
 
+func validateStorageLifecycle(val interface{}) error {
+	lifecycle, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	for _, validLifecycle := range storageLifecycles {
+		if lifecycle == validLifecycle {
+			return nil
+		}
+	}
+	return fmt.Errorf(fmtErrInvalidStorageLifecycle, lifecycle, prettify(storageLifecycles))
+}
+
 func validateMySQLDBName(val interface{}) error {
 	const (
 		minMySQLDBNameLength = 1
@@ -370,6 +397,21 @@ func validateEnvironmentName(val interface{}) error {
 	if err := basicNameValidation(val); err != nil {
 		return fmt.Errorf("environment name %v is invalid: %w", val, err)
 	}
+	if val == "addons" {
+		// Environment manifests are under copilot/environments/{name}/ next to the environment addons.
+		return fmt.Errorf("environment name %v is invalid: %w", val, errEnvNameReserved)
+	}
+	return nil
+}
+
+func validatePipelineName(val interface{}) error {
+	const maxPipelineNameLength = 100
+	if err := basicNameValidation(val); err != nil {
+		return fmt.Errorf("pipeline name %v is invalid: %w", val, err)
+	}
+	if s := val.(string); len(s) > maxPipelineNameLength {
+		return fmt.Errorf("pipeline name %s is invalid: value must not exceed %d characters", s, maxPipelineNameLength)
+	}
 	return nil
 }
 
@@ -594,6 +636,33 @@ func rdsNameValidation(val interface{}) error {
 	return nil
 }
 
+func storageLogicalIDNameValidation(val interface{}) error {
+	const minStorageNameLength = 1
+	const maxStorageNameLength = 100
+
+	s, ok := val.(string)
+	if !ok {
+		return errValueNotAString
+	}
+	if len(s) < minStorageNameLength || len(s) > maxStorageNameLength {
+		return fmt.Errorf(fmtErrValueBadSize, minStorageNameLength, maxStorageNameLength)
+	}
+	if !storageLogicalIDNameRegExp.MatchString(s) {
+		return errInvalidStorageNameCharacters
+	}
+	return nil
+}
+
+func validateSQSMaxReceiveCount(count int) error {
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-dead-letter-queues.html
+	const minMaxReceiveCount = 1
+	const maxMaxReceiveCount = 1000
+	if count < minMaxReceiveCount || count > maxMaxReceiveCount {
+		return fmt.Errorf("--%s must be between %d and %d", storageSQSMaxReceiveCountFlag, minMaxReceiveCount, maxMaxReceiveCount)
+	}
+	return nil
+}
+
 func validateKey(val interface{}) error {
 	s, ok := val.(string)
 	if !ok {
@@ -136,7 +136,15 @@ func TestValidateSvcName(t *testing.T) {
 }
 
 func TestValidateEnvironmentName(t *testing.T) {
-	testCases := basicNameTestCases
+	testCases := map[string]testCase{
+		"reserved for the environment addons": {
+			input: "addons",
+			want:  errEnvNameReserved,
+		},
+	}
+	for name, tc := range basicNameTestCases {
+		testCases[name] = tc
+	}
 
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -147,6 +155,23 @@ func TestValidateEnvironmentName(t *testing.T) {
 	}
 }
 
+func TestValidatePipelineName(t *testing.T) {
+	testCases := basicNameTestCases
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := validatePipelineName(tc.input)
+
+			require.True(t, errors.Is(got, tc.want))
+		})
+	}
+	t.Run("pipeline name too long", func(t *testing.T) {
+		got := validatePipelineName(strings.Repeat("a", 101))
+
+		require.EqualError(t, got, fmt.Sprintf("pipeline name %s is invalid: value must not exceed 100 characters", strings.Repeat("a", 101)))
+	})
+}
+
 func TestValidateS3Name(t *testing.T) {
 	testCases := map[string]testCase{
 		"good case": {
@@ -261,6 +286,37 @@ func TestValidateRDSName(t *testing.T) {
 	}
 }
 
+func TestValidateStorageLogicalIDName(t *testing.T) {
+	testCases := map[string]testCase{
+		"good case": {
+			input: "my-queue_1",
+			want:  nil,
+		},
+		"too long": {
+			input: strings.Repeat("a", 101),
+			want:  errors.New("value must be between 1 and 100 characters in length"),
+		},
+		"starts with a number": {
+			input: "1queue",
+			want:  errInvalidStorageNameCharacters,
+		},
+		"bad character": {
+			input: "my.queue",
+			want:  errInvalidStorageNameCharacters,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := storageLogicalIDNameValidation(tc.input)
+			if tc.want != nil {
+				require.EqualError(t, got, tc.want.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestValidatePath(t *testing.T) {
 	testCases := map[string]struct {
 		input interface{}
@@ -14,12 +14,21 @@ import (
 
 // Application is a named collection of environments and services.
 type Application struct {
-	Name               string            `json:"name"`               // Name of an Application. Must be unique amongst other apps in the same account.
-	AccountID          string            `json:"account"`            // AccountID this app is mastered in.
-	Domain             string            `json:"domain"`             // Existing domain name in Route53. An empty domain name means the user does not have one.
-	DomainHostedZoneID string            `json:"domainHostedZoneID"` // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
-	Version            string            `json:"version"`            // The version of the app layout in the underlying datastore (e.g. SSM).
-	Tags               map[string]string `json:"tags,omitempty"`     // Labels to apply to resources created within the app.
+	Name               string            `json:"name"`                      // Name of an Application. Must be unique amongst other apps in the same account.
+	AccountID          string            `json:"account"`                   // AccountID this app is mastered in.
+	Domain             string            `json:"domain"`                    // Existing domain name in Route53. An empty domain name means the user does not have one.
+	DomainHostedZoneID string            `json:"domainHostedZoneID"`        // Existing domain hosted zone in Route53. An empty domain name means the user does not have one.
+	Version            string            `json:"version"`                   // The version of the app layout in the underlying datastore (e.g. SSM).
+	Tags               map[string]string `json:"tags,omitempty"`            // Labels to apply to resources created within the app.
+	ImageRepository    *ImageRepository  `json:"imageRepository,omitempty"` // Settings of the ECR repositories of the app's workloads.
+}
+
+// ImageRepository holds the settings applied to the ECR repositories of all the workloads in an application.
+type ImageRepository struct {
+	KeepImages         *int  `json:"keepImages,omitempty"`         // Number of images to retain in each repository.
+	UntaggedExpiryDays *int  `json:"untaggedExpiryDays,omitempty"` // Number of days after which untagged images expire.
+	ScanOnPush         *bool `json:"scanOnPush,omitempty"`         // Whether images are scanned for vulnerabilities when they are pushed.
+	ImmutableTags      *bool `json:"immutableTags,omitempty"`      // Whether image tags can't be overwritten.
 }
 
 // RequiresDNSDelegation returns true if we have to set up DNS Delegation resources
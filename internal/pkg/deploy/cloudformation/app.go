@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -271,10 +273,13 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	wlList = append(wlList, wlName)
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          wlList,
+		Sidecars:          previouslyDeployedConfig.Sidecars,
+		Accounts:          previouslyDeployedConfig.Accounts,
+		App:               appConfig.Name,
+		ImageRepository:   appImageRepositoryConfig(app),
+		ImageRepositories: previouslyDeployedConfig.ImageRepositories,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
 		return err
@@ -283,6 +288,99 @@ func (cf CloudFormation) addWorkloadToApp(app *config.Application, wlName string
 	return nil
 }
 
+// AddSidecarsToApp attempts to add an ECR repository for each sidecar of a workload built from a Dockerfile
+// to the application resource stack. Repositories that already exist are left untouched.
+func (cf CloudFormation) AddSidecarsToApp(app *config.Application, wlName string, sidecars []string) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:           app.Name,
+		AccountID:      app.AccountID,
+		AdditionalTags: app.Tags,
+		Version:        deploy.LatestAppTemplateVersion,
+	})
+	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("get previous application %s config: %w", app.Name, err)
+	}
+
+	existing := make(map[string]bool)
+	for _, sidecar := range previouslyDeployedConfig.Sidecars {
+		existing[sidecar] = true
+	}
+	sidecarList := previouslyDeployedConfig.Sidecars
+	for _, sidecar := range sidecars {
+		repo := fmt.Sprintf(stack.SidecarRepoNameFormat, wlName, sidecar)
+		if existing[repo] {
+			continue
+		}
+		existing[repo] = true
+		sidecarList = append(sidecarList, repo)
+	}
+	if len(sidecarList) == len(previouslyDeployedConfig.Sidecars) {
+		return nil
+	}
+
+	newDeploymentConfig := stack.AppResourcesConfig{
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          previouslyDeployedConfig.Services,
+		Sidecars:          sidecarList,
+		Accounts:          previouslyDeployedConfig.Accounts,
+		App:               appConfig.Name,
+		ImageRepository:   appImageRepositoryConfig(app),
+		ImageRepositories: previouslyDeployedConfig.ImageRepositories,
+	}
+	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
+		return fmt.Errorf("adding %s sidecar resources to application %s: %w", wlName, app.Name, err)
+	}
+	return nil
+}
+
+// UpdateImageRepository applies the repository settings overridden by a workload to its ECR repositories
+// in the application resource stack. A nil config reverts the repositories to the application-wide settings.
+// The StackSet is left untouched if the settings didn't change.
+func (cf CloudFormation) UpdateImageRepository(app *config.Application, wlName string, repo *stack.ImageRepositoryConfig) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:           app.Name,
+		AccountID:      app.AccountID,
+		AdditionalTags: app.Tags,
+		Version:        deploy.LatestAppTemplateVersion,
+	})
+	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("get previous application %s config: %w", app.Name, err)
+	}
+
+	repos := make(map[string]stack.ImageRepositoryConfig)
+	for wl, prev := range previouslyDeployedConfig.ImageRepositories {
+		repos[wl] = prev
+	}
+	delete(repos, wlName)
+	if repo != nil {
+		repos[wlName] = *repo
+	}
+	if len(repos) == 0 {
+		repos = nil
+	}
+	appRepo := appImageRepositoryConfig(app)
+	if reflect.DeepEqual(appRepo, previouslyDeployedConfig.ImageRepository) &&
+		reflect.DeepEqual(repos, previouslyDeployedConfig.ImageRepositories) {
+		return nil
+	}
+
+	newDeploymentConfig := stack.AppResourcesConfig{
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          previouslyDeployedConfig.Services,
+		Sidecars:          previouslyDeployedConfig.Sidecars,
+		Accounts:          previouslyDeployedConfig.Accounts,
+		App:               appConfig.Name,
+		ImageRepository:   appRepo,
+		ImageRepositories: repos,
+	}
+	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
+		return fmt.Errorf("update image repository of %s in application %s: %w", wlName, app.Name, err)
+	}
+	return nil
+}
+
 // RemoveServiceFromApp attempts to remove service-specific resources (ECR repositories) from the application resource stack.
 func (cf CloudFormation) RemoveServiceFromApp(app *config.Application, svcName string) error {
 	if err := cf.removeWorkloadFromApp(app, svcName); err != nil {
@@ -327,11 +425,35 @@ func (cf CloudFormation) removeWorkloadFromApp(app *config.Application, wlName s
 		return nil
 	}
 
+	// Remove the repositories of the workload's sidecars along with the workload's.
+	var sidecarList []string
+	for _, sidecar := range previouslyDeployedConfig.Sidecars {
+		if strings.HasPrefix(sidecar, wlName+"/") {
+			continue
+		}
+		sidecarList = append(sidecarList, sidecar)
+	}
+
+	// Drop the repository settings overridden by the workload.
+	var repos map[string]stack.ImageRepositoryConfig
+	for wl, repo := range previouslyDeployedConfig.ImageRepositories {
+		if wl == wlName {
+			continue
+		}
+		if repos == nil {
+			repos = make(map[string]stack.ImageRepositoryConfig)
+		}
+		repos[wl] = repo
+	}
+
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: wlList,
-		Accounts: previouslyDeployedConfig.Accounts,
-		App:      appConfig.Name,
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          wlList,
+		Sidecars:          sidecarList,
+		Accounts:          previouslyDeployedConfig.Accounts,
+		App:               appConfig.Name,
+		ImageRepository:   appImageRepositoryConfig(app),
+		ImageRepositories: repos,
 	}
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
 		return err
@@ -380,10 +502,13 @@ func (cf CloudFormation) AddEnvToApp(opts *AddEnvToAppOpts) error {
 	}
 
 	newDeploymentConfig := stack.AppResourcesConfig{
-		Version:  previouslyDeployedConfig.Version + 1,
-		Services: previouslyDeployedConfig.Services,
-		Accounts: accountList,
-		App:      appConfig.Name,
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          previouslyDeployedConfig.Services,
+		Sidecars:          previouslyDeployedConfig.Sidecars,
+		Accounts:          accountList,
+		App:               appConfig.Name,
+		ImageRepository:   appImageRepositoryConfig(opts.App),
+		ImageRepositories: previouslyDeployedConfig.ImageRepositories,
 	}
 
 	if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
@@ -426,6 +551,73 @@ func (cf CloudFormation) AddPipelineResourcesToApp(
 	return nil
 }
 
+// AppResourcesConfig returns the configuration of the resources last deployed by the application StackSet,
+// such as the accounts and workloads that the StackSet holds resources for.
+func (cf CloudFormation) AppResourcesConfig(app *config.Application) (*stack.AppResourcesConfig, error) {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:      app.Name,
+		AccountID: app.AccountID,
+		Version:   deploy.LatestAppTemplateVersion,
+	})
+	resources, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return nil, fmt.Errorf("get resources of application %s: %w", app.Name, err)
+	}
+	return resources, nil
+}
+
+// ImportAppResources adds the accounts, workloads and sidecars of an application exported from another account
+// to the application StackSet, and creates a stack instance in each of the regions if there isn't one yet.
+// Resources that are already deployed are left untouched, so the import can safely be retried.
+func (cf CloudFormation) ImportAppResources(app *config.Application, resources *stack.AppResourcesConfig, regions []string) error {
+	appConfig := stack.NewAppStackConfig(&deploy.CreateAppInput{
+		Name:           app.Name,
+		AccountID:      app.AccountID,
+		AdditionalTags: app.Tags,
+		Version:        deploy.LatestAppTemplateVersion,
+	})
+	previouslyDeployedConfig, err := cf.getLastDeployedAppConfig(appConfig)
+	if err != nil {
+		return fmt.Errorf("get previous application %s config: %w", app.Name, err)
+	}
+
+	repos := make(map[string]stack.ImageRepositoryConfig)
+	for wl, repo := range resources.ImageRepositories {
+		repos[wl] = repo
+	}
+	for wl, prev := range previouslyDeployedConfig.ImageRepositories {
+		repos[wl] = prev
+	}
+	if len(repos) == 0 {
+		repos = nil
+	}
+	newDeploymentConfig := stack.AppResourcesConfig{
+		Version:           previouslyDeployedConfig.Version + 1,
+		Services:          appendMissing(previouslyDeployedConfig.Services, resources.Services),
+		Sidecars:          appendMissing(previouslyDeployedConfig.Sidecars, resources.Sidecars),
+		Accounts:          appendMissing(previouslyDeployedConfig.Accounts, resources.Accounts),
+		App:               appConfig.Name,
+		ImageRepository:   appImageRepositoryConfig(app),
+		ImageRepositories: repos,
+	}
+	if len(newDeploymentConfig.Services) != len(previouslyDeployedConfig.Services) ||
+		len(newDeploymentConfig.Sidecars) != len(previouslyDeployedConfig.Sidecars) ||
+		len(newDeploymentConfig.Accounts) != len(previouslyDeployedConfig.Accounts) ||
+		!reflect.DeepEqual(newDeploymentConfig.ImageRepository, previouslyDeployedConfig.ImageRepository) ||
+		!reflect.DeepEqual(newDeploymentConfig.ImageRepositories, previouslyDeployedConfig.ImageRepositories) {
+		if err := cf.deployAppConfig(appConfig, &newDeploymentConfig); err != nil {
+			return fmt.Errorf("import resources to application %s: %w", app.Name, err)
+		}
+	}
+
+	for _, region := range regions {
+		if err := cf.addNewAppStackInstances(appConfig, region); err != nil {
+			return fmt.Errorf("add stack instance in region %s to application %s: %w", region, app.Name, err)
+		}
+	}
+	return nil
+}
+
 func (cf CloudFormation) deployAppConfig(appConfig *stack.AppStackConfig, resources *stack.AppResourcesConfig) error {
 	newTemplateToDeploy, err := appConfig.ResourceTemplate(resources)
 	if err != nil {
@@ -493,6 +685,39 @@ func (cf CloudFormation) getLastDeployedAppConfig(appConfig *stack.AppStackConfi
 	return previouslyDeployedConfig, nil
 }
 
+// appImageRepositoryConfig returns the settings applied to the ECR repositories of all the workloads in the application.
+func appImageRepositoryConfig(app *config.Application) *stack.ImageRepositoryConfig {
+	if app.ImageRepository == nil {
+		return nil
+	}
+	return &stack.ImageRepositoryConfig{
+		KeepImages:         app.ImageRepository.KeepImages,
+		UntaggedExpiryDays: app.ImageRepository.UntaggedExpiryDays,
+		ScanOnPush:         app.ImageRepository.ScanOnPush,
+		ImmutableTags:      app.ImageRepository.ImmutableTags,
+	}
+}
+
+// appendMissing returns the elements of existing followed by the elements of others that are not in existing yet.
+func appendMissing(existing, others []string) []string {
+	seen := make(map[string]bool)
+	merged := append([]string{}, existing...)
+	for _, el := range existing {
+		seen[el] = true
+	}
+	for _, el := range others {
+		if seen[el] {
+			continue
+		}
+		seen[el] = true
+		merged = append(merged, el)
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
 // DeleteApp deletes all application specific StackSet and Stack resources.
 func (cf CloudFormation) DeleteApp(appName string) error {
 	if err := cf.appStackSet.Delete(fmt.Sprintf("%s-infrastructure", appName)); err != nil {
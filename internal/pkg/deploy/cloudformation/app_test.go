@@ -521,6 +521,395 @@ func TestCloudFormation_AddServiceToApp(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_AddSidecarsToApp(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	testCases := map[string]struct {
+		sidecars     []string
+		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+		wantedErr    error
+	}{
+		"adds repositories for new sidecars only": {
+			sidecars: []string{"proxy", "metrics"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Sidecars: []string{"test/proxy"},
+					Accounts: []string{"5678"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.ElementsMatch(t, []string{"test"}, configToDeploy.Services)
+						require.ElementsMatch(t, []string{"test/proxy", "test/metrics"}, configToDeploy.Sidecars)
+						require.ElementsMatch(t, []string{"5678"}, configToDeploy.Accounts)
+						require.Equal(t, 2, configToDeploy.Version)
+					})
+				return m
+			},
+		},
+		"does not update the stack set if all repositories exist": {
+			sidecars: []string{"proxy"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Sidecars: []string{"test/proxy"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+				return m
+			},
+		},
+		"wraps the error if the stack set fails to update": {
+			sidecars: []string{"proxy"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("adding test sidecar resources to application testapp: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				region:      "us-west-2",
+			}
+
+			got := cf.AddSidecarsToApp(mockApp, "test", tc.sidecars)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, got, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_UpdateImageRepository(t *testing.T) {
+	testCases := map[string]struct {
+		app          *config.Application
+		repo         *stack.ImageRepositoryConfig
+		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+		wantedErr    error
+	}{
+		"overrides the repository settings of the workload": {
+			app: &config.Application{
+				Name:      "testapp",
+				AccountID: "1234",
+				ImageRepository: &config.ImageRepository{
+					ScanOnPush: aws.Bool(true),
+				},
+			},
+			repo: &stack.ImageRepositoryConfig{
+				KeepImages: aws.Int(10),
+			},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test", "other"},
+					Accounts: []string{"5678"},
+					Version:  1,
+					ImageRepository: &stack.ImageRepositoryConfig{
+						ScanOnPush: aws.Bool(true),
+					},
+					ImageRepositories: map[string]stack.ImageRepositoryConfig{
+						"other": {ImmutableTags: aws.Bool(true)},
+					},
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.ElementsMatch(t, []string{"test", "other"}, configToDeploy.Services)
+						require.ElementsMatch(t, []string{"5678"}, configToDeploy.Accounts)
+						require.Equal(t, &stack.ImageRepositoryConfig{ScanOnPush: aws.Bool(true)}, configToDeploy.ImageRepository)
+						require.Equal(t, map[string]stack.ImageRepositoryConfig{
+							"other": {ImmutableTags: aws.Bool(true)},
+							"test":  {KeepImages: aws.Int(10)},
+						}, configToDeploy.ImageRepositories)
+						require.Equal(t, 2, configToDeploy.Version)
+					})
+				return m
+			},
+		},
+		"reverts to the application settings if the workload doesn't override them anymore": {
+			app: &config.Application{
+				Name:      "testapp",
+				AccountID: "1234",
+			},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Version:  1,
+					ImageRepositories: map[string]stack.ImageRepositoryConfig{
+						"test": {KeepImages: aws.Int(10)},
+					},
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.Nil(t, configToDeploy.ImageRepositories)
+					})
+				return m
+			},
+		},
+		"does not update the stack set if the settings didn't change": {
+			app: &config.Application{
+				Name:      "testapp",
+				AccountID: "1234",
+			},
+			repo: &stack.ImageRepositoryConfig{
+				KeepImages: aws.Int(10),
+			},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Version:  1,
+					ImageRepositories: map[string]stack.ImageRepositoryConfig{
+						"test": {KeepImages: aws.Int(10)},
+					},
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+				return m
+			},
+		},
+		"wraps the error if the stack set fails to update": {
+			app: &config.Application{
+				Name:      "testapp",
+				AccountID: "1234",
+			},
+			repo: &stack.ImageRepositoryConfig{
+				ScanOnPush: aws.Bool(true),
+			},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"test"},
+					Version:  1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("update image repository of test in application testapp: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				region:      "us-west-2",
+			}
+
+			got := cf.UpdateImageRepository(tc.app, "test", tc.repo)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, got, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
+func TestCloudFormation_ImportAppResources(t *testing.T) {
+	mockApp := &config.Application{
+		Name:      "testapp",
+		AccountID: "1234",
+	}
+	testCases := map[string]struct {
+		resources    *stack.AppResourcesConfig
+		regions      []string
+		mockStackSet func(t *testing.T, ctrl *gomock.Controller) stackSetClient
+		wantedErr    error
+	}{
+		"merges the imported resources and creates missing stack instances": {
+			resources: &stack.AppResourcesConfig{
+				Accounts: []string{"1234", "5678"},
+				Services: []string{"api", "frontend"},
+				Sidecars: []string{"api/nginx"},
+				ImageRepositories: map[string]stack.ImageRepositoryConfig{
+					"api":      {KeepImages: aws.Int(5)},
+					"frontend": {ScanOnPush: aws.Bool(true)},
+				},
+			},
+			regions: []string{"us-west-2", "us-east-1"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"frontend"},
+					Accounts: []string{"1234"},
+					Version:  1,
+					ImageRepositories: map[string]stack.ImageRepositoryConfig{
+						"frontend": {ImmutableTags: aws.Bool(true)},
+					},
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Do(func(_, template string, _ ...stackset.CreateOrUpdateOption) {
+						configToDeploy, err := stack.AppConfigFrom(&template)
+						require.NoError(t, err)
+						require.ElementsMatch(t, []string{"frontend", "api"}, configToDeploy.Services)
+						require.ElementsMatch(t, []string{"api/nginx"}, configToDeploy.Sidecars)
+						require.ElementsMatch(t, []string{"1234", "5678"}, configToDeploy.Accounts)
+						require.Equal(t, map[string]stack.ImageRepositoryConfig{
+							"api":      {KeepImages: aws.Int(5)},
+							"frontend": {ImmutableTags: aws.Bool(true)},
+						}, configToDeploy.ImageRepositories)
+						require.Equal(t, 2, configToDeploy.Version)
+					})
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{
+					{Region: "us-west-2"},
+				}, nil).Times(2)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), []string{"1234"}, []string{"us-east-1"}).Return(nil)
+				return m
+			},
+		},
+		"does not update the stack set if all the resources are already deployed": {
+			resources: &stack.AppResourcesConfig{
+				Accounts: []string{"1234"},
+				Services: []string{"frontend"},
+			},
+			regions: []string{"us-west-2"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Services: []string{"frontend"},
+					Accounts: []string{"1234"},
+					Version:  3,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(0)
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return([]stackset.InstanceSummary{
+					{Region: "us-west-2"},
+				}, nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+				return m
+			},
+		},
+		"wraps the error if the stack set fails to update": {
+			resources: &stack.AppResourcesConfig{
+				Services: []string{"frontend"},
+			},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Version: 1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().UpdateAndWait(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("import resources to application testapp: some error"),
+		},
+		"wraps the error if a stack instance fails to be created": {
+			resources: &stack.AppResourcesConfig{},
+			regions:   []string{"us-east-1"},
+			mockStackSet: func(t *testing.T, ctrl *gomock.Controller) stackSetClient {
+				m := mocks.NewMockstackSetClient(ctrl)
+				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
+					Version: 1,
+				}})
+				require.NoError(t, err)
+				m.EXPECT().Describe(gomock.Any()).Return(stackset.Description{
+					Template: string(body),
+				}, nil)
+				m.EXPECT().InstanceSummaries(gomock.Any()).Return(nil, nil)
+				m.EXPECT().CreateInstancesAndWait(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("some error"))
+				return m
+			},
+			wantedErr: errors.New("add stack instance in region us-east-1 to application testapp: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := CloudFormation{
+				appStackSet: tc.mockStackSet(t, ctrl),
+				region:      "us-west-2",
+			}
+
+			got := cf.ImportAppResources(mockApp, tc.resources, tc.regions)
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, got, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, got)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 	mockApp := &config.Application{
 		Name:      "testapp",
@@ -539,6 +928,7 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 				m := mocks.NewMockstackSetClient(ctrl)
 				body, err := yaml.Marshal(stack.DeployedAppMetadata{Metadata: stack.AppResourcesConfig{
 					Services: []string{"test", "firsttest"},
+					Sidecars: []string{"test/proxy", "firsttest/proxy"},
 					Version:  1,
 				}})
 				require.NoError(t, err)
@@ -551,6 +941,7 @@ func TestCloudFormation_RemoveServiceFromApp(t *testing.T) {
 						configToDeploy, err := stack.AppConfigFrom(&template)
 						require.NoError(t, err)
 						require.ElementsMatch(t, []string{"firsttest"}, configToDeploy.Services)
+						require.ElementsMatch(t, []string{"firsttest/proxy"}, configToDeploy.Sidecars)
 						require.Empty(t, configToDeploy.Accounts, "config account list should be empty")
 						require.Equal(t, 2, configToDeploy.Version)
 					})
@@ -23,6 +23,7 @@ import (
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/stream"
@@ -38,6 +39,9 @@ const (
 	// CloudFormation resource types.
 	ecsServiceResourceType    = "AWS::ECS::Service"
 	envControllerResourceType = "Custom::EnvControllerFunction"
+
+	// ECS service deployment constants.
+	ecsPrimaryDeploymentStatus = "PRIMARY"
 )
 
 // StackConfiguration represents the set of methods needed to deploy a cloudformation stack.
@@ -52,6 +56,11 @@ type ecsClient interface {
 	stream.ECSServiceDescriber
 }
 
+type codeDeployClient interface {
+	stream.CodeDeployDeploymentDescriber
+	CreateECSDeployment(in codedeploy.ECSDeploymentInput) (string, error)
+}
+
 type cfnClient interface {
 	// Methods augmented by the aws wrapper struct.
 	Create(*cloudformation.Stack) (string, error)
@@ -59,6 +68,8 @@ type cfnClient interface {
 	WaitForCreate(ctx context.Context, stackName string) error
 	Update(*cloudformation.Stack) (string, error)
 	UpdateAndWait(*cloudformation.Stack) error
+	CreateChangeSet(*cloudformation.Stack) (string, error)
+	DeleteChangeSet(changeSetID, stackName string) error
 	WaitForUpdate(ctx context.Context, stackName string) error
 	Delete(stackName string) error
 	DeleteAndWait(stackName string) error
@@ -104,6 +115,7 @@ type CloudFormation struct {
 	codeStarClient codeStarClient
 	cpClient       codePipelineClient
 	ecsClient      ecsClient
+	cdClient       codeDeployClient
 	regionalClient func(region string) cfnClient
 	appStackSet    stackSetClient
 	s3Client       s3Client
@@ -117,6 +129,7 @@ func New(sess *session.Session) CloudFormation {
 		codeStarClient: codestar.New(sess),
 		cpClient:       codepipeline.New(sess),
 		ecsClient:      ecs.New(sess),
+		cdClient:       codedeploy.New(sess),
 		regionalClient: func(region string) cfnClient {
 			return cloudformation.New(sess.Copy(&aws.Config{
 				Region: aws.String(region),
@@ -372,7 +385,10 @@ func (cf CloudFormation) errOnFailedStack(stackName string) error {
 	}
 	status := aws.StringValue(stack.StackStatus)
 	if cloudformation.StackStatus(status).Failure() {
-		return fmt.Errorf("stack %s did not complete successfully and exited with status %s", stackName, status)
+		return &errStackFailed{
+			name:   stackName,
+			status: status,
+		}
 	}
 	return nil
 }
@@ -95,6 +95,52 @@ func (cf CloudFormation) UpdateEnvironmentTemplate(appName, envName, templateBod
 	return cf.cfnClient.UpdateAndWait(s)
 }
 
+// UpdateEnvironmentTrust updates the parameters of an environment stack that refer to the account of its application,
+// so that the environment trusts the application after the application moves to another account.
+// The template and the other parameters and tags of the stack are kept.
+func (cf CloudFormation) UpdateEnvironmentTrust(app *deploy.AppInformation, envName, cfnExecRoleARN string) error {
+	stackName := stack.NameForEnv(app.Name, envName)
+	descr, err := cf.cfnClient.Describe(stackName)
+	if err != nil {
+		return fmt.Errorf("describe stack %s: %w", stackName, err)
+	}
+	body, err := cf.cfnClient.TemplateBody(stackName)
+	if err != nil {
+		return fmt.Errorf("get template of stack %s: %w", stackName, err)
+	}
+	overrides := map[string]string{
+		stack.EnvParamToolsAccountPrincipalKey: app.AccountPrincipalARN,
+		stack.EnvParamAppDNSKey:                app.DNSName,
+		stack.EnvParamAppDNSDelegationRoleKey:  app.DNSDelegationRole(),
+	}
+	s := cloudformation.NewStack(stackName, body)
+	for _, param := range descr.Parameters {
+		if value, ok := overrides[aws.StringValue(param.ParameterKey)]; ok {
+			s.Parameters = append(s.Parameters, &awscfn.Parameter{
+				ParameterKey:   param.ParameterKey,
+				ParameterValue: aws.String(value),
+			})
+			continue
+		}
+		s.Parameters = append(s.Parameters, &awscfn.Parameter{
+			ParameterKey:     param.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		})
+	}
+	s.Tags = descr.Tags
+	s.RoleARN = aws.String(cfnExecRoleARN)
+	err = cf.cfnClient.UpdateAndWait(s)
+	var emptyChangeSet *cloudformation.ErrChangeSetEmpty
+	if errors.As(err, &emptyChangeSet) {
+		// The environment already trusts the application.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("update and wait for stack %s: %w", stackName, err)
+	}
+	return nil
+}
+
 // UpgradeEnvironment updates an environment stack's template to a newer version.
 func (cf CloudFormation) UpgradeEnvironment(in *deploy.CreateEnvironmentInput) error {
 	return cf.upgradeEnvironment(in, func(param *awscfn.Parameter) *awscfn.Parameter {
@@ -106,6 +152,20 @@ func (cf CloudFormation) UpgradeEnvironment(in *deploy.CreateEnvironmentInput) e
 	})
 }
 
+// DeployEnvironment updates an environment stack with the template generated from its manifest.
+// The parameters of the stack are kept except for the URL of the environment addons template, which is set to the one in the input.
+func (cf CloudFormation) DeployEnvironment(in *deploy.CreateEnvironmentInput) error {
+	return cf.upgradeEnvironment(in, func(param *awscfn.Parameter) *awscfn.Parameter {
+		return &awscfn.Parameter{
+			ParameterKey:     param.ParameterKey,
+			UsePreviousValue: aws.Bool(true),
+		}
+	}, &awscfn.Parameter{
+		ParameterKey:   aws.String(stack.EnvParamAddonsTemplateURLKey),
+		ParameterValue: aws.String(in.AddonsTemplateURL),
+	})
+}
+
 // UpgradeLegacyEnvironment updates a legacy environment stack to a newer version.
 //
 // UpgradeEnvironment and UpgradeLegacyEnvironment are separate methods because the legacy cloudformation stack has the
@@ -128,7 +188,10 @@ func (cf CloudFormation) UpgradeLegacyEnvironment(in *deploy.CreateEnvironmentIn
 	})
 }
 
-func (cf CloudFormation) upgradeEnvironment(in *deploy.CreateEnvironmentInput, transformParam func(param *awscfn.Parameter) *awscfn.Parameter) error {
+// upgradeEnvironment updates the environment stack with the parameters of the deployed stack transformed by transformParam.
+// The overrides replace the deployed parameters with the same keys, and are added if the deployed stack doesn't have them.
+func (cf CloudFormation) upgradeEnvironment(in *deploy.CreateEnvironmentInput, transformParam func(param *awscfn.Parameter) *awscfn.Parameter,
+	overrides ...*awscfn.Parameter) error {
 	s, err := toStack(stack.NewEnvStackConfig(in))
 	if err != nil {
 		return err
@@ -148,11 +211,18 @@ func (cf CloudFormation) upgradeEnvironment(in *deploy.CreateEnvironmentInput, t
 		}
 
 		// Keep the parameters and tags of the stack.
+		overridden := make(map[string]bool)
+		for _, param := range overrides {
+			overridden[aws.StringValue(param.ParameterKey)] = true
+		}
 		var params []*awscfn.Parameter
 		for _, param := range descr.Parameters {
+			if overridden[aws.StringValue(param.ParameterKey)] {
+				continue
+			}
 			params = append(params, transformParam(param))
 		}
-		s.Parameters = params
+		s.Parameters = append(params, overrides...)
 		s.Tags = descr.Tags
 
 		// Apply a service role if provided.
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -146,6 +147,83 @@ func TestCloudFormation_UpgradeEnvironment(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_DeployEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inAddonsTemplateURL string
+		inParams            []*awscfn.Parameter
+
+		wantedParams []*awscfn.Parameter
+	}{
+		"adds the addons template URL to a stack without the parameter": {
+			inAddonsTemplateURL: "https://mockbucket.s3-us-west-2.amazonaws.com/test.env.addons.stack.yml",
+			inParams: []*awscfn.Parameter{
+				{
+					ParameterKey:   aws.String("ALBWorkloads"),
+					ParameterValue: aws.String("frontend,admin"),
+				},
+			},
+			wantedParams: []*awscfn.Parameter{
+				{
+					ParameterKey:     aws.String("ALBWorkloads"),
+					UsePreviousValue: aws.Bool(true),
+				},
+				{
+					ParameterKey:   aws.String("AddonsTemplateURL"),
+					ParameterValue: aws.String("https://mockbucket.s3-us-west-2.amazonaws.com/test.env.addons.stack.yml"),
+				},
+			},
+		},
+		"removes the addons template URL if there are no addons": {
+			inParams: []*awscfn.Parameter{
+				{
+					ParameterKey:   aws.String("ALBWorkloads"),
+					ParameterValue: aws.String("frontend,admin"),
+				},
+				{
+					ParameterKey:   aws.String("AddonsTemplateURL"),
+					ParameterValue: aws.String("https://mockbucket.s3-us-west-2.amazonaws.com/test.env.addons.stack.yml"),
+				},
+			},
+			wantedParams: []*awscfn.Parameter{
+				{
+					ParameterKey:     aws.String("ALBWorkloads"),
+					UsePreviousValue: aws.Bool(true),
+				},
+				{
+					ParameterKey:   aws.String("AddonsTemplateURL"),
+					ParameterValue: aws.String(""),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewMockcfnClient(ctrl)
+			m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+				Parameters: tc.inParams,
+			}, nil)
+			m.EXPECT().UpdateAndWait(gomock.Any()).Return(nil).Do(func(s *cloudformation.Stack) {
+				require.ElementsMatch(t, tc.wantedParams, s.Parameters)
+			})
+			cf := &CloudFormation{
+				cfnClient: m,
+			}
+			in := mockCreateEnvInput
+			in.AddonsTemplateURL = tc.inAddonsTemplateURL
+
+			// WHEN
+			err := cf.DeployEnvironment(&in)
+
+			// THEN
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestCloudFormation_UpgradeLegacyEnvironment(t *testing.T) {
 	testCases := map[string]struct {
 		in            *deploy.CreateEnvironmentInput
@@ -243,6 +321,120 @@ func TestCloudFormation_EnvironmentTemplate(t *testing.T) {
 	}
 }
 
+func TestCloudFormation_UpdateEnvironmentTrust(t *testing.T) {
+	mockApp := &deploy.AppInformation{
+		Name:                "phonetool",
+		DNSName:             "phonetool.com",
+		AccountPrincipalARN: "arn:aws:iam::1111:root",
+	}
+	mockParams := []*awscfn.Parameter{
+		{
+			ParameterKey:   aws.String(stack.EnvParamToolsAccountPrincipalKey),
+			ParameterValue: aws.String("arn:aws:iam::4321:root"),
+		},
+		{
+			ParameterKey:   aws.String(stack.EnvParamAppDNSKey),
+			ParameterValue: aws.String("phonetool.com"),
+		},
+		{
+			ParameterKey:   aws.String(stack.EnvParamAppDNSDelegationRoleKey),
+			ParameterValue: aws.String("arn:aws:iam::4321:role/phonetool-DNSDelegationRole"),
+		},
+		{
+			ParameterKey:   aws.String("ALBWorkloads"),
+			ParameterValue: aws.String("frontend"),
+		},
+	}
+	testCases := map[string]struct {
+		inClient func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient
+
+		wantedError error
+	}{
+		"wraps error if describe fails": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedError: errors.New("describe stack phonetool-test: some error"),
+		},
+		"updates the parameters that refer to the application account and keeps the template": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				tags := []*awscfn.Tag{
+					{
+						Key:   aws.String("copilot-application"),
+						Value: aws.String("phonetool"),
+					},
+				}
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Parameters: mockParams,
+					Tags:       tags,
+				}, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return("hello", nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(nil).
+					Do(func(s *cloudformation.Stack) {
+						require.Equal(t, "phonetool-test", s.Name)
+						require.Equal(t, "hello", s.TemplateBody)
+						require.Equal(t, []*awscfn.Parameter{
+							{
+								ParameterKey:   aws.String(stack.EnvParamToolsAccountPrincipalKey),
+								ParameterValue: aws.String("arn:aws:iam::1111:root"),
+							},
+							{
+								ParameterKey:   aws.String(stack.EnvParamAppDNSKey),
+								ParameterValue: aws.String("phonetool.com"),
+							},
+							{
+								ParameterKey:   aws.String(stack.EnvParamAppDNSDelegationRoleKey),
+								ParameterValue: aws.String("arn:aws:iam::1111:role/phonetool-DNSDelegationRole"),
+							},
+							{
+								ParameterKey:     aws.String("ALBWorkloads"),
+								UsePreviousValue: aws.Bool(true),
+							},
+						}, s.Parameters)
+						require.Equal(t, tags, s.Tags)
+						require.Equal(t, aws.String("arn"), s.RoleARN)
+					})
+				return m
+			},
+		},
+		"succeeds if the environment already trusts the application": {
+			inClient: func(t *testing.T, ctrl *gomock.Controller) *mocks.MockcfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().Describe("phonetool-test").Return(&cloudformation.StackDescription{
+					Parameters: mockParams,
+				}, nil)
+				m.EXPECT().TemplateBody("phonetool-test").Return("hello", nil)
+				m.EXPECT().UpdateAndWait(gomock.Any()).Return(&cloudformation.ErrChangeSetEmpty{})
+				return m
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			cf := &CloudFormation{
+				cfnClient: tc.inClient(t, ctrl),
+			}
+
+			// WHEN
+			err := cf.UpdateEnvironmentTrust(mockApp, "test", "arn")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestCloudFormation_UpdateEnvironmentTemplate(t *testing.T) {
 	testCases := map[string]struct {
 		inAppName      string
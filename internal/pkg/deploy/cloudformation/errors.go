@@ -0,0 +1,37 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cloudformation
+
+import "fmt"
+
+// ErrECSDeploymentRolledBack occurs when the ECS deployment circuit breaker rolls back a failed service deployment.
+type ErrECSDeploymentRolledBack struct {
+	StackName      string
+	TaskDefinition string // Task definition that the service was rolled back to in the format "family:revision".
+}
+
+func (e *ErrECSDeploymentRolledBack) Error() string {
+	return fmt.Sprintf("deployment circuit breaker rolled back the ECS service in stack %s to task definition %s", e.StackName, e.TaskDefinition)
+}
+
+// ErrBlueGreenDeploymentFailed occurs when a CodeDeploy blue/green deployment fails or is stopped.
+type ErrBlueGreenDeploymentFailed struct {
+	DeploymentID string
+	Status       string
+	Reason       string
+}
+
+func (e *ErrBlueGreenDeploymentFailed) Error() string {
+	return fmt.Sprintf("blue/green deployment %s exited with status %s: %s", e.DeploymentID, e.Status, e.Reason)
+}
+
+// errStackFailed occurs when a stack operation ends in a failure status.
+type errStackFailed struct {
+	name   string
+	status string
+}
+
+func (e *errStackFailed) Error() string {
+	return fmt.Sprintf("stack %s did not complete successfully and exited with status %s", e.name, e.status)
+}
@@ -12,6 +12,7 @@ import (
 	cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	cloudformation0 "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
 	stackset "github.com/aws/copilot-cli/internal/pkg/aws/cloudformation/stackset"
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -135,6 +136,59 @@ func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
 }
 
+// MockcodeDeployClient is a mock of codeDeployClient interface.
+type MockcodeDeployClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockcodeDeployClientMockRecorder
+}
+
+// MockcodeDeployClientMockRecorder is the mock recorder for MockcodeDeployClient.
+type MockcodeDeployClientMockRecorder struct {
+	mock *MockcodeDeployClient
+}
+
+// NewMockcodeDeployClient creates a new mock instance.
+func NewMockcodeDeployClient(ctrl *gomock.Controller) *MockcodeDeployClient {
+	mock := &MockcodeDeployClient{ctrl: ctrl}
+	mock.recorder = &MockcodeDeployClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcodeDeployClient) EXPECT() *MockcodeDeployClientMockRecorder {
+	return m.recorder
+}
+
+// CreateECSDeployment mocks base method.
+func (m *MockcodeDeployClient) CreateECSDeployment(in codedeploy.ECSDeploymentInput) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateECSDeployment", in)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateECSDeployment indicates an expected call of CreateECSDeployment.
+func (mr *MockcodeDeployClientMockRecorder) CreateECSDeployment(in interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateECSDeployment", reflect.TypeOf((*MockcodeDeployClient)(nil).CreateECSDeployment), in)
+}
+
+// Deployment mocks base method.
+func (m *MockcodeDeployClient) Deployment(id string) (*codedeploy.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deployment", id)
+	ret0, _ := ret[0].(*codedeploy.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deployment indicates an expected call of Deployment.
+func (mr *MockcodeDeployClientMockRecorder) Deployment(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deployment", reflect.TypeOf((*MockcodeDeployClient)(nil).Deployment), id)
+}
+
 // MockcfnClient is a mock of cfnClient interface.
 type MockcfnClient struct {
 	ctrl     *gomock.Controller
@@ -187,6 +241,21 @@ func (mr *MockcfnClientMockRecorder) CreateAndWait(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAndWait", reflect.TypeOf((*MockcfnClient)(nil).CreateAndWait), arg0)
 }
 
+// CreateChangeSet mocks base method.
+func (m *MockcfnClient) CreateChangeSet(arg0 *cloudformation0.Stack) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChangeSet", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChangeSet indicates an expected call of CreateChangeSet.
+func (mr *MockcfnClientMockRecorder) CreateChangeSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChangeSet", reflect.TypeOf((*MockcfnClient)(nil).CreateChangeSet), arg0)
+}
+
 // Delete mocks base method.
 func (m *MockcfnClient) Delete(stackName string) error {
 	m.ctrl.T.Helper()
@@ -229,6 +298,20 @@ func (mr *MockcfnClientMockRecorder) DeleteAndWaitWithRoleARN(stackName, roleARN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAndWaitWithRoleARN", reflect.TypeOf((*MockcfnClient)(nil).DeleteAndWaitWithRoleARN), stackName, roleARN)
 }
 
+// DeleteChangeSet mocks base method.
+func (m *MockcfnClient) DeleteChangeSet(changeSetID, stackName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChangeSet", changeSetID, stackName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChangeSet indicates an expected call of DeleteChangeSet.
+func (mr *MockcfnClientMockRecorder) DeleteChangeSet(changeSetID, stackName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChangeSet", reflect.TypeOf((*MockcfnClient)(nil).DeleteChangeSet), changeSetID, stackName)
+}
+
 // Describe mocks base method.
 func (m *MockcfnClient) Describe(stackName string) (*cloudformation0.StackDescription, error) {
 	m.ctrl.T.Helper()
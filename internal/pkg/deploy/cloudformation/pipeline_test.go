@@ -143,7 +143,7 @@ func TestCloudFormation_CreatePipeline(t *testing.T) {
 			},
 			createCpMock: func(ctrl *gomock.Controller) codePipelineClient {
 				m := mocks.NewMockcodePipelineClient(ctrl)
-				m.EXPECT().RetryStageExecution(gomock.Any(), gomock.Any()).Return(nil)
+				m.EXPECT().RetryStageExecution("pipeline-kudos-cicd", "Source").Return(nil)
 				return m
 			},
 			wantedErr: nil,
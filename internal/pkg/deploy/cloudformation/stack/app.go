@@ -4,6 +4,7 @@
 package stack
 
 import (
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -26,10 +27,22 @@ type DeployedAppMetadata struct {
 // AppResourcesConfig is a configuration for a deployed Application
 // StackSet.
 type AppResourcesConfig struct {
-	Accounts []string `yaml:"Accounts,flow"`
-	Services []string `yaml:"Services,flow"`
-	App      string   `yaml:"App"`
-	Version  int      `yaml:"Version"`
+	Accounts []string `yaml:"Accounts,flow" json:"accounts"`
+	Services []string `yaml:"Services,flow" json:"services"`
+	Sidecars []string `yaml:"Sidecars,flow" json:"sidecars,omitempty"` // Sidecars built from a Dockerfile, in the form of "<workload>/<sidecar>".
+	App      string   `yaml:"App" json:"-"`
+	Version  int      `yaml:"Version" json:"-"`
+
+	ImageRepository   *ImageRepositoryConfig           `yaml:"ImageRepository,omitempty" json:"-"`                             // Settings applied to the repositories of all the workloads.
+	ImageRepositories map[string]ImageRepositoryConfig `yaml:"ImageRepositories,omitempty" json:"imageRepositories,omitempty"` // Settings overridden by workloads, keyed by workload name.
+}
+
+// ImageRepositoryConfig holds the settings of the ECR repositories created by the application StackSet.
+type ImageRepositoryConfig struct {
+	KeepImages         *int  `yaml:"KeepImages,omitempty" json:"keepImages,omitempty"`
+	UntaggedExpiryDays *int  `yaml:"UntaggedExpiryDays,omitempty" json:"untaggedExpiryDays,omitempty"`
+	ScanOnPush         *bool `yaml:"ScanOnPush,omitempty" json:"scanOnPush,omitempty"`
+	ImmutableTags      *bool `yaml:"ImmutableTags,omitempty" json:"immutableTags,omitempty"`
 }
 
 // AppStackConfig is for providing all the values to set up an
@@ -44,7 +57,7 @@ type AppRegionalResources struct {
 	Region         string            // The region these resources are in.
 	KMSKeyARN      string            // A KMS Key ARN for encrypting Pipeline artifacts.
 	S3Bucket       string            // S3 bucket for Pipeline artifacts.
-	RepositoryURLs map[string]string // The image repository URLs by service name.
+	RepositoryURLs map[string]string // The image repository URLs by service name, or by "<workload>/<sidecar>" for sidecars.
 }
 
 const (
@@ -56,11 +69,16 @@ const (
 	appOutputKMSKey               = "KMSKeyARN"
 	appOutputS3Bucket             = "PipelineBucket"
 	appOutputECRRepoPrefix        = "ECRRepo"
+	appOutputSidecarECRRepoPrefix = "SidecarECRRepo"
 	appDNSDelegatedAccountsKey    = "AppDNSDelegatedAccounts"
 	appDomainNameKey              = "AppDomainName"
 	appDomainHostedZoneIDKey      = "AppDomainHostedZoneID"
 	appNameKey                    = "AppName"
 
+	// SidecarRepoNameFormat is the name of a sidecar's ECR repository relative to the application: "<workload>/<sidecar>".
+	SidecarRepoNameFormat = "%s/%s"
+	slashReplacement      = "SLASH"
+
 	// arn:${partition}:iam::${account}:role/${roleName}
 	fmtStackSetAdminRoleARN = "arn:%s:iam::%s:role/%s"
 )
@@ -103,16 +121,25 @@ func (c *AppStackConfig) Template() (string, error) {
 
 // ResourceTemplate generates a StackSet template with all the Application-wide resources (ECR Repos, KMS keys, S3 buckets)
 func (c *AppStackConfig) ResourceTemplate(config *AppResourcesConfig) (string, error) {
-	// Sort the account IDs and Services so that the template we generate is deterministic
+	// Sort the account IDs, Services and Sidecars so that the template we generate is deterministic
 	sort.Strings(config.Accounts)
 	sort.Strings(config.Services)
+	sort.Strings(config.Sidecars)
 
+	repoSettings, err := workloadRepoSettings(config)
+	if err != nil {
+		return "", err
+	}
 	content, err := c.parser.Parse(appResourcesTemplatePath, struct {
 		*AppResourcesConfig
+		SidecarRepos    []sidecarRepo
+		RepoSettings    map[string]ecrRepoSettings
 		ServiceTagKey   string
 		TemplateVersion string
 	}{
 		config,
+		sidecarRepos(config.Sidecars),
+		repoSettings,
 		deploy.ServiceTagKey,
 		c.Version,
 	}, template.WithFuncs(cfTemplateFunctions))
@@ -211,6 +238,137 @@ func (c *AppStackConfig) dnsDelegationAccounts() []string {
 	return uniqueAccountIDs
 }
 
+// sidecarRepo holds the fields needed to render the ECR repository of a sidecar built from a Dockerfile.
+type sidecarRepo struct {
+	LogicalID string // Logical ID of the repository, also used as its output key.
+	Name      string // Name of the repository relative to the application.
+	Workload  string // Name of the workload the sidecar belongs to.
+}
+
+func sidecarRepos(sidecars []string) []sidecarRepo {
+	var repos []sidecarRepo
+	for _, name := range sidecars {
+		repos = append(repos, sidecarRepo{
+			LogicalID: appOutputSidecarECRRepoPrefix + template.ReplaceDashesFunc(strings.ReplaceAll(name, "/", slashReplacement)),
+			Name:      name,
+			Workload:  strings.Split(name, "/")[0],
+		})
+	}
+	return repos
+}
+
+// ecrRepoSettings holds the rendered settings of the ECR repositories of a workload.
+type ecrRepoSettings struct {
+	LifecyclePolicy string // JSON lifecycle policy document. Empty if images never expire.
+	ScanOnPush      bool
+	ImmutableTags   bool
+}
+
+type ecrLifecycleRule struct {
+	RulePriority int                   `json:"rulePriority"`
+	Description  string                `json:"description"`
+	Selection    ecrLifecycleSelection `json:"selection"`
+	Action       ecrLifecycleAction    `json:"action"`
+}
+
+type ecrLifecycleSelection struct {
+	TagStatus   string `json:"tagStatus"`
+	CountType   string `json:"countType"`
+	CountUnit   string `json:"countUnit,omitempty"`
+	CountNumber int    `json:"countNumber"`
+}
+
+type ecrLifecycleAction struct {
+	Type string `json:"type"`
+}
+
+// workloadRepoSettings returns the settings of the repositories of each workload, keyed by workload name.
+// The settings overridden by a workload take precedence over the application-wide ones.
+func workloadRepoSettings(config *AppResourcesConfig) (map[string]ecrRepoSettings, error) {
+	settings := make(map[string]ecrRepoSettings, len(config.Services))
+	for _, wl := range config.Services {
+		var merged ImageRepositoryConfig
+		if config.ImageRepository != nil {
+			merged = *config.ImageRepository
+		}
+		if override, ok := config.ImageRepositories[wl]; ok {
+			merged = merged.withOverride(override)
+		}
+		policy, err := merged.lifecyclePolicy()
+		if err != nil {
+			return nil, fmt.Errorf("render lifecycle policy of the repository of %s: %w", wl, err)
+		}
+		settings[wl] = ecrRepoSettings{
+			LifecyclePolicy: policy,
+			ScanOnPush:      aws.BoolValue(merged.ScanOnPush),
+			ImmutableTags:   aws.BoolValue(merged.ImmutableTags),
+		}
+	}
+	return settings, nil
+}
+
+func (cfg ImageRepositoryConfig) withOverride(override ImageRepositoryConfig) ImageRepositoryConfig {
+	if override.KeepImages != nil {
+		cfg.KeepImages = override.KeepImages
+	}
+	if override.UntaggedExpiryDays != nil {
+		cfg.UntaggedExpiryDays = override.UntaggedExpiryDays
+	}
+	if override.ScanOnPush != nil {
+		cfg.ScanOnPush = override.ScanOnPush
+	}
+	if override.ImmutableTags != nil {
+		cfg.ImmutableTags = override.ImmutableTags
+	}
+	return cfg
+}
+
+// lifecyclePolicy returns the JSON lifecycle policy of a repository, or an empty string if images never expire.
+func (cfg ImageRepositoryConfig) lifecyclePolicy() (string, error) {
+	var rules []ecrLifecycleRule
+	if cfg.UntaggedExpiryDays != nil {
+		rules = append(rules, ecrLifecycleRule{
+			Description: fmt.Sprintf("Expire untagged images after %d days", aws.IntValue(cfg.UntaggedExpiryDays)),
+			Selection: ecrLifecycleSelection{
+				TagStatus:   "untagged",
+				CountType:   "sinceImagePushed",
+				CountUnit:   "days",
+				CountNumber: aws.IntValue(cfg.UntaggedExpiryDays),
+			},
+			Action: ecrLifecycleAction{Type: "expire"},
+		})
+	}
+	if cfg.KeepImages != nil {
+		// A rule selecting any image must have the lowest priority.
+		rules = append(rules, ecrLifecycleRule{
+			Description: fmt.Sprintf("Keep the last %d images", aws.IntValue(cfg.KeepImages)),
+			Selection: ecrLifecycleSelection{
+				TagStatus:   "any",
+				CountType:   "imageCountMoreThan",
+				CountNumber: aws.IntValue(cfg.KeepImages),
+			},
+			Action: ecrLifecycleAction{Type: "expire"},
+		})
+	}
+	if len(rules) == 0 {
+		return "", nil
+	}
+	for i := range rules {
+		rules[i].RulePriority = i + 1
+	}
+	policy, err := json.Marshal(struct {
+		Rules []ecrLifecycleRule `json:"rules"`
+	}{rules})
+	if err != nil {
+		return "", err
+	}
+	return string(policy), nil
+}
+
+func sidecarRepoNameFromLogicalID(safeName string) string {
+	return strings.ReplaceAll(template.DashReplacedLogicalIDToOriginal(safeName), slashReplacement, "/")
+}
+
 // ToAppRegionalResources takes an Application Resource Stack Instance stack, reads the output resources
 // and returns a modeled  ProjectRegionalResources.
 func ToAppRegionalResources(stack *cloudformation.Stack) (*AppRegionalResources, error) {
@@ -226,6 +384,13 @@ func ToAppRegionalResources(stack *cloudformation.Stack) (*AppRegionalResources,
 			regionalResources.KMSKeyARN = value
 		case key == appOutputS3Bucket:
 			regionalResources.S3Bucket = value
+		case strings.HasPrefix(key, appOutputSidecarECRRepoPrefix):
+			uri, err := ecr.URIFromARN(value)
+			if err != nil {
+				return nil, err
+			}
+			safeName := strings.TrimPrefix(key, appOutputSidecarECRRepoPrefix)
+			regionalResources.RepositoryURLs[sidecarRepoNameFromLogicalID(safeName)] = uri
 		case strings.HasPrefix(key, appOutputECRRepoPrefix):
 			// If the output starts with the ECR Repo Prefix,
 			// we'll pull the ARN out and construct a URL from it.
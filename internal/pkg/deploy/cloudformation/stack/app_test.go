@@ -16,6 +16,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/template/mocks"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -149,6 +150,8 @@ func TestAppResourceTemplate(t *testing.T) {
 				m := mocks.NewMockReadParser(ctrl)
 				m.EXPECT().Parse(appResourcesTemplatePath, struct {
 					*AppResourcesConfig
+					SidecarRepos    []sidecarRepo
+					RepoSettings    map[string]ecrRepoSettings
 					ServiceTagKey   string
 					TemplateVersion string
 				}{
@@ -158,6 +161,58 @@ func TestAppResourceTemplate(t *testing.T) {
 						Version:  1,
 						App:      "testapp",
 					},
+					nil,
+					map[string]ecrRepoSettings{
+						"app-1": {},
+						"app-2": {},
+					},
+					deploy.ServiceTagKey,
+					"",
+				}, gomock.Any()).Return(&template.Content{
+					Buffer: bytes.NewBufferString("template"),
+				}, nil)
+				c.parser = m
+			},
+
+			wantedTemplate: "template",
+		},
+		"should render sidecar repositories": {
+			given: &AppResourcesConfig{
+				Services: []string{"front-end"},
+				Sidecars: []string{"front-end/metrics", "front-end/auth-proxy"},
+				Version:  2,
+				App:      "testapp",
+			},
+			mockDependencies: func(ctrl *gomock.Controller, c *AppStackConfig) {
+				m := mocks.NewMockReadParser(ctrl)
+				m.EXPECT().Parse(appResourcesTemplatePath, struct {
+					*AppResourcesConfig
+					SidecarRepos    []sidecarRepo
+					RepoSettings    map[string]ecrRepoSettings
+					ServiceTagKey   string
+					TemplateVersion string
+				}{
+					&AppResourcesConfig{
+						Services: []string{"front-end"},
+						Sidecars: []string{"front-end/auth-proxy", "front-end/metrics"},
+						Version:  2,
+						App:      "testapp",
+					},
+					[]sidecarRepo{
+						{
+							LogicalID: "SidecarECRRepofrontDASHendSLASHauthDASHproxy",
+							Name:      "front-end/auth-proxy",
+							Workload:  "front-end",
+						},
+						{
+							LogicalID: "SidecarECRRepofrontDASHendSLASHmetrics",
+							Name:      "front-end/metrics",
+							Workload:  "front-end",
+						},
+					},
+					map[string]ecrRepoSettings{
+						"front-end": {},
+					},
 					deploy.ServiceTagKey,
 					"",
 				}, gomock.Any()).Return(&template.Content{
@@ -166,6 +221,54 @@ func TestAppResourceTemplate(t *testing.T) {
 				c.parser = m
 			},
 
+			wantedTemplate: "template",
+		},
+		"should render repository settings with workload overrides": {
+			given: &AppResourcesConfig{
+				Services: []string{"api", "worker"},
+				Version:  3,
+				App:      "testapp",
+				ImageRepository: &ImageRepositoryConfig{
+					KeepImages:         aws.Int(30),
+					UntaggedExpiryDays: aws.Int(7),
+					ScanOnPush:         aws.Bool(true),
+				},
+				ImageRepositories: map[string]ImageRepositoryConfig{
+					"worker": {
+						KeepImages:    aws.Int(5),
+						ScanOnPush:    aws.Bool(false),
+						ImmutableTags: aws.Bool(true),
+					},
+				},
+			},
+			mockDependencies: func(ctrl *gomock.Controller, c *AppStackConfig) {
+				m := mocks.NewMockReadParser(ctrl)
+				m.EXPECT().Parse(appResourcesTemplatePath, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ string, data interface{}, _ ...template.ParseOption) (*template.Content, error) {
+						settings := data.(struct {
+							*AppResourcesConfig
+							SidecarRepos    []sidecarRepo
+							RepoSettings    map[string]ecrRepoSettings
+							ServiceTagKey   string
+							TemplateVersion string
+						}).RepoSettings
+						require.Equal(t, map[string]ecrRepoSettings{
+							"api": {
+								LifecyclePolicy: `{"rules":[{"rulePriority":1,"description":"Expire untagged images after 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the last 30 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":30},"action":{"type":"expire"}}]}`,
+								ScanOnPush:      true,
+							},
+							"worker": {
+								LifecyclePolicy: `{"rules":[{"rulePriority":1,"description":"Expire untagged images after 7 days","selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":7},"action":{"type":"expire"}},{"rulePriority":2,"description":"Keep the last 5 images","selection":{"tagStatus":"any","countType":"imageCountMoreThan","countNumber":5},"action":{"type":"expire"}}]}`,
+								ImmutableTags:   true,
+							},
+						}, settings)
+						return &template.Content{
+							Buffer: bytes.NewBufferString("template"),
+						}, nil
+					})
+				c.parser = m
+			},
+
 			wantedTemplate: "template",
 		},
 	}
@@ -276,6 +379,22 @@ func TestToRegionalResources(t *testing.T) {
 				},
 			},
 		},
+		"should map sidecar repositories to their workload": {
+			givenStackOutputs: map[string]string{
+				appOutputKMSKey:       "arn:aws:kms:us-west-2:01234567890:key/0000",
+				appOutputS3Bucket:     "tests3-bucket-us-west-2",
+				"ECRRepofrontDASHend": "arn:aws:ecr:us-west-2:0123456789:repository/app/front-end",
+				"SidecarECRRepofrontDASHendSLASHauthDASHproxy": "arn:aws:ecr:us-west-2:0123456789:repository/app/front-end/auth-proxy",
+			},
+			wantedResource: AppRegionalResources{
+				KMSKeyARN: "arn:aws:kms:us-west-2:01234567890:key/0000",
+				S3Bucket:  "tests3-bucket-us-west-2",
+				RepositoryURLs: map[string]string{
+					"front-end":            "0123456789.dkr.ecr.us-west-2.amazonaws.com/app/front-end",
+					"front-end/auth-proxy": "0123456789.dkr.ecr.us-west-2.amazonaws.com/app/front-end/auth-proxy",
+				},
+			},
+		},
 		"should return error when no bucket exists": {
 			givenStackOutputs: map[string]string{
 				appOutputKMSKey:       "arn:aws:kms:us-west-2:01234567890:key/0000",
@@ -398,3 +517,48 @@ Metadata:
 		Services: []string{"testsvc1", "testsvc2"},
 	}, *config)
 }
+
+func TestAppResourceTemplate_ImageRepositoryMetadata(t *testing.T) {
+	// GIVEN
+	given := &AppResourcesConfig{
+		Accounts: []string{"1234"},
+		Services: []string{"api"},
+		Sidecars: []string{"api/proxy"},
+		Version:  4,
+		App:      "testapp",
+		ImageRepository: &ImageRepositoryConfig{
+			UntaggedExpiryDays: aws.Int(14),
+			ScanOnPush:         aws.Bool(true),
+		},
+		ImageRepositories: map[string]ImageRepositoryConfig{
+			"api": {
+				ImmutableTags: aws.Bool(true),
+			},
+		},
+	}
+	appStack := NewAppStackConfig(&deploy.CreateAppInput{Name: "testapp", AccountID: "1234"})
+
+	// WHEN
+	tpl, err := appStack.ResourceTemplate(given)
+
+	// THEN
+	require.NoError(t, err)
+	var parsed struct {
+		Resources map[string]struct {
+			Properties map[string]interface{} `yaml:"Properties"`
+		} `yaml:"Resources"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(tpl), &parsed))
+	for _, logicalID := range []string{"ECRRepoapi", "SidecarECRRepoapiSLASHproxy"} {
+		props := parsed.Resources[logicalID].Properties
+		require.Equal(t, "IMMUTABLE", props["ImageTagMutability"], logicalID)
+		require.Equal(t, map[string]interface{}{"ScanOnPush": true}, props["ImageScanningConfiguration"], logicalID)
+		require.Contains(t, props["LifecyclePolicy"], "LifecyclePolicyText", logicalID)
+		require.Equal(t, true, props["EmptyOnDelete"], logicalID)
+	}
+
+	config, err := AppConfigFrom(&tpl)
+	require.NoError(t, err)
+	require.Equal(t, given.ImageRepository, config.ImageRepository)
+	require.Equal(t, given.ImageRepositories, config.ImageRepositories)
+}
@@ -45,6 +45,10 @@ func NewBackendService(mft *manifest.BackendService, env, app string, rc Runtime
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
+	envAddons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
 	return &BackendService{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
@@ -58,6 +62,7 @@ func NewBackendService(mft *manifest.BackendService, env, app string, rc Runtime
 			},
 			logRetention:        mft.Logging.Retention,
 			tc:                  mft.TaskConfig,
+			envAddons:           envAddons,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
 		manifest: mft,
@@ -80,7 +85,11 @@ func (s *BackendService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := convertSidecar(s.manifest.Sidecars)
+	envAddonsOutputs, err := s.envAddonsOutputs()
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := convertSidecar(s.manifest.Sidecars, s.rc.SidecarImages)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
@@ -113,8 +122,9 @@ func (s *BackendService) Template() (string, error) {
 	}
 	content, err := s.parser.ParseBackendService(template.WorkloadOpts{
 		Variables:                s.manifest.BackendServiceConfig.Variables,
-		Secrets:                  s.manifest.BackendServiceConfig.Secrets,
+		Secrets:                  convertSecrets(s.manifest.BackendServiceConfig.Secrets),
 		NestedStack:              outputs,
+		EnvAddons:                envAddonsOutputs,
 		Sidecars:                 sidecars,
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
@@ -123,15 +133,17 @@ func (s *BackendService) Template() (string, error) {
 		WorkloadType:             manifest.BackendServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.BackendServiceConfig.ImageConfig.HealthCheck),
 		LogConfig:                convertLogging(s.manifest.Logging),
-		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
+		DockerLabels:             dockerLabels(s.manifest.ImageConfig.Image.DockerLabels, s.rc.Image),
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
 		Network:                  convertNetworkConfig(s.manifest.Network),
+		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig),
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
+		Platform:                 convertPlatform(&s.manifest.TaskConfig),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
 	})
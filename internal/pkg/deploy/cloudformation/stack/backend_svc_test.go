@@ -75,6 +75,57 @@ func TestBackendService_Template(t *testing.T) {
 			},
 			wantedErr: fmt.Errorf("generate addons template for %s: %w", testServiceName, errors.New("some error")),
 		},
+		"unexpected environment addons outputs error": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.Storage.Shared = []string{"orders"}
+				svc.tc = svc.manifest.TaskConfig
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+				svc.envAddons = mockEnvAddons{err: errors.New("some error")}
+			},
+			wantedErr: fmt.Errorf("get outputs of environment addon orders for %s: %w", testServiceName, errors.New("some error")),
+		},
+		"render template with shared environment addons": {
+			setUpManifest: func(svc *BackendService) {
+				svc.manifest = manifest.NewBackendService(baseProps)
+				svc.manifest.Storage.Shared = []string{"orders", "jobs"}
+				svc.tc = svc.manifest.TaskConfig
+			},
+			mockDependencies: func(t *testing.T, ctrl *gomock.Controller, svc *BackendService) {
+				m := mocks.NewMockbackendSvcReadParser(ctrl)
+				m.EXPECT().Read(desiredCountGeneratorPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().Read(envControllerPath).Return(&template.Content{Buffer: bytes.NewBufferString("something")}, nil)
+				m.EXPECT().ParseBackendService(gomock.Any()).DoAndReturn(func(opts template.WorkloadOpts) (*template.Content, error) {
+					require.Nil(t, opts.NestedStack)
+					require.Equal(t, &template.WorkloadEnvAddonsOpts{
+						VariableOutputs:      []string{"ordersName", "jobsSecurityGroup"},
+						PolicyOutputs:        []string{"ordersAccessPolicy"},
+						SecurityGroupOutputs: []string{"jobsSecurityGroup"},
+					}, opts.EnvAddons)
+					return &template.Content{Buffer: bytes.NewBufferString("template")}, nil
+				})
+				svc.parser = m
+				svc.addons = mockTemplater{err: &addon.ErrAddonsNotFound{}}
+				svc.envAddons = mockEnvAddons{
+					outputs: map[string][]addon.Output{
+						"orders": {
+							{Name: "ordersName"},
+							{Name: "ordersAccessPolicy", IsManagedPolicy: true},
+						},
+						"jobs": {
+							{Name: "jobsSecurityGroup", IsSecurityGroup: true},
+						},
+					},
+				}
+			},
+			wantedTemplate: "template",
+		},
 		"failed parsing sidecars template": {
 			setUpManifest: func(svc *BackendService) {
 				testBackendSvcManifestWithBadSidecar := manifest.NewBackendService(baseProps)
@@ -41,6 +41,37 @@ func TestCC_Pipeline_Template(t *testing.T) {
 				RequiresApproval: false,
 				TestCommands:     []string{`echo "test"`},
 			},
+			{
+				AssociatedEnvironment: &deploy.AssociatedEnvironment{
+					Name:      "prod",
+					Region:    "us-west-2",
+					AccountID: "1111",
+				},
+				LocalWorkloads:   []string{"api"},
+				RequiresApproval: true,
+				PreDeployments: []deploy.PrePostDeployAction{
+					{
+						Name:          "migrate_db",
+						BuildspecPath: "copilot/pipelines/migrate/buildspec.yml",
+						Order:         1,
+					},
+				},
+				PostDeployments: []deploy.PrePostDeployAction{
+					{
+						Name:          "smoke",
+						BuildspecPath: "copilot/pipelines/smoke/buildspec.yml",
+						Order:         1,
+					},
+					{
+						Name:          "load",
+						BuildspecPath: "copilot/pipelines/load/buildspec.yml",
+						Order:         2,
+					},
+				},
+			},
+		},
+		Notifications: &deploy.PipelineNotifications{
+			Emails: []string{"team@example.com"},
 		},
 		ArtifactBuckets: []deploy.ArtifactBucket{
 			{
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
 )
 
 type envReadParser interface {
@@ -32,23 +33,31 @@ const (
 	// Mandatory parameter keys.
 	envParamAppNameKey               = "AppName"
 	envParamEnvNameKey               = "EnvironmentName"
-	envParamToolsAccountPrincipalKey = "ToolsAccountPrincipalARN"
-	envParamAppDNSKey                = "AppDNSName"
-	envParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
+	EnvParamToolsAccountPrincipalKey = "ToolsAccountPrincipalARN"
+	EnvParamAppDNSKey                = "AppDNSName"
+	EnvParamAppDNSDelegationRoleKey  = "AppDNSDelegationRole"
 	EnvParamAliasesKey               = "Aliases"
+	EnvParamInternalALBWorkloadsKey  = "InternalALBWorkloads"
+	EnvParamAddonsTemplateURLKey     = "AddonsTemplateURL"
 
 	// Output keys.
-	EnvOutputVPCID                   = "VpcId"
-	EnvOutputPublicSubnets           = "PublicSubnets"
-	EnvOutputPrivateSubnets          = "PrivateSubnets"
-	envOutputCFNExecutionRoleARN     = "CFNExecutionRoleARN"
-	envOutputManagerRoleKey          = "EnvironmentManagerRoleARN"
-	EnvParamServiceDiscoveryEndpoint = "ServiceDiscoveryEndpoint"
+	EnvOutputVPCID                       = "VpcId"
+	EnvOutputPublicSubnets               = "PublicSubnets"
+	EnvOutputPrivateSubnets              = "PrivateSubnets"
+	EnvOutputInternalLoadBalancerDNSName = "InternalLoadBalancerDNSName"
+	EnvOutputVPCFlowLogsDestination      = "VPCFlowLogsDestination"
+	EnvOutputPublicLBAccessLogsBucket    = "PublicLoadBalancerAccessLogsBucket"
+	EnvOutputPublicLBWebACLArn           = "PublicLoadBalancerWebACLArn"
+	envOutputCFNExecutionRoleARN         = "CFNExecutionRoleARN"
+	envOutputManagerRoleKey              = "EnvironmentManagerRoleARN"
+	EnvParamServiceDiscoveryEndpoint     = "ServiceDiscoveryEndpoint"
 
 	// Default parameter values
 	DefaultVPCCIDR            = "10.0.0.0/16"
 	DefaultPublicSubnetCIDRs  = "10.0.0.0/24,10.0.1.0/24"
 	DefaultPrivateSubnetCIDRs = "10.0.2.0/24,10.0.3.0/24"
+
+	flowLogsRetentionDefault = 30 // Number of days to keep the flow logs of the VPC for.
 )
 
 var (
@@ -88,8 +97,30 @@ func (e *EnvStackConfig) Template() (string, error) {
 		return "", err
 	}
 
-	if e.in.AdjustVPCConfig != nil {
-		vpcConf = e.in.AdjustVPCConfig
+	importVPC, adjustVPC, telemetry := e.in.ImportVPCConfig, e.in.AdjustVPCConfig, e.in.Telemetry
+	var httpConfig, internalHTTPConfig template.HTTPConfig
+	var githubActions *template.GitHubActionsOpts
+	var flowLogs *template.FlowLogsOpts
+	var privateOnly bool
+	if e.in.Mft != nil {
+		importVPC = e.in.Mft.Network.VPC.ImportedVPC()
+		adjustVPC = e.in.Mft.Network.VPC.ManagedVPC()
+		privateOnly = e.in.Mft.Network.VPC.IsPrivateOnly()
+		flowLogs = convertFlowLogsConfig(e.in.Mft.Network.VPC.FlowLogs)
+		telemetry = e.in.Mft.Telemetry()
+		httpConfig = convertPublicHTTPConfig(e.in.Mft.HTTPConfig.Public)
+		internalHTTPConfig = convertInternalHTTPConfig(e.in.Mft.HTTPConfig.Internal)
+		githubActions = convertGitHubActionsConfig(e.in.Mft.CICD.GitHubActions)
+	}
+	if adjustVPC != nil {
+		vpcConf = adjustVPC
+	}
+	if privateOnly {
+		// A private-only VPC doesn't have public subnets.
+		vpcConf = &config.AdjustVPC{
+			CIDR:               vpcConf.CIDR,
+			PrivateSubnetCIDRs: vpcConf.PrivateSubnetCIDRs,
+		}
 	}
 
 	content, err := e.parser.ParseEnv(&template.EnvOpts{
@@ -99,8 +130,15 @@ func (e *EnvStackConfig) Template() (string, error) {
 		EnableLongARNFormatLambda: enableLongARN,
 		CustomDomainLambda:        customDomain,
 		ScriptBucketName:          bucket,
-		ImportVPC:                 e.in.ImportVPCConfig,
+		ImportVPC:                 importVPC,
 		VPCConfig:                 vpcConf,
+		PrivateOnly:               privateOnly,
+		FlowLogs:                  flowLogs,
+		PublicHTTPConfig:          httpConfig,
+		InternalHTTPConfig:        internalHTTPConfig,
+		Telemetry:                 convertTelemetry(telemetry),
+		GitHubActions:             githubActions,
+		SerializedManifest:        string(e.in.RawMft),
 		Version:                   e.in.Version,
 		LatestVersion:             deploy.LatestEnvTemplateVersion,
 	}, template.WithFuncs(map[string]interface{}{
@@ -124,21 +162,25 @@ func (e *EnvStackConfig) Parameters() ([]*cloudformation.Parameter, error) {
 			ParameterValue: aws.String(e.in.Name),
 		},
 		{
-			ParameterKey:   aws.String(envParamToolsAccountPrincipalKey),
+			ParameterKey:   aws.String(EnvParamToolsAccountPrincipalKey),
 			ParameterValue: aws.String(e.in.App.AccountPrincipalARN),
 		},
 		{
-			ParameterKey:   aws.String(envParamAppDNSKey),
+			ParameterKey:   aws.String(EnvParamAppDNSKey),
 			ParameterValue: aws.String(e.in.App.DNSName),
 		},
 		{
-			ParameterKey:   aws.String(envParamAppDNSDelegationRoleKey),
+			ParameterKey:   aws.String(EnvParamAppDNSDelegationRoleKey),
 			ParameterValue: aws.String(e.in.App.DNSDelegationRole()),
 		},
 		{
 			ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
 			ParameterValue: aws.String(fmt.Sprintf(fmtServiceDiscoveryEndpoint, e.in.Name, e.in.App.Name)),
 		},
+		{
+			ParameterKey:   aws.String(EnvParamAddonsTemplateURLKey),
+			ParameterValue: aws.String(e.in.AddonsTemplateURL),
+		},
 	}, nil
 }
 
@@ -178,3 +220,20 @@ func (e *EnvStackConfig) ToEnv(stack *cloudformation.Stack) (*config.Environment
 		ExecutionRoleARN: stackOutputs[envOutputCFNExecutionRoleARN],
 	}, nil
 }
+
+// EnvManifestFromTemplate returns the environment manifest stored in the metadata of an environment template.
+// If the environment wasn't deployed with a manifest, it returns nil.
+func EnvManifestFromTemplate(tpl string) ([]byte, error) {
+	var body struct {
+		Metadata struct {
+			Manifest string `yaml:"Manifest"`
+		} `yaml:"Metadata"`
+	}
+	if err := yaml.Unmarshal([]byte(tpl), &body); err != nil {
+		return nil, fmt.Errorf("unmarshal environment template: %w", err)
+	}
+	if body.Metadata.Manifest == "" {
+		return nil, nil
+	}
+	return []byte(body.Metadata.Manifest), nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack/mocks"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,7 @@ import (
 
 func TestEnv_Template(t *testing.T) {
 	testCases := map[string]struct {
+		inManifest       string
 		mockDependencies func(ctrl *gomock.Controller, e *EnvStackConfig)
 		expectedOutput   string
 		want             error
@@ -47,6 +49,141 @@ func TestEnv_Template(t *testing.T) {
 			},
 			expectedOutput: mockTemplate,
 		},
+		"should use the configuration from the environment manifest": {
+			inManifest: `name: test
+type: Environment
+network:
+  vpc:
+    id: vpc-1234
+    subnets:
+      private:
+        - id: subnet-1
+        - id: subnet-2
+http:
+  public:
+    allowed_source_ips: ["10.24.34.0/23"]
+    ssl_policy: ELBSecurityPolicy-FS-1-1-2019-08
+    certificates:
+      - arn:aws:acm:us-west-2:123456789012:certificate/abc
+observability:
+  container_insights: true
+cicd:
+  github_actions:
+    repository: aws/copilot-cli
+    create_oidc_provider: true
+`,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					AppName:                   "project",
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					CustomDomainLambda:        "mockkey4",
+					ImportVPC: &config.ImportVPC{
+						ID:               "vpc-1234",
+						PrivateSubnetIDs: []string{"subnet-1", "subnet-2"},
+					},
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					PublicHTTPConfig: template.HTTPConfig{
+						AllowedSourceIPs: []string{"10.24.34.0/23"},
+						SSLPolicy:        aws.String("ELBSecurityPolicy-FS-1-1-2019-08"),
+						ImportedCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+					},
+					Telemetry: &template.Telemetry{
+						EnableContainerInsights: true,
+					},
+					GitHubActions: &template.GitHubActionsOpts{
+						Repository:         "aws/copilot-cli",
+						CreateOIDCProvider: true,
+					},
+					LatestVersion: deploy.LatestEnvTemplateVersion,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should configure flow logs, access logs and the web ACL": {
+			inManifest: `name: test
+type: Environment
+network:
+  vpc:
+    flow_logs:
+      destination: s3
+http:
+  public:
+    access_logs:
+      prefix: my-prefix
+    web_acl: arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234
+`,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					AppName:                   "project",
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					CustomDomainLambda:        "mockkey4",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+						PublicSubnetCIDRs:  strings.Split(DefaultPublicSubnetCIDRs, ","),
+					},
+					FlowLogs: &template.FlowLogsOpts{
+						Destination: "s3",
+						Retention:   30,
+					},
+					PublicHTTPConfig: template.HTTPConfig{
+						ELBAccessLogs: &template.ELBAccessLogsOpts{
+							Prefix: "my-prefix",
+						},
+						WebACLArn: "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234",
+					},
+					LatestVersion: deploy.LatestEnvTemplateVersion,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
+		"should not create public subnets in a private-only VPC": {
+			inManifest: `name: test
+type: Environment
+network:
+  vpc:
+    private_only: true
+http:
+  internal:
+    allowed_source_ips: ["10.0.0.0/16"]
+`,
+			mockDependencies: func(ctrl *gomock.Controller, e *EnvStackConfig) {
+				m := mocks.NewMockenvReadParser(ctrl)
+				m.EXPECT().ParseEnv(&template.EnvOpts{
+					AppName:                   "project",
+					ScriptBucketName:          "mockbucket",
+					DNSCertValidatorLambda:    "mockkey1",
+					DNSDelegationLambda:       "mockkey2",
+					EnableLongARNFormatLambda: "mockkey3",
+					CustomDomainLambda:        "mockkey4",
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               DefaultVPCCIDR,
+						PrivateSubnetCIDRs: strings.Split(DefaultPrivateSubnetCIDRs, ","),
+					},
+					PrivateOnly: true,
+					InternalHTTPConfig: template.HTTPConfig{
+						AllowedSourceIPs: []string{"10.0.0.0/16"},
+					},
+					LatestVersion: deploy.LatestEnvTemplateVersion,
+				}, gomock.Any()).Return(&template.Content{Buffer: bytes.NewBufferString("mockTemplate")}, nil)
+				e.parser = m
+			},
+			expectedOutput: mockTemplate,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -54,8 +191,14 @@ func TestEnv_Template(t *testing.T) {
 			// GIVEN
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
+			in := mockDeployEnvironmentInput()
+			if tc.inManifest != "" {
+				mft, err := manifest.UnmarshalEnvironment([]byte(tc.inManifest))
+				require.NoError(t, err)
+				in.Mft = mft
+			}
 			envStack := &EnvStackConfig{
-				in: mockDeployEnvironmentInput(),
+				in: in,
 			}
 			tc.mockDependencies(ctrl, envStack)
 
@@ -93,21 +236,25 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterValue: aws.String(deploymentInput.Name),
 				},
 				{
-					ParameterKey:   aws.String(envParamToolsAccountPrincipalKey),
+					ParameterKey:   aws.String(EnvParamToolsAccountPrincipalKey),
 					ParameterValue: aws.String(deploymentInput.App.AccountPrincipalARN),
 				},
 				{
-					ParameterKey:   aws.String(envParamAppDNSKey),
+					ParameterKey:   aws.String(EnvParamAppDNSKey),
 					ParameterValue: aws.String(""),
 				},
 				{
-					ParameterKey:   aws.String(envParamAppDNSDelegationRoleKey),
+					ParameterKey:   aws.String(EnvParamAppDNSDelegationRoleKey),
 					ParameterValue: aws.String(""),
 				},
 				{
 					ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
 					ParameterValue: aws.String("env.project.local"),
 				},
+				{
+					ParameterKey:   aws.String(EnvParamAddonsTemplateURLKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 		"with DNS": {
@@ -122,21 +269,25 @@ func TestEnv_Parameters(t *testing.T) {
 					ParameterValue: aws.String(deploymentInputWithDNS.Name),
 				},
 				{
-					ParameterKey:   aws.String(envParamToolsAccountPrincipalKey),
+					ParameterKey:   aws.String(EnvParamToolsAccountPrincipalKey),
 					ParameterValue: aws.String(deploymentInputWithDNS.App.AccountPrincipalARN),
 				},
 				{
-					ParameterKey:   aws.String(envParamAppDNSKey),
+					ParameterKey:   aws.String(EnvParamAppDNSKey),
 					ParameterValue: aws.String(deploymentInputWithDNS.App.DNSName),
 				},
 				{
-					ParameterKey:   aws.String(envParamAppDNSDelegationRoleKey),
+					ParameterKey:   aws.String(EnvParamAppDNSDelegationRoleKey),
 					ParameterValue: aws.String("arn:aws:iam::000000000:role/project-DNSDelegationRole"),
 				},
 				{
 					ParameterKey:   aws.String(EnvParamServiceDiscoveryEndpoint),
 					ParameterValue: aws.String("env.project.local"),
 				},
+				{
+					ParameterKey:   aws.String(EnvParamAddonsTemplateURLKey),
+					ParameterValue: aws.String(""),
+				},
 			},
 		},
 	}
@@ -190,6 +341,46 @@ func TestStackName(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%s-%s", deploymentInput.App.Name, deploymentInput.Name), env.StackName())
 }
 
+func TestEnvManifestFromTemplate(t *testing.T) {
+	testCases := map[string]struct {
+		inTemplate string
+
+		wantedManifest []byte
+	}{
+		"returns nil if the template has no manifest": {
+			inTemplate: `Metadata:
+  Version: v1.9.0
+Resources:
+  VPC:
+    Type: AWS::EC2::VPC
+`,
+		},
+		"returns the manifest stored in the metadata": {
+			inTemplate: `Metadata:
+  Version: v1.9.0
+  Manifest: |
+    name: test
+    type: Environment
+Resources:
+  Cluster:
+    Properties:
+      ClusterName: !Sub ${AppName}-${EnvironmentName}
+`,
+			wantedManifest: []byte("name: test\ntype: Environment\n"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			mft, err := EnvManifestFromTemplate(tc.inTemplate)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedManifest, mft)
+		})
+	}
+}
+
 func TestToEnv(t *testing.T) {
 	mockDeployInput := mockDeployEnvironmentInput()
 	testCases := map[string]struct {
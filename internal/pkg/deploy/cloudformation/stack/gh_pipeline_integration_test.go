@@ -27,6 +27,7 @@ func TestGHPipeline_Template(t *testing.T) {
 			ProviderName:  manifest.GithubProviderName,
 			RepositoryURL: "https://github.com/aws/phonetool",
 			Branch:        "mainline",
+			Paths:         []string{"api/**", "copilot/api/**"},
 		},
 		Build: deploy.PipelineBuildFromManifest(nil),
 		Stages: []deploy.PipelineStage{
@@ -6,6 +6,7 @@ package stack
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -40,20 +41,36 @@ type loadBalancedWebSvcReadParser interface {
 // LoadBalancedWebService represents the configuration needed to create a CloudFormation stack from a load balanced web service manifest.
 type LoadBalancedWebService struct {
 	*ecsWkld
-	manifest     *manifest.LoadBalancedWebService
-	httpsEnabled bool
+	manifest               *manifest.LoadBalancedWebService
+	httpsEnabled           bool
+	publicSubnetCIDRBlocks []string
 
 	parser loadBalancedWebSvcReadParser
 }
 
+// LoadBalancedWebServiceOption is used to configure an optional field for LoadBalancedWebService.
+type LoadBalancedWebServiceOption func(s *LoadBalancedWebService)
+
+// WithNLB enables the Network Load Balancer of the service.
+// The CIDR blocks of the environment's public subnets, where the load balancer lives, are allowed to reach the tasks.
+func WithNLB(cidrBlocks []string) LoadBalancedWebServiceOption {
+	return func(s *LoadBalancedWebService) {
+		s.publicSubnetCIDRBlocks = cidrBlocks
+	}
+}
+
 // NewLoadBalancedWebService creates a new LoadBalancedWebService stack from a manifest file.
-func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig) (*LoadBalancedWebService, error) {
+func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig, opts ...LoadBalancedWebServiceOption) (*LoadBalancedWebService, error) {
 	parser := template.New()
 	addons, err := addon.New(aws.StringValue(mft.Name))
 	if err != nil {
 		return nil, fmt.Errorf("new addons: %w", err)
 	}
-	return &LoadBalancedWebService{
+	envAddons, err := addon.NewEnv()
+	if err != nil {
+		return nil, fmt.Errorf("new environment addons: %w", err)
+	}
+	s := &LoadBalancedWebService{
 		ecsWkld: &ecsWkld{
 			wkld: &wkld{
 				name:   aws.StringValue(mft.Name),
@@ -66,24 +83,30 @@ func NewLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app st
 			},
 			logRetention:        mft.Logging.Retention,
 			tc:                  mft.TaskConfig,
+			envAddons:           envAddons,
 			taskDefOverrideFunc: override.CloudFormationTemplate,
 		},
 		manifest:     mft,
 		httpsEnabled: false,
 
 		parser: parser,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
 }
 
 // NewHTTPSLoadBalancedWebService  creates a new LoadBalancedWebService stack from its manifest that needs to be deployed to
 // a environment within an application. It creates an HTTPS listener and assumes that the environment
 // it's being deployed into has an HTTPS configured listener.
-func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig) (*LoadBalancedWebService, error) {
-	webSvc, err := NewLoadBalancedWebService(mft, env, app, rc)
+func NewHTTPSLoadBalancedWebService(mft *manifest.LoadBalancedWebService, env, app string, rc RuntimeConfig, opts ...LoadBalancedWebServiceOption) (*LoadBalancedWebService, error) {
+	webSvc, err := NewLoadBalancedWebService(mft, env, app, rc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	webSvc.httpsEnabled = true
+	// The internal load balancer of an environment only listens on HTTP.
+	webSvc.httpsEnabled = !mft.RoutingRule.IsInternal()
 	return webSvc, nil
 }
 
@@ -105,7 +128,11 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	sidecars, err := convertSidecar(s.manifest.Sidecars)
+	envAddonsOutputs, err := s.envAddonsOutputs()
+	if err != nil {
+		return "", err
+	}
+	sidecars, err := convertSidecar(s.manifest.Sidecars, s.rc.SidecarImages)
 	if err != nil {
 		return "", fmt.Errorf("convert the sidecar configuration for service %s: %w", s.name, err)
 	}
@@ -140,7 +167,7 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 
 	var aliases []string
 	if s.httpsEnabled {
-		if aliases, err = convertAlias(s.manifest.Alias); err != nil {
+		if aliases, err = convertAlias(s.manifest.RoutingRule.Alias); err != nil {
 			return "", err
 		}
 	}
@@ -151,35 +178,51 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 	}
 
 	var allowedSourceIPs []string
-	for _, ipNet := range s.manifest.AllowedSourceIps {
+	for _, ipNet := range s.manifest.RoutingRule.AllowedSourceIps {
 		allowedSourceIPs = append(allowedSourceIPs, string(ipNet))
 	}
+	additionalRules, err := s.convertAdditionalHTTPRules(aliases)
+	if err != nil {
+		return "", err
+	}
+	nlb, err := s.convertNetworkLoadBalancer()
+	if err != nil {
+		return "", err
+	}
 	content, err := s.parser.ParseLoadBalancedWebService(template.WorkloadOpts{
 		Variables:                s.manifest.Variables,
-		Secrets:                  s.manifest.Secrets,
+		Secrets:                  convertSecrets(s.manifest.Secrets),
 		Aliases:                  aliases,
 		NestedStack:              outputs,
+		EnvAddons:                envAddonsOutputs,
 		Sidecars:                 sidecars,
 		LogConfig:                convertLogging(s.manifest.Logging),
-		DockerLabels:             s.manifest.ImageConfig.Image.DockerLabels,
+		DockerLabels:             dockerLabels(s.manifest.ImageConfig.Image.DockerLabels, s.rc.Image),
 		Autoscaling:              autoscaling,
 		CapacityProviders:        capacityProviders,
 		DesiredCountOnSpot:       desiredCountOnSpot,
 		ExecuteCommand:           convertExecuteCommand(&s.manifest.ExecuteCommand),
 		WorkloadType:             manifest.LoadBalancedWebServiceType,
 		HealthCheck:              convertContainerHealthCheck(s.manifest.ImageConfig.HealthCheck),
-		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.HealthCheck),
+		HTTPHealthCheck:          convertHTTPHealthCheck(&s.manifest.RoutingRule.HealthCheck),
 		DeregistrationDelay:      deregistrationDelay,
 		AllowedSourceIps:         allowedSourceIPs,
+		HTTPConditions:           convertHTTPConditions(s.manifest.RoutingRule.RoutingRule),
+		AdditionalHTTPRules:      additionalRules,
+		NLB:                      nlb,
 		RulePriorityLambda:       rulePriorityLambda.String(),
 		DesiredCountLambda:       desiredCountLambda.String(),
 		EnvControllerLambda:      envControllerLambda.String(),
 		Storage:                  convertStorageOpts(s.manifest.Name, s.manifest.Storage),
 		Network:                  convertNetworkConfig(s.manifest.Network),
+		DeploymentConfiguration:  convertDeploymentConfig(s.manifest.DeployConfig.DeploymentConfiguration),
+		BlueGreen:                convertBlueGreen(s.manifest.DeployConfig.BlueGreen, s.rc.DeployedTaskDefinition, s.rc.TrafficTargetGroup),
+		InternalALB:              s.manifest.RoutingRule.IsInternal(),
 		EntryPoint:               entrypoint,
 		Command:                  command,
 		DependsOn:                convertDependsOn(s.manifest.ImageConfig.Image.DependsOn),
 		CredentialsParameter:     aws.StringValue(s.manifest.ImageConfig.Image.Credentials),
+		Platform:                 convertPlatform(&s.manifest.TaskConfig),
 		ServiceDiscoveryEndpoint: s.rc.ServiceDiscoveryEndpoint,
 		Publish:                  publishers,
 	})
@@ -194,16 +237,21 @@ func (s *LoadBalancedWebService) Template() (string, error) {
 }
 
 func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string, targetPort *string, err error) {
+	return s.httpRuleTarget(s.manifest.RoutingRule.RoutingRule)
+}
+
+// httpRuleTarget returns the container and port that receive the traffic matched by a routing rule.
+func (s *LoadBalancedWebService) httpRuleTarget(rule manifest.RoutingRule) (targetContainer *string, targetPort *string, err error) {
 	containerName := s.name
 	containerPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)
 	// Route load balancer traffic to main container by default.
 	targetContainer = aws.String(containerName)
 	targetPort = aws.String(containerPort)
-	if s.manifest.TargetContainer == nil && s.manifest.TargetContainerCamelCase != nil {
-		s.manifest.TargetContainer = s.manifest.TargetContainerCamelCase
+	mftTargetContainer := rule.TargetContainer
+	if mftTargetContainer == nil {
+		mftTargetContainer = rule.TargetContainerCamelCase
 	}
-	mftTargetContainer := s.manifest.TargetContainer
-	if mftTargetContainer != nil {
+	if mftTargetContainer != nil && aws.StringValue(mftTargetContainer) != containerName {
 		sidecar, ok := s.manifest.Sidecars[*mftTargetContainer]
 		if ok {
 			if sidecar.Port == nil {
@@ -218,6 +266,124 @@ func (s *LoadBalancedWebService) loadBalancerTarget() (targetContainer *string,
 	return
 }
 
+// convertAdditionalHTTPRules converts the rules listed after the first one in the "http" field.
+// Rules without an alias share the aliases of the first rule.
+func (s *LoadBalancedWebService) convertAdditionalHTTPRules(mainAliases []string) ([]template.HTTPRuleOpts, error) {
+	var rules []template.HTTPRuleOpts
+	for i, rule := range s.manifest.RoutingRule.AdditionalRules {
+		index := i + 1
+		targetContainer, targetPort, err := s.httpRuleTarget(rule)
+		if err != nil {
+			return nil, fmt.Errorf(`convert "http[%d]": %w`, index, err)
+		}
+		var aliases []string
+		if s.httpsEnabled {
+			if aliases, err = convertAlias(rule.Alias); err != nil {
+				return nil, fmt.Errorf(`convert "http[%d]": %w`, index, err)
+			}
+			if len(aliases) == 0 {
+				aliases = mainAliases
+			}
+		}
+		deregistrationDelay := aws.Int64(60)
+		if rule.DeregistrationDelay != nil {
+			deregistrationDelay = aws.Int64(int64(rule.DeregistrationDelay.Seconds()))
+		}
+		var allowedSourceIPs []string
+		for _, ipNet := range rule.AllowedSourceIps {
+			allowedSourceIPs = append(allowedSourceIPs, string(ipNet))
+		}
+		rules = append(rules, template.HTTPRuleOpts{
+			Index:               index,
+			Path:                aws.StringValue(rule.Path),
+			Aliases:             aliases,
+			TargetContainer:     aws.StringValue(targetContainer),
+			TargetPort:          aws.StringValue(targetPort),
+			HTTPHealthCheck:     convertHTTPHealthCheck(&rule.HealthCheck),
+			DeregistrationDelay: deregistrationDelay,
+			Stickiness:          aws.BoolValue(rule.Stickiness),
+			AllowedSourceIps:    allowedSourceIPs,
+			Conditions:          convertHTTPConditions(rule),
+		})
+	}
+	return rules, nil
+}
+
+func (s *LoadBalancedWebService) convertNetworkLoadBalancer() (*template.NetworkLoadBalancer, error) {
+	nlbConfig := s.manifest.NLBConfig
+	if nlbConfig.IsEmpty() {
+		return nil, nil
+	}
+	port, protocol, err := nlbConfig.ListenerPortAndProtocol()
+	if err != nil {
+		return nil, fmt.Errorf(`parse "nlb.port": %w`, err)
+	}
+	if protocol == manifest.NLBListenerProtocolTLS && !s.httpsEnabled {
+		return nil, fmt.Errorf("%s listener of the network load balancer requires an application with a domain name", protocol)
+	}
+	if len(s.publicSubnetCIDRBlocks) == 0 {
+		return nil, fmt.Errorf("CIDR blocks of the public subnets are required to allow the network load balancer to reach service %s", s.name)
+	}
+
+	// Route network load balancer traffic to the main container by default.
+	targetContainer := s.name
+	targetPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)
+	if nlbConfig.TargetContainer != nil && aws.StringValue(nlbConfig.TargetContainer) != s.name {
+		sidecar, ok := s.manifest.Sidecars[aws.StringValue(nlbConfig.TargetContainer)]
+		if !ok {
+			return nil, fmt.Errorf("target container %s of the network load balancer doesn't exist", aws.StringValue(nlbConfig.TargetContainer))
+		}
+		if sidecar.Port == nil {
+			return nil, fmt.Errorf("target container %s of the network load balancer doesn't expose any port", aws.StringValue(nlbConfig.TargetContainer))
+		}
+		sidecarPort, sidecarProtocol, err := parsePortMapping(sidecar.Port)
+		if err != nil {
+			return nil, err
+		}
+		// Only the main container gets an extra port mapping, so the target port of a sidecar must be the port it exposes.
+		if nlbConfig.TargetPort != nil && strconv.Itoa(aws.IntValue(nlbConfig.TargetPort)) != aws.StringValue(sidecarPort) {
+			return nil, fmt.Errorf(`"nlb.target_port" %d must be the port %s exposed by target container %s`,
+				aws.IntValue(nlbConfig.TargetPort), aws.StringValue(sidecarPort), aws.StringValue(nlbConfig.TargetContainer))
+		}
+		wantedProtocol, exposedProtocol := "tcp", "tcp"
+		if protocol == manifest.NLBListenerProtocolUDP {
+			wantedProtocol = "udp"
+		}
+		if sidecarProtocol != nil {
+			exposedProtocol = strings.ToLower(aws.StringValue(sidecarProtocol))
+		}
+		if exposedProtocol != wantedProtocol {
+			return nil, fmt.Errorf("target container %s of the network load balancer must expose port %s/%s",
+				aws.StringValue(nlbConfig.TargetContainer), aws.StringValue(sidecarPort), wantedProtocol)
+		}
+		targetContainer = aws.StringValue(nlbConfig.TargetContainer)
+		targetPort = aws.StringValue(sidecarPort)
+	} else if nlbConfig.TargetPort != nil {
+		targetPort = strconv.Itoa(aws.IntValue(nlbConfig.TargetPort))
+	}
+	listener := template.NetworkLoadBalancerListener{
+		Port:            strconv.FormatUint(uint64(port), 10),
+		Protocol:        protocol,
+		TargetContainer: targetContainer,
+		TargetPort:      targetPort,
+		SSLPolicy:       nlbConfig.SSLPolicy,
+		Stickiness:      nlbConfig.Stickiness,
+		HealthCheck:     convertNLBHealthCheck(&nlbConfig.HealthCheck),
+	}
+	nlb := &template.NetworkLoadBalancer{
+		PublicSubnetCIDRs: s.publicSubnetCIDRBlocks,
+		Listener:          listener,
+	}
+	mainPort := strconv.FormatUint(uint64(aws.Uint16Value(s.manifest.ImageConfig.Port)), 10)
+	if targetContainer == s.name && (targetPort != mainPort || listener.TargetProtocol() == manifest.NLBListenerProtocolUDP) {
+		nlb.MainContainerPortMapping = &template.PortMapping{
+			Port:     targetPort,
+			Protocol: strings.ToLower(listener.TargetProtocol()),
+		}
+	}
+	return nlb, nil
+}
+
 // Parameters returns the list of CloudFormation parameters used by the template.
 func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, error) {
 	wkldParams, err := s.ecsWkld.Parameters()
@@ -235,7 +401,7 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceRulePathParamKey),
-			ParameterValue: s.manifest.Path,
+			ParameterValue: s.manifest.RoutingRule.Path,
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceHTTPSParamKey),
@@ -251,7 +417,7 @@ func (s *LoadBalancedWebService) Parameters() ([]*cloudformation.Parameter, erro
 		},
 		{
 			ParameterKey:   aws.String(LBWebServiceStickinessParamKey),
-			ParameterValue: aws.String(strconv.FormatBool(aws.BoolValue(s.manifest.Stickiness))),
+			ParameterValue: aws.String(strconv.FormatBool(aws.BoolValue(s.manifest.RoutingRule.Stickiness))),
 		},
 	}...), nil
 }
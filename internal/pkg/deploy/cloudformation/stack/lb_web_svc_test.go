@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -40,6 +41,18 @@ func (m mockTemplater) Template() (string, error) {
 	return m.tpl, nil
 }
 
+type mockEnvAddons struct {
+	outputs map[string][]addon.Output
+	err     error
+}
+
+func (m mockEnvAddons) Outputs(name string) ([]addon.Output, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.outputs[name], nil
+}
+
 var mockCloudFormationOverrideFunc = func(overrideRules []override.Rule, origTemp []byte) ([]byte, error) {
 	return origTemp, nil
 }
@@ -109,7 +122,7 @@ func TestLoadBalancedWebService_Template(t *testing.T) {
 	testLBWebServiceManifest.ImageConfig.HealthCheck = manifest.ContainerHealthCheck{
 		Retries: aws.Int(5),
 	}
-	testLBWebServiceManifest.Alias = manifest.Alias{String: aws.String("mockAlias")}
+	testLBWebServiceManifest.RoutingRule.Alias = manifest.Alias{String: aws.String("mockAlias")}
 	testLBWebServiceManifest.EntryPoint = manifest.EntryPointOverride{
 		String:      nil,
 		StringSlice: []string{"/bin/echo", "hello"},
@@ -326,6 +339,297 @@ Outputs:
 	}
 }
 
+func TestLoadBalancedWebService_convertNetworkLoadBalancer(t *testing.T) {
+	healthCheckInterval := 10 * time.Second
+	testCases := map[string]struct {
+		inNLB          manifest.NetworkLoadBalancerConfiguration
+		inSidecars     map[string]*manifest.SidecarConfig
+		inHTTPSEnabled bool
+		inCIDRBlocks   []string
+
+		wanted    *template.NetworkLoadBalancer
+		wantedErr error
+	}{
+		"no network load balancer": {
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+		},
+		"error if tls without a domain": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443/tls"),
+			},
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+			wantedErr:    errors.New("TLS listener of the network load balancer requires an application with a domain name"),
+		},
+		"error if public subnet CIDR blocks are missing": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port: aws.String("443"),
+			},
+			wantedErr: errors.New("CIDR blocks of the public subnets are required to allow the network load balancer to reach service frontend"),
+		},
+		"error if target container does not exist": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.String("443"),
+				TargetContainer: aws.String("envoy"),
+			},
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+			wantedErr:    errors.New("target container envoy of the network load balancer doesn't exist"),
+		},
+		"error if target container doesn't expose any port": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.String("443"),
+				TargetContainer: aws.String("envoy"),
+				TargetPort:      aws.Int(9090),
+			},
+			inSidecars: map[string]*manifest.SidecarConfig{
+				"envoy": {},
+			},
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+			wantedErr:    errors.New("target container envoy of the network load balancer doesn't expose any port"),
+		},
+		"error if the target port is not the port exposed by the target sidecar": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.String("443"),
+				TargetContainer: aws.String("envoy"),
+				TargetPort:      aws.Int(9091),
+			},
+			inSidecars: map[string]*manifest.SidecarConfig{
+				"envoy": {
+					Port: aws.String("9090"),
+				},
+			},
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+			wantedErr:    errors.New(`"nlb.target_port" 9091 must be the port 9090 exposed by target container envoy`),
+		},
+		"error if the target sidecar doesn't expose its port over udp": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.String("53/udp"),
+				TargetContainer: aws.String("dns"),
+			},
+			inSidecars: map[string]*manifest.SidecarConfig{
+				"dns": {
+					Port: aws.String("5353/tcp"),
+				},
+			},
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+			wantedErr:    errors.New("target container dns of the network load balancer must expose port 5353/udp"),
+		},
+		"targets the main container by default": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:       aws.String("443/tls"),
+				Stickiness: aws.Bool(true),
+				HealthCheck: manifest.NLBHealthCheckArgs{
+					Port:     aws.Int(8081),
+					Interval: &healthCheckInterval,
+				},
+			},
+			inHTTPSEnabled: true,
+			inCIDRBlocks:   []string{"10.0.0.0/24", "10.0.1.0/24"},
+			wanted: &template.NetworkLoadBalancer{
+				PublicSubnetCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+				Listener: template.NetworkLoadBalancerListener{
+					Port:            "443",
+					Protocol:        "TLS",
+					TargetContainer: "frontend",
+					TargetPort:      "80",
+					Stickiness:      aws.Bool(true),
+					HealthCheck: template.NLBHealthCheck{
+						Port:     "8081",
+						Interval: aws.Int64(10),
+					},
+				},
+			},
+		},
+		"adds a port mapping to the main container for a different target port": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:       aws.String("53/udp"),
+				TargetPort: aws.Int(5353),
+			},
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+			wanted: &template.NetworkLoadBalancer{
+				PublicSubnetCIDRs: []string{"10.0.0.0/24"},
+				Listener: template.NetworkLoadBalancerListener{
+					Port:            "53",
+					Protocol:        "UDP",
+					TargetContainer: "frontend",
+					TargetPort:      "5353",
+				},
+				MainContainerPortMapping: &template.PortMapping{
+					Port:     "5353",
+					Protocol: "udp",
+				},
+			},
+		},
+		"targets a sidecar": {
+			inNLB: manifest.NetworkLoadBalancerConfiguration{
+				Port:            aws.String("8080"),
+				TargetContainer: aws.String("envoy"),
+				TargetPort:      aws.Int(9090),
+			},
+			inSidecars: map[string]*manifest.SidecarConfig{
+				"envoy": {
+					Port: aws.String("9090/tcp"),
+				},
+			},
+			inCIDRBlocks: []string{"10.0.0.0/24"},
+			wanted: &template.NetworkLoadBalancer{
+				PublicSubnetCIDRs: []string{"10.0.0.0/24"},
+				Listener: template.NetworkLoadBalancerListener{
+					Port:            "8080",
+					Protocol:        "TCP",
+					TargetContainer: "envoy",
+					TargetPort:      "9090",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+				WorkloadProps: &manifest.WorkloadProps{
+					Name: "frontend",
+				},
+				Path: "frontend",
+				Port: 80,
+			})
+			mft.NLBConfig = tc.inNLB
+			mft.Sidecars = tc.inSidecars
+			conf, err := NewLoadBalancedWebService(mft, testEnvName, testAppName, RuntimeConfig{}, WithNLB(tc.inCIDRBlocks))
+			require.NoError(t, err)
+			conf.httpsEnabled = tc.inHTTPSEnabled
+
+			// WHEN
+			got, err := conf.convertNetworkLoadBalancer()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func TestLoadBalancedWebService_convertAdditionalHTTPRules(t *testing.T) {
+	testCases := map[string]struct {
+		inRules        []manifest.RoutingRule
+		inSidecars     map[string]*manifest.SidecarConfig
+		inHTTPSEnabled bool
+		inMainAliases  []string
+
+		wanted    []template.HTTPRuleOpts
+		wantedErr error
+	}{
+		"no additional rules": {},
+		"error if target container does not exist": {
+			inRules: []manifest.RoutingRule{
+				{
+					Path:            aws.String("v2"),
+					TargetContainer: aws.String("envoy"),
+				},
+			},
+			wantedErr: errors.New(`convert "http[1]": target container envoy doesn't exist`),
+		},
+		"inherits the aliases of the first rule and targets the main container by default": {
+			inRules: []manifest.RoutingRule{
+				{
+					Path: aws.String("v2"),
+					QueryStrings: map[string]string{
+						"version": "2",
+					},
+				},
+			},
+			inHTTPSEnabled: true,
+			inMainAliases:  []string{"example.com"},
+			wanted: []template.HTTPRuleOpts{
+				{
+					Index:           1,
+					Path:            "v2",
+					Aliases:         []string{"example.com"},
+					TargetContainer: "frontend",
+					TargetPort:      "80",
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+						GracePeriod:     aws.Int64(60),
+					},
+					DeregistrationDelay: aws.Int64(60),
+					Conditions: &template.HTTPConditionOpts{
+						QueryStrings: []template.QueryStringOpts{
+							{Key: "version", Value: "2"},
+						},
+					},
+				},
+			},
+		},
+		"routes to a sidecar on its own aliases": {
+			inRules: []manifest.RoutingRule{
+				{
+					Path:             aws.String("admin"),
+					Alias:            manifest.Alias{String: aws.String("admin.example.com")},
+					TargetContainer:  aws.String("envoy"),
+					Stickiness:       aws.Bool(true),
+					AllowedSourceIps: []manifest.IPNet{"10.1.0.0/24"},
+				},
+			},
+			inSidecars: map[string]*manifest.SidecarConfig{
+				"envoy": {
+					Port: aws.String("9090"),
+				},
+			},
+			inHTTPSEnabled: true,
+			inMainAliases:  []string{"example.com"},
+			wanted: []template.HTTPRuleOpts{
+				{
+					Index:           1,
+					Path:            "admin",
+					Aliases:         []string{"admin.example.com"},
+					TargetContainer: "envoy",
+					TargetPort:      "9090",
+					HTTPHealthCheck: template.HTTPHealthCheckOpts{
+						HealthCheckPath: "/",
+						GracePeriod:     aws.Int64(60),
+					},
+					DeregistrationDelay: aws.Int64(60),
+					Stickiness:          true,
+					AllowedSourceIps:    []string{"10.1.0.0/24"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			mft := manifest.NewLoadBalancedWebService(&manifest.LoadBalancedWebServiceProps{
+				WorkloadProps: &manifest.WorkloadProps{
+					Name: "frontend",
+				},
+				Path: "frontend",
+				Port: 80,
+			})
+			mft.RoutingRule.AdditionalRules = tc.inRules
+			mft.Sidecars = tc.inSidecars
+			conf, err := NewLoadBalancedWebService(mft, testEnvName, testAppName, RuntimeConfig{})
+			require.NoError(t, err)
+			conf.httpsEnabled = tc.inHTTPSEnabled
+
+			// WHEN
+			got, err := conf.convertAdditionalHTTPRules(tc.inMainAliases)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func TestLoadBalancedWebService_Parameters(t *testing.T) {
 	baseProps := &manifest.LoadBalancedWebServiceProps{
 		WorkloadProps: &manifest.WorkloadProps{
@@ -355,14 +659,14 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		},
 	}
 	testLBWebServiceManifestWithSidecar := manifest.NewLoadBalancedWebService(baseProps)
-	testLBWebServiceManifestWithSidecar.TargetContainer = aws.String("xray")
+	testLBWebServiceManifestWithSidecar.RoutingRule.TargetContainer = aws.String("xray")
 	testLBWebServiceManifestWithSidecar.Sidecars = map[string]*manifest.SidecarConfig{
 		"xray": {
 			Port: aws.String("5000"),
 		},
 	}
 	testLBWebServiceManifestWithStickiness := manifest.NewLoadBalancedWebService(baseProps)
-	testLBWebServiceManifestWithStickiness.Stickiness = aws.Bool(true)
+	testLBWebServiceManifestWithStickiness.RoutingRule.Stickiness = aws.Bool(true)
 	testLBWebServiceManifestWithExecEnabled := manifest.NewLoadBalancedWebService(baseProps)
 	testLBWebServiceManifestWithExecEnabled.ExecuteCommand = manifest.ExecuteCommand{
 		Enable: aws.Bool(false),
@@ -371,10 +675,10 @@ func TestLoadBalancedWebService_Parameters(t *testing.T) {
 		},
 	}
 	testLBWebServiceManifestWithBadSidecarName := manifest.NewLoadBalancedWebService(baseProps)
-	testLBWebServiceManifestWithBadSidecarName.TargetContainer = aws.String("xray")
+	testLBWebServiceManifestWithBadSidecarName.RoutingRule.TargetContainer = aws.String("xray")
 
 	testLBWebServiceManifestWithBadSidecarPort := manifest.NewLoadBalancedWebService(baseProps)
-	testLBWebServiceManifestWithBadSidecarPort.TargetContainer = aws.String("xray")
+	testLBWebServiceManifestWithBadSidecarPort.RoutingRule.TargetContainer = aws.String("xray")
 	testLBWebServiceManifestWithBadSidecarPort.Sidecars = map[string]*manifest.SidecarConfig{
 		"xray": {},
 	}
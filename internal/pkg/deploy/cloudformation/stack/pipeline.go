@@ -27,7 +27,10 @@ func NewPipelineStackConfig(in *deploy.CreatePipelineInput) *pipelineStackConfig
 }
 
 func (p *pipelineStackConfig) StackName() string {
-	return p.Name
+	if p.IsLegacy {
+		return p.Name
+	}
+	return deploy.PipelineStackName(p.AppName, p.Name)
 }
 
 func (p *pipelineStackConfig) Template() (string, error) {
@@ -39,6 +42,7 @@ func (p *pipelineStackConfig) Template() (string, error) {
 			_, ok := source.(connectionName)
 			return ok
 		},
+		"alphanumeric": template.StripNonAlphaNumFunc,
 	}))
 	if err != nil {
 		return "", err
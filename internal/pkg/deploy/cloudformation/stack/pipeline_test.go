@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -56,11 +57,39 @@ func TestPipelineTags(t *testing.T) {
 }
 
 func TestPipelineStackName(t *testing.T) {
-	pipeline := NewPipelineStackConfig(
-		mockCreatePipelineInput(),
-	)
+	testCases := map[string]struct {
+		inName     string
+		inIsLegacy bool
 
-	require.Equal(t, pipelineName, pipeline.StackName(), "unexpected StackName")
+		wanted string
+	}{
+		"namespaces the pipeline name with the application": {
+			inName: pipelineName,
+			wanted: "pipeline-chickenProject-wingspipeline",
+		},
+		"keeps a name that is already namespaced": {
+			inName: "pipeline-chickenProject-wings-repo",
+			wanted: "pipeline-chickenProject-wings-repo",
+		},
+		"keeps the name of a legacy pipeline": {
+			inName:     pipelineName,
+			inIsLegacy: true,
+			wanted:     pipelineName,
+		},
+		"truncates the name to 100 characters": {
+			inName: strings.Repeat("a", 100),
+			wanted: "pipeline-chickenProject-" + strings.Repeat("a", 76),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			in := mockCreatePipelineInput()
+			in.Name = tc.inName
+			in.IsLegacy = tc.inIsLegacy
+
+			require.Equal(t, tc.wanted, NewPipelineStackConfig(in).StackName())
+		})
+	}
 }
 
 func TestPipelineStackConfig_Template(t *testing.T) {
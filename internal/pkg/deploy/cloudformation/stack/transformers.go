@@ -6,10 +6,14 @@ package stack
 import (
 	"fmt"
 	"hash/crc32"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/template/override"
 
 	"github.com/aws/copilot-cli/internal/pkg/aws/s3"
@@ -33,6 +37,11 @@ const (
 	defaultWritePermission = false
 )
 
+// Default values for the blue/green deployment configuration of an ECS service.
+const (
+	defaultBakeTimeMins = 5
+)
+
 // Supported capacityproviders for Fargate services
 const (
 	capacityProviderFargateSpot = "FARGATE_SPOT"
@@ -41,10 +50,33 @@ const (
 
 var (
 	taskDefOverrideRulePrefixes = []string{"Resources", "TaskDefinition", "Properties"}
+
+	// CPU architectures of a task definition keyed by docker architecture.
+	taskDefArch = map[string]string{
+		dockerengine.Amd64Arch: "X86_64",
+		dockerengine.Arm64Arch: "ARM64",
+	}
 )
 
+// convertSecrets converts the secrets of a manifest to the secrets of a container definition.
+func convertSecrets(secrets map[string]manifest.Secret) map[string]template.Secret {
+	if len(secrets) == 0 {
+		return nil
+	}
+	converted := make(map[string]template.Secret, len(secrets))
+	for name, secret := range secrets {
+		if secret.IsSecretsManagerName() {
+			converted[name] = template.SecretFromSecretsManager(secret.Value())
+			continue
+		}
+		converted[name] = template.SecretFromPlainSSMOrARN(secret.Value())
+	}
+	return converted
+}
+
 // convertSidecar converts the manifest sidecar configuration into a format parsable by the templates pkg.
-func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOpts, error) {
+// Sidecars built from a Dockerfile refer to their pushed image in builtImages.
+func convertSidecar(s map[string]*manifest.SidecarConfig, builtImages map[string]ECRImage) ([]*template.SidecarOpts, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -62,18 +94,22 @@ func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOp
 		if err != nil {
 			return nil, err
 		}
+		image, err := convertSidecarImage(name, config.Image, builtImages)
+		if err != nil {
+			return nil, err
+		}
 		mp := convertSidecarMountPoints(config.MountPoints)
 		sidecars = append(sidecars, &template.SidecarOpts{
 			Name:         aws.String(name),
-			Image:        config.Image,
+			Image:        image,
 			Essential:    config.Essential,
 			Port:         port,
 			Protocol:     protocol,
 			CredsParam:   config.CredsParam,
-			Secrets:      config.Secrets,
+			Secrets:      convertSecrets(config.Secrets),
 			Variables:    config.Variables,
 			MountPoints:  mp,
-			DockerLabels: config.DockerLabels,
+			DockerLabels: sidecarDockerLabels(name, config.DockerLabels, builtImages),
 			DependsOn:    convertDependsOn(config.DependsOn),
 			EntryPoint:   entrypoint,
 			HealthCheck:  convertContainerHealthCheck(config.HealthCheck),
@@ -83,6 +119,26 @@ func convertSidecar(s map[string]*manifest.SidecarConfig) ([]*template.SidecarOp
 	return sidecars, nil
 }
 
+// sidecarDockerLabels returns the docker labels of a sidecar, recording the build inputs of its image if it's built from a Dockerfile.
+func sidecarDockerLabels(name string, labels map[string]string, builtImages map[string]ECRImage) map[string]string {
+	built, ok := builtImages[name]
+	if !ok {
+		return labels
+	}
+	return dockerLabels(labels, &built)
+}
+
+func convertSidecarImage(name string, image manifest.SidecarImage, builtImages map[string]ECRImage) (*string, error) {
+	if !image.BuildRequired() {
+		return image.Location, nil
+	}
+	built, ok := builtImages[name]
+	if !ok {
+		return nil, fmt.Errorf("sidecar %s is built from a Dockerfile but its image was not pushed to ECR", name)
+	}
+	return aws.String(built.GetLocation()), nil
+}
+
 func convertContainerHealthCheck(hc manifest.ContainerHealthCheck) *template.ContainerHealthCheck {
 	if hc.IsEmpty() {
 		return nil
@@ -253,6 +309,23 @@ func convertHTTPHealthCheck(hc *manifest.HealthCheckArgsOrString) template.HTTPH
 	return opts
 }
 
+func convertNLBHealthCheck(hc *manifest.NLBHealthCheckArgs) template.NLBHealthCheck {
+	opts := template.NLBHealthCheck{
+		HealthyThreshold:   hc.HealthyThreshold,
+		UnhealthyThreshold: hc.UnhealthyThreshold,
+	}
+	if hc.Port != nil {
+		opts.Port = strconv.Itoa(aws.IntValue(hc.Port))
+	}
+	if hc.Interval != nil {
+		opts.Interval = aws.Int64(int64(hc.Interval.Seconds()))
+	}
+	if hc.Timeout != nil {
+		opts.Timeout = aws.Int64(int64(hc.Timeout.Seconds()))
+	}
+	return opts
+}
+
 func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandOpts {
 	if e.Config.IsEmpty() && !aws.BoolValue(e.Enable) {
 		return nil
@@ -260,6 +333,22 @@ func convertExecuteCommand(e *manifest.ExecuteCommand) *template.ExecuteCommandO
 	return &template.ExecuteCommandOpts{}
 }
 
+// convertPlatform returns the runtime platform of the tasks, or nil if the manifest doesn't specify a platform.
+func convertPlatform(t *manifest.TaskConfig) *template.RuntimePlatformOpts {
+	platform, err := t.TaskPlatform()
+	if err != nil || platform == nil {
+		return nil
+	}
+	parts := strings.SplitN(aws.StringValue(platform), "/", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+	return &template.RuntimePlatformOpts{
+		OS:   strings.ToUpper(parts[0]),
+		Arch: taskDefArch[parts[1]],
+	}
+}
+
 func convertLogging(lc manifest.Logging) *template.LogConfigOpts {
 	if lc.IsEmpty() {
 		return nil
@@ -492,6 +581,54 @@ func convertNetworkConfig(network manifest.NetworkConfig) *template.NetworkOpts
 	return opts
 }
 
+// convertDeploymentConfig converts the manifest deployment configuration into a format parsable by the templates pkg.
+func convertDeploymentConfig(in manifest.DeploymentConfiguration) *template.DeploymentConfigurationOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	opts := template.DefaultDeploymentConfigurationOpts()
+	if in.MinHealthyPercent != nil {
+		opts.MinHealthyPercent = aws.IntValue(in.MinHealthyPercent)
+	}
+	if in.MaxPercent != nil {
+		opts.MaxPercent = aws.IntValue(in.MaxPercent)
+	}
+	if in.Rollback != nil {
+		opts.Rollback = aws.BoolValue(in.Rollback)
+	}
+	return opts
+}
+
+// convertBlueGreen converts the manifest blue/green deployment configuration into a format parsable by the templates pkg.
+// deployedTaskDef is the ARN of the task definition currently serving traffic, empty if the service isn't deployed yet.
+func convertBlueGreen(in manifest.BlueGreenDeployment, deployedTaskDef, trafficTargetGroup string) *template.BlueGreenOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	opts := &template.BlueGreenOpts{
+		TrafficRouting:         template.BlueGreenTrafficAllAtOnce,
+		TestListenerPort:       aws.IntValue(in.TestListenerPort),
+		TerminationWaitMins:    defaultBakeTimeMins,
+		Alarms:                 in.Alarms,
+		DeployedTaskDefinition: deployedTaskDef,
+		TrafficTargetGroup:     trafficTargetGroup,
+	}
+	switch aws.StringValue(in.TrafficShifting) {
+	case manifest.TrafficShiftingLinear:
+		opts.TrafficRouting = template.BlueGreenTrafficLinear
+	case manifest.TrafficShiftingCanary:
+		opts.TrafficRouting = template.BlueGreenTrafficCanary
+	}
+	if !opts.IsAllAtOnce() {
+		opts.StepPercentage = aws.IntValue(in.Percentage)
+		opts.StepIntervalMins = int(in.Interval.Minutes())
+	}
+	if in.BakeTime != nil {
+		opts.TerminationWaitMins = int(in.BakeTime.Minutes())
+	}
+	return opts
+}
+
 func convertAlias(alias manifest.Alias) ([]string, error) {
 	out, err := alias.ToStringSlice()
 	if err != nil {
@@ -500,6 +637,34 @@ func convertAlias(alias manifest.Alias) ([]string, error) {
 	return out, nil
 }
 
+// convertHTTPConditions converts the header and query string conditions of a routing rule into a format parsable by the templates pkg.
+// The conditions are sorted by name so that the generated template is deterministic.
+func convertHTTPConditions(rule manifest.RoutingRule) *template.HTTPConditionOpts {
+	if len(rule.Headers) == 0 && len(rule.QueryStrings) == 0 {
+		return nil
+	}
+	conditions := &template.HTTPConditionOpts{}
+	for name, values := range rule.Headers {
+		conditions.Headers = append(conditions.Headers, template.HTTPHeaderOpts{
+			Name:   name,
+			Values: values,
+		})
+	}
+	sort.Slice(conditions.Headers, func(i, j int) bool {
+		return conditions.Headers[i].Name < conditions.Headers[j].Name
+	})
+	for key, value := range rule.QueryStrings {
+		conditions.QueryStrings = append(conditions.QueryStrings, template.QueryStringOpts{
+			Key:   key,
+			Value: value,
+		})
+	}
+	sort.Slice(conditions.QueryStrings, func(i, j int) bool {
+		return conditions.QueryStrings[i].Key < conditions.QueryStrings[j].Key
+	})
+	return conditions
+}
+
 func convertEntryPoint(entrypoint manifest.EntryPointOverride) ([]string, error) {
 	out, err := entrypoint.ToStringSlice()
 	if err != nil {
@@ -640,3 +805,73 @@ func convertAppInformation(app deploy.AppInformation) (delegationRole *string, d
 	}
 	return
 }
+
+func convertPublicHTTPConfig(in manifest.PublicHTTPConfig) template.HTTPConfig {
+	var cidrs []string
+	for _, cidr := range in.AllowedSourceIPs {
+		cidrs = append(cidrs, string(cidr))
+	}
+	return template.HTTPConfig{
+		AllowedSourceIPs: cidrs,
+		SSLPolicy:        in.SSLPolicy,
+		ImportedCertARNs: in.Certificates,
+		ELBAccessLogs:    convertELBAccessLogsConfig(in.AccessLogs),
+		WebACLArn:        aws.StringValue(in.WebACL),
+	}
+}
+
+func convertELBAccessLogsConfig(in manifest.ELBAccessLogsArgsOrBool) *template.ELBAccessLogsOpts {
+	if !in.IsEnabled() {
+		return nil
+	}
+	return &template.ELBAccessLogsOpts{
+		BucketName: aws.StringValue(in.Advanced.BucketName),
+		Prefix:     aws.StringValue(in.Advanced.Prefix),
+	}
+}
+
+func convertFlowLogsConfig(in manifest.FlowLogsArgsOrBool) *template.FlowLogsOpts {
+	if !in.IsEnabled() {
+		return nil
+	}
+	opts := &template.FlowLogsOpts{
+		Destination: manifest.FlowLogsDestinationCloudWatch,
+		Retention:   flowLogsRetentionDefault,
+	}
+	if in.Advanced.Destination != nil {
+		opts.Destination = aws.StringValue(in.Advanced.Destination)
+	}
+	if in.Advanced.Retention != nil {
+		opts.Retention = aws.IntValue(in.Advanced.Retention)
+	}
+	return opts
+}
+
+func convertInternalHTTPConfig(in manifest.InternalHTTPConfig) template.HTTPConfig {
+	var cidrs []string
+	for _, cidr := range in.AllowedSourceIPs {
+		cidrs = append(cidrs, string(cidr))
+	}
+	return template.HTTPConfig{
+		AllowedSourceIPs: cidrs,
+	}
+}
+
+func convertGitHubActionsConfig(in manifest.GitHubActionsConfig) *template.GitHubActionsOpts {
+	if in.IsEmpty() {
+		return nil
+	}
+	return &template.GitHubActionsOpts{
+		Repository:         aws.StringValue(in.Repository),
+		CreateOIDCProvider: aws.BoolValue(in.CreateOIDCProvider),
+	}
+}
+
+func convertTelemetry(in *config.Telemetry) *template.Telemetry {
+	if in == nil {
+		return nil
+	}
+	return &template.Telemetry{
+		EnableContainerInsights: in.EnableContainerInsights,
+	}
+}
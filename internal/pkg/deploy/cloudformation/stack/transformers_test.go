@@ -19,6 +19,8 @@ import (
 func Test_convertSidecar(t *testing.T) {
 	mockImage := aws.String("mockImage")
 	mockMap := map[string]string{"foo": "bar"}
+	mockSecrets := map[string]manifest.Secret{"foo": manifest.SecretFromString("bar")}
+	mockTemplateSecrets := map[string]template.Secret{"foo": template.SecretFromPlainSSMOrARN("bar")}
 	mockCredsParam := aws.String("mockCredsParam")
 	testCases := map[string]struct {
 		inPort            *string
@@ -27,6 +29,8 @@ func Test_convertSidecar(t *testing.T) {
 		inDependsOn       map[string]string
 		inImageOverride   manifest.ImageOverride
 		inHealthCheck     manifest.ContainerHealthCheck
+		inImage           *manifest.SidecarImage
+		inBuiltImages     map[string]ECRImage
 		circDepContainers []string
 
 		wanted    *template.SidecarOpts
@@ -46,7 +50,7 @@ func Test_convertSidecar(t *testing.T) {
 				Port:       aws.String("2000"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(true),
 			},
@@ -61,7 +65,7 @@ func Test_convertSidecar(t *testing.T) {
 				Protocol:   aws.String("udp"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(true),
 			},
@@ -78,7 +82,7 @@ func Test_convertSidecar(t *testing.T) {
 				Port:       aws.String("2000"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(true),
 				DependsOn: map[string]string{
@@ -98,7 +102,7 @@ func Test_convertSidecar(t *testing.T) {
 				Port:       aws.String("2000"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				DockerLabels: map[string]string{
@@ -111,7 +115,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: nil,
@@ -127,7 +131,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: []string{"bin"},
@@ -143,7 +147,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: []string{"bin", "arg"},
@@ -159,7 +163,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: nil,
@@ -175,13 +179,72 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				EntryPoint: nil,
 				Command:    []string{"arg1", "arg2"},
 			},
 		},
+		"image built from a Dockerfile": {
+			inImage: &manifest.SidecarImage{
+				Build: manifest.BuildArgsOrString{
+					BuildString: aws.String("proxy/Dockerfile"),
+				},
+			},
+			inBuiltImages: map[string]ECRImage{
+				"foo": {
+					RepoURL: "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc/foo",
+					Digest:  "sha256:1234",
+				},
+			},
+
+			wanted: &template.SidecarOpts{
+				Name:       aws.String("foo"),
+				CredsParam: mockCredsParam,
+				Image:      aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc/foo@sha256:1234"),
+				Secrets:    mockTemplateSecrets,
+				Variables:  mockMap,
+				Essential:  aws.Bool(false),
+			},
+		},
+		"records the build inputs of an image built from a Dockerfile": {
+			inLabels: map[string]string{"team": "payments"},
+			inImage: &manifest.SidecarImage{
+				Build: manifest.BuildArgsOrString{
+					BuildString: aws.String("proxy/Dockerfile"),
+				},
+			},
+			inBuiltImages: map[string]ECRImage{
+				"foo": {
+					RepoURL:     "123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc/foo",
+					Digest:      "sha256:1234",
+					BuildInputs: "abcd",
+				},
+			},
+
+			wanted: &template.SidecarOpts{
+				Name:       aws.String("foo"),
+				CredsParam: mockCredsParam,
+				Image:      aws.String("123456789012.dkr.ecr.us-west-2.amazonaws.com/app/svc/foo@sha256:1234"),
+				Secrets:    mockTemplateSecrets,
+				Variables:  mockMap,
+				Essential:  aws.Bool(false),
+				DockerLabels: map[string]string{
+					"team":                               "payments",
+					"com.amazonaws.copilot.build-inputs": "abcd",
+				},
+			},
+		},
+		"image built from a Dockerfile but not pushed": {
+			inImage: &manifest.SidecarImage{
+				Build: manifest.BuildArgsOrString{
+					BuildString: aws.String("proxy/Dockerfile"),
+				},
+			},
+
+			wantedErr: fmt.Errorf("sidecar foo is built from a Dockerfile but its image was not pushed to ECR"),
+		},
 		"with health check": {
 			inHealthCheck: manifest.ContainerHealthCheck{
 				Command: []string{"foo", "bar"},
@@ -191,7 +254,7 @@ func Test_convertSidecar(t *testing.T) {
 				Name:       aws.String("foo"),
 				CredsParam: mockCredsParam,
 				Image:      mockImage,
-				Secrets:    mockMap,
+				Secrets:    mockTemplateSecrets,
 				Variables:  mockMap,
 				Essential:  aws.Bool(false),
 				HealthCheck: &template.ContainerHealthCheck{
@@ -206,11 +269,17 @@ func Test_convertSidecar(t *testing.T) {
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
+			image := manifest.SidecarImage{
+				Location: mockImage,
+			}
+			if tc.inImage != nil {
+				image = *tc.inImage
+			}
 			sidecar := map[string]*manifest.SidecarConfig{
 				"foo": {
 					CredsParam:    mockCredsParam,
-					Image:         mockImage,
-					Secrets:       mockMap,
+					Image:         image,
+					Secrets:       mockSecrets,
 					Variables:     mockMap,
 					Essential:     aws.Bool(tc.inEssential),
 					Port:          tc.inPort,
@@ -220,7 +289,7 @@ func Test_convertSidecar(t *testing.T) {
 					HealthCheck:   tc.inHealthCheck,
 				},
 			}
-			got, err := convertSidecar(sidecar)
+			got, err := convertSidecar(sidecar, tc.inBuiltImages)
 
 			if tc.wantedErr != nil {
 				require.EqualError(t, err, tc.wantedErr.Error())
@@ -685,6 +754,47 @@ func Test_convertHTTPHealthCheck(t *testing.T) {
 	}
 }
 
+func Test_convertHTTPConditions(t *testing.T) {
+	testCases := map[string]struct {
+		in     manifest.RoutingRule
+		wanted *template.HTTPConditionOpts
+	}{
+		"no conditions": {
+			in: manifest.RoutingRule{
+				Path: aws.String("api"),
+			},
+		},
+		"sorts headers and query strings by name": {
+			in: manifest.RoutingRule{
+				Headers: map[string][]string{
+					"X-Version": {"2"},
+					"X-Canary":  {"true", "yes"},
+				},
+				QueryStrings: map[string]string{
+					"version": "2",
+					"beta":    "on",
+				},
+			},
+			wanted: &template.HTTPConditionOpts{
+				Headers: []template.HTTPHeaderOpts{
+					{Name: "X-Canary", Values: []string{"true", "yes"}},
+					{Name: "X-Version", Values: []string{"2"}},
+				},
+				QueryStrings: []template.QueryStringOpts{
+					{Key: "beta", Value: "on"},
+					{Key: "version", Value: "2"},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, convertHTTPConditions(tc.in))
+		})
+	}
+}
+
 func Test_convertManagedFSInfo(t *testing.T) {
 	testCases := map[string]struct {
 		inVolumes         map[string]*manifest.Volume
@@ -1162,6 +1272,150 @@ func Test_convertExecuteCommand(t *testing.T) {
 	}
 }
 
+func Test_convertPlatform(t *testing.T) {
+	arm := manifest.PlatformString("linux/arm64")
+	testCases := map[string]struct {
+		in manifest.PlatformArgsOrString
+
+		wanted *template.RuntimePlatformOpts
+	}{
+		"without platform": {
+			wanted: nil,
+		},
+		"platform string": {
+			in: manifest.PlatformArgsOrString{PlatformString: &arm},
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "LINUX",
+				Arch: "ARM64",
+			},
+		},
+		"tasks run on the first platform of the list": {
+			in: manifest.PlatformArgsOrString{PlatformList: []manifest.PlatformString{"linux/amd64", "linux/arm64"}},
+			wanted: &template.RuntimePlatformOpts{
+				OS:   "LINUX",
+				Arch: "X86_64",
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertPlatform(&manifest.TaskConfig{Platform: tc.in})
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertDeploymentConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inConfig manifest.DeploymentConfiguration
+
+		wanted *template.DeploymentConfigurationOpts
+	}{
+		"empty configuration": {
+			inConfig: manifest.DeploymentConfiguration{},
+			wanted:   nil,
+		},
+		"fills in defaults for unset fields": {
+			inConfig: manifest.DeploymentConfiguration{
+				MinHealthyPercent: aws.Int(50),
+			},
+			wanted: &template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 50,
+				MaxPercent:        200,
+				Rollback:          true,
+			},
+		},
+		"rollback disabled": {
+			inConfig: manifest.DeploymentConfiguration{
+				MinHealthyPercent: aws.Int(0),
+				MaxPercent:        aws.Int(100),
+				Rollback:          aws.Bool(false),
+			},
+			wanted: &template.DeploymentConfigurationOpts{
+				MinHealthyPercent: 0,
+				MaxPercent:        100,
+				Rollback:          false,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertDeploymentConfig(tc.inConfig)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
+func Test_convertBlueGreen(t *testing.T) {
+	interval := 2 * time.Minute
+	bakeTime := 30 * time.Minute
+	testCases := map[string]struct {
+		inConfig             manifest.BlueGreenDeployment
+		inDeployedTaskDef    string
+		inTrafficTargetGroup string
+
+		wanted *template.BlueGreenOpts
+	}{
+		"empty configuration": {
+			inConfig: manifest.BlueGreenDeployment{},
+			wanted:   nil,
+		},
+		"fills in defaults for all at once": {
+			inConfig: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("all_at_once"),
+			},
+			wanted: &template.BlueGreenOpts{
+				TrafficRouting:      template.BlueGreenTrafficAllAtOnce,
+				TerminationWaitMins: 5,
+			},
+		},
+		"linear with a test listener and alarms on a deployed service": {
+			inConfig: manifest.BlueGreenDeployment{
+				TrafficShifting:  aws.String("linear"),
+				Percentage:       aws.Int(20),
+				Interval:         &interval,
+				TestListenerPort: aws.Int(8080),
+				BakeTime:         &bakeTime,
+				Alarms:           []string{"HighLatency"},
+			},
+			inDeployedTaskDef:    "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:3",
+			inTrafficTargetGroup: "GreenTargetGroup",
+			wanted: &template.BlueGreenOpts{
+				TrafficRouting:         template.BlueGreenTrafficLinear,
+				StepPercentage:         20,
+				StepIntervalMins:       2,
+				TestListenerPort:       8080,
+				TerminationWaitMins:    30,
+				Alarms:                 []string{"HighLatency"},
+				DeployedTaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:3",
+				TrafficTargetGroup:     "GreenTargetGroup",
+			},
+		},
+		"canary": {
+			inConfig: manifest.BlueGreenDeployment{
+				TrafficShifting: aws.String("canary"),
+				Percentage:      aws.Int(10),
+				Interval:        &interval,
+			},
+			wanted: &template.BlueGreenOpts{
+				TrafficRouting:      template.BlueGreenTrafficCanary,
+				StepPercentage:      10,
+				StepIntervalMins:    2,
+				TerminationWaitMins: 5,
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := convertBlueGreen(tc.inConfig, tc.inDeployedTaskDef, tc.inTrafficTargetGroup)
+
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
+
 func Test_convertSidecarMountPoints(t *testing.T) {
 	testCases := map[string]struct {
 		inMountPoints  []manifest.SidecarMountPoint
@@ -58,23 +58,31 @@ const (
 	ecsWkldLogRetentionDefault = 30
 )
 
+// BuildInputsDockerLabel is the docker label of the containers whose image is built from a Dockerfile.
+// Its value is the hash of the build inputs, so that the image can be compared with the current manifest when it's promoted.
+const BuildInputsDockerLabel = "com.amazonaws.copilot.build-inputs"
+
 // RuntimeConfig represents configuration that's defined outside of the manifest file
 // that is needed to create a CloudFormation stack.
 type RuntimeConfig struct {
-	Image                    *ECRImage         // Optional. Image location in an ECR repository.
-	AddonsTemplateURL        string            // Optional. S3 object URL for the addons template.
-	AdditionalTags           map[string]string // AdditionalTags are labels applied to resources in the workload stack.
-	ServiceDiscoveryEndpoint string            // Endpoint for the service discovery namespace in the environment.
-	AccountID                string            // Account ID for constructing ARNs
-	Region                   string            // Region for constructing ARNs
+	Image                    *ECRImage           // Optional. Image location in an ECR repository.
+	AddonsTemplateURL        string              // Optional. S3 object URL for the addons template.
+	AdditionalTags           map[string]string   // AdditionalTags are labels applied to resources in the workload stack.
+	ServiceDiscoveryEndpoint string              // Endpoint for the service discovery namespace in the environment.
+	AccountID                string              // Account ID for constructing ARNs
+	Region                   string              // Region for constructing ARNs
+	DeployedTaskDefinition   string              // Optional. ARN of the task definition serving traffic for blue/green services.
+	TrafficTargetGroup       string              // Optional. Logical ID of the target group serving traffic for blue/green services.
+	SidecarImages            map[string]ECRImage // Optional. Image locations of the sidecars built from a Dockerfile, keyed by sidecar name.
 }
 
 // ECRImage represents configuration about the pushed ECR image that is needed to
 // create a CloudFormation stack.
 type ECRImage struct {
-	RepoURL  string // RepoURL is the ECR repository URL the container image should be pushed to.
-	ImageTag string // Tag is the container image's unique tag.
-	Digest   string // The image digest.
+	RepoURL     string // RepoURL is the ECR repository URL the container image should be pushed to.
+	ImageTag    string // Tag is the container image's unique tag.
+	Digest      string // The image digest.
+	BuildInputs string // Optional. Hash of the Dockerfile, context and arguments that the image is built with.
 }
 
 // GetLocation returns the ECR image URI.
@@ -91,10 +99,27 @@ func (i ECRImage) GetLocation() string {
 	return fmt.Sprintf("%s:%s", i.RepoURL, "latest")
 }
 
+// dockerLabels returns the docker labels of a container along with the label recording the build inputs of its image, if any.
+func dockerLabels(labels map[string]string, img *ECRImage) map[string]string {
+	if img == nil || img.BuildInputs == "" {
+		return labels
+	}
+	out := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		out[k] = v
+	}
+	out[BuildInputsDockerLabel] = img.BuildInputs
+	return out
+}
+
 type templater interface {
 	Template() (string, error)
 }
 
+type envAddonsOutputsReader interface {
+	Outputs(name string) ([]addon.Output, error)
+}
+
 type location interface {
 	GetLocation() string
 }
@@ -207,6 +232,27 @@ func (w *wkld) addonsOutputs() (*template.WorkloadNestedStackOpts, error) {
 	}, nil
 }
 
+// envAddonsOutputs returns the outputs of the environment addons listed under "storage.shared" in the manifest.
+func (w *ecsWkld) envAddonsOutputs() (*template.WorkloadEnvAddonsOpts, error) {
+	if len(w.tc.Storage.Shared) == 0 {
+		return nil, nil
+	}
+	var out []addon.Output
+	for _, name := range w.tc.Storage.Shared {
+		outputs, err := w.envAddons.Outputs(name)
+		if err != nil {
+			return nil, fmt.Errorf("get outputs of environment addon %s for %s: %w", name, w.name, err)
+		}
+		out = append(out, outputs...)
+	}
+	return &template.WorkloadEnvAddonsOpts{
+		VariableOutputs:      envVarOutputNames(out),
+		SecretOutputs:        secretOutputNames(out),
+		PolicyOutputs:        managedPolicyOutputNames(out),
+		SecurityGroupOutputs: securityGroupOutputNames(out),
+	}, nil
+}
+
 func securityGroupOutputNames(outputs []addon.Output) []string {
 	var securityGroups []string
 	for _, out := range outputs {
@@ -251,6 +297,7 @@ type ecsWkld struct {
 	*wkld
 	tc           manifest.TaskConfig
 	logRetention *int
+	envAddons    envAddonsOutputsReader
 
 	// Overriden in unit tests.
 	taskDefOverrideFunc func(overrideRules []override.Rule, origTemp []byte) ([]byte, error)
@@ -4,16 +4,53 @@
 package cloudformation
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
+	"github.com/aws/copilot-cli/internal/pkg/stream"
+	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
+	"golang.org/x/sync/errgroup"
 )
 
+// ecsCircuitBreakerReason is the substring of the status reason of an ECS service resource
+// whose update failed because the deployment circuit breaker was triggered.
+const ecsCircuitBreakerReason = "Circuit Breaker"
+
+// Outputs of a load balanced web service stack with blue/green deployments.
+const (
+	blueGreenTaskDefOutputKey         = "TaskDefinitionArn"
+	blueGreenTargetContainerOutputKey = "TargetContainer"
+	blueGreenTargetPortOutputKey      = "TargetPort"
+	blueGreenApplicationOutputKey     = "CodeDeployApplication"
+	blueGreenGroupOutputKey           = "CodeDeployDeploymentGroup"
+	blueGreenServiceOutputKey         = "ECSService"
+	blueGreenGreenTargetGroupKey      = "GreenTargetGroupArn"
+)
+
+// ecsTaskSetPrimary is the status of the task set of an ECS service that serves production traffic.
+const ecsTaskSetPrimary = "PRIMARY"
+
+// BlueGreenDeploymentInput holds the fields required to shift traffic to the latest task definition of a service stack.
+type BlueGreenDeploymentInput struct {
+	StackName              string
+	DeployedTaskDefinition string // ARN of the task definition serving traffic before the stack was deployed, empty for new services.
+	Force                  bool   // Start a deployment even if the task definition did not change.
+}
+
 // DeployService deploys a service stack and renders progress updates to out until the deployment is done.
 // If the service stack doesn't exist, then it creates the stack.
 // If the service stack already exists, it updates the stack.
+// If the ECS deployment circuit breaker rolled back the service, it returns an ErrECSDeploymentRolledBack.
 func (cf CloudFormation) DeployService(out progress.FileWriter, conf StackConfiguration, opts ...cloudformation.StackOption) error {
 	stack, err := toStack(conf)
 	if err != nil {
@@ -22,7 +59,100 @@ func (cf CloudFormation) DeployService(out progress.FileWriter, conf StackConfig
 	for _, opt := range opts {
 		opt(stack)
 	}
-	return cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
+	err = cf.renderStackChanges(cf.newRenderWorkloadInput(out, stack))
+	var errFailed *errStackFailed
+	if !errors.As(err, &errFailed) || errFailed.status != sdkcloudformation.StackStatusUpdateRollbackComplete {
+		return err
+	}
+	rollback, describeErr := cf.ecsDeploymentRollback(stack.Name)
+	if describeErr != nil {
+		return fmt.Errorf("%w: %v", err, describeErr)
+	}
+	if rollback != nil {
+		return rollback
+	}
+	return err
+}
+
+// ecsDeploymentRollback returns an ErrECSDeploymentRolledBack if the ECS service failed to update during the latest
+// stack operation because the deployment circuit breaker was triggered. Otherwise, returns nil.
+func (cf CloudFormation) ecsDeploymentRollback(stackName string) (*ErrECSDeploymentRolledBack, error) {
+	events, err := cf.cfnClient.Events(stackName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve events for stack %s: %w", stackName, err)
+	}
+	// Events are in chronological order, walk backwards until the start of the latest stack update.
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if aws.StringValue(event.LogicalResourceId) == stackName &&
+			aws.StringValue(event.ResourceStatus) == sdkcloudformation.ResourceStatusUpdateInProgress {
+			break
+		}
+		if aws.StringValue(event.ResourceType) != ecsServiceResourceType ||
+			aws.StringValue(event.ResourceStatus) != sdkcloudformation.ResourceStatusUpdateFailed {
+			continue
+		}
+		if !strings.Contains(aws.StringValue(event.ResourceStatusReason), ecsCircuitBreakerReason) {
+			continue
+		}
+		serviceARN := ecs.ServiceArn(aws.StringValue(event.PhysicalResourceId))
+		cluster, err := serviceARN.ClusterName()
+		if err != nil {
+			return nil, err
+		}
+		service, err := serviceARN.ServiceName()
+		if err != nil {
+			return nil, err
+		}
+		desc, err := cf.ecsClient.Service(cluster, service)
+		if err != nil {
+			return nil, fmt.Errorf("describe ECS service %s: %w", service, err)
+		}
+		for _, deployment := range desc.Deployments {
+			if aws.StringValue(deployment.Status) != ecsPrimaryDeploymentStatus {
+				continue
+			}
+			return &ErrECSDeploymentRolledBack{
+				StackName:      stackName,
+				TaskDefinition: parseTaskDefinitionFromARN(aws.StringValue(deployment.TaskDefinition)),
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateWorkloadChangeSet creates a change set for an existing workload stack without executing it.
+// It returns the ID of the change set along with its description.
+func (cf CloudFormation) CreateWorkloadChangeSet(conf StackConfiguration, opts ...cloudformation.StackOption) (string, *cloudformation.ChangeSetDescription, error) {
+	stack, err := toStack(conf)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, opt := range opts {
+		opt(stack)
+	}
+	changeSetID, err := cf.cfnClient.CreateChangeSet(stack)
+	if err != nil {
+		return "", nil, fmt.Errorf("create change set for stack %s: %w", stack.Name, err)
+	}
+	descr, err := cf.cfnClient.DescribeChangeSet(changeSetID, stack.Name)
+	if err != nil {
+		return "", nil, fmt.Errorf("describe change set %s for stack %s: %w", changeSetID, stack.Name, err)
+	}
+	return changeSetID, descr, nil
+}
+
+// DeleteWorkloadChangeSet deletes a change set of a workload stack that wasn't executed.
+func (cf CloudFormation) DeleteWorkloadChangeSet(changeSetID, stackName string) error {
+	return cf.cfnClient.DeleteChangeSet(changeSetID, stackName)
+}
+
+// parseTaskDefinitionFromARN returns the family and revision of a task definition given its ARN.
+// For example, given the input "arn:aws:ecs:us-west-2:1111:task-definition/webapp-test-frontend:3"
+// the output is "webapp-test-frontend:3".
+func parseTaskDefinitionFromARN(arn string) string {
+	parts := strings.Split(arn, "/")
+	return parts[len(parts)-1]
 }
 
 func (cf CloudFormation) handleStackError(stackName string, err error) error {
@@ -43,3 +173,102 @@ func (cf CloudFormation) handleStackError(stackName string, err error) error {
 func (cf CloudFormation) DeleteWorkload(in deploy.DeleteWorkloadInput) error {
 	return cf.cfnClient.DeleteAndWait(fmt.Sprintf("%s-%s-%s", in.AppName, in.EnvName, in.Name))
 }
+
+// DeployBlueGreen starts a CodeDeploy deployment that shifts traffic to the latest task definition of the service
+// stack, and renders the traffic shifting progress to out until the deployment is done.
+// If the task definition did not change since the last deployment, no deployment is started unless forced.
+// If the deployment fails or is stopped, it returns an ErrBlueGreenDeploymentFailed.
+func (cf CloudFormation) DeployBlueGreen(out progress.FileWriter, in BlueGreenDeploymentInput) error {
+	outputs, err := cf.cfnClient.Outputs(&cloudformation.Stack{Name: in.StackName})
+	if err != nil {
+		return err
+	}
+	taskDef := outputs[blueGreenTaskDefOutputKey]
+	if taskDef == "" {
+		return fmt.Errorf("stack %s does not have a %s output", in.StackName, blueGreenTaskDefOutputKey)
+	}
+	if !in.Force && (in.DeployedTaskDefinition == "" || in.DeployedTaskDefinition == taskDef) {
+		// The service was just created with the latest task definition, or the task definition didn't change.
+		return nil
+	}
+	port, err := strconv.Atoi(outputs[blueGreenTargetPortOutputKey])
+	if err != nil {
+		return fmt.Errorf("parse %s output of stack %s: %w", blueGreenTargetPortOutputKey, in.StackName, err)
+	}
+	id, err := cf.cdClient.CreateECSDeployment(codedeploy.ECSDeploymentInput{
+		ApplicationName:     outputs[blueGreenApplicationOutputKey],
+		DeploymentGroupName: outputs[blueGreenGroupOutputKey],
+		TaskDefinitionARN:   taskDef,
+		ContainerName:       outputs[blueGreenTargetContainerOutputKey],
+		ContainerPort:       port,
+	})
+	if err != nil {
+		return err
+	}
+
+	// CodeDeploy stops deployments that exceed their own timeouts, so there is no need to add one.
+	g, ctx := errgroup.WithContext(context.Background())
+	streamer := stream.NewCodeDeployDeploymentStreamer(cf.cdClient, id)
+	renderer := progress.ListeningTrafficShiftRenderer(streamer,
+		fmt.Sprintf("Shifting traffic to the new tasks with CodeDeploy deployment %s", id), progress.RenderOptions{})
+	g.Go(func() error {
+		return stream.Stream(ctx, streamer)
+	})
+	g.Go(func() error {
+		return progress.Render(ctx, progress.NewTabbedFileWriter(out), renderer)
+	})
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	deployment, err := cf.cdClient.Deployment(id)
+	if err != nil {
+		return err
+	}
+	if deployment.Status != codedeploy.DeploymentStatusSucceeded {
+		return &ErrBlueGreenDeploymentFailed{
+			DeploymentID: id,
+			Status:       deployment.Status,
+			Reason:       deployment.ErrorMessage,
+		}
+	}
+	return nil
+}
+
+// BlueGreenTrafficTargetGroup returns the logical ID of the target group that serves the production traffic of a
+// blue/green service stack. Each CodeDeploy deployment moves the traffic to the other target group of the stack,
+// so the listener rules of the stack must keep forwarding to the target group of the primary task set.
+func (cf CloudFormation) BlueGreenTrafficTargetGroup(stackName string) (string, error) {
+	outputs, err := cf.cfnClient.Outputs(&cloudformation.Stack{Name: stackName})
+	if err != nil {
+		return "", err
+	}
+	serviceARN := ecs.ServiceArn(outputs[blueGreenServiceOutputKey])
+	if serviceARN == "" {
+		// The stack was deployed before it exported its service, so CodeDeploy hasn't swapped its target groups yet.
+		return template.BlueGreenBlueTargetGroup, nil
+	}
+	clusterName, err := serviceARN.ClusterName()
+	if err != nil {
+		return "", fmt.Errorf("parse %s output of stack %s: %w", blueGreenServiceOutputKey, stackName, err)
+	}
+	serviceName, err := serviceARN.ServiceName()
+	if err != nil {
+		return "", fmt.Errorf("parse %s output of stack %s: %w", blueGreenServiceOutputKey, stackName, err)
+	}
+	service, err := cf.ecsClient.Service(clusterName, serviceName)
+	if err != nil {
+		return "", err
+	}
+	for _, taskSet := range service.TaskSets {
+		if aws.StringValue(taskSet.Status) != ecsTaskSetPrimary {
+			continue
+		}
+		for _, lb := range taskSet.LoadBalancers {
+			if aws.StringValue(lb.TargetGroupArn) == outputs[blueGreenGreenTargetGroupKey] {
+				return template.BlueGreenGreenTargetGroup, nil
+			}
+		}
+	}
+	return template.BlueGreenBlueTargetGroup, nil
+}
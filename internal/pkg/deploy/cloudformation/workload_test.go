@@ -4,10 +4,17 @@
 package cloudformation
 
 import (
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	sdkcloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
+	awsecs "github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/cloudformation"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/mocks"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -88,6 +95,76 @@ func TestCloudFormation_DeployService(t *testing.T) {
 	t.Run("renders a stack with an EnvController that triggers no Env Stack updates", func(t *testing.T) {
 		testDeployWorkload_WithEnvControllerRenderer_NoStackUpdates(t, "myapp-myenv-mysvc", when)
 	})
+	t.Run("returns ErrECSDeploymentRolledBack if the deployment circuit breaker rolled back the service", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockCFN := mocks.NewMockcfnClient(ctrl)
+		mockECS := mocks.NewMockecsClient(ctrl)
+		mockCFN.EXPECT().Create(gomock.Any()).Return("1234", nil)
+		mockCFN.EXPECT().DescribeChangeSet(gomock.Any(), gomock.Any()).Return(&cloudformation.ChangeSetDescription{}, nil)
+		mockCFN.EXPECT().TemplateBodyFromChangeSet(gomock.Any(), gomock.Any()).Return("", nil)
+		mockCFN.EXPECT().DescribeStackEvents(gomock.Any()).Return(&sdkcloudformation.DescribeStackEventsOutput{
+			StackEvents: []*sdkcloudformation.StackEvent{
+				{
+					EventId:           aws.String("1"),
+					LogicalResourceId: aws.String("myapp-myenv-mysvc"),
+					ResourceStatus:    aws.String("UPDATE_ROLLBACK_COMPLETE"),
+					Timestamp:         aws.Time(time.Now()),
+				},
+			},
+		}, nil).AnyTimes()
+		mockCFN.EXPECT().Describe("myapp-myenv-mysvc").Return(&cloudformation.StackDescription{
+			StackStatus: aws.String("UPDATE_ROLLBACK_COMPLETE"),
+		}, nil)
+		mockCFN.EXPECT().Events("myapp-myenv-mysvc").Return([]cloudformation.StackEvent{
+			{
+				// Failure from a previous deployment that should be ignored.
+				LogicalResourceId:    aws.String("Service"),
+				ResourceType:         aws.String("AWS::ECS::Service"),
+				ResourceStatus:       aws.String("UPDATE_FAILED"),
+				ResourceStatusReason: aws.String("Error occurred during operation 'ECS Deployment Circuit Breaker was triggered'."),
+				PhysicalResourceId:   aws.String("arn:aws:ecs:us-west-2:1111:service/old-cluster/old-service"),
+			},
+			{
+				LogicalResourceId: aws.String("myapp-myenv-mysvc"),
+				ResourceStatus:    aws.String("UPDATE_IN_PROGRESS"),
+			},
+			{
+				LogicalResourceId:    aws.String("Service"),
+				ResourceType:         aws.String("AWS::ECS::Service"),
+				ResourceStatus:       aws.String("UPDATE_FAILED"),
+				ResourceStatusReason: aws.String("Error occurred during operation 'ECS Deployment Circuit Breaker was triggered'."),
+				PhysicalResourceId:   aws.String("arn:aws:ecs:us-west-2:1111:service/my-cluster/my-service"),
+			},
+			{
+				LogicalResourceId: aws.String("myapp-myenv-mysvc"),
+				ResourceStatus:    aws.String("UPDATE_ROLLBACK_COMPLETE"),
+			},
+		}, nil)
+		mockECS.EXPECT().Service("my-cluster", "my-service").Return(&ecs.Service{
+			Deployments: []*awsecs.Deployment{
+				{
+					Status:         aws.String("PRIMARY"),
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-myenv-mysvc:3"),
+				},
+				{
+					Status:         aws.String("ACTIVE"),
+					TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-myenv-mysvc:4"),
+				},
+			},
+		}, nil)
+		client := CloudFormation{cfnClient: mockCFN, ecsClient: mockECS}
+
+		// WHEN
+		err := when(mockFileWriter{Writer: new(strings.Builder)}, client)
+
+		// THEN
+		var errRollback *ErrECSDeploymentRolledBack
+		require.True(t, errors.As(err, &errRollback))
+		require.Equal(t, "myapp-myenv-mysvc:3", errRollback.TaskDefinition)
+		require.EqualError(t, err, "deployment circuit breaker rolled back the ECS service in stack myapp-myenv-mysvc to task definition myapp-myenv-mysvc:3")
+	})
 	t.Run("renders a stack with an ECS service", func(t *testing.T) {
 		testDeployWorkload_RenderNewlyCreatedStackWithECSService(t, "myapp-myenv-mysvc", when)
 	})
@@ -133,3 +210,298 @@ func TestCloudFormation_DeleteWorkload(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudFormation_CreateWorkloadChangeSet(t *testing.T) {
+	serviceConfig := &mockStackConfig{
+		name:     "myapp-myenv-mysvc",
+		template: "template",
+	}
+	testCases := map[string]struct {
+		createMock func(ctrl *gomock.Controller) cfnClient
+
+		wantedChangeSetID string
+		wantedDescription *cloudformation.ChangeSetDescription
+		wantedErr         string
+	}{
+		"returns a wrapped error if the change set cannot be created": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("", errors.New("some error"))
+				return m
+			},
+			wantedErr: "create change set for stack myapp-myenv-mysvc: some error",
+		},
+		"returns a wrapped error if the change set cannot be described": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).Return("mockChangeSet", nil)
+				m.EXPECT().DescribeChangeSet("mockChangeSet", "myapp-myenv-mysvc").Return(nil, errors.New("some error"))
+				return m
+			},
+			wantedErr: "describe change set mockChangeSet for stack myapp-myenv-mysvc: some error",
+		},
+		"creates the change set with the stack options applied and describes it": {
+			createMock: func(ctrl *gomock.Controller) cfnClient {
+				m := mocks.NewMockcfnClient(ctrl)
+				m.EXPECT().CreateChangeSet(gomock.Any()).DoAndReturn(func(stack *cloudformation.Stack) (string, error) {
+					require.Equal(t, "myapp-myenv-mysvc", stack.Name)
+					require.Equal(t, aws.String("arn:aws:iam::1111:role/execution"), stack.RoleARN)
+					return "mockChangeSet", nil
+				})
+				m.EXPECT().DescribeChangeSet("mockChangeSet", "myapp-myenv-mysvc").Return(&cloudformation.ChangeSetDescription{
+					StatusReason: "mockReason",
+				}, nil)
+				return m
+			},
+			wantedChangeSetID: "mockChangeSet",
+			wantedDescription: &cloudformation.ChangeSetDescription{
+				StatusReason: "mockReason",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := CloudFormation{
+				cfnClient: tc.createMock(ctrl),
+			}
+
+			// WHEN
+			id, descr, err := c.CreateWorkloadChangeSet(serviceConfig, cloudformation.WithRoleARN("arn:aws:iam::1111:role/execution"))
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedChangeSetID, id)
+			require.Equal(t, tc.wantedDescription, descr)
+		})
+	}
+}
+
+func TestCloudFormation_DeleteWorkloadChangeSet(t *testing.T) {
+	// GIVEN
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := mocks.NewMockcfnClient(ctrl)
+	m.EXPECT().DeleteChangeSet("mockChangeSet", "myapp-myenv-mysvc").Return(errors.New("some error"))
+	c := CloudFormation{
+		cfnClient: m,
+	}
+
+	// WHEN
+	err := c.DeleteWorkloadChangeSet("mockChangeSet", "myapp-myenv-mysvc")
+
+	// THEN
+	require.EqualError(t, err, "some error")
+}
+
+func TestCloudFormation_DeployBlueGreen(t *testing.T) {
+	const (
+		mockStack      = "myapp-myenv-mysvc"
+		mockOldTaskDef = "arn:aws:ecs:us-west-2:1111:task-definition/myapp-myenv-mysvc:1"
+		mockNewTaskDef = "arn:aws:ecs:us-west-2:1111:task-definition/myapp-myenv-mysvc:2"
+	)
+	mockOutputs := map[string]string{
+		"TaskDefinitionArn":         mockNewTaskDef,
+		"TargetContainer":           "mysvc",
+		"TargetPort":                "8080",
+		"CodeDeployApplication":     "myapp-myenv-mysvc",
+		"CodeDeployDeploymentGroup": "myapp-myenv-mysvc",
+	}
+	wantedDeploymentInput := codedeploy.ECSDeploymentInput{
+		ApplicationName:     "myapp-myenv-mysvc",
+		DeploymentGroupName: "myapp-myenv-mysvc",
+		TaskDefinitionARN:   mockNewTaskDef,
+		ContainerName:       "mysvc",
+		ContainerPort:       8080,
+	}
+	testCases := map[string]struct {
+		in         BlueGreenDeploymentInput
+		setupMocks func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient)
+
+		wantedErr string
+	}{
+		"returns an error if the stack outputs cannot be retrieved": {
+			in: BlueGreenDeploymentInput{StackName: mockStack, DeployedTaskDefinition: mockOldTaskDef},
+			setupMocks: func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient) {
+				cfn.EXPECT().Outputs(&cloudformation.Stack{Name: mockStack}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"returns an error if the stack does not deploy with CodeDeploy": {
+			in: BlueGreenDeploymentInput{StackName: mockStack, DeployedTaskDefinition: mockOldTaskDef},
+			setupMocks: func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(map[string]string{}, nil)
+			},
+			wantedErr: "stack myapp-myenv-mysvc does not have a TaskDefinitionArn output",
+		},
+		"does not start a deployment for a new service": {
+			in: BlueGreenDeploymentInput{StackName: mockStack},
+			setupMocks: func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+			},
+		},
+		"does not start a deployment if the task definition did not change": {
+			in: BlueGreenDeploymentInput{StackName: mockStack, DeployedTaskDefinition: mockNewTaskDef},
+			setupMocks: func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+			},
+		},
+		"returns an error if the deployment cannot be created": {
+			in: BlueGreenDeploymentInput{StackName: mockStack, DeployedTaskDefinition: mockOldTaskDef},
+			setupMocks: func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+				cd.EXPECT().CreateECSDeployment(wantedDeploymentInput).Return("", errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"returns ErrBlueGreenDeploymentFailed if the deployment is stopped": {
+			in: BlueGreenDeploymentInput{StackName: mockStack, DeployedTaskDefinition: mockOldTaskDef},
+			setupMocks: func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+				cd.EXPECT().CreateECSDeployment(wantedDeploymentInput).Return("d-1234", nil)
+				cd.EXPECT().Deployment("d-1234").Return(&codedeploy.Deployment{
+					ID:           "d-1234",
+					Status:       codedeploy.DeploymentStatusStopped,
+					ErrorMessage: "One or more alarms have been activated.",
+				}, nil).MinTimes(2)
+			},
+			wantedErr: "blue/green deployment d-1234 exited with status Stopped: One or more alarms have been activated.",
+		},
+		"starts a deployment when forced even if the task definition did not change": {
+			in: BlueGreenDeploymentInput{StackName: mockStack, DeployedTaskDefinition: mockNewTaskDef, Force: true},
+			setupMocks: func(cfn *mocks.MockcfnClient, cd *mocks.MockcodeDeployClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+				cd.EXPECT().CreateECSDeployment(wantedDeploymentInput).Return("d-1234", nil)
+				cd.EXPECT().Deployment("d-1234").Return(&codedeploy.Deployment{
+					ID:     "d-1234",
+					Status: codedeploy.DeploymentStatusSucceeded,
+				}, nil).MinTimes(2)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCFN := mocks.NewMockcfnClient(ctrl)
+			mockCD := mocks.NewMockcodeDeployClient(ctrl)
+			tc.setupMocks(mockCFN, mockCD)
+			client := CloudFormation{cfnClient: mockCFN, cdClient: mockCD}
+
+			// WHEN
+			err := client.DeployBlueGreen(mockFileWriter{Writer: new(strings.Builder)}, tc.in)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCloudFormation_BlueGreenTrafficTargetGroup(t *testing.T) {
+	const (
+		mockStack       = "myapp-myenv-mysvc"
+		mockServiceARN  = "arn:aws:ecs:us-west-2:1111:service/myapp-myenv-Cluster/myapp-myenv-mysvc-Service"
+		mockBlueTGARN   = "arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/myapp-Targe-1/abc"
+		mockGreenTGARN  = "arn:aws:elasticloadbalancing:us-west-2:1111:targetgroup/myapp-Green-2/def"
+		mockClusterName = "myapp-myenv-Cluster"
+		mockServiceName = "myapp-myenv-mysvc-Service"
+		primaryStatus   = "PRIMARY"
+		activeStatus    = "ACTIVE"
+	)
+	mockOutputs := map[string]string{
+		"ECSService":          mockServiceARN,
+		"GreenTargetGroupArn": mockGreenTGARN,
+	}
+	taskSet := func(status, targetGroupARN string) *awsecs.TaskSet {
+		return &awsecs.TaskSet{
+			Status: aws.String(status),
+			LoadBalancers: []*awsecs.LoadBalancer{
+				{TargetGroupArn: aws.String(targetGroupARN)},
+			},
+		}
+	}
+	testCases := map[string]struct {
+		setupMocks func(cfn *mocks.MockcfnClient, ecsClient *mocks.MockecsClient)
+
+		wanted    string
+		wantedErr string
+	}{
+		"returns an error if the stack outputs cannot be retrieved": {
+			setupMocks: func(cfn *mocks.MockcfnClient, ecsClient *mocks.MockecsClient) {
+				cfn.EXPECT().Outputs(&cloudformation.Stack{Name: mockStack}).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"returns the blue target group if the stack doesn't output its service": {
+			setupMocks: func(cfn *mocks.MockcfnClient, ecsClient *mocks.MockecsClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(map[string]string{}, nil)
+			},
+			wanted: "TargetGroup",
+		},
+		"returns an error if the service cannot be described": {
+			setupMocks: func(cfn *mocks.MockcfnClient, ecsClient *mocks.MockecsClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+				ecsClient.EXPECT().Service(mockClusterName, mockServiceName).Return(nil, errors.New("some error"))
+			},
+			wantedErr: "some error",
+		},
+		"returns the blue target group if the primary task set is registered to it": {
+			setupMocks: func(cfn *mocks.MockcfnClient, ecsClient *mocks.MockecsClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+				ecsClient.EXPECT().Service(mockClusterName, mockServiceName).Return(&ecs.Service{
+					TaskSets: []*awsecs.TaskSet{taskSet(primaryStatus, mockBlueTGARN)},
+				}, nil)
+			},
+			wanted: "TargetGroup",
+		},
+		"returns the green target group after CodeDeploy swapped the traffic": {
+			setupMocks: func(cfn *mocks.MockcfnClient, ecsClient *mocks.MockecsClient) {
+				cfn.EXPECT().Outputs(gomock.Any()).Return(mockOutputs, nil)
+				ecsClient.EXPECT().Service(mockClusterName, mockServiceName).Return(&ecs.Service{
+					TaskSets: []*awsecs.TaskSet{
+						taskSet(activeStatus, mockBlueTGARN),
+						taskSet(primaryStatus, mockGreenTGARN),
+					},
+				}, nil)
+			},
+			wanted: "GreenTargetGroup",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCFN := mocks.NewMockcfnClient(ctrl)
+			mockECS := mocks.NewMockecsClient(ctrl)
+			tc.setupMocks(mockCFN, mockECS)
+			client := CloudFormation{cfnClient: mockCFN, ecsClient: mockECS}
+
+			// WHEN
+			got, err := client.BlueGreenTrafficTargetGroup(mockStack)
+
+			// THEN
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wanted, got)
+		})
+	}
+}
@@ -7,13 +7,16 @@ package deploy
 
 import (
 	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/manifest"
 )
 
 const (
 	// LegacyEnvTemplateVersion is the version associated with the environment template before we started versioning.
 	LegacyEnvTemplateVersion = "v0.0.0"
 	// LatestEnvTemplateVersion is the latest version number available for environment templates.
-	LatestEnvTemplateVersion = "v1.6.1"
+	LatestEnvTemplateVersion = "v1.9.0"
+	// EnvAddonsCfnTemplateNameFormat is the file name of the environment addons template uploaded by `env deploy`.
+	EnvAddonsCfnTemplateNameFormat = "%s.env.addons.stack.yml"
 )
 
 // CreateEnvironmentInput holds the fields required to deploy an environment.
@@ -28,8 +31,13 @@ type CreateEnvironmentInput struct {
 	CustomResourcesURLs map[string]string // Environment custom resource script S3 object URLs.
 	ImportVPCConfig     *config.ImportVPC // Optional configuration if users have an existing VPC.
 	AdjustVPCConfig     *config.AdjustVPC // Optional configuration if users want to override default VPC configuration.
+	Telemetry           *config.Telemetry // Optional observability and monitoring configuration.
+
+	Mft    *manifest.Environment // Optional. The environment manifest; takes precedence over the VPC and telemetry configuration above.
+	RawMft []byte                // Optional. The content of the manifest file, stored in the template so that upgrades can reuse it.
 
 	CFNServiceRoleARN string // Optional. A service role ARN that CloudFormation should use to make calls to resources in the stack.
+	AddonsTemplateURL string // Optional. S3 object URL of the environment addons template.
 }
 
 // CreateEnvironmentResponse holds the created environment on successful deployment.
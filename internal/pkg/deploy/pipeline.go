@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
 
@@ -23,7 +25,11 @@ const (
 	fmtErrMissingProperty    = "missing `%s` in properties"
 	fmtErrPropertyNotAString = "property `%s` is not a string"
 
-	defaultPipelineBuildImage = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
+	defaultPipelineBuildImage    = "aws/codebuild/amazonlinux2-x86_64-standard:3.0"
+	defaultPipelineBuildspecPath = "copilot/buildspec.yml"
+
+	fmtPipelineStackNamePrefix = "pipeline-%s-" // Ex: "pipeline-appName-"
+	maxPipelineStackNameLength = 100            // CodePipeline names are limited to 100 characters.
 )
 
 var (
@@ -44,6 +50,9 @@ type CreatePipelineInput struct {
 	// Name of the pipeline
 	Name string
 
+	// IsLegacy is true if the pipeline stack is named after the pipeline without the application prefix.
+	IsLegacy bool
+
 	// The source code provider for this pipeline
 	Source interface{}
 
@@ -60,6 +69,41 @@ type CreatePipelineInput struct {
 
 	// AdditionalTags are labels applied to resources under the application.
 	AdditionalTags map[string]string
+
+	// Notifications configures who gets notified of approval requests and stage failures.
+	Notifications *PipelineNotifications
+}
+
+// PipelineStackName returns the name of the stack and CodePipeline of the pipeline named pipelineName in the application.
+// Pipeline names are namespaced with the application so that applications in the same account and region
+// can have pipelines with the same name.
+func PipelineStackName(appName, pipelineName string) string {
+	prefix := fmt.Sprintf(fmtPipelineStackNamePrefix, appName)
+	name := pipelineName
+	if !strings.HasPrefix(name, prefix) {
+		// Default pipeline names generated by "pipeline init" are already namespaced.
+		name = prefix + pipelineName
+	}
+	if len(name) > maxPipelineStackNameLength {
+		return name[:maxPipelineStackNameLength]
+	}
+	return name
+}
+
+// PipelineNotifications represents the subscribers of the pipeline's notification topic.
+type PipelineNotifications struct {
+	// Email addresses subscribed to approval requests and stage failures.
+	Emails []string
+}
+
+// PipelineNotificationsFromManifest processes manifest info about the pipeline notifications.
+func PipelineNotificationsFromManifest(mfNotifications *manifest.PipelineNotifications) *PipelineNotifications {
+	if mfNotifications == nil || len(mfNotifications.Emails) == 0 {
+		return nil
+	}
+	return &PipelineNotifications{
+		Emails: mfNotifications.Emails,
+	}
 }
 
 // Build represents CodeBuild project used in the CodePipeline
@@ -67,6 +111,9 @@ type CreatePipelineInput struct {
 type Build struct {
 	// The URI that identifies the Docker image to use for this build project.
 	Image string
+
+	// The path to the buildspec file, relative to the root of the source repository.
+	BuildspecPath string
 }
 
 // ArtifactBucket represents an S3 bucket used by the CodePipeline to store
@@ -108,6 +155,7 @@ type GitHubSource struct {
 	RepositoryURL        GitHubURL
 	ConnectionARN        string
 	OutputArtifactFormat string
+	Paths                []string // File path patterns that trigger the pipeline. If empty, any change triggers it.
 }
 
 // GitHubURL is the common type for repo URLs for both GitHubSource versions:
@@ -129,6 +177,7 @@ type BitbucketSource struct {
 	RepositoryURL        string
 	ConnectionARN        string
 	OutputArtifactFormat string
+	Paths                []string // File path patterns that trigger the pipeline. If empty, any change triggers it.
 }
 
 func convertRequiredProperty(properties map[string]interface{}, key string) (string, error) {
@@ -201,6 +250,7 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 				Branch:               branch,
 				RepositoryURL:        GitHubURL(repository),
 				OutputArtifactFormat: outputFormat,
+				Paths:                mfSource.Paths,
 			}
 			if !ok {
 				return repo, true, nil
@@ -223,6 +273,7 @@ func PipelineSourceFromManifest(mfSource *manifest.Source) (source interface{},
 			Branch:               branch,
 			RepositoryURL:        repository,
 			OutputArtifactFormat: outputFormat,
+			Paths:                mfSource.Paths,
 		}
 		if !ok {
 			return repo, true, nil
@@ -241,7 +292,8 @@ func PipelineBuildFromManifest(mfBuild *manifest.Build) (build *Build) {
 		image = mfBuild.Image
 	}
 	return &Build{
-		Image: image,
+		Image:         image,
+		BuildspecPath: defaultPipelineBuildspecPath,
 	}
 }
 
@@ -433,6 +485,104 @@ type PipelineStage struct {
 	LocalWorkloads   []string
 	RequiresApproval bool
 	TestCommands     []string
+	PreDeployments   []PrePostDeployAction
+	PostDeployments  []PrePostDeployAction
+}
+
+// PrePostDeployAction represents a CodeBuild action that runs a buildspec before or after
+// the workloads of a stage are deployed.
+type PrePostDeployAction struct {
+	Name          string
+	BuildspecPath string
+	// Order is the 1-based position of the action among the stage's pre- or post-deployments.
+	// Actions with the same order run in parallel, after all the actions they depend on.
+	Order int
+}
+
+// PrePostDeployActionsFromManifest converts the pre- or post-deployments of a stage in the manifest
+// into actions sorted by their order. It returns an error if the actions have circular dependencies.
+func PrePostDeployActionsFromManifest(mfActions map[string]*manifest.PrePostDeployment) ([]PrePostDeployAction, error) {
+	orders := make(map[string]int, len(mfActions))
+	visiting := make(map[string]bool)
+	var order func(name string) (int, error)
+	order = func(name string) (int, error) {
+		if o, ok := orders[name]; ok {
+			return o, nil
+		}
+		if visiting[name] {
+			return 0, fmt.Errorf("circular dependency on action %s", name)
+		}
+		action, ok := mfActions[name]
+		if !ok {
+			return 0, fmt.Errorf("action %s does not exist", name)
+		}
+		visiting[name] = true
+		o := 1
+		for _, dep := range action.DependsOn {
+			depOrder, err := order(dep)
+			if err != nil {
+				return 0, err
+			}
+			if depOrder+1 > o {
+				o = depOrder + 1
+			}
+		}
+		visiting[name] = false
+		orders[name] = o
+		return o, nil
+	}
+
+	var actions []PrePostDeployAction
+	for name, action := range mfActions {
+		o, err := order(name)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, PrePostDeployAction{
+			Name:          name,
+			BuildspecPath: action.BuildspecPath,
+			Order:         o,
+		})
+	}
+	sort.Slice(actions, func(i, j int) bool {
+		if actions[i].Order != actions[j].Order {
+			return actions[i].Order < actions[j].Order
+		}
+		return actions[i].Name < actions[j].Name
+	})
+	return actions, nil
+}
+
+// PreDeploymentRunOrder returns the run order of a pre-deployment action in the stage.
+// Pre-deployments run after the manual approval, if any.
+func (s *PipelineStage) PreDeploymentRunOrder(action PrePostDeployAction) int {
+	return 1 + action.Order
+}
+
+// DeployRunOrder returns the run order of the actions that deploy the workloads of the stage.
+func (s *PipelineStage) DeployRunOrder() int {
+	return 2 + maxOrder(s.PreDeployments)
+}
+
+// PostDeploymentRunOrder returns the run order of a post-deployment action in the stage.
+func (s *PipelineStage) PostDeploymentRunOrder(action PrePostDeployAction) int {
+	return s.DeployRunOrder() + action.Order
+}
+
+// TestCommandsRunOrder returns the run order of the test commands action of the stage.
+// Test commands run after the last post-deployment actions.
+func (s *PipelineStage) TestCommandsRunOrder() int {
+	return s.DeployRunOrder() + maxOrder(s.PostDeployments) + 1
+}
+
+func maxOrder(actions []PrePostDeployAction) int {
+	var max int
+	for _, action := range actions {
+		if action.Order > max {
+			max = action.Order
+		}
+	}
+	return max
 }
 
 // WorkloadTemplatePath returns the full path to the workload CFN template
@@ -206,7 +206,8 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 		"set default image if not be specified in manifest": {
 			mfBuild: nil,
 			expectedBuild: &Build{
-				Image: defaultImage,
+				Image:         defaultImage,
+				BuildspecPath: "copilot/buildspec.yml",
 			},
 		},
 		"set image according to manifest": {
@@ -214,7 +215,8 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 				Image: "aws/codebuild/standard:3.0",
 			},
 			expectedBuild: &Build{
-				Image: "aws/codebuild/standard:3.0",
+				Image:         "aws/codebuild/standard:3.0",
+				BuildspecPath: "copilot/buildspec.yml",
 			},
 		},
 	}
@@ -226,6 +228,117 @@ func TestPipelineBuildFromManifest(t *testing.T) {
 	}
 }
 
+func TestPrePostDeployActionsFromManifest(t *testing.T) {
+	testCases := map[string]struct {
+		mfActions map[string]*manifest.PrePostDeployment
+
+		expectedActions []PrePostDeployAction
+		expectedErr     error
+	}{
+		"no actions": {},
+		"orders actions by their dependencies": {
+			mfActions: map[string]*manifest.PrePostDeployment{
+				"load": {
+					BuildspecPath: "load.yml",
+					DependsOn:     []string{"smoke", "seed"},
+				},
+				"smoke": {
+					BuildspecPath: "smoke.yml",
+				},
+				"seed": {
+					BuildspecPath: "seed.yml",
+					DependsOn:     []string{"migrate"},
+				},
+				"migrate": {
+					BuildspecPath: "migrate.yml",
+				},
+			},
+			expectedActions: []PrePostDeployAction{
+				{Name: "migrate", BuildspecPath: "migrate.yml", Order: 1},
+				{Name: "smoke", BuildspecPath: "smoke.yml", Order: 1},
+				{Name: "seed", BuildspecPath: "seed.yml", Order: 2},
+				{Name: "load", BuildspecPath: "load.yml", Order: 3},
+			},
+		},
+		"error on circular dependencies": {
+			mfActions: map[string]*manifest.PrePostDeployment{
+				"migrate": {
+					BuildspecPath: "migrate.yml",
+					DependsOn:     []string{"migrate"},
+				},
+			},
+			expectedErr: errors.New("circular dependency on action migrate"),
+		},
+		"error on unknown dependency": {
+			mfActions: map[string]*manifest.PrePostDeployment{
+				"migrate": {
+					BuildspecPath: "migrate.yml",
+					DependsOn:     []string{"backup"},
+				},
+			},
+			expectedErr: errors.New("action backup does not exist"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actions, err := PrePostDeployActionsFromManifest(tc.mfActions)
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedActions, actions)
+		})
+	}
+}
+
+func TestPipelineStage_RunOrders(t *testing.T) {
+	testCases := map[string]struct {
+		stage PipelineStage
+
+		expectedPreDeploymentRunOrders  []int
+		expectedDeployRunOrder          int
+		expectedPostDeploymentRunOrders []int
+		expectedTestCommandsRunOrder    int
+	}{
+		"without pre or post deployments": {
+			expectedDeployRunOrder:       2,
+			expectedTestCommandsRunOrder: 3,
+		},
+		"with pre and post deployments": {
+			stage: PipelineStage{
+				PreDeployments: []PrePostDeployAction{
+					{Name: "backup", Order: 1},
+					{Name: "migrate", Order: 2},
+				},
+				PostDeployments: []PrePostDeployAction{
+					{Name: "smoke", Order: 1},
+					{Name: "load", Order: 2},
+				},
+			},
+			expectedPreDeploymentRunOrders:  []int{2, 3},
+			expectedDeployRunOrder:          4,
+			expectedPostDeploymentRunOrders: []int{5, 6},
+			expectedTestCommandsRunOrder:    7,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var pre, post []int
+			for _, action := range tc.stage.PreDeployments {
+				pre = append(pre, tc.stage.PreDeploymentRunOrder(action))
+			}
+			for _, action := range tc.stage.PostDeployments {
+				post = append(post, tc.stage.PostDeploymentRunOrder(action))
+			}
+			require.Equal(t, tc.expectedPreDeploymentRunOrders, pre)
+			require.Equal(t, tc.expectedDeployRunOrder, tc.stage.DeployRunOrder())
+			require.Equal(t, tc.expectedPostDeploymentRunOrders, post)
+			require.Equal(t, tc.expectedTestCommandsRunOrder, tc.stage.TestCommandsRunOrder())
+		})
+	}
+}
+
 func TestParseOwnerAndRepo(t *testing.T) {
 	testCases := map[string]struct {
 		src            *GitHubSource
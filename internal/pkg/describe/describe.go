@@ -8,6 +8,7 @@ import (
 	"io"
 	"strings"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/describe/stack"
 
@@ -43,6 +44,10 @@ type stackDescriber interface {
 	StackSetMetadata() (string, error)
 }
 
+type subnetCIDRGetter interface {
+	SubnetCIDRBlocks(filters ...ec2.Filter) ([]string, error)
+}
+
 type deployedSvcResources map[string][]*stack.Resource
 
 func (c deployedSvcResources) humanStringByEnv(w io.Writer, envs []string) {
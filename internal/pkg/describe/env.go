@@ -11,6 +11,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
@@ -31,6 +32,24 @@ type EnvDescription struct {
 	Tags           map[string]string   `json:"tags,omitempty"`
 	Resources      []*stack.Resource   `json:"resources,omitempty"`
 	EnvironmentVPC EnvironmentVPC      `json:"environmentVPC"`
+	// InternalLoadBalancerDNSName is the DNS name of the internal load balancer, if the environment has one.
+	InternalLoadBalancerDNSName string `json:"internalLoadBalancerDNSName,omitempty"`
+	// Security holds the logging and firewall settings of the environment, if any are enabled.
+	Security *EnvironmentSecurity `json:"security,omitempty"`
+}
+
+// EnvironmentSecurity holds where the environment stores its network logs and the web ACL protecting its load balancer.
+type EnvironmentSecurity struct {
+	VPCFlowLogs   string `json:"vpcFlowLogs,omitempty"`   // ARN of the log group or bucket that stores the VPC flow logs.
+	ALBAccessLogs string `json:"albAccessLogs,omitempty"` // Name of the bucket that stores the access logs of the public load balancer.
+	WebACL        string `json:"webACL,omitempty"`        // ARN of the WAF web ACL associated with the public load balancer.
+}
+
+type envStackInfo struct {
+	tags              map[string]string
+	vpc               EnvironmentVPC
+	internalLBDNSName string
+	security          *EnvironmentSecurity
 }
 
 // EnvironmentVPC holds the ID of the environment's VPC configuration.
@@ -49,6 +68,7 @@ type EnvDescriber struct {
 	configStore ConfigStoreSvc
 	deployStore DeployedEnvServicesLister
 	cfn         stackDescriber
+	ec2Client   subnetCIDRGetter
 
 	// Cached values for reuse.
 	description *EnvDescription
@@ -81,6 +101,7 @@ func NewEnvDescriber(opt NewEnvDescriberConfig) (*EnvDescriber, error) {
 		configStore: opt.ConfigStore,
 		deployStore: opt.DeployStore,
 		cfn:         stack.NewStackDescriber(cfnstack.NameForEnv(opt.App, opt.Env), sess),
+		ec2Client:   ec2.New(sess),
 	}, nil
 }
 
@@ -94,7 +115,7 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 		return nil, err
 	}
 
-	tags, environmentVPC, err := d.loadStackInfo()
+	stackInfo, err := d.loadStackInfo()
 	if err != nil {
 		return nil, err
 	}
@@ -109,9 +130,12 @@ func (d *EnvDescriber) Describe() (*EnvDescription, error) {
 	d.description = &EnvDescription{
 		Environment:    d.env,
 		Services:       svcs,
-		Tags:           tags,
+		Tags:           stackInfo.tags,
 		Resources:      stackResources,
-		EnvironmentVPC: environmentVPC,
+		EnvironmentVPC: stackInfo.vpc,
+
+		InternalLoadBalancerDNSName: stackInfo.internalLBDNSName,
+		Security:                    stackInfo.security,
 	}
 	return d.description, nil
 }
@@ -156,6 +180,26 @@ func (d *EnvDescriber) Version() (string, error) {
 	return metadata.Version, nil
 }
 
+// PublicCIDRBlocks returns the CIDR blocks of the public subnets of the environment.
+func (d *EnvDescriber) PublicCIDRBlocks() ([]string, error) {
+	outputs, err := d.Outputs()
+	if err != nil {
+		return nil, fmt.Errorf("get outputs of environment %s in app %s: %w", d.env.Name, d.app, err)
+	}
+	subnets, ok := outputs[cfnstack.EnvOutputPublicSubnets]
+	if !ok || subnets == "" {
+		return nil, fmt.Errorf("environment %s in app %s does not have public subnets", d.env.Name, d.app)
+	}
+	cidrBlocks, err := d.ec2Client.SubnetCIDRBlocks(ec2.Filter{
+		Name:   "subnet-id",
+		Values: strings.Split(subnets, ","),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get CIDR blocks of public subnets %s: %w", subnets, err)
+	}
+	return cidrBlocks, nil
+}
+
 // ServiceDiscoveryEndpoint returns the endpoint the environment was initialized with, if any. Otherwise,
 // it returns the legacy app.local endpoint.
 func (d *EnvDescriber) ServiceDiscoveryEndpoint() (string, error) {
@@ -178,26 +222,38 @@ func (d *EnvDescriber) ServiceDiscoveryEndpoint() (string, error) {
 	return fmt.Sprintf(fmtLegacySvcDiscoveryEndpoint, d.app), nil
 }
 
-func (d *EnvDescriber) loadStackInfo() (map[string]string, EnvironmentVPC, error) {
-	var environmentVPC EnvironmentVPC
-
+func (d *EnvDescriber) loadStackInfo() (*envStackInfo, error) {
 	envStack, err := d.cfn.Describe()
 	if err != nil {
-		return nil, environmentVPC, fmt.Errorf("retrieve environment stack: %w", err)
+		return nil, fmt.Errorf("retrieve environment stack: %w", err)
 	}
 
+	info := &envStackInfo{
+		tags: envStack.Tags,
+	}
+	var security EnvironmentSecurity
 	for k, v := range envStack.Outputs {
 		switch k {
 		case cfnstack.EnvOutputVPCID:
-			environmentVPC.ID = v
+			info.vpc.ID = v
 		case cfnstack.EnvOutputPublicSubnets:
-			environmentVPC.PublicSubnetIDs = strings.Split(v, ",")
+			info.vpc.PublicSubnetIDs = strings.Split(v, ",")
 		case cfnstack.EnvOutputPrivateSubnets:
-			environmentVPC.PrivateSubnetIDs = strings.Split(v, ",")
+			info.vpc.PrivateSubnetIDs = strings.Split(v, ",")
+		case cfnstack.EnvOutputInternalLoadBalancerDNSName:
+			info.internalLBDNSName = v
+		case cfnstack.EnvOutputVPCFlowLogsDestination:
+			security.VPCFlowLogs = v
+		case cfnstack.EnvOutputPublicLBAccessLogsBucket:
+			security.ALBAccessLogs = v
+		case cfnstack.EnvOutputPublicLBWebACLArn:
+			security.WebACL = v
 		}
 	}
-
-	return envStack.Tags, environmentVPC, nil
+	if security != (EnvironmentSecurity{}) {
+		info.security = &security
+	}
+	return info, nil
 }
 
 func (d *EnvDescriber) filterDeployedSvcs() ([]*config.Workload, error) {
@@ -239,6 +295,22 @@ func (e *EnvDescription) HumanString() string {
 	fmt.Fprintf(writer, "  %s\t%t\n", "Production", e.Environment.Prod)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Region", e.Environment.Region)
 	fmt.Fprintf(writer, "  %s\t%s\n", "Account ID", e.Environment.AccountID)
+	if e.InternalLoadBalancerDNSName != "" {
+		fmt.Fprintf(writer, "  %s\t%s\n", "Internal ALB", e.InternalLoadBalancerDNSName)
+	}
+	if e.Security != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nSecurity\n\n"))
+		writer.Flush()
+		if e.Security.VPCFlowLogs != "" {
+			fmt.Fprintf(writer, "  %s\t%s\n", "VPC Flow Logs", e.Security.VPCFlowLogs)
+		}
+		if e.Security.ALBAccessLogs != "" {
+			fmt.Fprintf(writer, "  %s\t%s\n", "ALB Access Logs", e.Security.ALBAccessLogs)
+		}
+		if e.Security.WebACL != "" {
+			fmt.Fprintf(writer, "  %s\t%s\n", "WAF Web ACL", e.Security.WebACL)
+		}
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nServices\n\n"))
 	writer.Flush()
 	headers := []string{"Name", "Type"}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	cfstack "github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
@@ -69,6 +70,10 @@ func TestEnvDescriber_Describe(t *testing.T) {
 		"VpcId":          "vpc-012abcd345",
 		"PublicSubnets":  "subnet-0789ab,subnet-0123cd",
 		"PrivateSubnets": "subnet-023ff,subnet-04af",
+
+		"InternalLoadBalancerDNSName": "internal-testApp-Inter-1234.us-west-2.elb.amazonaws.com",
+		"VPCFlowLogsDestination":      "arn:aws:logs:us-west-2:123456789012:log-group:/copilot/testApp-testEnv-vpc-flow-logs:*",
+		"PublicLoadBalancerWebACLArn": "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234",
 	}
 	mockResource1 := &stack.Resource{
 		PhysicalID: "testApp-testEnv-CFNExecutionRole",
@@ -164,6 +169,11 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
 					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
 				},
+				InternalLoadBalancerDNSName: "internal-testApp-Inter-1234.us-west-2.elb.amazonaws.com",
+				Security: &EnvironmentSecurity{
+					VPCFlowLogs: "arn:aws:logs:us-west-2:123456789012:log-group:/copilot/testApp-testEnv-vpc-flow-logs:*",
+					WebACL:      "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234",
+				},
 			},
 		},
 		"success with resources": {
@@ -195,6 +205,11 @@ func TestEnvDescriber_Describe(t *testing.T) {
 					PublicSubnetIDs:  []string{"subnet-0789ab", "subnet-0123cd"},
 					PrivateSubnetIDs: []string{"subnet-023ff", "subnet-04af"},
 				},
+				InternalLoadBalancerDNSName: "internal-testApp-Inter-1234.us-west-2.elb.amazonaws.com",
+				Security: &EnvironmentSecurity{
+					VPCFlowLogs: "arn:aws:logs:us-west-2:123456789012:log-group:/copilot/testApp-testEnv-vpc-flow-logs:*",
+					WebACL:      "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234",
+				},
 			},
 		},
 	}
@@ -294,6 +309,85 @@ func TestEnvDescriber_Version(t *testing.T) {
 	}
 }
 
+func TestEnvDescriber_PublicCIDRBlocks(t *testing.T) {
+	testCases := map[string]struct {
+		setupMocks func(cfn *mocks.MockstackDescriber, ec2Client *mocks.MocksubnetCIDRGetter)
+
+		wantedCIDRBlocks []string
+		wantedErr        error
+	}{
+		"error if fail to describe the environment stack": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, _ *mocks.MocksubnetCIDRGetter) {
+				cfn.EXPECT().Describe().Return(stack.StackDescription{}, errors.New("some error"))
+			},
+			wantedErr: errors.New("get outputs of environment test in app phonetool: some error"),
+		},
+		"error if the environment does not have public subnets": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, _ *mocks.MocksubnetCIDRGetter) {
+				cfn.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						cfstack.EnvOutputPrivateSubnets: "subnet-3",
+					},
+				}, nil)
+			},
+			wantedErr: errors.New("environment test in app phonetool does not have public subnets"),
+		},
+		"error if fail to get the CIDR blocks": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, ec2Client *mocks.MocksubnetCIDRGetter) {
+				cfn.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						cfstack.EnvOutputPublicSubnets: "subnet-1,subnet-2",
+					},
+				}, nil)
+				ec2Client.EXPECT().SubnetCIDRBlocks(gomock.Any()).Return(nil, errors.New("some error"))
+			},
+			wantedErr: errors.New("get CIDR blocks of public subnets subnet-1,subnet-2: some error"),
+		},
+		"success": {
+			setupMocks: func(cfn *mocks.MockstackDescriber, ec2Client *mocks.MocksubnetCIDRGetter) {
+				cfn.EXPECT().Describe().Return(stack.StackDescription{
+					Outputs: map[string]string{
+						cfstack.EnvOutputPublicSubnets: "subnet-1,subnet-2",
+					},
+				}, nil)
+				ec2Client.EXPECT().SubnetCIDRBlocks(ec2.Filter{
+					Name:   "subnet-id",
+					Values: []string{"subnet-1", "subnet-2"},
+				}).Return([]string{"10.0.0.0/24", "10.0.1.0/24"}, nil)
+			},
+			wantedCIDRBlocks: []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCFN := mocks.NewMockstackDescriber(ctrl)
+			mockEC2 := mocks.NewMocksubnetCIDRGetter(ctrl)
+			tc.setupMocks(mockCFN, mockEC2)
+			d := &EnvDescriber{
+				app:       "phonetool",
+				env:       &config.Environment{Name: "test"},
+				cfn:       mockCFN,
+				ec2Client: mockEC2,
+			}
+
+			// WHEN
+			actual, err := d.PublicCIDRBlocks()
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedCIDRBlocks, actual)
+			}
+		})
+	}
+}
+
 func TestEnvDescriber_ServiceDiscoveryEndpoint(t *testing.T) {
 	testCases := map[string]struct {
 		given func(ctrl *gomock.Controller) *EnvDescriber
@@ -456,6 +550,13 @@ func TestEnvDescription_HumanString(t *testing.T) {
   Production        false
   Region            us-west-2
   Account ID        123456789012
+  Internal ALB      internal-testApp-Inter-1234.us-west-2.elb.amazonaws.com
+
+Security
+
+  VPC Flow Logs     arn:aws:logs:us-west-2:123456789012:log-group:/copilot/testApp-testEnv-vpc-flow-logs:*
+  ALB Access Logs   testapp-testenv-elbaccesslogsbucket-1234
+  WAF Web ACL       arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234
 
 Services
 
@@ -486,6 +587,13 @@ Resources
 		Services:    allSvcs,
 		Tags:        testApp.Tags,
 		Resources:   wantedResources,
+
+		InternalLoadBalancerDNSName: "internal-testApp-Inter-1234.us-west-2.elb.amazonaws.com",
+		Security: &EnvironmentSecurity{
+			VPCFlowLogs:   "arn:aws:logs:us-west-2:123456789012:log-group:/copilot/testApp-testEnv-vpc-flow-logs:*",
+			ALBAccessLogs: "testapp-testenv-elbaccesslogsbucket-1234",
+			WebACL:        "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234",
+		},
 	}
 
 	// WHEN
@@ -0,0 +1,59 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// JobExecution contains the summary of a single execution of a job.
+type JobExecution struct {
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	StartedAt time.Time  `json:"startedAt"`
+	StoppedAt *time.Time `json:"stoppedAt,omitempty"`
+	Retries   int        `json:"retries"`
+}
+
+// JobExecutions contains the most recent executions of a job in an environment.
+type JobExecutions struct {
+	Job        string          `json:"job"`
+	Env        string          `json:"environment"`
+	Executions []*JobExecution `json:"executions"`
+}
+
+// JSONString returns stringified JobExecutions struct with json format.
+func (e *JobExecutions) JSONString() (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", fmt.Errorf("marshal job executions: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns stringified JobExecutions struct with human readable format.
+func (e *JobExecutions) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Execution", "Started", "Duration", "Status", "Retries"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, execution := range e.Executions {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\n", execution.Name, humanizeTime(execution.StartedAt), execution.duration(), execution.Status, execution.Retries)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (e *JobExecution) duration() string {
+	if e.StoppedAt == nil {
+		return "-"
+	}
+	return e.StoppedAt.Sub(e.StartedAt).Round(time.Second).String()
+}
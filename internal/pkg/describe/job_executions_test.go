@@ -0,0 +1,54 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobExecutions_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-06-19T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	startedAt, _ := time.Parse(time.RFC3339, "2020-06-18T22:00:00+00:00")
+	stoppedAt := startedAt.Add(90 * time.Second)
+	executions := &JobExecutions{
+		Job: "report",
+		Env: "test",
+		Executions: []*JobExecution{
+			{
+				Name:      "f3e7a1b2",
+				Status:    "RUNNING",
+				StartedAt: startedAt,
+			},
+			{
+				Name:      "0c9d8e7f",
+				Status:    "FAILED",
+				StartedAt: startedAt,
+				StoppedAt: &stoppedAt,
+				Retries:   2,
+			},
+		},
+	}
+
+	human := executions.HumanString()
+	json, err := executions.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `Execution           Started             Duration            Status              Retries
+---------           -------             --------            ------              -------
+f3e7a1b2            2 hours ago         -                   RUNNING             0
+0c9d8e7f            2 hours ago         1m30s               FAILED              2
+`, human)
+	require.Equal(t, "{\"job\":\"report\",\"environment\":\"test\",\"executions\":[{\"name\":\"f3e7a1b2\",\"status\":\"RUNNING\",\"startedAt\":\"2020-06-18T22:00:00Z\",\"retries\":0},{\"name\":\"0c9d8e7f\",\"status\":\"FAILED\",\"startedAt\":\"2020-06-18T22:00:00Z\",\"stoppedAt\":\"2020-06-18T22:01:30Z\",\"retries\":2}]}\n", json)
+}
@@ -25,6 +25,10 @@ import (
 const (
 	envOutputPublicLoadBalancerDNSName = "PublicLoadBalancerDNSName"
 	envOutputSubdomain                 = "EnvironmentSubdomain"
+	envOutputHTTPSListenerArn          = "HTTPSListenerArn"
+
+	svcOutputPublicNLBDNSName = "PublicNetworkLoadBalancerDNSName"
+	svcOutputPublicNLBPort    = "PublicNetworkLoadBalancerPort"
 )
 
 type envDescriber interface {
@@ -40,6 +44,8 @@ type LBWebServiceDescriber struct {
 
 	// cache only last svc paramerters
 	svcParams map[string]string
+	// cache only last env aliases that are not managed by Copilot
+	aliasRecords []*CNAMERecord
 }
 
 // NewLBWebServiceDescriber instantiates a load balanced service describer.
@@ -90,6 +96,8 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 	}
 
 	var routes []*WebServiceRoute
+	var aliasRecords []*CNAMERecord
+	var nlbRoutes []*WebServiceRoute
 	var configs []*ECSServiceConfig
 	var serviceDiscoveries []*ServiceDiscovery
 	var envVars []*containerEnvVar
@@ -107,6 +115,17 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 			Environment: env,
 			URL:         webServiceURI,
 		})
+		aliasRecords = append(aliasRecords, d.aliasRecords...)
+		svcOutputs, err := d.svcStackDescriber[env].Outputs()
+		if err != nil {
+			return nil, fmt.Errorf("get stack outputs for service %s: %w", d.svc, err)
+		}
+		if dnsName, ok := svcOutputs[svcOutputPublicNLBDNSName]; ok {
+			nlbRoutes = append(nlbRoutes, &WebServiceRoute{
+				Environment: env,
+				URL:         fmt.Sprintf("%s:%s", dnsName, svcOutputs[svcOutputPublicNLBPort]),
+			})
+		}
 		configs = append(configs, &ECSServiceConfig{
 			ServiceConfig: &ServiceConfig{
 				Environment: env,
@@ -157,6 +176,8 @@ func (d *LBWebServiceDescriber) Describe() (HumanJSONStringer, error) {
 		App:              d.app,
 		Configurations:   configs,
 		Routes:           routes,
+		AliasRecords:     aliasRecords,
+		NLBRoutes:        nlbRoutes,
 		ServiceDiscovery: serviceDiscoveries,
 		Variables:        envVars,
 		Secrets:          secrets,
@@ -228,6 +249,13 @@ type WebServiceRoute struct {
 	URL         string `json:"url"`
 }
 
+// CNAMERecord contains the DNS record to create for an alias of a web service when Copilot doesn't manage its hosted zone.
+type CNAMERecord struct {
+	Environment string `json:"environment"`
+	Alias       string `json:"alias"`
+	Target      string `json:"target"` // The DNS name of the load balancer that the alias must point to.
+}
+
 // ServiceDiscovery contains serialized service discovery info for an service.
 type ServiceDiscovery struct {
 	Environment []string `json:"environment"`
@@ -252,6 +280,8 @@ type webSvcDesc struct {
 	App              string               `json:"application"`
 	Configurations   ecsConfigurations    `json:"configurations"`
 	Routes           []*WebServiceRoute   `json:"routes"`
+	AliasRecords     []*CNAMERecord       `json:"aliasRecords,omitempty"`
+	NLBRoutes        []*WebServiceRoute   `json:"nlbRoutes,omitempty"`
 	ServiceDiscovery serviceDiscoveries   `json:"serviceDiscovery"`
 	Variables        containerEnvVars     `json:"variables"`
 	Secrets          secrets              `json:"secrets,omitempty"`
@@ -289,6 +319,25 @@ func (w *webSvcDesc) HumanString() string {
 	for _, route := range w.Routes {
 		fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
 	}
+	if len(w.AliasRecords) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nAliases\n\n"))
+		writer.Flush()
+		aliasHeaders := []string{"Environment", "Alias", "CNAME Target"}
+		fmt.Fprintf(writer, "  %s\n", strings.Join(aliasHeaders, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(aliasHeaders), "\t"))
+		for _, record := range w.AliasRecords {
+			fmt.Fprintf(writer, "  %s\t%s\t%s\n", record.Environment, record.Alias, record.Target)
+		}
+	}
+	if len(w.NLBRoutes) != 0 {
+		fmt.Fprint(writer, color.Bold.Sprint("\nNetwork Load Balancer\n\n"))
+		writer.Flush()
+		fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+		fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+		for _, route := range w.NLBRoutes {
+			fmt.Fprintf(writer, "  %s\t%s\n", route.Environment, route.URL)
+		}
+	}
 	fmt.Fprint(writer, color.Bold.Sprint("\nService Discovery\n\n"))
 	writer.Flush()
 	w.ServiceDiscovery.humanString(writer)
@@ -7,6 +7,7 @@ package mocks
 import (
 	reflect "reflect"
 
+	ec2 "github.com/aws/copilot-cli/internal/pkg/aws/ec2"
 	stack "github.com/aws/copilot-cli/internal/pkg/describe/stack"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -145,3 +146,45 @@ func (mr *MockstackDescriberMockRecorder) StackSetMetadata() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StackSetMetadata", reflect.TypeOf((*MockstackDescriber)(nil).StackSetMetadata))
 }
+
+// MocksubnetCIDRGetter is a mock of subnetCIDRGetter interface.
+type MocksubnetCIDRGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MocksubnetCIDRGetterMockRecorder
+}
+
+// MocksubnetCIDRGetterMockRecorder is the mock recorder for MocksubnetCIDRGetter.
+type MocksubnetCIDRGetterMockRecorder struct {
+	mock *MocksubnetCIDRGetter
+}
+
+// NewMocksubnetCIDRGetter creates a new mock instance.
+func NewMocksubnetCIDRGetter(ctrl *gomock.Controller) *MocksubnetCIDRGetter {
+	mock := &MocksubnetCIDRGetter{ctrl: ctrl}
+	mock.recorder = &MocksubnetCIDRGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksubnetCIDRGetter) EXPECT() *MocksubnetCIDRGetterMockRecorder {
+	return m.recorder
+}
+
+// SubnetCIDRBlocks mocks base method.
+func (m *MocksubnetCIDRGetter) SubnetCIDRBlocks(filters ...ec2.Filter) ([]string, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range filters {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SubnetCIDRBlocks", varargs...)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubnetCIDRBlocks indicates an expected call of SubnetCIDRBlocks.
+func (mr *MocksubnetCIDRGetterMockRecorder) SubnetCIDRBlocks(filters ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetCIDRBlocks", reflect.TypeOf((*MocksubnetCIDRGetter)(nil).SubnetCIDRBlocks), filters...)
+}
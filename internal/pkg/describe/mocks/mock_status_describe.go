@@ -10,6 +10,7 @@ import (
 	apprunner "github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	cloudwatch "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	cloudwatchlogs "github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	codedeploy "github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	elbv2 "github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	ecs0 "github.com/aws/copilot-cli/internal/pkg/ecs"
@@ -311,3 +312,41 @@ func (mr *MockautoscalingAlarmNamesGetterMockRecorder) ECSServiceAlarmNames(clus
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ECSServiceAlarmNames", reflect.TypeOf((*MockautoscalingAlarmNamesGetter)(nil).ECSServiceAlarmNames), cluster, service)
 }
+
+// MockblueGreenDeploymentGetter is a mock of blueGreenDeploymentGetter interface.
+type MockblueGreenDeploymentGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockblueGreenDeploymentGetterMockRecorder
+}
+
+// MockblueGreenDeploymentGetterMockRecorder is the mock recorder for MockblueGreenDeploymentGetter.
+type MockblueGreenDeploymentGetterMockRecorder struct {
+	mock *MockblueGreenDeploymentGetter
+}
+
+// NewMockblueGreenDeploymentGetter creates a new mock instance.
+func NewMockblueGreenDeploymentGetter(ctrl *gomock.Controller) *MockblueGreenDeploymentGetter {
+	mock := &MockblueGreenDeploymentGetter{ctrl: ctrl}
+	mock.recorder = &MockblueGreenDeploymentGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockblueGreenDeploymentGetter) EXPECT() *MockblueGreenDeploymentGetterMockRecorder {
+	return m.recorder
+}
+
+// LastDeployment mocks base method.
+func (m *MockblueGreenDeploymentGetter) LastDeployment(appName, groupName string) (*codedeploy.Deployment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastDeployment", appName, groupName)
+	ret0, _ := ret[0].(*codedeploy.Deployment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastDeployment indicates an expected call of LastDeployment.
+func (mr *MockblueGreenDeploymentGetterMockRecorder) LastDeployment(appName, groupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastDeployment", reflect.TypeOf((*MockblueGreenDeploymentGetter)(nil).LastDeployment), appName, groupName)
+}
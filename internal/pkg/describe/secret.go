@@ -0,0 +1,123 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// Secret contains the metadata of a secret in an environment.
+type Secret struct {
+	Name         string    `json:"name"`
+	Environment  string    `json:"environment"`
+	Backend      string    `json:"backend"`
+	ValueFrom    string    `json:"valueFrom"` // Name of the secret in its backend, as referenced from manifests.
+	LastModified time.Time `json:"lastModified"`
+
+	RotationLambdaARN string `json:"rotationLambda,omitempty"`
+	RotationDays      int64  `json:"rotationDays,omitempty"`
+}
+
+// Secrets contains the secrets of an application.
+type Secrets struct {
+	App     string    `json:"application"`
+	Secrets []*Secret `json:"secrets"`
+}
+
+// JSONString returns stringified Secrets struct with json format.
+func (s *Secrets) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal secrets: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns stringified Secrets struct with human readable format.
+func (s *Secrets) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	headers := []string{"Name", "Environment", "Backend", "Last Modified"}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "%s\n", strings.Join(underline(headers), "\t"))
+	for _, secret := range s.Secrets {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", secret.Name, secret.Environment, secret.Backend, humanizeTime(secret.LastModified))
+	}
+	writer.Flush()
+	return b.String()
+}
+
+// SecretReference is an environment variable of a workload that is set from a secret.
+type SecretReference struct {
+	Workload    string `json:"workload"`
+	Environment string `json:"environment"`
+	Container   string `json:"container"`
+	Variable    string `json:"variable"`
+}
+
+// SecretDescription contains the metadata of a secret across the environments of an application,
+// and the workloads that reference it.
+type SecretDescription struct {
+	Name         string             `json:"name"`
+	App          string             `json:"application"`
+	Environments []*Secret          `json:"environments"`
+	References   []*SecretReference `json:"references"`
+}
+
+// JSONString returns stringified SecretDescription struct with json format.
+func (s *SecretDescription) JSONString() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", fmt.Errorf("marshal secret description: %w", err)
+	}
+	return fmt.Sprintf("%s\n", b), nil
+}
+
+// HumanString returns stringified SecretDescription struct with human readable format.
+func (s *SecretDescription) HumanString() string {
+	var b bytes.Buffer
+	writer := tabwriter.NewWriter(&b, minCellWidth, tabWidth, cellPaddingWidth, paddingChar, noAdditionalFormatting)
+	fmt.Fprint(writer, color.Bold.Sprint("About\n\n"))
+	writer.Flush()
+	fmt.Fprintf(writer, "  %s\t%s\n", "Name", s.Name)
+	fmt.Fprintf(writer, "  %s\t%s\n", "Application", s.App)
+	fmt.Fprint(writer, color.Bold.Sprint("\nEnvironments\n\n"))
+	writer.Flush()
+	headers := []string{"Environment", "Backend", "Value From", "Last Modified", "Rotation"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, secret := range s.Environments {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\t%s\n", secret.Environment, secret.Backend, secret.ValueFrom, humanizeTime(secret.LastModified), secret.rotation())
+	}
+	writer.Flush()
+	fmt.Fprint(writer, color.Bold.Sprint("\nReferences\n\n"))
+	writer.Flush()
+	if len(s.References) == 0 {
+		fmt.Fprintf(writer, "  %s\n", "No workload in the workspace references this secret.")
+		writer.Flush()
+		return b.String()
+	}
+	headers = []string{"Workload", "Environment", "Container", "Variable"}
+	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
+	fmt.Fprintf(writer, "  %s\n", strings.Join(underline(headers), "\t"))
+	for _, ref := range s.References {
+		fmt.Fprintf(writer, "  %s\t%s\t%s\t%s\n", ref.Workload, ref.Environment, ref.Container, ref.Variable)
+	}
+	writer.Flush()
+	return b.String()
+}
+
+func (s *Secret) rotation() string {
+	if s.RotationLambdaARN == "" {
+		return "-"
+	}
+	return fmt.Sprintf("every %d days", s.RotationDays)
+}
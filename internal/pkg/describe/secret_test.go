@@ -0,0 +1,157 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package describe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecrets_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-06-19T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	lastModified, _ := time.Parse(time.RFC3339, "2020-06-18T22:00:00+00:00")
+	secrets := &Secrets{
+		App: "phonetool",
+		Secrets: []*Secret{
+			{
+				Name:         "db_password",
+				Environment:  "test",
+				Backend:      "ssm",
+				ValueFrom:    "/copilot/phonetool/test/secrets/db_password",
+				LastModified: lastModified,
+			},
+			{
+				Name:              "db_password",
+				Environment:       "prod",
+				Backend:           "secretsmanager",
+				ValueFrom:         "copilot/phonetool/prod/secrets/db_password",
+				LastModified:      lastModified,
+				RotationLambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+				RotationDays:      30,
+			},
+		},
+	}
+
+	human := secrets.HumanString()
+	json, err := secrets.JSONString()
+
+	require.NoError(t, err)
+	require.Equal(t, `Name                Environment         Backend             Last Modified
+----                -----------         -------             -------------
+db_password         test                ssm                 2 hours ago
+db_password         prod                secretsmanager      2 hours ago
+`, human)
+	require.Equal(t, "{\"application\":\"phonetool\",\"secrets\":[{\"name\":\"db_password\",\"environment\":\"test\",\"backend\":\"ssm\",\"valueFrom\":\"/copilot/phonetool/test/secrets/db_password\",\"lastModified\":\"2020-06-18T22:00:00Z\"},{\"name\":\"db_password\",\"environment\":\"prod\",\"backend\":\"secretsmanager\",\"valueFrom\":\"copilot/phonetool/prod/secrets/db_password\",\"lastModified\":\"2020-06-18T22:00:00Z\",\"rotationLambda\":\"arn:aws:lambda:us-west-2:123456789012:function:rotate\",\"rotationDays\":30}]}\n", json)
+}
+
+func TestSecretDescription_String(t *testing.T) {
+	oldHumanize := humanizeTime
+	humanizeTime = func(then time.Time) string {
+		now, _ := time.Parse(time.RFC3339, "2020-06-19T00:00:00+00:00")
+		return humanize.RelTime(then, now, "ago", "from now")
+	}
+	defer func() {
+		humanizeTime = oldHumanize
+	}()
+	lastModified, _ := time.Parse(time.RFC3339, "2020-06-18T22:00:00+00:00")
+	testCases := map[string]struct {
+		inReferences []*SecretReference
+
+		wantedHuman string
+		wantedJSON  string
+	}{
+		"secret referenced by workloads": {
+			inReferences: []*SecretReference{
+				{
+					Workload:    "frontend",
+					Environment: "test",
+					Container:   "frontend",
+					Variable:    "DB_PASSWORD",
+				},
+			},
+			wantedHuman: `About
+
+  Name              db_password
+  Application       phonetool
+
+Environments
+
+  Environment       Backend             Value From                                   Last Modified       Rotation
+  -----------       -------             ----------                                   -------------       --------
+  test              ssm                 /copilot/phonetool/test/secrets/db_password  2 hours ago         -
+  prod              secretsmanager      copilot/phonetool/prod/secrets/db_password   2 hours ago         every 30 days
+
+References
+
+  Workload          Environment         Container           Variable
+  --------          -----------         ---------           --------
+  frontend          test                frontend            DB_PASSWORD
+`,
+			wantedJSON: "{\"name\":\"db_password\",\"application\":\"phonetool\",\"environments\":[{\"name\":\"db_password\",\"environment\":\"test\",\"backend\":\"ssm\",\"valueFrom\":\"/copilot/phonetool/test/secrets/db_password\",\"lastModified\":\"2020-06-18T22:00:00Z\"},{\"name\":\"db_password\",\"environment\":\"prod\",\"backend\":\"secretsmanager\",\"valueFrom\":\"copilot/phonetool/prod/secrets/db_password\",\"lastModified\":\"2020-06-18T22:00:00Z\",\"rotationLambda\":\"arn:aws:lambda:us-west-2:123456789012:function:rotate\",\"rotationDays\":30}],\"references\":[{\"workload\":\"frontend\",\"environment\":\"test\",\"container\":\"frontend\",\"variable\":\"DB_PASSWORD\"}]}\n",
+		},
+		"secret not referenced by any workload": {
+			wantedHuman: `About
+
+  Name              db_password
+  Application       phonetool
+
+Environments
+
+  Environment       Backend             Value From                                   Last Modified       Rotation
+  -----------       -------             ----------                                   -------------       --------
+  test              ssm                 /copilot/phonetool/test/secrets/db_password  2 hours ago         -
+  prod              secretsmanager      copilot/phonetool/prod/secrets/db_password   2 hours ago         every 30 days
+
+References
+
+  No workload in the workspace references this secret.
+`,
+			wantedJSON: "{\"name\":\"db_password\",\"application\":\"phonetool\",\"environments\":[{\"name\":\"db_password\",\"environment\":\"test\",\"backend\":\"ssm\",\"valueFrom\":\"/copilot/phonetool/test/secrets/db_password\",\"lastModified\":\"2020-06-18T22:00:00Z\"},{\"name\":\"db_password\",\"environment\":\"prod\",\"backend\":\"secretsmanager\",\"valueFrom\":\"copilot/phonetool/prod/secrets/db_password\",\"lastModified\":\"2020-06-18T22:00:00Z\",\"rotationLambda\":\"arn:aws:lambda:us-west-2:123456789012:function:rotate\",\"rotationDays\":30}],\"references\":null}\n",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			secret := &SecretDescription{
+				Name: "db_password",
+				App:  "phonetool",
+				Environments: []*Secret{
+					{
+						Name:         "db_password",
+						Environment:  "test",
+						Backend:      "ssm",
+						ValueFrom:    "/copilot/phonetool/test/secrets/db_password",
+						LastModified: lastModified,
+					},
+					{
+						Name:              "db_password",
+						Environment:       "prod",
+						Backend:           "secretsmanager",
+						ValueFrom:         "copilot/phonetool/prod/secrets/db_password",
+						LastModified:      lastModified,
+						RotationLambdaARN: "arn:aws:lambda:us-west-2:123456789012:function:rotate",
+						RotationDays:      30,
+					},
+				},
+				References: tc.inReferences,
+			}
+
+			human := secret.HumanString()
+			json, err := secret.JSONString()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedHuman, human)
+			require.Equal(t, tc.wantedJSON, json)
+		})
+	}
+}
@@ -15,6 +15,7 @@ type cfn interface {
 	Describe(name string) (*cloudformation.StackDescription, error)
 	StackResources(name string) ([]*cloudformation.StackResource, error)
 	Metadata(opt cloudformation.MetadataOpts) (string, error)
+	TemplateBody(name string) (string, error)
 }
 
 // StackDescription is the description of a cloudformation stack.
@@ -101,6 +102,15 @@ func (d *StackDescriber) StackSetMetadata() (string, error) {
 	return metadata, nil
 }
 
+// Template returns the template body of the deployed stack.
+func (d *StackDescriber) Template() (string, error) {
+	body, err := d.cfn.TemplateBody(d.name)
+	if err != nil {
+		return "", fmt.Errorf("get template for stack %s: %w", d.name, err)
+	}
+	return body, nil
+}
+
 func flattenResources(stackResources []*cloudformation.StackResource) []*Resource {
 	var resources []*Resource
 	for _, stackResource := range stackResources {
@@ -226,3 +226,54 @@ func TestStackDescriber_Metadata(t *testing.T) {
 		})
 	}
 }
+
+func TestStackDescriber_Template(t *testing.T) {
+	const mockStackName = "phonetool"
+	testCases := map[string]struct {
+		setupMocks func(mocks stackDescriberMocks)
+
+		wantedTemplate string
+		wantedError    error
+	}{
+		"return error if fail to get the template": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().TemplateBody(mockStackName).Return("", errors.New("some error"))
+			},
+			wantedError: fmt.Errorf("get template for stack phonetool: some error"),
+		},
+		"success": {
+			setupMocks: func(m stackDescriberMocks) {
+				m.cfn.EXPECT().TemplateBody(mockStackName).Return("mockTemplate", nil)
+			},
+			wantedTemplate: "mockTemplate",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockcfn := mocks.NewMockcfn(ctrl)
+			tc.setupMocks(stackDescriberMocks{
+				cfn: mockcfn,
+			})
+
+			d := &StackDescriber{
+				name: mockStackName,
+				cfn:  mockcfn,
+			}
+
+			// WHEN
+			actual, err := d.Template()
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTemplate, actual)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/term/color"
@@ -28,6 +29,7 @@ import (
 
 const (
 	maxAlarmStatusColumnWidth = 30
+	maxBlueGreenReasonWidth   = 60
 	defaultServiceLogsLimit   = 10
 	shortTaskIDLength         = 8
 	summaryBarWidth           = 10
@@ -46,6 +48,7 @@ type ecsServiceStatus struct {
 	Alarms                   []cloudwatch.AlarmStatus `json:"alarms"`
 	StoppedTasks             []awsecs.TaskStatus      `json:"stoppedTasks"`
 	TargetHealthDescriptions []taskTargetHealth       `json:"targetHealthDescriptions"`
+	BlueGreenDeployment      *codedeploy.Deployment   `json:"blueGreenDeployment,omitempty"`
 }
 
 // appRunnerServiceStatus contains the status for an AppRunner service.
@@ -107,6 +110,13 @@ func (s *ecsServiceStatus) HumanString() string {
 	s.writeTaskSummary(writer)
 	writer.Flush()
 
+	if s.BlueGreenDeployment != nil {
+		fmt.Fprint(writer, color.Bold.Sprint("\nBlue/Green Deployment\n\n"))
+		writer.Flush()
+		s.writeBlueGreenDeployment(writer)
+		writer.Flush()
+	}
+
 	if len(s.StoppedTasks) > 0 {
 		fmt.Fprint(writer, color.Bold.Sprint("\nStopped Tasks\n\n"))
 		writer.Flush()
@@ -352,6 +362,35 @@ func (s *ecsServiceStatus) writeCapacityProvidersSummary(writer io.Writer) {
 	fmt.Fprintf(writer, "\t%s\n", strings.Join(cpSummaries, ", "))
 }
 
+func (s *ecsServiceStatus) writeBlueGreenDeployment(writer io.Writer) {
+	d := s.BlueGreenDeployment
+	fmt.Fprintf(writer, "  %s\t%s\t%s\n", "Status", d.Status, fmt.Sprintf("started %s", humanizeTime(d.CreatedAt)))
+	for _, ts := range d.TaskSets {
+		repColor := color.Blue
+		if ts.Label == codedeploy.TaskSetLabelGreen {
+			repColor = color.Green
+		}
+		weight := int(math.Round(ts.TrafficWeight))
+		data := []summarybar.Datum{
+			{
+				Value:          weight,
+				Representation: repColor.Sprint("█"),
+			},
+			{
+				Value:          100 - weight,
+				Representation: repColor.Sprint("░"),
+			},
+		}
+		renderer := summarybar.New(data, summaryBarWidthConfig, summaryBarEmptyRepConfig)
+		fmt.Fprintf(writer, "  %s\t", ts.Label)
+		_, _ = renderer.Render(writer)
+		fmt.Fprintf(writer, "\t%d%% of traffic, %d/%d running tasks\n", weight, ts.RunningCount, ts.DesiredCount)
+	}
+	if d.ErrorMessage != "" {
+		printWithMaxWidth(writer, "  %s\t\t%s\n", maxBlueGreenReasonWidth, "Reason", d.ErrorMessage)
+	}
+}
+
 func (s *ecsServiceStatus) writeStoppedTasks(writer io.Writer) {
 	headers := []string{"Reason", "Task Count", "Sample Task IDs"}
 	fmt.Fprintf(writer, "  %s\n", strings.Join(headers, "\t"))
@@ -426,7 +465,8 @@ func (s *ecsServiceStatus) writeAlarms(writer io.Writer) {
 type ecsTaskStatus awsecs.TaskStatus
 
 // Example output:
-//   6ca7a60d          RUNNING             42            19 hours ago       -              UNKNOWN
+//
+//	6ca7a60d          RUNNING             42            19 hours ago       -              UNKNOWN
 func (ts ecsTaskStatus) humanString(opts ...ecsTaskStatusConfigOpts) string {
 	config := &ecsTaskStatusConfig{}
 	for _, opt := range opts {
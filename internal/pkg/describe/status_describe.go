@@ -4,14 +4,17 @@
 package describe
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
+	ecsapi "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/aas"
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/aws/sessions"
@@ -19,7 +22,10 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/ecs"
 )
 
-const fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+const (
+	fmtAppRunnerSvcLogGroupName = "/aws/apprunner/%s/%s/service"
+	fmtBlueGreenDeploymentGroup = "%s-%s-%s" // The CodeDeploy application and deployment group share the same name.
+)
 
 type targetHealthGetter interface {
 	TargetsHealth(targetGroupARN string) ([]*elbv2.TargetHealth, error)
@@ -51,6 +57,10 @@ type autoscalingAlarmNamesGetter interface {
 	ECSServiceAlarmNames(cluster, service string) ([]string, error)
 }
 
+type blueGreenDeploymentGetter interface {
+	LastDeployment(appName, groupName string) (*codedeploy.Deployment, error)
+}
+
 type ecsStatusDescriber struct {
 	app string
 	env string
@@ -61,6 +71,7 @@ type ecsStatusDescriber struct {
 	cwSvcGetter        alarmStatusGetter
 	aasSvcGetter       autoscalingAlarmNamesGetter
 	targetHealthGetter targetHealthGetter
+	deploymentGetter   blueGreenDeploymentGetter
 }
 
 type appRunnerStatusDescriber struct {
@@ -99,6 +110,7 @@ func NewECSStatusDescriber(opt *NewServiceStatusConfig) (*ecsStatusDescriber, er
 		ecsSvcGetter:       awsecs.New(sess),
 		aasSvcGetter:       aas.New(sess),
 		targetHealthGetter: elbv2.New(sess),
+		deploymentGetter:   codedeploy.New(sess),
 	}, nil
 }
 
@@ -185,15 +197,39 @@ func (s *ecsStatusDescriber) Describe() (HumanJSONStringer, error) {
 		return tasksTargetHealth[i].TargetGroupARN < tasksTargetHealth[j].TargetGroupARN
 	})
 
+	var blueGreen *codedeploy.Deployment
+	if service.DeploymentController != nil && aws.StringValue(service.DeploymentController.Type) == ecsapi.DeploymentControllerTypeCodeDeploy {
+		blueGreen, err = s.lastBlueGreenDeployment()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &ecsServiceStatus{
 		Service:                  service.ServiceStatus(),
 		DesiredRunningTasks:      taskStatus,
 		Alarms:                   alarms,
 		StoppedTasks:             stoppedTaskStatus,
 		TargetHealthDescriptions: tasksTargetHealth,
+		BlueGreenDeployment:      blueGreen,
 	}, nil
 }
 
+// lastBlueGreenDeployment returns the latest CodeDeploy deployment of the service.
+// It returns nil if the service never went through a blue/green deployment.
+func (s *ecsStatusDescriber) lastBlueGreenDeployment() (*codedeploy.Deployment, error) {
+	name := fmt.Sprintf(fmtBlueGreenDeploymentGroup, s.app, s.env, s.svc)
+	deployment, err := s.deploymentGetter.LastDeployment(name, name)
+	if err != nil {
+		var errNotFound *codedeploy.ErrDeploymentGroupNotFound
+		if errors.As(err, &errNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get last blue/green deployment of service %s: %w", s.svc, err)
+	}
+	return deployment, nil
+}
+
 func (s *ecsStatusDescriber) ecsServiceAutoscalingAlarms(cluster, service string) ([]cloudwatch.AlarmStatus, error) {
 	alarmNames, err := s.aasSvcGetter.ECSServiceAlarmNames(cluster, service)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/describe/mocks"
@@ -31,6 +32,7 @@ type serviceStatusDescriberMocks struct {
 	aas                   *mocks.MockautoscalingAlarmNamesGetter
 	logGetter             *mocks.MocklogGetter
 	targetHealthGetter    *mocks.MocktargetHealthGetter
+	deploymentGetter      *mocks.MockblueGreenDeploymentGetter
 }
 
 func TestServiceStatus_Describe(t *testing.T) {
@@ -197,6 +199,130 @@ func TestServiceStatus_Describe(t *testing.T) {
 				//rendererConfigurer:       &barRendererConfigurer{},
 			},
 		},
+		"errors if failed to get the last blue/green deployment": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+						Deployments: []*ecsapi.Deployment{
+							{
+								UpdatedAt: aws.Time(startTime),
+							},
+						},
+						DeploymentController: &ecsapi.DeploymentController{
+							Type: aws.String("CODE_DEPLOY"),
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(gomock.Any(), gomock.Any()).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.deploymentGetter.EXPECT().LastDeployment("mockApp-mockEnv-mockSvc", "mockApp-mockEnv-mockSvc").Return(nil, mockError),
+				)
+			},
+
+			wantedError: fmt.Errorf("get last blue/green deployment of service mockSvc: some error"),
+		},
+		"do not error out if the blue/green deployment group does not exist": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+						Deployments: []*ecsapi.Deployment{
+							{
+								UpdatedAt: aws.Time(startTime),
+							},
+						},
+						DeploymentController: &ecsapi.DeploymentController{
+							Type: aws.String("CODE_DEPLOY"),
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(gomock.Any(), gomock.Any()).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.deploymentGetter.EXPECT().LastDeployment(gomock.Any(), gomock.Any()).Return(nil, &codedeploy.ErrDeploymentGroupNotFound{}),
+				)
+			},
+			wantedContent: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					Deployments: []awsecs.Deployment{
+						{
+							UpdatedAt: startTime,
+						},
+					},
+					LastDeploymentAt: startTime,
+				},
+				DesiredRunningTasks: []awsecs.TaskStatus{
+					{
+						ID:        "1234567890123456789",
+						StartedAt: startTime,
+					},
+				},
+			},
+		},
+		"retrieve the last blue/green deployment of a service": {
+			setupMocks: func(m serviceStatusDescriberMocks) {
+				gomock.InOrder(
+					m.serviceDescriber.EXPECT().DescribeService("mockApp", "mockEnv", "mockSvc").Return(mockServiceDesc, nil),
+					m.ecsServiceGetter.EXPECT().Service(mockCluster, mockService).Return(&awsecs.Service{
+						Deployments: []*ecsapi.Deployment{
+							{
+								UpdatedAt: aws.Time(startTime),
+							},
+						},
+						DeploymentController: &ecsapi.DeploymentController{
+							Type: aws.String("CODE_DEPLOY"),
+						},
+					}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmsWithTags(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.aas.EXPECT().ECSServiceAlarmNames(gomock.Any(), gomock.Any()).Return([]string{}, nil),
+					m.alarmStatusGetter.EXPECT().AlarmStatus(gomock.Any()).Return([]cloudwatch.AlarmStatus{}, nil),
+					m.deploymentGetter.EXPECT().LastDeployment("mockApp-mockEnv-mockSvc", "mockApp-mockEnv-mockSvc").Return(&codedeploy.Deployment{
+						ID:     "d-1234",
+						Status: "InProgress",
+						TaskSets: []codedeploy.TaskSet{
+							{
+								Label:         "Blue",
+								TrafficWeight: 90,
+							},
+							{
+								Label:         "Green",
+								TrafficWeight: 10,
+							},
+						},
+					}, nil),
+				)
+			},
+			wantedContent: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					Deployments: []awsecs.Deployment{
+						{
+							UpdatedAt: startTime,
+						},
+					},
+					LastDeploymentAt: startTime,
+				},
+				DesiredRunningTasks: []awsecs.TaskStatus{
+					{
+						ID:        "1234567890123456789",
+						StartedAt: startTime,
+					},
+				},
+				BlueGreenDeployment: &codedeploy.Deployment{
+					ID:     "d-1234",
+					Status: "InProgress",
+					TaskSets: []codedeploy.TaskSet{
+						{
+							Label:         "Blue",
+							TrafficWeight: 90,
+						},
+						{
+							Label:         "Green",
+							TrafficWeight: 10,
+						},
+					},
+				},
+			},
+		},
 		"retrieve all target health information in service": {
 			setupMocks: func(m serviceStatusDescriberMocks) {
 				gomock.InOrder(
@@ -494,12 +620,14 @@ func TestServiceStatus_Describe(t *testing.T) {
 			mockSvcDescriber := mocks.NewMockserviceDescriber(ctrl)
 			mockaasClient := mocks.NewMockautoscalingAlarmNamesGetter(ctrl)
 			mockTargetHealthGetter := mocks.NewMocktargetHealthGetter(ctrl)
+			mockDeploymentGetter := mocks.NewMockblueGreenDeploymentGetter(ctrl)
 			mocks := serviceStatusDescriberMocks{
 				ecsServiceGetter:   mockecsSvc,
 				alarmStatusGetter:  mockcwSvc,
 				serviceDescriber:   mockSvcDescriber,
 				aas:                mockaasClient,
 				targetHealthGetter: mockTargetHealthGetter,
+				deploymentGetter:   mockDeploymentGetter,
 			}
 
 			tc.setupMocks(mocks)
@@ -513,6 +641,7 @@ func TestServiceStatus_Describe(t *testing.T) {
 				svcDescriber:       mockSvcDescriber,
 				aasSvcGetter:       mockaasClient,
 				targetHealthGetter: mockTargetHealthGetter,
+				deploymentGetter:   mockDeploymentGetter,
 			}
 
 			// WHEN
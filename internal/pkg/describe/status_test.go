@@ -10,6 +10,7 @@ import (
 	"github.com/aws/copilot-cli/internal/pkg/aws/apprunner"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatch"
 	"github.com/aws/copilot-cli/internal/pkg/aws/cloudwatchlogs"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
 	awsecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/elbv2"
 	"github.com/aws/copilot-cli/internal/pkg/term/progress"
@@ -535,6 +536,58 @@ Tasks
   Running   ░░░░░░░░░░  0/0 desired tasks are running
 `,
 			json: `{"Service":{"desiredCount":0,"runningCount":0,"status":"ACTIVE","deployments":[{"id":"id-4","desiredCount":0,"runningCount":0,"updatedAt":"0001-01-01T00:00:00Z","launchType":"","taskDefinition":"arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6","status":"PRIMARY"}],"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":[],"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null}
+`,
+		},
+		"while shifting traffic during a blue/green deployment": {
+			desc: &ecsServiceStatus{
+				Service: awsecs.ServiceStatus{
+					DesiredCount: 2,
+					RunningCount: 2,
+					Status:       "ACTIVE",
+					Deployments: []awsecs.Deployment{
+						{
+							Id:             "id-4",
+							DesiredCount:   2,
+							RunningCount:   2,
+							Status:         "PRIMARY",
+							TaskDefinition: "arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6",
+						},
+					},
+				},
+				BlueGreenDeployment: &codedeploy.Deployment{
+					ID:        "d-1234",
+					Status:    "InProgress",
+					CreatedAt: updateTime,
+					TaskSets: []codedeploy.TaskSet{
+						{
+							Label:         "Blue",
+							Status:        "PRIMARY",
+							TrafficWeight: 70,
+							DesiredCount:  2,
+							RunningCount:  2,
+						},
+						{
+							Label:         "Green",
+							Status:        "ACTIVE",
+							TrafficWeight: 30,
+							DesiredCount:  2,
+							RunningCount:  1,
+							PendingCount:  1,
+						},
+					},
+				},
+			},
+			human: `Task Summary
+
+  Running   ██████████  2/2 desired tasks are running
+
+Blue/Green Deployment
+
+  Status    InProgress  started 2 months from now
+  Blue      ███████░░░  70% of traffic, 2/2 running tasks
+  Green     ███░░░░░░░  30% of traffic, 1/2 running tasks
+`,
+			json: `{"Service":{"desiredCount":2,"runningCount":2,"status":"ACTIVE","deployments":[{"id":"id-4","desiredCount":2,"runningCount":2,"updatedAt":"0001-01-01T00:00:00Z","launchType":"","taskDefinition":"arn:aws:ecs:us-east-1:000000000000:task-definition/some-task-def:6","status":"PRIMARY"}],"lastDeploymentAt":"0001-01-01T00:00:00Z","taskDefinition":""},"tasks":null,"alarms":null,"stoppedTasks":null,"targetHealthDescriptions":null,"blueGreenDeployment":{"id":"d-1234","status":"InProgress","createdAt":"2020-03-13T19:50:30Z","taskSets":[{"label":"Blue","status":"PRIMARY","trafficWeight":70,"desiredCount":2,"runningCount":2,"pendingCount":0},{"label":"Green","status":"ACTIVE","trafficWeight":30,"desiredCount":2,"runningCount":1,"pendingCount":1}]}}
 `,
 		},
 	}
@@ -6,6 +6,7 @@ package describe
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/aws/copilot-cli/internal/pkg/deploy/cloudformation/stack"
 	"github.com/aws/copilot-cli/internal/pkg/manifest"
@@ -68,12 +69,25 @@ func (d *LBWebServiceDescriber) URI(envName string) (string, error) {
 		DNSNames: []string{envOutputs[envOutputPublicLoadBalancerDNSName]},
 		Path:     svcParams[stack.LBWebServiceRulePathParamKey],
 	}
+	if isInternalALBWorkload(envParams, d.svc) {
+		// Services behind the internal load balancer are only reachable over HTTP from within the VPC.
+		uri.DNSNames = []string{envOutputs[stack.EnvOutputInternalLoadBalancerDNSName]}
+		d.svcParams = svcParams
+		return uri.String(), nil
+	}
 	_, isHTTPS := envOutputs[envOutputSubdomain]
+	_, hasHTTPSListener := envOutputs[envOutputHTTPSListenerArn]
+	// Without a subdomain, the HTTPS listener serves imported certificates and the aliases aren't managed by Copilot.
+	hasImportedCerts := !isHTTPS && hasHTTPSListener
 	if isHTTPS {
 		dnsName := fmt.Sprintf("%s.%s", d.svc, envOutputs[envOutputSubdomain])
 		uri.DNSNames = []string{dnsName}
 		uri.HTTPS = true
 	}
+	if hasImportedCerts {
+		uri.HTTPS = true
+	}
+	d.aliasRecords = nil
 	aliases := envParams[stack.EnvParamAliasesKey]
 	if aliases != "" {
 		value := make(map[string][]string)
@@ -82,12 +96,36 @@ func (d *LBWebServiceDescriber) URI(envName string) (string, error) {
 		}
 		if value[d.svc] != nil {
 			uri.DNSNames = value[d.svc]
+			if hasImportedCerts {
+				d.aliasRecords = cnameRecords(envName, value[d.svc], envOutputs[envOutputPublicLoadBalancerDNSName])
+			}
 		}
 	}
 	d.svcParams = svcParams
 	return uri.String(), nil
 }
 
+func cnameRecords(env string, aliases []string, target string) []*CNAMERecord {
+	var records []*CNAMERecord
+	for _, alias := range aliases {
+		records = append(records, &CNAMERecord{
+			Environment: env,
+			Alias:       alias,
+			Target:      target,
+		})
+	}
+	return records
+}
+
+func isInternalALBWorkload(envParams map[string]string, svc string) bool {
+	for _, wkld := range strings.Split(envParams[stack.EnvParamInternalALBWorkloadsKey], ",") {
+		if wkld == svc {
+			return true
+		}
+	}
+	return false
+}
+
 // URI returns the service discovery namespace and is used to make
 // BackendServiceDescriber have the same signature as WebServiceDescriber.
 func (d *BackendServiceDescriber) URI(envName string) (string, error) {
@@ -27,8 +27,9 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 	testCases := map[string]struct {
 		setupMocks func(mocks lbWebSvcDescriberMocks)
 
-		wantedURI   string
-		wantedError error
+		wantedURI          string
+		wantedAliasRecords []*CNAMERecord
+		wantedError        error
 	}{
 		"fail to get parameters of environment stack": {
 			setupMocks: func(m lbWebSvcDescriberMocks) {
@@ -109,6 +110,55 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 
 			wantedURI: "https://example.com or https://v1.example.com",
 		},
+		"with alias in an environment with imported certificates": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{
+						stack.EnvParamAliasesKey: `{"jobs": ["example.com", "v1.example.com"]}`,
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName: testEnvLBDNSName,
+						envOutputHTTPSListenerArn:          "mockListenerArn",
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: testSvcPath,
+					}, nil),
+				)
+			},
+
+			wantedURI: "https://example.com or https://v1.example.com",
+			wantedAliasRecords: []*CNAMERecord{
+				{
+					Environment: testEnv,
+					Alias:       "example.com",
+					Target:      testEnvLBDNSName,
+				},
+				{
+					Environment: testEnv,
+					Alias:       "v1.example.com",
+					Target:      testEnvLBDNSName,
+				},
+			},
+		},
+		"internal web service": {
+			setupMocks: func(m lbWebSvcDescriberMocks) {
+				gomock.InOrder(
+					m.envDescriber.EXPECT().Params().Return(map[string]string{
+						stack.EnvParamInternalALBWorkloadsKey: "api,jobs",
+					}, nil),
+					m.envDescriber.EXPECT().Outputs().Return(map[string]string{
+						envOutputPublicLoadBalancerDNSName:         testEnvLBDNSName,
+						envOutputSubdomain:                         testEnvSubdomain,
+						stack.EnvOutputInternalLoadBalancerDNSName: "internal-abc.us-west-1.elb.amazonaws.com",
+					}, nil),
+					m.ecsStackDescriber.EXPECT().Params().Return(map[string]string{
+						stack.LBWebServiceRulePathParamKey: "jobs",
+					}, nil),
+				)
+			},
+
+			wantedURI: "http://internal-abc.us-west-1.elb.amazonaws.com/jobs",
+		},
 	}
 
 	for name, tc := range testCases {
@@ -150,6 +200,7 @@ func TestLBWebServiceDescriber_URI(t *testing.T) {
 			} else {
 				require.NoError(t, err)
 				require.Equal(t, tc.wantedURI, actual)
+				require.Equal(t, tc.wantedAliasRecords, d.aliasRecords)
 			}
 		})
 	}
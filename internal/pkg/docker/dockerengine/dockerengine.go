@@ -8,12 +8,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	osexec "os/exec"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/exec"
@@ -29,6 +32,7 @@ type Cmd interface {
 const (
 	LinuxOS   = "linux"
 	Amd64Arch = "amd64"
+	Arm64Arch = "arm64"
 )
 
 const (
@@ -53,14 +57,16 @@ func New(cmd Cmd) CmdClient {
 
 // BuildArguments holds the arguments that can be passed while building a container.
 type BuildArguments struct {
-	URI        string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
-	Tags       []string          // Optional. List of tags to apply to the image besides "latest".
-	Dockerfile string            // Required. Dockerfile to pass to `docker build` via --file flag.
-	Context    string            // Optional. Build context directory to pass to `docker build`.
-	Target     string            // Optional. The target build stage to pass to `docker build`.
-	CacheFrom  []string          // Optional. Images to consider as cache sources to pass to `docker build`
-	Platform   string            // Optional. OS/Arch to pass to `docker build`.
-	Args       map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
+	URI           string            // Required. Location of ECR Repo. Used to generate image name in conjunction with tag.
+	Tags          []string          // Optional. List of tags to apply to the image besides "latest".
+	ExcludeLatest bool              // Optional. Don't tag the image with "latest", required if the repository's tags are immutable.
+	Dockerfile    string            // Required. Dockerfile to pass to `docker build` via --file flag.
+	Context       string            // Optional. Build context directory to pass to `docker build`.
+	Target        string            // Optional. The target build stage to pass to `docker build`.
+	CacheFrom     []string          // Optional. Images to consider as cache sources to pass to `docker build`
+	Platform      string            // Optional. OS/Arch to pass to `docker build`.
+	Platforms     []string          // Optional. OS/Arch pairs to build a multi-architecture image for with `docker buildx build`.
+	Args          map[string]string // Optional. Build args to pass via `--build-arg` flags. Equivalent to ARG directives in dockerfile.
 }
 
 type dockerConfig struct {
@@ -78,7 +84,9 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	args := []string{"build"}
 
 	// Add additional image tags to the docker build call.
-	args = append(args, "-t", in.URI)
+	if !in.ExcludeLatest {
+		args = append(args, "-t", in.URI)
+	}
 	for _, tag := range in.Tags {
 		args = append(args, "-t", imageName(in.URI, tag))
 	}
@@ -122,6 +130,62 @@ func (c CmdClient) Build(in *BuildArguments) error {
 	return nil
 }
 
+// BuildAndPushManifestList will run a `docker buildx build` command that builds the image for each of the platforms
+// and pushes the images along with a manifest list referencing them. It returns the digest of the manifest list on success.
+// The client must be logged in to the repository beforehand since the images are pushed while they are built.
+func (c CmdClient) BuildAndPushManifestList(in *BuildArguments) (digest string, err error) {
+	dfDir := in.Context
+	if dfDir == "" { // Context wasn't specified use the Dockerfile's directory as context.
+		dfDir = filepath.Dir(in.Dockerfile)
+	}
+
+	args := []string{"buildx", "build", "--platform", strings.Join(in.Platforms, ","), "--push"}
+	if !in.ExcludeLatest {
+		args = append(args, "-t", in.URI)
+	}
+	for _, tag := range in.Tags {
+		args = append(args, "-t", imageName(in.URI, tag))
+	}
+	for _, imageFrom := range in.CacheFrom {
+		args = append(args, "--cache-from", imageFrom)
+	}
+	if len(in.CacheFrom) != 0 {
+		// Embed the cache metadata in the pushed images so that they can be used as cache sources by the next build.
+		args = append(args, "--cache-to", "type=inline")
+	}
+	if in.Target != "" {
+		args = append(args, "--target", in.Target)
+	}
+	for _, k := range sortedKeys(in.Args) {
+		args = append(args, "--build-arg", fmt.Sprintf("%s=%s", k, in.Args[k]))
+	}
+	args = append(args, dfDir, "-f", in.Dockerfile)
+	log.Infof("Building your multi-architecture container image: docker %s\n", strings.Join(args, " "))
+	if err := c.runner.Run("docker", args); err != nil {
+		return "", fmt.Errorf("building and pushing multi-architecture image: %w", err)
+	}
+
+	// Inspect a tagged image if possible in case "latest" was overwritten by another build in the meantime.
+	img := in.URI
+	if len(in.Tags) != 0 {
+		img = imageName(in.URI, in.Tags[0])
+	}
+	buf := new(strings.Builder)
+	if err := c.runner.Run("docker", []string{"buildx", "imagetools", "inspect", img, "--format", "{{json .Manifest}}"}, exec.Stdout(buf)); err != nil {
+		return "", fmt.Errorf("inspect manifest list of %s: %w", img, err)
+	}
+	var manifestList struct {
+		Digest string `json:"digest"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(buf.String())), &manifestList); err != nil {
+		return "", fmt.Errorf("unmarshal manifest list of %s: %w", img, err)
+	}
+	if manifestList.Digest == "" {
+		return "", fmt.Errorf("manifest list of %s does not have a digest", img)
+	}
+	return manifestList.Digest, nil
+}
+
 // Login will run a `docker login` command against the Service repository URI with the input uri and auth data.
 func (c CmdClient) Login(uri, username, password string) error {
 	err := c.runner.Run("docker",
@@ -159,6 +223,135 @@ func (c CmdClient) Push(uri string, tags ...string) (digest string, err error) {
 	return parts[1], nil
 }
 
+// HealthCheck holds the health check configuration of a container.
+type HealthCheck struct {
+	Command     string        // Required. The command run inside the container to check its health.
+	Interval    time.Duration // Optional. Time between two checks.
+	Retries     int           // Optional. Consecutive failures needed to report the container as unhealthy.
+	Timeout     time.Duration // Optional. Maximum time for a single check to run.
+	StartPeriod time.Duration // Optional. Time for the container to bootstrap before failures are counted.
+}
+
+// RunOptions holds the options for running a container with `docker run`.
+type RunOptions struct {
+	ImageURI      string            // Required. The image to run.
+	ContainerName string            // Required. The name of the container.
+	NetworkName   string            // Optional. The user-defined network to connect the container to.
+	NetworkAlias  string            // Optional. The alias of the container on the network. Defaults to the container name.
+	EnvVars       map[string]string // Optional. Environment variables to set in the container.
+	Secrets       map[string]string // Optional. Environment variables whose values are kept out of the docker command line.
+	Ports         map[string]string // Optional. Host ports to publish, keyed by container port.
+	EntryPoint    []string          // Optional. Overrides the ENTRYPOINT of the image.
+	Command       []string          // Optional. Overrides the CMD of the image.
+	HealthCheck   *HealthCheck      // Optional. Overrides the HEALTHCHECK of the image.
+	LogDriver     string            // Optional. The logging driver of the container.
+	LogOptions    map[string]string // Optional. Options of the logging driver.
+	Stdout        io.Writer         // Optional. Where to write the standard output of the container.
+	Stderr        io.Writer         // Optional. Where to write the standard error of the container.
+}
+
+// Run runs a container with `docker run` and blocks until the container exits.
+// The container is removed once it exits.
+func (c CmdClient) Run(in *RunOptions) error {
+	args := []string{"run", "--rm", "--name", in.ContainerName}
+	if in.NetworkName != "" {
+		alias := in.ContainerName
+		if in.NetworkAlias != "" {
+			alias = in.NetworkAlias
+		}
+		args = append(args, "--network", in.NetworkName, "--network-alias", alias)
+	}
+	for _, port := range sortedKeys(in.Ports) {
+		args = append(args, "--publish", fmt.Sprintf("%s:%s", in.Ports[port], port))
+	}
+	for _, name := range sortedKeys(in.EnvVars) {
+		args = append(args, "--env", fmt.Sprintf("%s=%s", name, in.EnvVars[name]))
+	}
+	// Secrets are read by docker from its own environment so that their values don't show up in the process list.
+	var secrets []string
+	for _, name := range sortedKeys(in.Secrets) {
+		args = append(args, "--env", name)
+		secrets = append(secrets, fmt.Sprintf("%s=%s", name, in.Secrets[name]))
+	}
+	if hc := in.HealthCheck; hc != nil {
+		args = append(args, "--health-cmd", hc.Command)
+		if hc.Interval != 0 {
+			args = append(args, "--health-interval", hc.Interval.String())
+		}
+		if hc.Retries != 0 {
+			args = append(args, "--health-retries", strconv.Itoa(hc.Retries))
+		}
+		if hc.Timeout != 0 {
+			args = append(args, "--health-timeout", hc.Timeout.String())
+		}
+		if hc.StartPeriod != 0 {
+			args = append(args, "--health-start-period", hc.StartPeriod.String())
+		}
+	}
+	if in.LogDriver != "" {
+		args = append(args, "--log-driver", in.LogDriver)
+	}
+	for _, name := range sortedKeys(in.LogOptions) {
+		args = append(args, "--log-opt", fmt.Sprintf("%s=%s", name, in.LogOptions[name]))
+	}
+	// docker only accepts the executable in the --entrypoint flag, the rest of the entrypoint is prepended to the command.
+	var cmdArgs []string
+	if len(in.EntryPoint) != 0 {
+		args = append(args, "--entrypoint", in.EntryPoint[0])
+		cmdArgs = append(cmdArgs, in.EntryPoint[1:]...)
+	}
+	args = append(args, in.ImageURI)
+	args = append(args, append(cmdArgs, in.Command...)...)
+
+	var opts []exec.CmdOption
+	if in.Stdout != nil {
+		opts = append(opts, exec.Stdout(in.Stdout))
+	}
+	if in.Stderr != nil {
+		opts = append(opts, exec.Stderr(in.Stderr))
+	}
+	if len(secrets) != 0 {
+		opts = append(opts, exec.Env(secrets))
+	}
+	if err := c.runner.Run("docker", args, opts...); err != nil {
+		return fmt.Errorf("run container %s: %w", in.ContainerName, err)
+	}
+	return nil
+}
+
+// Stop stops a running container with `docker stop`.
+func (c CmdClient) Stop(containerName string) error {
+	if err := c.runner.Run("docker", []string{"stop", containerName}, exec.Stdout(ioutil.Discard)); err != nil {
+		return fmt.Errorf("stop container %s: %w", containerName, err)
+	}
+	return nil
+}
+
+// IsContainerHealthy returns true if the health check of a running container reports it as healthy.
+func (c CmdClient) IsContainerHealthy(containerName string) (bool, error) {
+	buf := &bytes.Buffer{}
+	if err := c.runner.Run("docker", []string{"inspect", "--format", "{{.State.Health.Status}}", containerName}, exec.Stdout(buf)); err != nil {
+		return false, fmt.Errorf("inspect health status of container %s: %w", containerName, err)
+	}
+	return strings.TrimSpace(buf.String()) == "healthy", nil
+}
+
+// CreateNetwork creates a user-defined bridge network with `docker network create`.
+func (c CmdClient) CreateNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "create", name}, exec.Stdout(ioutil.Discard)); err != nil {
+		return fmt.Errorf("create network %s: %w", name, err)
+	}
+	return nil
+}
+
+// RemoveNetwork removes a network with `docker network rm`.
+func (c CmdClient) RemoveNetwork(name string) error {
+	if err := c.runner.Run("docker", []string{"network", "rm", name}, exec.Stdout(ioutil.Discard)); err != nil {
+		return fmt.Errorf("remove network %s: %w", name, err)
+	}
+	return nil
+}
+
 // CheckDockerEngineRunning will run `docker info` command to check if the docker engine is running.
 func (c CmdClient) CheckDockerEngineRunning() error {
 	if _, err := osexec.LookPath("docker"); err != nil {
@@ -215,6 +408,16 @@ func DockerBuildPlatform(os, arch string) string {
 	return fmt.Sprintf("%s/%s", os, arch)
 }
 
+// sortedKeys returns the keys of the map in order for test stability.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func imageName(uri, tag string) string {
 	if tag == "" {
 		return uri // If no tag is specified build with latest.
@@ -10,6 +10,7 @@ import (
 	osexec "os/exec"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/copilot-cli/internal/pkg/exec"
 
@@ -33,13 +34,14 @@ func TestDockerCommand_Build(t *testing.T) {
 	var mockCmd *MockCmd
 
 	tests := map[string]struct {
-		path       string
-		context    string
-		tags       []string
-		args       map[string]string
-		target     string
-		cacheFrom  []string
-		setupMocks func(controller *gomock.Controller)
+		path          string
+		context       string
+		tags          []string
+		excludeLatest bool
+		args          map[string]string
+		target        string
+		cacheFrom     []string
+		setupMocks    func(controller *gomock.Controller)
 
 		wantedError error
 	}{
@@ -69,6 +71,17 @@ func TestDockerCommand_Build(t *testing.T) {
 					"-f", "mockPath/to/mockDockerfile"}).Return(nil)
 			},
 		},
+		"should not tag the image with latest if excluded": {
+			path:          mockPath,
+			tags:          []string{mockTag1},
+			excludeLatest: true,
+			setupMocks: func(controller *gomock.Controller) {
+				mockCmd = NewMockCmd(controller)
+				mockCmd.EXPECT().Run("docker", []string{"build",
+					"-t", "mockURI:tag1", "mockPath/to",
+					"-f", "mockPath/to/mockDockerfile"}).Return(nil)
+			},
+		},
 		"context differs from path": {
 			path:    mockPath,
 			context: mockContext,
@@ -156,6 +169,8 @@ func TestDockerCommand_Build(t *testing.T) {
 				Target:     tc.target,
 				CacheFrom:  tc.cacheFrom,
 				Tags:       tc.tags,
+
+				ExcludeLatest: tc.excludeLatest,
 			}
 			got := s.Build(&buildInput)
 
@@ -298,6 +313,257 @@ func TestDockerCommand_Push(t *testing.T) {
 	})
 }
 
+func TestDockerCommand_BuildAndPushManifestList(t *testing.T) {
+	const mockURI = "aws_account_id.dkr.ecr.region.amazonaws.com/my-web-app"
+	testCases := map[string]struct {
+		in         *BuildArguments
+		setupMocks func(m *MockCmd)
+
+		wantedDigest string
+		wantedErr    error
+	}{
+		"builds and pushes the images for every platform with buildx": {
+			in: &BuildArguments{
+				URI:        mockURI,
+				Tags:       []string{"g123bfc"},
+				Dockerfile: "mockPath/to/mockDockerfile",
+				CacheFrom:  []string{"foo/bar:latest"},
+				Target:     "build-stage",
+				Args:       map[string]string{"GOPROXY": "direct"},
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+			},
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "build",
+					"--platform", "linux/amd64,linux/arm64", "--push",
+					"-t", mockURI,
+					"-t", mockURI + ":g123bfc",
+					"--cache-from", "foo/bar:latest",
+					"--cache-to", "type=inline",
+					"--target", "build-stage",
+					"--build-arg", "GOPROXY=direct",
+					"mockPath/to", "-f", "mockPath/to/mockDockerfile"}).Return(nil)
+				m.EXPECT().Run("docker", []string{"buildx", "imagetools", "inspect", mockURI + ":g123bfc", "--format", "{{json .Manifest}}"}, gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte(`{"mediaType":"application/vnd.docker.distribution.manifest.list.v2+json","digest":"sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807","size":743}` + "\n"))
+					}).Return(nil)
+			},
+			wantedDigest: "sha256:f1d4ae3f7261a72e98c6ebefe9985cf10a0ea5bd762585a43e0700ed99863807",
+		},
+		"returns a wrapped error if the build fails": {
+			in: &BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "Dockerfile",
+				Context:    "ctx",
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+			},
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", []string{"buildx", "build",
+					"--platform", "linux/amd64,linux/arm64", "--push",
+					"-t", mockURI,
+					"ctx", "-f", "Dockerfile"}).Return(errors.New("some error"))
+			},
+			wantedErr: errors.New("building and pushing multi-architecture image: some error"),
+		},
+		"returns an error if the manifest list has no digest": {
+			in: &BuildArguments{
+				URI:        mockURI,
+				Dockerfile: "Dockerfile",
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+			},
+			setupMocks: func(m *MockCmd) {
+				m.EXPECT().Run("docker", gomock.Any()).Return(nil)
+				m.EXPECT().Run("docker", []string{"buildx", "imagetools", "inspect", mockURI, "--format", "{{json .Manifest}}"}, gomock.Any()).
+					Do(func(_ string, _ []string, opt exec.CmdOption) {
+						cmd := &osexec.Cmd{}
+						opt(cmd)
+						_, _ = cmd.Stdout.Write([]byte(`{}`))
+					}).Return(nil)
+			},
+			wantedErr: fmt.Errorf("manifest list of %s does not have a digest", mockURI),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			tc.setupMocks(m)
+			cmd := CmdClient{
+				runner: m,
+			}
+
+			// WHEN
+			digest, err := cmd.BuildAndPushManifestList(tc.in)
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
+
+func TestDockerCommand_Run(t *testing.T) {
+	t.Run("runs a container with all the options", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"run", "--rm", "--name", "phonetool-test-frontend-frontend",
+			"--network", "my-network", "--network-alias", "frontend",
+			"--publish", "8080:8080",
+			"--env", "LOG_LEVEL=debug", "--env", "PORT=8080",
+			"--env", "DB_PASSWORD",
+			"--health-cmd", "curl -f http://localhost:8080", "--health-interval", "10s", "--health-retries", "2",
+			"--log-driver", "fluentd", "--log-opt", "fluentd-async=true",
+			"--entrypoint", "/bin/sh", "nginx", "-c", "echo", "hello"}, gomock.Any()).
+			Do(func(_ string, _ []string, opts ...exec.CmdOption) {
+				cmd := &osexec.Cmd{}
+				for _, opt := range opts {
+					opt(cmd)
+				}
+				require.Contains(t, cmd.Env, "DB_PASSWORD=hunter2")
+			}).Return(nil)
+		cmd := CmdClient{
+			runner: m,
+		}
+
+		// WHEN
+		err := cmd.Run(&RunOptions{
+			ImageURI:      "nginx",
+			ContainerName: "phonetool-test-frontend-frontend",
+			NetworkName:   "my-network",
+			NetworkAlias:  "frontend",
+			EnvVars: map[string]string{
+				"PORT":      "8080",
+				"LOG_LEVEL": "debug",
+			},
+			Secrets: map[string]string{
+				"DB_PASSWORD": "hunter2",
+			},
+			Ports: map[string]string{
+				"8080": "8080",
+			},
+			EntryPoint: []string{"/bin/sh", "-c"},
+			Command:    []string{"echo", "hello"},
+			HealthCheck: &HealthCheck{
+				Command:  "curl -f http://localhost:8080",
+				Interval: 10 * time.Second,
+				Retries:  2,
+			},
+			LogDriver: "fluentd",
+			LogOptions: map[string]string{
+				"fluentd-async": "true",
+			},
+			Stdout: &bytes.Buffer{},
+			Stderr: &bytes.Buffer{},
+		})
+
+		// THEN
+		require.NoError(t, err)
+	})
+	t.Run("returns a wrapped error if the container fails", func(t *testing.T) {
+		// GIVEN
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m := NewMockCmd(ctrl)
+		m.EXPECT().Run("docker", []string{"run", "--rm", "--name", "frontend", "nginx"}).Return(errors.New("exit status 1"))
+		cmd := CmdClient{
+			runner: m,
+		}
+
+		// WHEN
+		err := cmd.Run(&RunOptions{
+			ImageURI:      "nginx",
+			ContainerName: "frontend",
+		})
+
+		// THEN
+		require.EqualError(t, err, "run container frontend: exit status 1")
+	})
+}
+
+func TestDockerCommand_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := NewMockCmd(ctrl)
+	m.EXPECT().Run("docker", []string{"stop", "frontend"}, gomock.Any()).Return(errors.New("some error"))
+	cmd := CmdClient{
+		runner: m,
+	}
+
+	err := cmd.Stop("frontend")
+
+	require.EqualError(t, err, "stop container frontend: some error")
+}
+
+func TestDockerCommand_IsContainerHealthy(t *testing.T) {
+	testCases := map[string]struct {
+		status  string
+		runErr  error
+		wanted  bool
+		wantErr error
+	}{
+		"healthy container": {
+			status: "healthy\n",
+			wanted: true,
+		},
+		"starting container": {
+			status: "starting\n",
+			wanted: false,
+		},
+		"wraps inspect error": {
+			runErr:  errors.New("some error"),
+			wantErr: errors.New("inspect health status of container frontend: some error"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := NewMockCmd(ctrl)
+			m.EXPECT().Run("docker", []string{"inspect", "--format", "{{.State.Health.Status}}", "frontend"}, gomock.Any()).
+				Do(func(_ string, _ []string, opt exec.CmdOption) {
+					cmd := &osexec.Cmd{}
+					opt(cmd)
+					_, _ = cmd.Stdout.Write([]byte(tc.status))
+				}).Return(tc.runErr)
+			cmd := CmdClient{
+				runner: m,
+			}
+
+			got, err := cmd.IsContainerHealthy("frontend")
+
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wanted, got)
+			}
+		})
+	}
+}
+
+func TestDockerCommand_Network(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	m := NewMockCmd(ctrl)
+	m.EXPECT().Run("docker", []string{"network", "create", "my-network"}, gomock.Any()).Return(nil)
+	m.EXPECT().Run("docker", []string{"network", "rm", "my-network"}, gomock.Any()).Return(errors.New("some error"))
+	cmd := CmdClient{
+		runner: m,
+	}
+
+	require.NoError(t, cmd.CreateNetwork("my-network"))
+	require.EqualError(t, cmd.RemoveNetwork("my-network"), "remove network my-network: some error")
+}
+
 func TestDockerCommand_CheckDockerEngineRunning(t *testing.T) {
 	mockError := errors.New("some error")
 	var mockCmd *MockCmd
@@ -37,6 +37,7 @@ type ecsClient interface {
 	NetworkConfiguration(cluster, serviceName string) (*ecs.NetworkConfiguration, error)
 	RunningTasks(cluster string) ([]*ecs.Task, error)
 	RunningTasksInFamily(cluster, family string) ([]*ecs.Task, error)
+	Service(clusterName, serviceName string) (*ecs.Service, error)
 	ServiceRunningTasks(clusterName, serviceName string) ([]*ecs.Task, error)
 	StoppedServiceTasks(cluster, service string) ([]*ecs.Task, error)
 	StopTasks(tasks []string, opts ...ecs.StopTasksOpts) error
@@ -46,6 +47,10 @@ type ecsClient interface {
 
 type stepFunctionsClient interface {
 	StateMachineDefinition(stateMachineARN string) (string, error)
+	Execute(stateMachineARN string) (string, error)
+	TaskSubmittedOutputs(executionARN string) ([]string, error)
+	DescribeExecution(executionARN string) (*stepfunctions.Execution, error)
+	ListExecutions(stateMachineARN string, maxResults int) ([]*stepfunctions.Execution, error)
 }
 
 // ServiceDesc contains the description of an ECS service.
@@ -215,6 +220,32 @@ func (c Client) TaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error
 	return taskDefinition, nil
 }
 
+// ServiceTaskDefinitionARN returns the ARN of the task definition that the ECS service of a Copilot service runs.
+func (c Client) ServiceTaskDefinitionARN(app, env, svc string) (string, error) {
+	clusterName, serviceName, err := c.fetchAndParseServiceARN(app, env, svc)
+	if err != nil {
+		return "", err
+	}
+	service, err := c.ecsClient.Service(clusterName, serviceName)
+	if err != nil {
+		return "", fmt.Errorf("get ECS service %s: %w", serviceName, err)
+	}
+	return aws.StringValue(service.TaskDefinition), nil
+}
+
+// ServiceTaskDefinition returns the task definition that the ECS service of a Copilot service runs.
+func (c Client) ServiceTaskDefinition(app, env, svc string) (*ecs.TaskDefinition, error) {
+	taskDefARN, err := c.ServiceTaskDefinitionARN(app, env, svc)
+	if err != nil {
+		return nil, err
+	}
+	taskDefinition, err := c.ecsClient.TaskDefinition(taskDefARN)
+	if err != nil {
+		return nil, fmt.Errorf("get task definition %s of service %s: %w", taskDefARN, svc, err)
+	}
+	return taskDefinition, nil
+}
+
 // NetworkConfiguration returns the network configuration of the service.
 func (c Client) NetworkConfiguration(app, env, svc string) (*ecs.NetworkConfiguration, error) {
 	clusterARN, err := c.clusterARN(app, env)
@@ -256,6 +287,81 @@ func (c Client) NetworkConfigurationForJob(app, env, job string) (*ecs.NetworkCo
 	return (*ecs.NetworkConfiguration)(&config), nil
 }
 
+// RunJob starts an execution of the job's state machine and returns the ARN of the execution.
+func (c Client) RunJob(app, env, job string) (string, error) {
+	jobARN, err := c.stateMachineARN(app, env, job)
+	if err != nil {
+		return "", err
+	}
+	executionARN, err := c.StepFuncClient.Execute(jobARN)
+	if err != nil {
+		return "", fmt.Errorf("run job %s: %w", job, err)
+	}
+	return executionARN, nil
+}
+
+// JobExecutions returns up to maxResults of the most recent executions of a job, from newest to oldest.
+func (c Client) JobExecutions(app, env, job string, maxResults int) ([]*stepfunctions.Execution, error) {
+	jobARN, err := c.stateMachineARN(app, env, job)
+	if err != nil {
+		return nil, err
+	}
+	executions, err := c.StepFuncClient.ListExecutions(jobARN, maxResults)
+	if err != nil {
+		return nil, fmt.Errorf("list executions of job %s: %w", job, err)
+	}
+	return executions, nil
+}
+
+// JobExecution returns the execution of a job given the name of the execution.
+func (c Client) JobExecution(app, env, job, name string) (*stepfunctions.Execution, error) {
+	jobARN, err := c.stateMachineARN(app, env, job)
+	if err != nil {
+		return nil, err
+	}
+	// An execution ARN is the state machine ARN with the "stateMachine" resource type replaced by "execution",
+	// followed by the name of the execution.
+	parsedARN, err := arn.Parse(jobARN)
+	if err != nil {
+		return nil, fmt.Errorf("parse state machine ARN %s: %w", jobARN, err)
+	}
+	parsedARN.Resource = fmt.Sprintf("execution:%s:%s", fmt.Sprintf(fmtStateMachineName, app, env, job), name)
+	execution, err := c.StepFuncClient.DescribeExecution(parsedARN.String())
+	if err != nil {
+		return nil, fmt.Errorf("get execution %s of job %s: %w", name, job, err)
+	}
+	return execution, nil
+}
+
+// JobExecutionTasks returns the ECS tasks started by a state machine execution of a job in chronological order.
+// A job with retries can start more than one task per execution.
+func (c Client) JobExecutionTasks(executionARN string) ([]*ecs.Task, error) {
+	outputs, err := c.StepFuncClient.TaskSubmittedOutputs(executionARN)
+	if err != nil {
+		return nil, err
+	}
+	var tasks []*ecs.Task
+	for _, output := range outputs {
+		// Only decode the identifiers, timestamps in the output are epoch milliseconds instead of RFC3339 strings.
+		var runTaskOutput struct {
+			Tasks []struct {
+				TaskArn    string
+				ClusterArn string
+			}
+		}
+		if err := json.Unmarshal([]byte(output), &runTaskOutput); err != nil {
+			return nil, fmt.Errorf("unmarshal run task output of execution %s: %w", executionARN, err)
+		}
+		for _, t := range runTaskOutput.Tasks {
+			tasks = append(tasks, &ecs.Task{
+				TaskArn:    aws.String(t.TaskArn),
+				ClusterArn: aws.String(t.ClusterArn),
+			})
+		}
+	}
+	return tasks, nil
+}
+
 // NetworkConfiguration wraps an ecs.NetworkConfiguration struct.
 type NetworkConfiguration ecs.NetworkConfiguration
 
@@ -12,6 +12,7 @@ import (
 	awsecs "github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	"github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	"github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	"github.com/aws/copilot-cli/internal/pkg/deploy"
 	"github.com/aws/copilot-cli/internal/pkg/ecs/mocks"
 	"github.com/golang/mock/gomock"
@@ -406,6 +407,181 @@ func TestClient_ForceUpdateService(t *testing.T) {
 	}
 }
 
+func TestClient_ServiceTaskDefinitionARN(t *testing.T) {
+	const (
+		mockApp     = "mockApp"
+		mockEnv     = "mockEnv"
+		mockSvc     = "mockSvc"
+		mockSvcARN  = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockCluster = "mockCluster"
+		mockService = "mockService"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedTaskDef string
+		wantedError   error
+	}{
+		"return error if failed to describe service": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(nil, errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("get ECS service mockService: some error"),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1234567890:task-definition/mockApp-mockEnv-mockSvc:3"),
+					}, nil),
+				)
+			},
+			wantedTaskDef: "arn:aws:ecs:us-west-2:1234567890:task-definition/mockApp-mockEnv-mockSvc:3",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				ecsClient:      mockECSClient,
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:  mockRgGetter,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			taskDef, err := client.ServiceTaskDefinitionARN(mockApp, mockEnv, mockSvc)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantedTaskDef, taskDef)
+			}
+		})
+	}
+}
+
+func TestClient_ServiceTaskDefinition(t *testing.T) {
+	const (
+		mockApp        = "mockApp"
+		mockEnv        = "mockEnv"
+		mockSvc        = "mockSvc"
+		mockSvcARN     = "arn:aws:ecs:us-west-2:1234567890:service/mockCluster/mockService"
+		mockCluster    = "mockCluster"
+		mockService    = "mockService"
+		mockTaskDefARN = "arn:aws:ecs:us-west-2:1234567890:task-definition/mockApp-mockEnv-mockSvc:3"
+	)
+	getRgInput := map[string]string{
+		deploy.AppTagKey:     mockApp,
+		deploy.EnvTagKey:     mockEnv,
+		deploy.ServiceTagKey: mockSvc,
+	}
+
+	tests := map[string]struct {
+		setupMocks func(mocks clientMocks)
+
+		wantedTaskDef *ecs.TaskDefinition
+		wantedError   error
+	}{
+		"return error if failed to get task definition": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						TaskDefinition: aws.String(mockTaskDefARN),
+					}, nil),
+					m.ecsClient.EXPECT().TaskDefinition(mockTaskDefARN).Return(nil, errors.New("some error")),
+				)
+			},
+			wantedError: fmt.Errorf("get task definition %s of service mockSvc: some error", mockTaskDefARN),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				gomock.InOrder(
+					m.resourceGetter.EXPECT().GetResourcesByTags(serviceResourceType, getRgInput).
+						Return([]*resourcegroups.Resource{
+							{ARN: mockSvcARN},
+						}, nil),
+					m.ecsClient.EXPECT().Service(mockCluster, mockService).Return(&ecs.Service{
+						TaskDefinition: aws.String(mockTaskDefARN),
+					}, nil),
+					m.ecsClient.EXPECT().TaskDefinition(mockTaskDefARN).Return(&ecs.TaskDefinition{
+						TaskDefinitionArn: aws.String(mockTaskDefARN),
+					}, nil),
+				)
+			},
+			wantedTaskDef: &ecs.TaskDefinition{
+				TaskDefinitionArn: aws.String(mockTaskDefARN),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// GIVEN
+			mockRgGetter := mocks.NewMockresourceGetter(ctrl)
+			mockECSClient := mocks.NewMockecsClient(ctrl)
+			mocks := clientMocks{
+				resourceGetter: mockRgGetter,
+				ecsClient:      mockECSClient,
+			}
+
+			test.setupMocks(mocks)
+
+			client := Client{
+				rgGetter:  mockRgGetter,
+				ecsClient: mockECSClient,
+			}
+
+			// WHEN
+			taskDef, err := client.ServiceTaskDefinition(mockApp, mockEnv, mockSvc)
+
+			// THEN
+			if test.wantedError != nil {
+				require.EqualError(t, err, test.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.wantedTaskDef, taskDef)
+			}
+		})
+	}
+}
+
 func TestClient_listActiveCopilotTasks(t *testing.T) {
 	const (
 		mockCluster   = "mockCluster"
@@ -1261,3 +1437,301 @@ func Test_NetworkConfigurationForJob(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_RunJob(t *testing.T) {
+	const (
+		testApp = "testApp"
+		testEnv = "testEnv"
+		testJob = "testJob"
+		testARN = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wantedARN   string
+		wantedError error
+	}{
+		"fail to find state machine": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(resourcegroups.ResourceTypeStateMachine, map[string]string{
+					deploy.AppTagKey:     testApp,
+					deploy.EnvTagKey:     testEnv,
+					deploy.ServiceTagKey: testJob,
+				}).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get state machine resource by tags for job testJob: some error"),
+		},
+		"fail to execute state machine": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return([]*resourcegroups.Resource{
+					{
+						ARN: testARN,
+					},
+				}, nil)
+				m.StepFuncClient.EXPECT().Execute(testARN).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("run job testJob: some error"),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return([]*resourcegroups.Resource{
+					{
+						ARN: testARN,
+					},
+				}, nil)
+				m.StepFuncClient.EXPECT().Execute(testARN).Return("mockExecutionARN", nil)
+			},
+			wantedARN: "mockExecutionARN",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			client := Client{
+				rgGetter:       m.resourceGetter,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.RunJob(testApp, testEnv, testJob)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedARN, got)
+			}
+		})
+	}
+}
+
+func TestClient_JobExecutionTasks(t *testing.T) {
+	const testExecutionARN = "arn:aws:states:us-east-1:1234456789012:execution:testApp-testEnv-testJob:mockExecution"
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wantedTasks []*ecs.Task
+		wantedError error
+	}{
+		"fail to get submitted task outputs": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().TaskSubmittedOutputs(testExecutionARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("some error"),
+		},
+		"fail to unmarshal output": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().TaskSubmittedOutputs(testExecutionARN).Return([]string{"not json"}, nil)
+			},
+			wantedError: fmt.Errorf("unmarshal run task output of execution %s: invalid character 'o' in literal null (expecting 'u')", testExecutionARN),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				m.StepFuncClient.EXPECT().TaskSubmittedOutputs(testExecutionARN).Return([]string{
+					`{"Failures":[],"Tasks":[{"ClusterArn":"cluster","CreatedAt":1633046400000,"TaskArn":"task1"}]}`,
+					`{"Failures":[],"Tasks":[{"ClusterArn":"cluster","CreatedAt":1633046500000,"TaskArn":"task2"}]}`,
+				}, nil)
+			},
+			wantedTasks: []*ecs.Task{
+				{
+					ClusterArn: aws.String("cluster"),
+					TaskArn:    aws.String("task1"),
+				},
+				{
+					ClusterArn: aws.String("cluster"),
+					TaskArn:    aws.String("task2"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+			}
+			tc.setupMocks(m)
+
+			client := Client{
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.JobExecutionTasks(testExecutionARN)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedTasks, got)
+			}
+		})
+	}
+}
+
+func TestClient_JobExecutions(t *testing.T) {
+	const (
+		testApp = "testApp"
+		testEnv = "testEnv"
+		testJob = "testJob"
+		testARN = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wantedExecutions []*stepfunctions.Execution
+		wantedError      error
+	}{
+		"fail to list executions": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return([]*resourcegroups.Resource{
+					{
+						ARN: testARN,
+					},
+				}, nil)
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("list executions of job testJob: some error"),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return([]*resourcegroups.Resource{
+					{
+						ARN: testARN,
+					},
+				}, nil)
+				m.StepFuncClient.EXPECT().ListExecutions(testARN, 5).Return([]*stepfunctions.Execution{
+					{
+						Name: "mockExecution",
+					},
+				}, nil)
+			},
+			wantedExecutions: []*stepfunctions.Execution{
+				{
+					Name: "mockExecution",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			client := Client{
+				rgGetter:       m.resourceGetter,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.JobExecutions(testApp, testEnv, testJob, 5)
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecutions, got)
+			}
+		})
+	}
+}
+
+func TestClient_JobExecution(t *testing.T) {
+	const (
+		testApp          = "testApp"
+		testEnv          = "testEnv"
+		testJob          = "testJob"
+		testARN          = "arn:aws:states:us-east-1:1234456789012:stateMachine:testApp-testEnv-testJob"
+		testExecutionARN = "arn:aws:states:us-east-1:1234456789012:execution:testApp-testEnv-testJob:mockExecution"
+	)
+	testCases := map[string]struct {
+		setupMocks func(m clientMocks)
+
+		wantedExecution *stepfunctions.Execution
+		wantedError     error
+	}{
+		"fail to describe execution": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return([]*resourcegroups.Resource{
+					{
+						ARN: testARN,
+					},
+				}, nil)
+				m.StepFuncClient.EXPECT().DescribeExecution(testExecutionARN).Return(nil, errors.New("some error"))
+			},
+			wantedError: errors.New("get execution mockExecution of job testJob: some error"),
+		},
+		"success": {
+			setupMocks: func(m clientMocks) {
+				m.resourceGetter.EXPECT().GetResourcesByTags(gomock.Any(), gomock.Any()).Return([]*resourcegroups.Resource{
+					{
+						ARN: testARN,
+					},
+				}, nil)
+				m.StepFuncClient.EXPECT().DescribeExecution(testExecutionARN).Return(&stepfunctions.Execution{
+					ARN:  testExecutionARN,
+					Name: "mockExecution",
+				}, nil)
+			},
+			wantedExecution: &stepfunctions.Execution{
+				ARN:  testExecutionARN,
+				Name: "mockExecution",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := clientMocks{
+				StepFuncClient: mocks.NewMockstepFunctionsClient(ctrl),
+				resourceGetter: mocks.NewMockresourceGetter(ctrl),
+			}
+			tc.setupMocks(m)
+
+			client := Client{
+				rgGetter:       m.resourceGetter,
+				StepFuncClient: m.StepFuncClient,
+			}
+
+			// WHEN
+			got, err := client.JobExecution(testApp, testEnv, testJob, "mockExecution")
+
+			// THEN
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedExecution, got)
+			}
+		})
+	}
+}
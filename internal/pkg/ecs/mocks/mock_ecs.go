@@ -9,6 +9,7 @@ import (
 
 	ecs "github.com/aws/copilot-cli/internal/pkg/aws/ecs"
 	resourcegroups "github.com/aws/copilot-cli/internal/pkg/aws/resourcegroups"
+	stepfunctions "github.com/aws/copilot-cli/internal/pkg/aws/stepfunctions"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -133,6 +134,21 @@ func (mr *MockecsClientMockRecorder) RunningTasksInFamily(cluster, family interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunningTasksInFamily", reflect.TypeOf((*MockecsClient)(nil).RunningTasksInFamily), cluster, family)
 }
 
+// Service mocks base method.
+func (m *MockecsClient) Service(clusterName, serviceName string) (*ecs.Service, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Service", clusterName, serviceName)
+	ret0, _ := ret[0].(*ecs.Service)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Service indicates an expected call of Service.
+func (mr *MockecsClientMockRecorder) Service(clusterName, serviceName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Service", reflect.TypeOf((*MockecsClient)(nil).Service), clusterName, serviceName)
+}
+
 // ServiceRunningTasks mocks base method.
 func (m *MockecsClient) ServiceRunningTasks(clusterName, serviceName string) ([]*ecs.Task, error) {
 	m.ctrl.T.Helper()
@@ -239,6 +255,51 @@ func (m *MockstepFunctionsClient) EXPECT() *MockstepFunctionsClientMockRecorder
 	return m.recorder
 }
 
+// DescribeExecution mocks base method.
+func (m *MockstepFunctionsClient) DescribeExecution(executionARN string) (*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeExecution", executionARN)
+	ret0, _ := ret[0].(*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeExecution indicates an expected call of DescribeExecution.
+func (mr *MockstepFunctionsClientMockRecorder) DescribeExecution(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeExecution", reflect.TypeOf((*MockstepFunctionsClient)(nil).DescribeExecution), executionARN)
+}
+
+// Execute mocks base method.
+func (m *MockstepFunctionsClient) Execute(stateMachineARN string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", stateMachineARN)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockstepFunctionsClientMockRecorder) Execute(stateMachineARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockstepFunctionsClient)(nil).Execute), stateMachineARN)
+}
+
+// ListExecutions mocks base method.
+func (m *MockstepFunctionsClient) ListExecutions(stateMachineARN string, maxResults int) ([]*stepfunctions.Execution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExecutions", stateMachineARN, maxResults)
+	ret0, _ := ret[0].([]*stepfunctions.Execution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExecutions indicates an expected call of ListExecutions.
+func (mr *MockstepFunctionsClientMockRecorder) ListExecutions(stateMachineARN, maxResults interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExecutions", reflect.TypeOf((*MockstepFunctionsClient)(nil).ListExecutions), stateMachineARN, maxResults)
+}
+
 // StateMachineDefinition mocks base method.
 func (m *MockstepFunctionsClient) StateMachineDefinition(stateMachineARN string) (string, error) {
 	m.ctrl.T.Helper()
@@ -253,3 +314,18 @@ func (mr *MockstepFunctionsClientMockRecorder) StateMachineDefinition(stateMachi
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateMachineDefinition", reflect.TypeOf((*MockstepFunctionsClient)(nil).StateMachineDefinition), stateMachineARN)
 }
+
+// TaskSubmittedOutputs mocks base method.
+func (m *MockstepFunctionsClient) TaskSubmittedOutputs(executionARN string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TaskSubmittedOutputs", executionARN)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TaskSubmittedOutputs indicates an expected call of TaskSubmittedOutputs.
+func (mr *MockstepFunctionsClientMockRecorder) TaskSubmittedOutputs(executionARN interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TaskSubmittedOutputs", reflect.TypeOf((*MockstepFunctionsClient)(nil).TaskSubmittedOutputs), executionARN)
+}
@@ -70,6 +70,14 @@ func Stderr(writer io.Writer) CmdOption {
 	}
 }
 
+// Env appends the key=value pairs to the environment of the internal *exec.Cmd.
+// The command still inherits the environment of the current process.
+func Env(kvs []string) CmdOption {
+	return func(c *exec.Cmd) {
+		c.Env = append(os.Environ(), kvs...)
+	}
+}
+
 // Run starts the named command and waits until it finishes.
 func (c *Cmd) Run(name string, args []string, opts ...CmdOption) error {
 	cmd := c.command(name, args, opts...)
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/ssm"
 )
 
 const (
@@ -59,6 +60,52 @@ func (s SSMPluginCommand) StartSession(ssmSess *ecs.Session) error {
 	return nil
 }
 
+// StartSessionWithIO starts a session using the ssm plugin, reading the input of the session from stdin
+// and writing its output to stdout instead of the terminal.
+// The input of the plugin is kept open until the session ends so that the plugin doesn't terminate the session early.
+func (s SSMPluginCommand) StartSessionWithIO(ssmSess *ecs.Session, stdin io.Reader, stdout io.Writer) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	r, w, err := os.Pipe()
+	if err != nil {
+		return fmt.Errorf("create pipe for the session input: %w", err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if stdin != nil {
+		go func() {
+			_, _ = io.Copy(w, stdin)
+		}()
+	}
+	if err := s.runner.Run(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction},
+		Stdin(r), Stdout(stdout)); err != nil {
+		return fmt.Errorf("start session: %w", err)
+	}
+	return nil
+}
+
+// StartPortForwardingSession starts a port forwarding session using the ssm plugin.
+// The plugin listens on the local port of the session request until it's interrupted.
+func (s SSMPluginCommand) StartPortForwardingSession(ssmSess *ssm.StartSessionOutput, in *ssm.StartSessionInput) error {
+	response, err := json.Marshal(ssmSess)
+	if err != nil {
+		return fmt.Errorf("marshal session response: %w", err)
+	}
+	request, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("marshal session request: %w", err)
+	}
+	// The plugin expects the AWS profile before the request, which is left empty.
+	if err := s.runner.InteractiveRun(ssmPluginBinaryName,
+		[]string{string(response), aws.StringValue(s.sess.Config.Region), startSessionAction, "", string(request)}); err != nil {
+		return fmt.Errorf("start port forwarding session: %w", err)
+	}
+	return nil
+}
+
 func download(client httpClient, filepath string, url string) error {
 	resp, err := client.Get(url)
 	if err != nil {
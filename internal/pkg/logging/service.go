@@ -24,6 +24,9 @@ const (
 
 	fmtSvclogGroupName    = "/copilot/%s-%s-%s"
 	fmtSvcLogStreamPrefix = "copilot/%s"
+
+	// The state machine of a job writes the logs of its executions to the same log group as the job's containers.
+	stateMachineLogStreamPrefix = "states"
 )
 
 type logGetter interface {
@@ -45,6 +48,13 @@ type WriteLogEventsOpts struct {
 	StartTime *int64
 	EndTime   *int64
 	TaskIDs   []string
+	// IncludeStateMachineLogs adds the state machine execution logs of a job to the logs of the tasks in TaskIDs.
+	IncludeStateMachineLogs bool
+	// StateMachineExecutionARN, if set, only keeps the state machine logs of this execution.
+	StateMachineExecutionARN string
+	// RefreshTaskIDs, if set, is called before each retrieval of the logs to update TaskIDs,
+	// so that the tasks started after the first retrieval are followed too.
+	RefreshTaskIDs func() ([]string, error)
 	// OnEvents is a handler that's invoked when logs are retrieved from the service.
 	OnEvents func(w io.Writer, logs []HumanJSONStringer) error
 }
@@ -137,32 +147,67 @@ func (s *ServiceClient) WriteLogEvents(opts WriteLogEventsOpts) error {
 		EndTime:   opts.EndTime,
 		StartTime: opts.StartTime,
 	}
-	if opts.TaskIDs != nil {
-		logEventsOpts.LogStreams = s.logStreams(opts.TaskIDs)
-	}
 	for {
-		logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
-		if err != nil {
-			return fmt.Errorf("get task log events for log group %s: %w", s.logGroupName, err)
+		if opts.RefreshTaskIDs != nil {
+			taskIDs, err := opts.RefreshTaskIDs()
+			if err != nil {
+				return fmt.Errorf("refresh task IDs: %w", err)
+			}
+			opts.TaskIDs = taskIDs
 		}
-		if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(logEventsOutput.Events)); err != nil {
-			return err
-		}
-		if !opts.Follow {
-			return nil
-		}
-		// for unit test.
-		if logEventsOutput.StreamLastEventTime == nil {
+		logEventsOpts.LogStreams = s.logStreams(opts)
+		// A non-nil list of task IDs without any log stream to retrieve means that no task has started yet.
+		// The log streams must not be left empty, otherwise the logs of every stream are retrieved.
+		if opts.TaskIDs == nil || len(logEventsOpts.LogStreams) != 0 {
+			logEventsOutput, err := s.eventsGetter.LogEvents(logEventsOpts)
+			if err != nil {
+				return fmt.Errorf("get task log events for log group %s: %w", s.logGroupName, err)
+			}
+			events := filterStateMachineEvents(logEventsOutput.Events, opts.StateMachineExecutionARN)
+			if err := opts.OnEvents(s.w, cwEventsToHumanJSONStringers(events)); err != nil {
+				return err
+			}
+			if !opts.Follow {
+				return nil
+			}
+			// for unit test.
+			if logEventsOutput.StreamLastEventTime == nil {
+				return nil
+			}
+			logEventsOpts.StreamLastEventTime = logEventsOutput.StreamLastEventTime
+		} else if !opts.Follow {
 			return nil
 		}
-		logEventsOpts.StreamLastEventTime = logEventsOutput.StreamLastEventTime
 		time.Sleep(cloudwatchlogs.SleepDuration)
 	}
 }
 
-func (s *ServiceClient) logStreams(taskIDs []string) (logStreamName []string) {
-	for _, taskID := range taskIDs {
-		logStreamName = append(logStreamName, fmt.Sprintf("%s/%s", s.logStreamNamePrefix, taskID))
+func (s *ServiceClient) logStreams(opts WriteLogEventsOpts) []string {
+	if opts.TaskIDs == nil {
+		return nil
+	}
+	logStreams := make([]string, 0, len(opts.TaskIDs)+1)
+	for _, taskID := range opts.TaskIDs {
+		logStreams = append(logStreams, fmt.Sprintf("%s/%s", s.logStreamNamePrefix, taskID))
+	}
+	if opts.IncludeStateMachineLogs {
+		logStreams = append(logStreams, stateMachineLogStreamPrefix)
+	}
+	return logStreams
+}
+
+// filterStateMachineEvents drops the state machine events that don't belong to the execution.
+// The state machine writes the logs of all its executions to the same log streams, and each event records its execution ARN.
+func filterStateMachineEvents(events []*cloudwatchlogs.Event, executionARN string) []*cloudwatchlogs.Event {
+	if executionARN == "" {
+		return events
+	}
+	var filtered []*cloudwatchlogs.Event
+	for _, event := range events {
+		if strings.HasPrefix(event.LogStreamName, stateMachineLogStreamPrefix+"/") && !strings.Contains(event.Message, executionARN) {
+			continue
+		}
+		filtered = append(filtered, event)
 	}
-	return
+	return filtered
 }
@@ -58,12 +58,15 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 	var mockNilLimit *int64
 	mockStartTime := aws.Int64(123456789)
 	testCases := map[string]struct {
-		follow     bool
-		limit      *int64
-		startTime  *int64
-		jsonOutput bool
-		taskIDs    []string
-		setupMocks func(mocks serviceLogsMocks)
+		follow           bool
+		limit            *int64
+		startTime        *int64
+		jsonOutput       bool
+		taskIDs          []string
+		stateMachineLogs bool
+		executionARN     string
+		refreshTaskIDs   func() ([]string, error)
+		setupMocks       func(mocks serviceLogsMocks)
 
 		wantedError   error
 		wantedContent string
@@ -110,6 +113,87 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "WARN some warnin
 
 			wantedContent: logEventsJSONString,
 		},
+		"success with state machine logs": {
+			taskIDs:          []string{"mockTaskID1"},
+			stateMachineLogs: true,
+			setupMocks: func(m serviceLogsMocks) {
+				gomock.InOrder(
+					m.logGetter.EXPECT().LogEvents(gomock.Any()).
+						Do(func(param cloudwatchlogs.LogEventsOpts) {
+							require.Equal(t, []string{"mockLogStreamPrefix/mockTaskID1", "states"}, param.LogStreams)
+						}).
+						Return(&cloudwatchlogs.LogEventsOutput{
+							Events: logEvents,
+						}, nil),
+				)
+			},
+
+			wantedContent: logEventsHumanString,
+		},
+		"only keeps the state machine logs of the execution": {
+			taskIDs:          []string{"mockTaskID1"},
+			stateMachineLogs: true,
+			executionARN:     "arn:aws:states:us-west-2:123456789012:execution:mockStateMachine:mockExecution",
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).Return(&cloudwatchlogs.LogEventsOutput{
+					Events: []*cloudwatchlogs.Event{
+						{
+							LogStreamName: "states/mockStateMachine/2020-01-01-00-00/abc",
+							Message:       `{"type":"ExecutionStarted","execution_arn":"arn:aws:states:us-west-2:123456789012:execution:mockStateMachine:mockExecution"}`,
+						},
+						{
+							LogStreamName: "states/mockStateMachine/2020-01-01-00-00/abc",
+							Message:       `{"type":"ExecutionStarted","execution_arn":"arn:aws:states:us-west-2:123456789012:execution:mockStateMachine:otherExecution"}`,
+						},
+						{
+							LogStreamName: "mockLogStreamPrefix/mockTaskID1",
+							Message:       "hello",
+						},
+					},
+				}, nil)
+			},
+
+			wantedContent: `states/mockStateMachine/2 {"type":"ExecutionStarted","execution_arn":"arn:aws:states:us-west-2:123456789012:execution:mockStateMachine:mockExecution"}
+mockLogStreamPrefix/mockT hello
+`,
+		},
+		"does not retrieve the logs of every stream if there are no tasks": {
+			taskIDs:    []string{},
+			setupMocks: func(m serviceLogsMocks) {},
+		},
+		"refreshes the task IDs while following the logs": {
+			follow: true,
+			refreshTaskIDs: func() func() ([]string, error) {
+				calls := 0
+				return func() ([]string, error) {
+					calls++
+					if calls == 1 {
+						return []string{}, nil
+					}
+					return []string{"mockTaskID1"}, nil
+				}
+			}(),
+			setupMocks: func(m serviceLogsMocks) {
+				m.logGetter.EXPECT().LogEvents(gomock.Any()).
+					Do(func(param cloudwatchlogs.LogEventsOpts) {
+						require.Equal(t, []string{"mockLogStreamPrefix/mockTaskID1"}, param.LogStreams)
+					}).
+					Return(&cloudwatchlogs.LogEventsOutput{
+						Events: moreLogEvents,
+					}, nil)
+			},
+
+			wantedContent: `firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1" 404 -
+`,
+		},
+		"returns error if fail to refresh the task IDs": {
+			refreshTaskIDs: func() ([]string, error) {
+				return nil, errors.New("some error")
+			},
+			setupMocks: func(m serviceLogsMocks) {},
+
+			wantedError: errors.New("refresh task IDs: some error"),
+		},
 		"success with follow flag": {
 			follow:  true,
 			taskIDs: []string{"mockTaskID1", "mockTaskID2"},
@@ -172,6 +256,10 @@ firelens_log_router/fcfe4 10.0.0.00 - - [01/Jan/1970 01:01:01] "GET / HTTP/1.1"
 				Limit:     tc.limit,
 				StartTime: tc.startTime,
 				OnEvents:  logWriter,
+
+				IncludeStateMachineLogs:  tc.stateMachineLogs,
+				StateMachineExecutionARN: tc.executionARN,
+				RefreshTaskIDs:           tc.refreshTaskIDs,
 			})
 
 			// THEN
@@ -28,11 +28,10 @@ func (mockWriter) Write(p []byte) (int, error) { return 0, nil }
 
 func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 	const (
-		groupName = "my-log-group"
-		taskARN1  = "arn:aws:ecs:us-west-2:123456789:task/cluster/task1"
-		taskARN2  = "arn:aws:ecs:us-west-2:123456789:task/cluster/task2"
-		taskARN3  = "arn:aws:ecs:us-west-2:123456789:task/cluster/task3"
-		taskARN4  = "task4"
+		taskARN1 = "arn:aws:ecs:us-west-2:123456789:task/cluster/task1"
+		taskARN2 = "arn:aws:ecs:us-west-2:123456789:task/cluster/task2"
+		taskARN3 = "arn:aws:ecs:us-west-2:123456789:task/cluster/task3"
+		taskARN4 = "task4"
 	)
 	now := time.Now()
 	tomorrow := now.AddDate(0, 0, 1)
@@ -133,8 +132,9 @@ func TestEventsWriter_WriteEventsUntilStopped(t *testing.T) {
 			tc.setUpMocks(mocks)
 
 			ew := &TaskClient{
-				groupName: groupName,
-				tasks:     tc.tasks,
+				logGroupName:        "/copilot/my-log-group",
+				logStreamNamePrefix: "copilot-task/my-log-group",
+				tasks:               tc.tasks,
 
 				eventsWriter:  mockWriter{},
 				eventsLogger:  mocks.logGetter,
@@ -31,6 +31,7 @@ type BackendServiceConfig struct {
 	Logging          `yaml:"logging,flow"`
 	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	Network          NetworkConfig             `yaml:"network"`
+	DeployConfig     DeploymentConfiguration   `yaml:"deployment"`
 	PublishConfig    PublishConfig             `yaml:"publish"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 }
@@ -96,6 +97,16 @@ func (s *BackendService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageRepository returns the configuration of the ECR repository storing the images built from a Dockerfile.
+func (s *BackendService) ImageRepository() ImageRepository {
+	return s.ImageConfig.Image.Repository
+}
+
+// SidecarBuildArgs returns the docker build arguments of the sidecars built from a Dockerfile, keyed by sidecar name.
+func (s *BackendService) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(s.Sidecars, wsRoot)
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s BackendService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -0,0 +1,414 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/aws/copilot-cli/internal/pkg/template"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// EnvironmentManifestType identifies that the type of a manifest is an environment manifest.
+	EnvironmentManifestType = "Environment"
+
+	environmentManifestPath = "environment/manifest.yml"
+)
+
+// Destinations of VPC flow logs.
+const (
+	FlowLogsDestinationCloudWatch = "cloudwatch"
+	FlowLogsDestinationS3         = "s3"
+)
+
+var flowLogsDestinations = []string{FlowLogsDestinationCloudWatch, FlowLogsDestinationS3}
+
+// cloudWatchLogsRetentionDays are the numbers of days that a CloudWatch log group can retain log events for.
+var cloudWatchLogsRetentionDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+
+var (
+	errUnmarshalFlowLogsOpts   = errors.New(`cannot unmarshal "flow_logs" field into bool or map`)
+	errUnmarshalAccessLogsOpts = errors.New(`cannot unmarshal "access_logs" field into bool or map`)
+)
+
+// Environment is the manifest configuration for an environment.
+type Environment struct {
+	Workload          `yaml:",inline"`
+	EnvironmentConfig `yaml:",inline"`
+
+	parser template.Parser
+}
+
+// EnvironmentProps contains properties for creating a new environment manifest.
+type EnvironmentProps struct {
+	Name         string
+	CustomConfig *config.CustomizeEnv
+	Telemetry    *config.Telemetry
+}
+
+// NewEnvironment creates a new environment manifest object.
+func NewEnvironment(props *EnvironmentProps) *Environment {
+	return FromEnvConfig(&config.Environment{
+		Name:         props.Name,
+		CustomConfig: props.CustomConfig,
+		Telemetry:    props.Telemetry,
+	}, template.New())
+}
+
+// FromEnvConfig transforms an environment configuration into a manifest.
+func FromEnvConfig(cfg *config.Environment, parser template.Parser) *Environment {
+	var vpc EnvironmentVPCConfig
+	var http EnvironmentHTTPConfig
+	if cfg.CustomConfig != nil {
+		vpc.loadVPCConfig(cfg.CustomConfig)
+		http.Public.Certificates = cfg.CustomConfig.ImportCertARNs
+	}
+	var obs EnvironmentObservability
+	if cfg.Telemetry != nil {
+		obs.ContainerInsights = aws.Bool(cfg.Telemetry.EnableContainerInsights)
+	}
+	return &Environment{
+		Workload: Workload{
+			Name: stringP(cfg.Name),
+			Type: stringP(EnvironmentManifestType),
+		},
+		EnvironmentConfig: EnvironmentConfig{
+			Network: EnvironmentNetworkConfig{
+				VPC: vpc,
+			},
+			Observability: obs,
+			HTTPConfig:    http,
+		},
+		parser: parser,
+	}
+}
+
+// EnvironmentConfig holds the configuration for an environment.
+type EnvironmentConfig struct {
+	Network       EnvironmentNetworkConfig `yaml:"network,omitempty"`
+	Observability EnvironmentObservability `yaml:"observability,omitempty"`
+	HTTPConfig    EnvironmentHTTPConfig    `yaml:"http,omitempty"`
+	CICD          EnvironmentCICDConfig    `yaml:"cicd,omitempty"`
+}
+
+// EnvironmentCICDConfig holds the configuration of the CI/CD systems that deploy to an environment.
+type EnvironmentCICDConfig struct {
+	GitHubActions GitHubActionsConfig `yaml:"github_actions,omitempty"`
+}
+
+// GitHubActionsConfig holds the configuration of the IAM role that GitHub Actions workflows assume
+// through OpenID Connect to deploy to an environment.
+type GitHubActionsConfig struct {
+	Repository         *string `yaml:"repository"`           // The "owner/name" of the repository whose workflows can assume the role.
+	CreateOIDCProvider *bool   `yaml:"create_oidc_provider"` // Whether the environment creates the account's GitHub OIDC provider.
+}
+
+// EnvironmentNetworkConfig holds the networking configuration of an environment.
+type EnvironmentNetworkConfig struct {
+	VPC EnvironmentVPCConfig `yaml:"vpc,omitempty"`
+}
+
+// EnvironmentVPCConfig holds the VPC configuration of an environment.
+// Either specify the ID of an existing VPC to import it, or the CIDR ranges of the VPC that Copilot creates.
+type EnvironmentVPCConfig struct {
+	ID          *string              `yaml:"id"`
+	CIDR        *IPNet               `yaml:"cidr"`
+	Subnets     SubnetsConfiguration `yaml:"subnets,omitempty"`
+	PrivateOnly *bool                `yaml:"private_only"` // Whether the VPC created by Copilot has no route to the internet.
+	FlowLogs    FlowLogsArgsOrBool   `yaml:"flow_logs,omitempty"`
+}
+
+// FlowLogsArgsOrBool contains custom unmarshaling logic for the `flow_logs` field in the manifest.
+type FlowLogsArgsOrBool struct {
+	Advanced FlowLogsArgs
+	Enabled  *bool
+}
+
+// FlowLogsArgs holds the configuration of the flow logs of the VPC.
+type FlowLogsArgs struct {
+	Destination *string `yaml:"destination"` // Either "cloudwatch" or "s3".
+	Retention   *int    `yaml:"retention"`   // Number of days to keep the flow logs for.
+}
+
+// SubnetsConfiguration holds the configuration of the public and private subnets of an environment.
+type SubnetsConfiguration struct {
+	Public  []SubnetConfiguration `yaml:"public,omitempty"`
+	Private []SubnetConfiguration `yaml:"private,omitempty"`
+}
+
+// SubnetConfiguration holds the configuration of a single subnet.
+type SubnetConfiguration struct {
+	SubnetID *string `yaml:"id"`
+	CIDR     *IPNet  `yaml:"cidr"`
+}
+
+// EnvironmentObservability holds the observability configuration of an environment.
+type EnvironmentObservability struct {
+	ContainerInsights *bool `yaml:"container_insights"`
+}
+
+// EnvironmentHTTPConfig holds the configuration of the load balancers of an environment.
+type EnvironmentHTTPConfig struct {
+	Public   PublicHTTPConfig   `yaml:"public,omitempty"`
+	Internal InternalHTTPConfig `yaml:"internal,omitempty"`
+}
+
+// PublicHTTPConfig holds the configuration of the public Application Load Balancer of an environment.
+type PublicHTTPConfig struct {
+	AllowedSourceIPs []IPNet                 `yaml:"allowed_source_ips"`
+	SSLPolicy        *string                 `yaml:"ssl_policy"`
+	Certificates     []string                `yaml:"certificates,omitempty"` // ARNs of existing ACM certificates to attach to the HTTPS listener.
+	AccessLogs       ELBAccessLogsArgsOrBool `yaml:"access_logs,omitempty"`
+	WebACL           *string                 `yaml:"web_acl"` // ARN of the WAFv2 web ACL to associate with the load balancer.
+}
+
+// ELBAccessLogsArgsOrBool contains custom unmarshaling logic for the `access_logs` field in the manifest.
+type ELBAccessLogsArgsOrBool struct {
+	Advanced ELBAccessLogsArgs
+	Enabled  *bool
+}
+
+// ELBAccessLogsArgs holds the configuration of the access logs of the public load balancer.
+type ELBAccessLogsArgs struct {
+	BucketName *string `yaml:"bucket_name"` // Name of an existing bucket. Copilot creates a bucket if it's not specified.
+	Prefix     *string `yaml:"prefix"`
+}
+
+// InternalHTTPConfig holds the configuration of the internal Application Load Balancer of an environment.
+type InternalHTTPConfig struct {
+	AllowedSourceIPs []IPNet `yaml:"allowed_source_ips"`
+}
+
+// IsEmpty returns true if there is no customization to the VPC.
+func (v *EnvironmentVPCConfig) IsEmpty() bool {
+	return v.ID == nil && v.CIDR == nil && len(v.Subnets.Public) == 0 && len(v.Subnets.Private) == 0 && v.PrivateOnly == nil &&
+		v.FlowLogs.IsEmpty()
+}
+
+// IsPrivateOnly returns true if the VPC created by Copilot has no public subnets, internet gateway, or NAT gateways.
+func (v *EnvironmentVPCConfig) IsPrivateOnly() bool {
+	return aws.BoolValue(v.PrivateOnly)
+}
+
+// IsEmpty returns true if GitHub Actions workflows can't deploy to the environment.
+func (c *GitHubActionsConfig) IsEmpty() bool {
+	return c.Repository == nil && c.CreateOIDCProvider == nil
+}
+
+// IsEmpty returns true if there is no customization to the public load balancer.
+func (c *PublicHTTPConfig) IsEmpty() bool {
+	return len(c.AllowedSourceIPs) == 0 && c.SSLPolicy == nil && len(c.Certificates) == 0 &&
+		c.AccessLogs.IsEmpty() && c.WebACL == nil
+}
+
+// IsEmpty returns true if the access logs of the load balancer are not configured.
+func (al *ELBAccessLogsArgsOrBool) IsEmpty() bool {
+	return al.Advanced.IsEmpty() && al.Enabled == nil
+}
+
+// IsEmpty returns true if none of the fields are specified.
+func (al *ELBAccessLogsArgs) IsEmpty() bool {
+	return al.BucketName == nil && al.Prefix == nil
+}
+
+// UnmarshalYAML implements the yaml(v3) interface. It allows access logs to be specified as a
+// bool or a struct alternately.
+func (al *ELBAccessLogsArgsOrBool) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&al.Advanced); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if !al.Advanced.IsEmpty() {
+		// Unmarshaled successfully to al.Advanced, unset al.Enabled, and return.
+		al.Enabled = nil
+		return nil
+	}
+	if err := value.Decode(&al.Enabled); err != nil {
+		return errUnmarshalAccessLogsOpts
+	}
+	return nil
+}
+
+// IsEnabled returns true if the load balancer should store its access logs.
+func (al *ELBAccessLogsArgsOrBool) IsEnabled() bool {
+	return aws.BoolValue(al.Enabled) || !al.Advanced.IsEmpty()
+}
+
+// IsEmpty returns true if the flow logs of the VPC are not configured.
+func (fl *FlowLogsArgsOrBool) IsEmpty() bool {
+	return fl.Advanced.IsEmpty() && fl.Enabled == nil
+}
+
+// IsEmpty returns true if none of the fields are specified.
+func (fl *FlowLogsArgs) IsEmpty() bool {
+	return fl.Destination == nil && fl.Retention == nil
+}
+
+// UnmarshalYAML implements the yaml(v3) interface. It allows flow logs to be specified as a
+// bool or a struct alternately.
+func (fl *FlowLogsArgsOrBool) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&fl.Advanced); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+	if !fl.Advanced.IsEmpty() {
+		// Unmarshaled successfully to fl.Advanced, unset fl.Enabled, and return.
+		fl.Enabled = nil
+		return nil
+	}
+	if err := value.Decode(&fl.Enabled); err != nil {
+		return errUnmarshalFlowLogsOpts
+	}
+	return nil
+}
+
+// IsEnabled returns true if the VPC should publish flow logs.
+func (fl *FlowLogsArgsOrBool) IsEnabled() bool {
+	return aws.BoolValue(fl.Enabled) || !fl.Advanced.IsEmpty()
+}
+
+// IsEmpty returns true if there is no customization to the internal load balancer.
+func (c *InternalHTTPConfig) IsEmpty() bool {
+	return len(c.AllowedSourceIPs) == 0
+}
+
+type subnetsOfType struct {
+	typ     string
+	configs []SubnetConfiguration
+}
+
+func (s SubnetsConfiguration) byType() []subnetsOfType {
+	return []subnetsOfType{
+		{typ: "public", configs: s.Public},
+		{typ: "private", configs: s.Private},
+	}
+}
+
+// ImportedVPC returns the configuration of the VPC to import, or nil if the environment doesn't import a VPC.
+func (v *EnvironmentVPCConfig) ImportedVPC() *config.ImportVPC {
+	if v.ID == nil {
+		return nil
+	}
+	imported := &config.ImportVPC{
+		ID: aws.StringValue(v.ID),
+	}
+	for _, subnet := range v.Subnets.Public {
+		imported.PublicSubnetIDs = append(imported.PublicSubnetIDs, aws.StringValue(subnet.SubnetID))
+	}
+	for _, subnet := range v.Subnets.Private {
+		imported.PrivateSubnetIDs = append(imported.PrivateSubnetIDs, aws.StringValue(subnet.SubnetID))
+	}
+	return imported
+}
+
+// ManagedVPC returns the configuration of the VPC created by Copilot, or nil if the default configuration is used.
+func (v *EnvironmentVPCConfig) ManagedVPC() *config.AdjustVPC {
+	if v.ID != nil || v.CIDR == nil {
+		return nil
+	}
+	adjusted := &config.AdjustVPC{
+		CIDR: string(*v.CIDR),
+	}
+	for _, subnet := range v.Subnets.Public {
+		if subnet.CIDR != nil {
+			adjusted.PublicSubnetCIDRs = append(adjusted.PublicSubnetCIDRs, string(*subnet.CIDR))
+		}
+	}
+	for _, subnet := range v.Subnets.Private {
+		if subnet.CIDR != nil {
+			adjusted.PrivateSubnetCIDRs = append(adjusted.PrivateSubnetCIDRs, string(*subnet.CIDR))
+		}
+	}
+	return adjusted
+}
+
+// CustomConfig returns the custom environment configuration, or nil if the default configuration is used.
+func (e *Environment) CustomConfig() *config.CustomizeEnv {
+	cfg := config.NewCustomizeEnv(e.Network.VPC.ImportedVPC(), e.Network.VPC.ManagedVPC())
+	if len(e.HTTPConfig.Public.Certificates) == 0 && !e.Network.VPC.IsPrivateOnly() {
+		return cfg
+	}
+	if cfg == nil {
+		cfg = &config.CustomizeEnv{}
+	}
+	cfg.ImportCertARNs = e.HTTPConfig.Public.Certificates
+	cfg.PrivateOnly = e.Network.VPC.IsPrivateOnly()
+	return cfg
+}
+
+// Telemetry returns the telemetry configuration of the environment, or nil if it isn't specified.
+func (e *Environment) Telemetry() *config.Telemetry {
+	if e.Observability.ContainerInsights == nil {
+		return nil
+	}
+	return &config.Telemetry{
+		EnableContainerInsights: aws.BoolValue(e.Observability.ContainerInsights),
+	}
+}
+
+func (v *EnvironmentVPCConfig) loadVPCConfig(cfg *config.CustomizeEnv) {
+	if cfg.ImportVPC != nil {
+		v.ID = stringP(cfg.ImportVPC.ID)
+		for _, id := range cfg.ImportVPC.PublicSubnetIDs {
+			v.Subnets.Public = append(v.Subnets.Public, SubnetConfiguration{SubnetID: stringP(id)})
+		}
+		for _, id := range cfg.ImportVPC.PrivateSubnetIDs {
+			v.Subnets.Private = append(v.Subnets.Private, SubnetConfiguration{SubnetID: stringP(id)})
+		}
+		return
+	}
+	if cfg.VPCConfig != nil {
+		v.CIDR = ipNetP(cfg.VPCConfig.CIDR)
+		for _, cidr := range cfg.VPCConfig.PublicSubnetCIDRs {
+			v.Subnets.Public = append(v.Subnets.Public, SubnetConfiguration{CIDR: ipNetP(cidr)})
+		}
+		for _, cidr := range cfg.VPCConfig.PrivateSubnetCIDRs {
+			v.Subnets.Private = append(v.Subnets.Private, SubnetConfiguration{CIDR: ipNetP(cidr)})
+		}
+	}
+}
+
+// MarshalBinary serializes the manifest object into a binary YAML document.
+// Implements the encoding.BinaryMarshaler interface.
+func (e *Environment) MarshalBinary() ([]byte, error) {
+	content, err := e.parser.Parse(environmentManifestPath, *e)
+	if err != nil {
+		return nil, err
+	}
+	return content.Bytes(), nil
+}
+
+// UnmarshalEnvironment deserializes the YAML input stream into an environment manifest object.
+// It returns an error if any issue occurs during deserialization or the manifest is not of the environment type.
+func UnmarshalEnvironment(in []byte) (*Environment, error) {
+	var m Environment
+	if err := yaml.Unmarshal(in, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal environment manifest: %w", err)
+	}
+	if typ := aws.StringValue(m.Type); typ != EnvironmentManifestType {
+		return nil, fmt.Errorf(`manifest type must be "%s" instead of "%s"`, EnvironmentManifestType, typ)
+	}
+	m.parser = template.New()
+	return &m, nil
+}
+
+func ipNetP(s string) *IPNet {
+	if s == "" {
+		return nil
+	}
+	ip := IPNet(s)
+	return &ip
+}
@@ -0,0 +1,359 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/copilot-cli/internal/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironment_MarshalBinary(t *testing.T) {
+	testCases := map[string]struct {
+		inProps EnvironmentProps
+
+		wantedTestdata string
+	}{
+		"with default configuration": {
+			inProps: EnvironmentProps{
+				Name: "test",
+			},
+			wantedTestdata: "environment-default.yml",
+		},
+		"with imported VPC": {
+			inProps: EnvironmentProps{
+				Name: "test",
+				CustomConfig: &config.CustomizeEnv{
+					ImportVPC: &config.ImportVPC{
+						ID:               "vpc-3f139646",
+						PublicSubnetIDs:  []string{"pub1", "pub2"},
+						PrivateSubnetIDs: []string{"priv1", "priv2"},
+					},
+				},
+			},
+			wantedTestdata: "environment-import-vpc.yml",
+		},
+		"with adjusted VPC and container insights": {
+			inProps: EnvironmentProps{
+				Name: "test",
+				CustomConfig: &config.CustomizeEnv{
+					VPCConfig: &config.AdjustVPC{
+						CIDR:               "10.0.0.0/16",
+						PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+						PrivateSubnetCIDRs: []string{"10.0.3.0/24", "10.0.4.0/24"},
+					},
+				},
+				Telemetry: &config.Telemetry{
+					EnableContainerInsights: true,
+				},
+			},
+			wantedTestdata: "environment-adjust-vpc.yml",
+		},
+		"with imported certificates": {
+			inProps: EnvironmentProps{
+				Name: "test",
+				CustomConfig: &config.CustomizeEnv{
+					ImportCertARNs: []string{
+						"arn:aws:acm:us-west-2:123456789012:certificate/abc",
+						"arn:aws:acm:us-west-2:123456789012:certificate/def",
+					},
+				},
+			},
+			wantedTestdata: "environment-import-certs.yml",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			path := filepath.Join("testdata", tc.wantedTestdata)
+			wantedBytes, err := ioutil.ReadFile(path)
+			require.NoError(t, err)
+			manifest := NewEnvironment(&tc.inProps)
+
+			// WHEN
+			tpl, err := manifest.MarshalBinary()
+			require.NoError(t, err)
+
+			// THEN
+			require.Equal(t, string(wantedBytes), string(tpl))
+		})
+	}
+}
+
+func TestUnmarshalEnvironment(t *testing.T) {
+	testCases := map[string]struct {
+		inContent string
+
+		wantedStruct *Environment
+		wantedErr    error
+	}{
+		"error if the manifest is not an environment manifest": {
+			inContent: `name: test
+type: Backend Service
+`,
+			wantedErr: errors.New(`manifest type must be "Environment" instead of "Backend Service"`),
+		},
+		"unmarshal with imported VPC and public load balancer settings": {
+			inContent: `name: test
+type: Environment
+
+network:
+  vpc:
+    id: vpc-3f139646
+    subnets:
+      public:
+        - id: pub1
+        - id: pub2
+      private:
+        - id: priv1
+        - id: priv2
+http:
+  public:
+    allowed_source_ips: ["10.24.34.0/23"]
+    ssl_policy: ELBSecurityPolicy-FS-1-1-2019-08
+observability:
+  container_insights: true
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("test"),
+					Type: aws.String(EnvironmentManifestType),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							ID: aws.String("vpc-3f139646"),
+							Subnets: SubnetsConfiguration{
+								Public: []SubnetConfiguration{
+									{SubnetID: aws.String("pub1")},
+									{SubnetID: aws.String("pub2")},
+								},
+								Private: []SubnetConfiguration{
+									{SubnetID: aws.String("priv1")},
+									{SubnetID: aws.String("priv2")},
+								},
+							},
+						},
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							AllowedSourceIPs: []IPNet{"10.24.34.0/23"},
+							SSLPolicy:        aws.String("ELBSecurityPolicy-FS-1-1-2019-08"),
+						},
+					},
+					Observability: EnvironmentObservability{
+						ContainerInsights: aws.Bool(true),
+					},
+				},
+			},
+		},
+		"unmarshal with flow logs, access logs and a web ACL enabled": {
+			inContent: `name: test
+type: Environment
+
+network:
+  vpc:
+    flow_logs: true
+http:
+  public:
+    access_logs: true
+    web_acl: arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("test"),
+					Type: aws.String(EnvironmentManifestType),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							FlowLogs: FlowLogsArgsOrBool{
+								Enabled: aws.Bool(true),
+							},
+						},
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							AccessLogs: ELBAccessLogsArgsOrBool{
+								Enabled: aws.Bool(true),
+							},
+							WebACL: aws.String("arn:aws:wafv2:us-west-2:123456789012:regional/webacl/mywebacl/1234"),
+						},
+					},
+				},
+			},
+		},
+		"unmarshal with advanced flow logs and access logs configuration": {
+			inContent: `name: test
+type: Environment
+
+network:
+  vpc:
+    flow_logs:
+      destination: s3
+      retention: 90
+http:
+  public:
+    access_logs:
+      bucket_name: my-bucket
+      prefix: my-prefix
+`,
+			wantedStruct: &Environment{
+				Workload: Workload{
+					Name: aws.String("test"),
+					Type: aws.String(EnvironmentManifestType),
+				},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							FlowLogs: FlowLogsArgsOrBool{
+								Advanced: FlowLogsArgs{
+									Destination: aws.String("s3"),
+									Retention:   aws.Int(90),
+								},
+							},
+						},
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							AccessLogs: ELBAccessLogsArgsOrBool{
+								Advanced: ELBAccessLogsArgs{
+									BucketName: aws.String("my-bucket"),
+									Prefix:     aws.String("my-prefix"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"error if flow_logs is neither a bool nor a map": {
+			inContent: `name: test
+type: Environment
+
+network:
+  vpc:
+    flow_logs: everything
+`,
+			wantedErr: fmt.Errorf("unmarshal environment manifest: %w", errUnmarshalFlowLogsOpts),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// WHEN
+			got, err := UnmarshalEnvironment([]byte(tc.inContent))
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedStruct.Workload, got.Workload)
+			require.Equal(t, tc.wantedStruct.EnvironmentConfig, got.EnvironmentConfig)
+		})
+	}
+}
+
+func TestEnvironment_CustomConfig(t *testing.T) {
+	testCases := map[string]struct {
+		inVPC   EnvironmentVPCConfig
+		inCerts []string
+
+		wanted *config.CustomizeEnv
+	}{
+		"nil if the default VPC configuration is used": {},
+		"imported VPC": {
+			inVPC: EnvironmentVPCConfig{
+				ID: aws.String("vpc-3f139646"),
+				Subnets: SubnetsConfiguration{
+					Public:  []SubnetConfiguration{{SubnetID: aws.String("pub1")}, {SubnetID: aws.String("pub2")}},
+					Private: []SubnetConfiguration{{SubnetID: aws.String("priv1")}, {SubnetID: aws.String("priv2")}},
+				},
+			},
+			wanted: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-3f139646",
+					PublicSubnetIDs:  []string{"pub1", "pub2"},
+					PrivateSubnetIDs: []string{"priv1", "priv2"},
+				},
+			},
+		},
+		"adjusted VPC": {
+			inVPC: EnvironmentVPCConfig{
+				CIDR: ipNetP("10.0.0.0/16"),
+				Subnets: SubnetsConfiguration{
+					Public:  []SubnetConfiguration{{CIDR: ipNetP("10.0.0.0/24")}, {CIDR: ipNetP("10.0.1.0/24")}},
+					Private: []SubnetConfiguration{{CIDR: ipNetP("10.0.3.0/24")}, {CIDR: ipNetP("10.0.4.0/24")}},
+				},
+			},
+			wanted: &config.CustomizeEnv{
+				VPCConfig: &config.AdjustVPC{
+					CIDR:               "10.0.0.0/16",
+					PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+					PrivateSubnetCIDRs: []string{"10.0.3.0/24", "10.0.4.0/24"},
+				},
+			},
+		},
+		"imported certificates with the default VPC configuration": {
+			inCerts: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+			wanted: &config.CustomizeEnv{
+				ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+			},
+		},
+		"private-only VPC with the default subnets": {
+			inVPC: EnvironmentVPCConfig{
+				PrivateOnly: aws.Bool(true),
+			},
+			wanted: &config.CustomizeEnv{
+				PrivateOnly: true,
+			},
+		},
+		"imported certificates with an imported VPC": {
+			inVPC: EnvironmentVPCConfig{
+				ID: aws.String("vpc-3f139646"),
+				Subnets: SubnetsConfiguration{
+					Public:  []SubnetConfiguration{{SubnetID: aws.String("pub1")}, {SubnetID: aws.String("pub2")}},
+					Private: []SubnetConfiguration{{SubnetID: aws.String("priv1")}, {SubnetID: aws.String("priv2")}},
+				},
+			},
+			inCerts: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+			wanted: &config.CustomizeEnv{
+				ImportVPC: &config.ImportVPC{
+					ID:               "vpc-3f139646",
+					PublicSubnetIDs:  []string{"pub1", "pub2"},
+					PrivateSubnetIDs: []string{"priv1", "priv2"},
+				},
+				ImportCertARNs: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mft := &Environment{
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: tc.inVPC,
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							Certificates: tc.inCerts,
+						},
+					},
+				},
+			}
+
+			require.Equal(t, tc.wanted, mft.CustomConfig())
+		})
+	}
+}
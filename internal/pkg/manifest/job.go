@@ -127,6 +127,16 @@ func (j *ScheduledJob) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return j.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageRepository returns the configuration of the ECR repository storing the images built from a Dockerfile.
+func (j *ScheduledJob) ImageRepository() ImageRepository {
+	return j.ImageConfig.Image.Repository
+}
+
+// SidecarBuildArgs returns the docker build arguments of the sidecars built from a Dockerfile, keyed by sidecar name.
+func (j *ScheduledJob) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(j.Sidecars, wsRoot)
+}
+
 // BuildRequired returns if the service requires building from the local Dockerfile.
 func (j *ScheduledJob) BuildRequired() (bool, error) {
 	return requiresBuild(j.ImageConfig.Image)
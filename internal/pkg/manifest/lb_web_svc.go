@@ -5,10 +5,14 @@ package manifest
 
 import (
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/copilot-cli/internal/pkg/template"
+	"github.com/dustin/go-humanize/english"
 	"github.com/imdario/mergo"
 	"gopkg.in/yaml.v3"
 )
@@ -23,6 +27,15 @@ const (
 	DefaultHealthCheckGracePeriod = 60
 )
 
+// Protocols of a network load balancer listener.
+const (
+	NLBListenerProtocolTCP = "TCP"
+	NLBListenerProtocolUDP = "UDP"
+	NLBListenerProtocolTLS = "TLS"
+)
+
+var nlbListenerProtocols = []string{NLBListenerProtocolTCP, NLBListenerProtocolUDP, NLBListenerProtocolTLS}
+
 var (
 	errUnmarshalHealthCheckArgs = errors.New("can't unmarshal healthcheck field into string or compose-style map")
 )
@@ -48,13 +61,15 @@ type LoadBalancedWebService struct {
 type LoadBalancedWebServiceConfig struct {
 	ImageConfig      ImageWithPortAndHealthcheck `yaml:"image,flow"`
 	ImageOverride    `yaml:",inline"`
-	RoutingRule      `yaml:"http,flow"`
+	RoutingRule      RoutingRuleConfiguration         `yaml:"http,flow"`
+	NLBConfig        NetworkLoadBalancerConfiguration `yaml:"nlb"`
 	TaskConfig       `yaml:",inline"`
 	Logging          `yaml:"logging,flow"`
-	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
-	Network          NetworkConfig             `yaml:"network"`
-	PublishConfig    PublishConfig             `yaml:"publish"`
-	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
+	Sidecars         map[string]*SidecarConfig           `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
+	Network          NetworkConfig                       `yaml:"network"`
+	DeployConfig     LBWebServiceDeploymentConfiguration `yaml:"deployment"`
+	PublishConfig    PublishConfig                       `yaml:"publish"`
+	TaskDefOverrides []OverrideRule                      `yaml:"taskdef_overrides"`
 }
 
 // LoadBalancedWebServiceProps contains properties for creating a new load balanced fargate service manifest.
@@ -90,9 +105,11 @@ func newDefaultLoadBalancedWebService() *LoadBalancedWebService {
 		},
 		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 			ImageConfig: ImageWithPortAndHealthcheck{},
-			RoutingRule: RoutingRule{
-				HealthCheck: HealthCheckArgsOrString{
-					HealthCheckPath: aws.String(DefaultHealthCheckPath),
+			RoutingRule: RoutingRuleConfiguration{
+				RoutingRule: RoutingRule{
+					HealthCheck: HealthCheckArgsOrString{
+						HealthCheckPath: aws.String(DefaultHealthCheckPath),
+					},
 				},
 			},
 			TaskConfig: TaskConfig{
@@ -148,6 +165,16 @@ func (s *LoadBalancedWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageRepository returns the configuration of the ECR repository storing the images built from a Dockerfile.
+func (s *LoadBalancedWebService) ImageRepository() ImageRepository {
+	return s.ImageConfig.Image.Repository
+}
+
+// SidecarBuildArgs returns the docker build arguments of the sidecars built from a Dockerfile, keyed by sidecar name.
+func (s *LoadBalancedWebService) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(s.Sidecars, wsRoot)
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s LoadBalancedWebService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -175,6 +202,56 @@ func (s LoadBalancedWebService) ApplyEnv(envName string) (WorkloadManifest, erro
 	return &s, nil
 }
 
+// RoutingRuleConfiguration holds the listener rules that route requests to the service.
+// The "http" field is either a single rule, or a list of rules where the first rule is the main one.
+type RoutingRuleConfiguration struct {
+	RoutingRule     `yaml:",inline"`
+	AdditionalRules []RoutingRule `yaml:"-"` // Rules after the first one when "http" is a list.
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the RoutingRuleConfiguration
+// struct, allowing it to perform more complex unmarshaling behavior.
+// This method implements the yaml.Unmarshaler (v3) interface.
+func (r *RoutingRuleConfiguration) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.SequenceNode {
+		return value.Decode(&r.RoutingRule)
+	}
+	if len(value.Content) == 0 {
+		return nil
+	}
+	// Decode the main rule on top of its default values.
+	if err := value.Content[0].Decode(&r.RoutingRule); err != nil {
+		return err
+	}
+	r.AdditionalRules = nil
+	for _, node := range value.Content[1:] {
+		var rule RoutingRule
+		if err := node.Decode(&rule); err != nil {
+			return err
+		}
+		r.AdditionalRules = append(r.AdditionalRules, rule)
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml(v3) interface. It writes a list of rules only if there are additional rules.
+func (r RoutingRuleConfiguration) MarshalYAML() (interface{}, error) {
+	if len(r.AdditionalRules) == 0 {
+		return r.RoutingRule, nil
+	}
+	return r.Rules(), nil
+}
+
+// Rules returns the main routing rule followed by the additional ones.
+func (r *RoutingRuleConfiguration) Rules() []RoutingRule {
+	return append([]RoutingRule{r.RoutingRule}, r.AdditionalRules...)
+}
+
+// IsInternal returns true if the service is behind the internal load balancer of the environment instead of the public one.
+func (r *RoutingRuleConfiguration) IsInternal() bool {
+	return aws.BoolValue(r.RoutingRule.Internal)
+}
+
 // RoutingRule holds the path to route requests to the service.
 type RoutingRule struct {
 	Path                *string                 `yaml:"path"`
@@ -182,10 +259,100 @@ type RoutingRule struct {
 	Stickiness          *bool                   `yaml:"stickiness"`
 	Alias               Alias                   `yaml:"alias"`
 	DeregistrationDelay *time.Duration          `yaml:"deregistration_delay"`
+	Internal            *bool                   `yaml:"internal"` // Whether the service is behind the environment's internal load balancer.
 	// TargetContainer is the container load balancer routes traffic to.
-	TargetContainer          *string `yaml:"target_container"`
-	TargetContainerCamelCase *string `yaml:"targetContainer"` // "targetContainerCamelCase" for backwards compatibility
-	AllowedSourceIps         []IPNet `yaml:"allowed_source_ips"`
+	TargetContainer          *string             `yaml:"target_container"`
+	TargetContainerCamelCase *string             `yaml:"targetContainer"` // "targetContainerCamelCase" for backwards compatibility
+	AllowedSourceIps         []IPNet             `yaml:"allowed_source_ips"`
+	Headers                  map[string][]string `yaml:"headers"`       // HTTP headers that requests must match, any of the values per header.
+	QueryStrings             map[string]string   `yaml:"query_strings"` // Query string key/value pairs that requests must match.
+}
+
+// LBWebServiceDeploymentConfiguration represents the deployment options of a Load Balanced Web Service.
+type LBWebServiceDeploymentConfiguration struct {
+	DeploymentConfiguration `yaml:",inline"`
+	BlueGreen               BlueGreenDeployment `yaml:"blue_green"`
+}
+
+// IsEmpty returns true if the LBWebServiceDeploymentConfiguration is not set.
+func (d *LBWebServiceDeploymentConfiguration) IsEmpty() bool {
+	return d.DeploymentConfiguration.IsEmpty() && d.BlueGreen.IsEmpty()
+}
+
+// BlueGreenDeployment represents the options of a blue/green deployment orchestrated by CodeDeploy.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/deployment-type-bluegreen.html.
+type BlueGreenDeployment struct {
+	TrafficShifting  *string        `yaml:"traffic_shifting"`   // One of "all_at_once", "linear", or "canary".
+	Percentage       *int           `yaml:"percentage"`         // Percentage of traffic shifted at each step for "linear" and "canary".
+	Interval         *time.Duration `yaml:"interval"`           // Time between each traffic shift for "linear" and "canary".
+	TestListenerPort *int           `yaml:"test_listener_port"` // Port of the load balancer listener that routes test traffic to the green tasks.
+	BakeTime         *time.Duration `yaml:"bake_time"`          // Time to keep the blue tasks running once all traffic is shifted.
+	Alarms           []string       `yaml:"alarms"`             // CloudWatch alarms that stop and roll back the deployment.
+}
+
+// IsEmpty returns true if the BlueGreenDeployment is not set.
+func (b *BlueGreenDeployment) IsEmpty() bool {
+	return b.TrafficShifting == nil && b.Percentage == nil && b.Interval == nil && b.TestListenerPort == nil &&
+		b.BakeTime == nil && len(b.Alarms) == 0
+}
+
+// NetworkLoadBalancerConfiguration holds options for a network load balancer.
+type NetworkLoadBalancerConfiguration struct {
+	Port            *string            `yaml:"port"` // The listener port and protocol, for example "443/tls".
+	HealthCheck     NLBHealthCheckArgs `yaml:"healthcheck"`
+	TargetContainer *string            `yaml:"target_container"`
+	TargetPort      *int               `yaml:"target_port"`
+	SSLPolicy       *string            `yaml:"ssl_policy"`
+	Stickiness      *bool              `yaml:"stickiness"`
+}
+
+// IsEmpty returns true if NetworkLoadBalancerConfiguration is empty.
+func (c *NetworkLoadBalancerConfiguration) IsEmpty() bool {
+	return c.Port == nil && c.HealthCheck.isEmpty() && c.TargetContainer == nil && c.TargetPort == nil &&
+		c.SSLPolicy == nil && c.Stickiness == nil
+}
+
+// ListenerPortAndProtocol returns the port and the protocol of the network load balancer's listener.
+// The "port" field is in the format "<port>[/<protocol>]" and the protocol defaults to TCP.
+func (c *NetworkLoadBalancerConfiguration) ListenerPortAndProtocol() (port uint16, protocol string, err error) {
+	if c.Port == nil {
+		return 0, "", &errFieldMustBeSpecified{
+			missingField: "port",
+		}
+	}
+	parts := strings.Split(aws.StringValue(c.Port), "/")
+	if len(parts) > 2 {
+		return 0, "", fmt.Errorf(`cannot parse port mapping from %s`, aws.StringValue(c.Port))
+	}
+	p, err := strconv.ParseUint(parts[0], 10, 16)
+	if err != nil || p == 0 {
+		return 0, "", fmt.Errorf(`cannot parse port %s: port must be a number between 1 and 65535`, parts[0])
+	}
+	protocol = NLBListenerProtocolTCP
+	if len(parts) == 2 {
+		protocol = strings.ToUpper(parts[1])
+	}
+	for _, valid := range nlbListenerProtocols {
+		if protocol == valid {
+			return uint16(p), protocol, nil
+		}
+	}
+	return 0, "", fmt.Errorf(`invalid protocol %s; valid protocols include %s`, parts[1], english.WordSeries(nlbListenerProtocols, "and"))
+}
+
+// NLBHealthCheckArgs holds the configuration to determine if the network load balanced web service is healthy.
+// These options are specifiable under the "healthcheck" field.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-elasticloadbalancingv2-targetgroup.html.
+type NLBHealthCheckArgs struct {
+	Port               *int           `yaml:"port"`
+	HealthyThreshold   *int64         `yaml:"healthy_threshold"`
+	UnhealthyThreshold *int64         `yaml:"unhealthy_threshold"`
+	Timeout            *time.Duration `yaml:"timeout"`
+	Interval           *time.Duration `yaml:"interval"`
+}
+
+func (h *NLBHealthCheckArgs) isEmpty() bool {
+	return h.Port == nil && h.HealthyThreshold == nil && h.UnhealthyThreshold == nil && h.Timeout == nil && h.Interval == nil
 }
 
 // IPNet represents an IP network string. For example: 10.1.0.0/16
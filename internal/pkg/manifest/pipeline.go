@@ -6,6 +6,8 @@ package manifest
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/aws/copilot-cli/internal/pkg/template"
 	"github.com/fatih/structs"
@@ -161,11 +163,13 @@ const (
 // and deployment ordering of your environments.
 type PipelineManifest struct {
 	// Name of the pipeline
-	Name    string                     `yaml:"name"`
-	Version PipelineSchemaMajorVersion `yaml:"version"`
-	Source  *Source                    `yaml:"source"`
-	Build   *Build                     `yaml:"build"`
-	Stages  []PipelineStage            `yaml:"stages"`
+	Name          string                     `yaml:"name"`
+	Version       PipelineSchemaMajorVersion `yaml:"version"`
+	Workloads     []string                   `yaml:"workloads,omitempty"` // Workloads to deploy. If empty, every workload in the workspace is deployed.
+	Source        *Source                    `yaml:"source"`
+	Build         *Build                     `yaml:"build"`
+	Stages        []PipelineStage            `yaml:"stages"`
+	Notifications *PipelineNotifications     `yaml:"notifications,omitempty"`
 
 	parser template.Parser
 }
@@ -174,6 +178,7 @@ type PipelineManifest struct {
 type Source struct {
 	ProviderName string                 `yaml:"provider"`
 	Properties   map[string]interface{} `yaml:"properties"`
+	Paths        []string               `yaml:"paths,omitempty"` // File path patterns that trigger the pipeline when changed.
 }
 
 // Build defines the build project to build and test image.
@@ -183,9 +188,22 @@ type Build struct {
 
 // PipelineStage represents a stage in the pipeline manifest
 type PipelineStage struct {
-	Name             string   `yaml:"name"`
-	RequiresApproval bool     `yaml:"requires_approval,omitempty"`
-	TestCommands     []string `yaml:"test_commands,omitempty"`
+	Name             string                        `yaml:"name"`
+	RequiresApproval bool                          `yaml:"requires_approval,omitempty"`
+	TestCommands     []string                      `yaml:"test_commands,omitempty"`
+	PreDeployments   map[string]*PrePostDeployment `yaml:"pre_deployments,omitempty"`
+	PostDeployments  map[string]*PrePostDeployment `yaml:"post_deployments,omitempty"`
+}
+
+// PrePostDeployment represents an action that runs a buildspec before or after the workloads of a stage are deployed.
+type PrePostDeployment struct {
+	BuildspecPath string   `yaml:"buildspec"`
+	DependsOn     []string `yaml:"depends_on,omitempty"`
+}
+
+// PipelineNotifications represents who gets notified of approval requests and stage failures in the pipeline.
+type PipelineNotifications struct {
+	Emails []string `yaml:"emails"`
 }
 
 // NewPipelineManifest returns a pipeline manifest object.
@@ -237,6 +255,14 @@ func UnmarshalPipeline(in []byte) (*PipelineManifest, error) {
 	// TODO: #221 Do more validations
 	switch version {
 	case Ver1:
+		if err := pm.Source.validatePaths(); err != nil {
+			return nil, err
+		}
+		for _, stage := range pm.Stages {
+			if err := stage.validate(); err != nil {
+				return nil, fmt.Errorf(`validate stage "%s": %w`, stage.Name, err)
+			}
+		}
 		return &pm, nil
 	}
 	// we should never reach here, this is just to make the compiler happy
@@ -255,6 +281,58 @@ func (s Source) IsCodeStarConnection() bool {
 	}
 }
 
+var actionNameRegExp = regexp.MustCompile(`^[A-Za-z0-9.@_-]{1,100}$`)
+
+// validate returns an error if the pre- or post-deployment actions of the stage are invalid.
+func (s PipelineStage) validate() error {
+	for _, field := range []struct {
+		name    string
+		actions map[string]*PrePostDeployment
+	}{
+		{name: "pre_deployments", actions: s.PreDeployments},
+		{name: "post_deployments", actions: s.PostDeployments},
+	} {
+		names := make([]string, 0, len(field.actions))
+		for name := range field.actions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		// The logical IDs of the actions in the pipeline template only keep the alphanumeric characters of their names.
+		sanitized := make(map[string]string, len(names))
+		for _, name := range names {
+			action := field.actions[name]
+			if !actionNameRegExp.MatchString(name) {
+				return fmt.Errorf(`"%s.%s": name must contain only alphanumeric characters and .@_- and be at most 100 characters long`, field.name, name)
+			}
+			id := template.StripNonAlphaNumFunc(name)
+			if other, ok := sanitized[id]; ok {
+				return fmt.Errorf(`"%s.%s": name must differ from "%s" by more than non-alphanumeric characters`, field.name, name, other)
+			}
+			sanitized[id] = name
+			if action == nil || action.BuildspecPath == "" {
+				return fmt.Errorf(`"%s.%s.buildspec" must be specified`, field.name, name)
+			}
+			for _, dep := range action.DependsOn {
+				if _, ok := field.actions[dep]; !ok {
+					return fmt.Errorf(`"%s.%s.depends_on": action "%s" does not exist in "%s"`, field.name, name, dep, field.name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validatePaths returns an error if the source has path filters but its provider can't filter triggers by file paths.
+func (s *Source) validatePaths() error {
+	if s == nil || len(s.Paths) == 0 {
+		return nil
+	}
+	if !s.IsCodeStarConnection() || s.Properties["access_token_secret"] != nil {
+		return fmt.Errorf(`"source.paths" is only supported for %s and %s sources`, GithubProviderName, BitbucketProviderName)
+	}
+	return nil
+}
+
 func validateVersion(pm *PipelineManifest) (PipelineSchemaMajorVersion, error) {
 	switch pm.Version {
 	case Ver1:
@@ -303,6 +303,217 @@ stages:
 				},
 			},
 		},
+		"valid pipeline.yml with workloads and paths": {
+			inContent: `
+name: api-pipeline
+version: 1
+workloads: [api, worker]
+
+source:
+  provider: Bitbucket
+  properties:
+    repository: https://bitbucket.org/aws/somethingCool
+    branch: main
+  paths: ['api/**', 'copilot/api/**']
+
+stages:
+    -
+      name: test
+`,
+			expectedManifest: &PipelineManifest{
+				Name:      "api-pipeline",
+				Version:   Ver1,
+				Workloads: []string{"api", "worker"},
+				Source: &Source{
+					ProviderName: "Bitbucket",
+					Properties: map[string]interface{}{
+						"repository": "https://bitbucket.org/aws/somethingCool",
+						"branch":     "main",
+					},
+					Paths: []string{"api/**", "copilot/api/**"},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+					},
+				},
+			},
+		},
+		"valid pipeline.yml with pre and post deployments and notifications": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    branch: main
+
+stages:
+    -
+      name: test
+      pre_deployments:
+        migrate:
+          buildspec: copilot/pipelines/migrate/buildspec.yml
+      post_deployments:
+        smoke:
+          buildspec: copilot/pipelines/smoke/buildspec.yml
+        load:
+          buildspec: copilot/pipelines/load/buildspec.yml
+          depends_on: [smoke]
+
+notifications:
+  emails: [team@example.com]
+`,
+			expectedManifest: &PipelineManifest{
+				Name:    "pipepiper",
+				Version: Ver1,
+				Source: &Source{
+					ProviderName: "GitHub",
+					Properties: map[string]interface{}{
+						"repository": "aws/somethingCool",
+						"branch":     "main",
+					},
+				},
+				Stages: []PipelineStage{
+					{
+						Name: "test",
+						PreDeployments: map[string]*PrePostDeployment{
+							"migrate": {
+								BuildspecPath: "copilot/pipelines/migrate/buildspec.yml",
+							},
+						},
+						PostDeployments: map[string]*PrePostDeployment{
+							"smoke": {
+								BuildspecPath: "copilot/pipelines/smoke/buildspec.yml",
+							},
+							"load": {
+								BuildspecPath: "copilot/pipelines/load/buildspec.yml",
+								DependsOn:     []string{"smoke"},
+							},
+						},
+					},
+				},
+				Notifications: &PipelineNotifications{
+					Emails: []string{"team@example.com"},
+				},
+			},
+		},
+		"pre deployment without a buildspec": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+
+stages:
+    -
+      name: test
+      pre_deployments:
+        migrate:
+          depends_on: []
+`,
+			expectedErr: errors.New(`validate stage "test": "pre_deployments.migrate.buildspec" must be specified`),
+		},
+		"post deployment that depends on an unknown action": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+
+stages:
+    -
+      name: test
+      post_deployments:
+        load:
+          buildspec: copilot/pipelines/load/buildspec.yml
+          depends_on: [migrate]
+`,
+			expectedErr: errors.New(`validate stage "test": "post_deployments.load.depends_on": action "migrate" does not exist in "post_deployments"`),
+		},
+		"deployment action with an invalid name": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+
+stages:
+    -
+      name: test
+      post_deployments:
+        "smoke test":
+          buildspec: copilot/pipelines/smoke/buildspec.yml
+`,
+			expectedErr: errors.New(`validate stage "test": "post_deployments.smoke test": name must contain only alphanumeric characters and .@_- and be at most 100 characters long`),
+		},
+		"deployment actions whose names only differ by non-alphanumeric characters": {
+			inContent: `
+name: pipepiper
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+
+stages:
+    -
+      name: test
+      pre_deployments:
+        db-migrate:
+          buildspec: copilot/pipelines/migrate/buildspec.yml
+        db_migrate:
+          buildspec: copilot/pipelines/migrate/buildspec.yml
+`,
+			expectedErr: errors.New(`validate stage "test": "pre_deployments.db_migrate": name must differ from "db-migrate" by more than non-alphanumeric characters`),
+		},
+		"paths with a CodeCommit source": {
+			inContent: `
+name: api-pipeline
+version: 1
+
+source:
+  provider: CodeCommit
+  properties:
+    repository: https://git-codecommit.us-west-2.amazonaws.com/v1/repos/somethingCool
+  paths: ['api/**']
+
+stages:
+    -
+      name: test
+`,
+			expectedErr: errors.New(`"source.paths" is only supported for GitHub and Bitbucket sources`),
+		},
+		"paths with a GitHub source using a personal access token": {
+			inContent: `
+name: api-pipeline
+version: 1
+
+source:
+  provider: GitHub
+  properties:
+    repository: aws/somethingCool
+    access_token_secret: "github-token-badgoose-backend"
+  paths: ['api/**']
+
+stages:
+    -
+      name: test
+`,
+			expectedErr: errors.New(`"source.paths" is only supported for GitHub and Bitbucket sources`),
+		},
 	}
 
 	for name, tc := range testCases {
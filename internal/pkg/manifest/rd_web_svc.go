@@ -104,6 +104,11 @@ func (s *RequestDrivenWebService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageRepository returns the configuration of the ECR repository storing the images built from a Dockerfile.
+func (s *RequestDrivenWebService) ImageRepository() ImageRepository {
+	return s.ImageConfig.Image.Repository
+}
+
 // ApplyEnv returns the service manifest with environment overrides.
 // If the environment passed in does not have any overrides then it returns itself.
 func (s RequestDrivenWebService) ApplyEnv(envName string) (WorkloadManifest, error) {
@@ -18,11 +18,12 @@ var (
 type Storage struct {
 	Ephemeral *int               `yaml:"ephemeral"`
 	Volumes   map[string]*Volume `yaml:"volumes"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
+	Shared    []string           `yaml:"shared"`  // Names of the environment addons whose outputs are injected into the workload.
 }
 
 // IsEmpty returns empty if the struct has all zero members.
 func (s *Storage) IsEmpty() bool {
-	return s.Ephemeral == nil && s.Volumes == nil
+	return s.Ephemeral == nil && s.Volumes == nil && s.Shared == nil
 }
 
 // Volume is an abstraction which merges the MountPoint and Volumes concepts from the ECS Task Definition
@@ -264,6 +264,11 @@ func TestStorage_IsEmpty(t *testing.T) {
 				},
 			},
 		},
+		"storage with shared environment addons": {
+			in: Storage{
+				Shared: []string{"orders"},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
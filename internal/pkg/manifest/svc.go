@@ -263,6 +263,19 @@ func (qs *QueueScaling) AcceptableBacklogPerTask() (int, error) {
 	return int(v), nil
 }
 
+// DeploymentConfiguration represents the rolling update options of an ECS service.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-properties-ecs-service-deploymentconfiguration.html.
+type DeploymentConfiguration struct {
+	MinHealthyPercent *int  `yaml:"minimum_healthy_percent"`
+	MaxPercent        *int  `yaml:"maximum_percent"`
+	Rollback          *bool `yaml:"rollback"` // Whether the deployment circuit breaker rolls back failed deployments.
+}
+
+// IsEmpty returns true if the DeploymentConfiguration is not set.
+func (d *DeploymentConfiguration) IsEmpty() bool {
+	return d.MinHealthyPercent == nil && d.MaxPercent == nil && d.Rollback == nil
+}
+
 // ServiceDockerfileBuildRequired returns if the service container image should be built from local Dockerfile.
 func ServiceDockerfileBuildRequired(svc interface{}) (bool, error) {
 	return dockerfileBuildRequired("service", svc)
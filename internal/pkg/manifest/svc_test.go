@@ -95,19 +95,21 @@ environments:
 								Credentials: aws.String("some arn"),
 							}, Port: aws.Uint16(80)},
 						},
-						RoutingRule: RoutingRule{
-							Alias: Alias{
-								StringSlice: []string{
-									"foobar.com",
-									"v1.foobar.com",
+						RoutingRule: RoutingRuleConfiguration{
+							RoutingRule: RoutingRule{
+								Alias: Alias{
+									StringSlice: []string{
+										"foobar.com",
+										"v1.foobar.com",
+									},
 								},
+								Path:            aws.String("svc"),
+								TargetContainer: aws.String("frontend"),
+								HealthCheck: HealthCheckArgsOrString{
+									HealthCheckPath: aws.String("/"),
+								},
+								AllowedSourceIps: []IPNet{IPNet("10.1.0.0/24"), IPNet("10.1.1.0/24")},
 							},
-							Path:            aws.String("svc"),
-							TargetContainer: aws.String("frontend"),
-							HealthCheck: HealthCheckArgsOrString{
-								HealthCheckPath: aws.String("/"),
-							},
-							AllowedSourceIps: []IPNet{IPNet("10.1.0.0/24"), IPNet("10.1.1.0/24")},
 						},
 						TaskConfig: TaskConfig{
 							CPU:    aws.Int(512),
@@ -124,14 +126,14 @@ environments:
 							Variables: map[string]string{
 								"LOG_LEVEL": "WARN",
 							},
-							Secrets: map[string]string{
-								"DB_PASSWORD": "MYSQL_DB_PASSWORD",
+							Secrets: map[string]Secret{
+								"DB_PASSWORD": SecretFromString("MYSQL_DB_PASSWORD"),
 							},
 						},
 						Sidecars: map[string]*SidecarConfig{
 							"xray": {
 								Port:       aws.String("2000/udp"),
-								Image:      aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+								Image:      SidecarImage{Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon")},
 								CredsParam: aws.String("some arn"),
 							},
 						},
@@ -262,8 +264,8 @@ secrets:
 							ExecuteCommand: ExecuteCommand{
 								Enable: aws.Bool(false),
 							},
-							Secrets: map[string]string{
-								"API_TOKEN": "SUBS_API_TOKEN",
+							Secrets: map[string]Secret{
+								"API_TOKEN": SecretFromString("SUBS_API_TOKEN"),
 							},
 						},
 						Network: NetworkConfig{
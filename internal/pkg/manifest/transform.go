@@ -17,6 +17,7 @@ var defaultTransformers = []mergo.Transformers{
 	// do not merge anything - they just unset the fields that do not get specified in source manifest.
 	basicTransformer{},
 	imageTransformer{},
+	sidecarImageTransformer{},
 	buildArgsOrStringTransformer{},
 	stringSliceOrStringTransformer{},
 	platformArgsOrStringTransformer{},
@@ -69,6 +70,32 @@ func (t imageTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Va
 	}
 }
 
+type sidecarImageTransformer struct{}
+
+// Transformer returns custom merge logic for SidecarImage's fields.
+func (t sidecarImageTransformer) Transformer(typ reflect.Type) func(dst, src reflect.Value) error {
+	if typ != reflect.TypeOf(SidecarImage{}) {
+		return nil
+	}
+
+	return func(dst, src reflect.Value) error {
+		dstStruct, srcStruct := dst.Interface().(SidecarImage), src.Interface().(SidecarImage)
+
+		if !srcStruct.Build.isEmpty() {
+			dstStruct.Location = nil
+		}
+
+		if srcStruct.Location != nil {
+			dstStruct.Build = BuildArgsOrString{}
+		}
+
+		if dst.CanSet() { // For extra safety to prevent panicking.
+			dst.Set(reflect.ValueOf(dstStruct))
+		}
+		return nil
+	}
+}
+
 type buildArgsOrStringTransformer struct{}
 
 // Transformer returns custom merge logic for BuildArgsOrString's fields.
@@ -135,10 +162,17 @@ func (t platformArgsOrStringTransformer) Transformer(typ reflect.Type) func(dst,
 
 		if srcStruct.PlatformString != nil {
 			dstStruct.PlatformArgs = PlatformArgs{}
+			dstStruct.PlatformList = nil
 		}
 
 		if !srcStruct.PlatformArgs.isEmpty() {
 			dstStruct.PlatformString = nil
+			dstStruct.PlatformList = nil
+		}
+
+		if len(srcStruct.PlatformList) != 0 {
+			dstStruct.PlatformString = nil
+			dstStruct.PlatformArgs = PlatformArgs{}
 		}
 
 		if dst.CanSet() { // For extra safety to prevent panicking.
@@ -218,6 +218,69 @@ func TestImageTransformer_Transformer(t *testing.T) {
 	}
 }
 
+func TestSidecarImageTransformer_Transformer(t *testing.T) {
+	testCases := map[string]struct {
+		original func(i *SidecarImage)
+		override func(i *SidecarImage)
+		wanted   func(i *SidecarImage)
+	}{
+		"build set to empty if location is not nil": {
+			original: func(i *SidecarImage) {
+				i.Build = BuildArgsOrString{
+					BuildString: aws.String("mockBuild"),
+				}
+			},
+			override: func(i *SidecarImage) {
+				i.Location = aws.String("mockLocation")
+			},
+			wanted: func(i *SidecarImage) {
+				i.Location = aws.String("mockLocation")
+				i.Build = BuildArgsOrString{}
+			},
+		},
+		"location set to empty if build is not nil": {
+			original: func(i *SidecarImage) {
+				i.Location = aws.String("mockLocation")
+			},
+			override: func(i *SidecarImage) {
+				i.Build = BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("mockDockerfile"),
+					},
+				}
+			},
+			wanted: func(i *SidecarImage) {
+				i.Location = nil
+				i.Build = BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("mockDockerfile"),
+					},
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var dst, override, wanted SidecarImage
+
+			tc.original(&dst)
+			tc.override(&override)
+			tc.wanted(&wanted)
+
+			// Perform default merge.
+			err := mergo.Merge(&dst, override, mergo.WithOverride)
+			require.NoError(t, err)
+
+			// Use sidecarImageTransformer.
+			err = mergo.Merge(&dst, override, mergo.WithOverride, mergo.WithTransformers(sidecarImageTransformer{}))
+			require.NoError(t, err)
+
+			require.Equal(t, wanted, dst)
+		})
+	}
+}
+
 func TestBuildArgsOrStringTransformer_Transformer(t *testing.T) {
 	testCases := map[string]struct {
 		original func(b *BuildArgsOrString)
@@ -371,6 +434,28 @@ func TestPlatformArgsOrStringTransformer_Transformer(t *testing.T) {
 				p.PlatformString = &mockPlatformStr
 			},
 		},
+		"list set to empty if string is not nil": {
+			original: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/amd64", "linux/arm64"}
+			},
+			override: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+			wanted: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+		},
+		"string and args set to empty if list is not empty": {
+			original: func(p *PlatformArgsOrString) {
+				p.PlatformString = &mockPlatformStr
+			},
+			override: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/arm64", "linux/amd64"}
+			},
+			wanted: func(p *PlatformArgsOrString) {
+				p.PlatformList = []PlatformString{"linux/arm64", "linux/amd64"}
+			},
+		},
 	}
 
 	for name, tc := range testCases {
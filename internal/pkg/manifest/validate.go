@@ -11,8 +11,11 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/copilot-cli/internal/pkg/docker/dockerengine"
 	"github.com/aws/copilot-cli/internal/pkg/graph"
 	"github.com/dustin/go-humanize/english"
 )
@@ -27,6 +30,14 @@ const (
 	// Min and Max values for task ephemeral storage in GiB.
 	ephemeralMinValueGiB = 20
 	ephemeralMaxValueGiB = 200
+
+	// Max number of target groups that an ECS service can be attached to.
+	maxTargetGroupsPerService = 5
+
+	// Service name of ARNs of ACM certificates.
+	acmServiceName = "acm"
+	// Service name of ARNs of WAFv2 web ACLs.
+	wafv2ServiceName = "wafv2"
 )
 
 var (
@@ -55,9 +66,20 @@ func (l *LoadBalancedWebService) Validate() error {
 	if err = l.RoutingRule.Validate(); err != nil {
 		return fmt.Errorf(`validate "http": %w`, err)
 	}
+	if err = l.NLBConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "nlb": %w`, err)
+	}
 	if err = l.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	for env, override := range l.Environments {
+		if override == nil {
+			continue
+		}
+		if err = validatePlatformOverride(l.Platform, override.Platform); err != nil {
+			return fmt.Errorf(`validate "environments[%s].platform": %w`, env, err)
+		}
+	}
 	if err = l.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -65,10 +87,32 @@ func (l *LoadBalancedWebService) Validate() error {
 		if err = v.Validate(); err != nil {
 			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
 		}
+		if err = validateBuiltSidecarName(k, v); err != nil {
+			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+		}
 	}
 	if err = l.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if err = l.DeployConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
+	if !l.DeployConfig.BlueGreen.IsEmpty() && !l.NLBConfig.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "deployment.blue_green",
+			secondField: "nlb",
+		}
+	}
+	if !l.DeployConfig.BlueGreen.IsEmpty() && len(l.RoutingRule.AdditionalRules) > 0 {
+		return errors.New(`"deployment.blue_green" cannot be specified with more than one "http" rule`)
+	}
+	targetGroups := len(l.RoutingRule.Rules())
+	if !l.NLBConfig.IsEmpty() {
+		targetGroups++
+	}
+	if targetGroups > maxTargetGroupsPerService {
+		return fmt.Errorf(`a service can have at most %d target groups: one for each "http" rule and one for "nlb"`, maxTargetGroupsPerService)
+	}
 	if err = l.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
@@ -105,6 +149,14 @@ func (b *BackendService) Validate() error {
 	if err = b.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	for env, override := range b.Environments {
+		if override == nil {
+			continue
+		}
+		if err = validatePlatformOverride(b.Platform, override.Platform); err != nil {
+			return fmt.Errorf(`validate "environments[%s].platform": %w`, env, err)
+		}
+	}
 	if err = b.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -112,10 +164,16 @@ func (b *BackendService) Validate() error {
 		if err = v.Validate(); err != nil {
 			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
 		}
+		if err = validateBuiltSidecarName(k, v); err != nil {
+			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+		}
 	}
 	if err = b.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if err = b.DeployConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
 	if err = b.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
@@ -152,6 +210,9 @@ func (r *RequestDrivenWebService) Validate() error {
 	if err = r.PublishConfig.Validate(); err != nil {
 		return fmt.Errorf(`validate "publish": %w`, err)
 	}
+	if err = validateAppRunnerPlatform(r.InstanceConfig.Platform); err != nil {
+		return fmt.Errorf(`validate "platform": %w`, err)
+	}
 	if aws.StringValue(r.Name) == "" {
 		return &errFieldMustBeSpecified{
 			missingField: "name",
@@ -172,6 +233,14 @@ func (w *WorkerService) Validate() error {
 	if err = w.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	for env, override := range w.Environments {
+		if override == nil {
+			continue
+		}
+		if err = validatePlatformOverride(w.Platform, override.Platform); err != nil {
+			return fmt.Errorf(`validate "environments[%s].platform": %w`, env, err)
+		}
+	}
 	if err = w.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -179,10 +248,16 @@ func (w *WorkerService) Validate() error {
 		if err = v.Validate(); err != nil {
 			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
 		}
+		if err = validateBuiltSidecarName(k, v); err != nil {
+			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+		}
 	}
 	if err = w.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
 	}
+	if err = w.DeployConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "deployment": %w`, err)
+	}
 	if err = w.Subscribe.Validate(); err != nil {
 		return fmt.Errorf(`validate "subscribe": %w`, err)
 	}
@@ -219,6 +294,14 @@ func (s *ScheduledJob) Validate() error {
 	if err = s.TaskConfig.Validate(); err != nil {
 		return err
 	}
+	for env, override := range s.Environments {
+		if override == nil {
+			continue
+		}
+		if err = validatePlatformOverride(s.Platform, override.Platform); err != nil {
+			return fmt.Errorf(`validate "environments[%s].platform": %w`, env, err)
+		}
+	}
 	if err = s.Logging.Validate(); err != nil {
 		return fmt.Errorf(`validate "logging": %w`, err)
 	}
@@ -226,6 +309,9 @@ func (s *ScheduledJob) Validate() error {
 		if err = v.Validate(); err != nil {
 			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
 		}
+		if err = validateBuiltSidecarName(k, v); err != nil {
+			return fmt.Errorf(`validate "sidecars[%s]": %w`, k, err)
+		}
 	}
 	if err = s.Network.Validate(); err != nil {
 		return fmt.Errorf(`validate "network": %w`, err)
@@ -260,6 +346,277 @@ func (s *ScheduledJob) Validate() error {
 	return nil
 }
 
+// Validate returns nil if Environment is configured correctly.
+func (e *Environment) Validate() error {
+	if aws.StringValue(e.Name) == "" {
+		return &errFieldMustBeSpecified{
+			missingField: "name",
+		}
+	}
+	if err := e.Network.Validate(); err != nil {
+		return fmt.Errorf(`validate "network": %w`, err)
+	}
+	if err := e.HTTPConfig.Validate(); err != nil {
+		return fmt.Errorf(`validate "http": %w`, err)
+	}
+	if e.Network.VPC.IsPrivateOnly() && !e.HTTPConfig.Public.IsEmpty() {
+		return errors.New(`"http.public" cannot be specified in a "private_only" environment`)
+	}
+	if err := e.CICD.Validate(); err != nil {
+		return fmt.Errorf(`validate "cicd": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if EnvironmentNetworkConfig is configured correctly.
+func (n *EnvironmentNetworkConfig) Validate() error {
+	if err := n.VPC.Validate(); err != nil {
+		return fmt.Errorf(`validate "vpc": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if EnvironmentVPCConfig is configured correctly.
+func (v *EnvironmentVPCConfig) Validate() error {
+	if v.IsEmpty() {
+		return nil
+	}
+	if v.ID != nil && v.CIDR != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "id",
+			secondField: "cidr",
+		}
+	}
+	if err := v.CIDR.Validate(); err != nil {
+		return fmt.Errorf(`validate "cidr": %w`, err)
+	}
+	if err := v.FlowLogs.Validate(); err != nil {
+		return fmt.Errorf(`validate "flow_logs": %w`, err)
+	}
+	for idx, subnet := range v.Subnets.Public {
+		if err := subnet.Validate(); err != nil {
+			return fmt.Errorf(`validate "subnets.public[%d]": %w`, idx, err)
+		}
+	}
+	for idx, subnet := range v.Subnets.Private {
+		if err := subnet.Validate(); err != nil {
+			return fmt.Errorf(`validate "subnets.private[%d]": %w`, idx, err)
+		}
+	}
+	if v.ID != nil {
+		if v.IsPrivateOnly() {
+			return errors.New(`"private_only" cannot be specified when importing a VPC`)
+		}
+		return v.validateImportedVPC()
+	}
+	if v.IsPrivateOnly() && len(v.Subnets.Public) != 0 {
+		return errors.New(`"subnets.public" cannot be specified in a "private_only" VPC`)
+	}
+	if v.CIDR == nil && len(v.Subnets.Public) == 0 && len(v.Subnets.Private) == 0 {
+		// Only "private_only" or "flow_logs" is specified, Copilot creates the default subnets.
+		return nil
+	}
+	return v.validateManagedVPC()
+}
+
+func (v *EnvironmentVPCConfig) validateImportedVPC() error {
+	for _, subnets := range v.Subnets.byType() {
+		for idx, subnet := range subnets.configs {
+			if subnet.SubnetID == nil {
+				return fmt.Errorf(`validate "subnets.%s[%d]": %w`, subnets.typ, idx, &errFieldMustBeSpecified{
+					missingField:      "id",
+					conditionalFields: []string{"network.vpc.id"},
+				})
+			}
+			if subnet.CIDR != nil {
+				return fmt.Errorf(`validate "subnets.%s[%d]": "cidr" cannot be specified when importing a VPC`, subnets.typ, idx)
+			}
+		}
+	}
+	if len(v.Subnets.Public) == 1 {
+		return errors.New("at least two public subnets must be imported to enable Load Balancing")
+	}
+	if len(v.Subnets.Private) < 2 {
+		return errors.New("at least two private subnets must be imported")
+	}
+	return nil
+}
+
+func (v *EnvironmentVPCConfig) validateManagedVPC() error {
+	if v.CIDR == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "cidr",
+			conditionalFields: []string{"subnets"},
+		}
+	}
+	for _, subnets := range v.Subnets.byType() {
+		for idx, subnet := range subnets.configs {
+			if subnet.CIDR == nil {
+				return fmt.Errorf(`validate "subnets.%s[%d]": %w`, subnets.typ, idx, &errFieldMustBeSpecified{
+					missingField:      "cidr",
+					conditionalFields: []string{"network.vpc.cidr"},
+				})
+			}
+			if subnet.SubnetID != nil {
+				return fmt.Errorf(`validate "subnets.%s[%d]": "id" can only be specified when importing a VPC`, subnets.typ, idx)
+			}
+		}
+	}
+	if v.IsPrivateOnly() {
+		if len(v.Subnets.Private) < 2 {
+			return errors.New("at least two private subnets must be specified")
+		}
+		return nil
+	}
+	if len(v.Subnets.Public) < 2 || len(v.Subnets.Private) < 2 {
+		return errors.New("at least two public subnets and two private subnets must be specified")
+	}
+	return nil
+}
+
+// Validate returns nil if SubnetConfiguration is configured correctly.
+func (s *SubnetConfiguration) Validate() error {
+	if s.SubnetID != nil && s.CIDR != nil {
+		return &errFieldMutualExclusive{
+			firstField:  "id",
+			secondField: "cidr",
+		}
+	}
+	if err := s.CIDR.Validate(); err != nil {
+		return fmt.Errorf(`validate "cidr": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if EnvironmentHTTPConfig is configured correctly.
+func (c *EnvironmentHTTPConfig) Validate() error {
+	if err := c.Public.Validate(); err != nil {
+		return fmt.Errorf(`validate "public": %w`, err)
+	}
+	if err := c.Internal.Validate(); err != nil {
+		return fmt.Errorf(`validate "internal": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if PublicHTTPConfig is configured correctly.
+func (c *PublicHTTPConfig) Validate() error {
+	for idx, ip := range c.AllowedSourceIPs {
+		if err := ip.Validate(); err != nil {
+			return fmt.Errorf(`validate "allowed_source_ips[%d]": %w`, idx, err)
+		}
+	}
+	if err := c.AccessLogs.Validate(); err != nil {
+		return fmt.Errorf(`validate "access_logs": %w`, err)
+	}
+	if c.WebACL != nil {
+		parsed, err := arn.Parse(aws.StringValue(c.WebACL))
+		if err != nil {
+			return fmt.Errorf(`parse "web_acl": %w`, err)
+		}
+		if parsed.Service != wafv2ServiceName {
+			return fmt.Errorf(`validate "web_acl": %q is not a WAFv2 web ACL ARN`, aws.StringValue(c.WebACL))
+		}
+	}
+	for idx, certARN := range c.Certificates {
+		parsed, err := arn.Parse(certARN)
+		if err != nil {
+			return fmt.Errorf(`parse "certificates[%d]": %w`, idx, err)
+		}
+		if parsed.Service != acmServiceName {
+			return fmt.Errorf(`validate "certificates[%d]": %q is not an ACM certificate ARN`, idx, certARN)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if FlowLogsArgsOrBool is configured correctly.
+func (fl *FlowLogsArgsOrBool) Validate() error {
+	return fl.Advanced.Validate()
+}
+
+// Validate returns nil if FlowLogsArgs is configured correctly.
+func (fl *FlowLogsArgs) Validate() error {
+	if fl.Destination != nil {
+		var isValid bool
+		for _, allowed := range flowLogsDestinations {
+			if aws.StringValue(fl.Destination) == allowed {
+				isValid = true
+				break
+			}
+		}
+		if !isValid {
+			return fmt.Errorf(`"destination" %s must be one of %s`, aws.StringValue(fl.Destination), strings.Join(flowLogsDestinations, ", "))
+		}
+	}
+	if fl.Retention == nil {
+		return nil
+	}
+	retention := aws.IntValue(fl.Retention)
+	if retention <= 0 {
+		return errors.New(`"retention" must be a positive number of days`)
+	}
+	if aws.StringValue(fl.Destination) == FlowLogsDestinationS3 {
+		return nil
+	}
+	// The flow logs are published to a CloudWatch log group by default.
+	for _, allowed := range cloudWatchLogsRetentionDays {
+		if retention == allowed {
+			return nil
+		}
+	}
+	days := make([]string, len(cloudWatchLogsRetentionDays))
+	for i, allowed := range cloudWatchLogsRetentionDays {
+		days[i] = strconv.Itoa(allowed)
+	}
+	return fmt.Errorf(`"retention" %d must be one of %s for the %s destination`, retention, strings.Join(days, ", "), FlowLogsDestinationCloudWatch)
+}
+
+// Validate returns nil if ELBAccessLogsArgsOrBool is configured correctly.
+func (al *ELBAccessLogsArgsOrBool) Validate() error {
+	if al.Advanced.BucketName != nil && aws.StringValue(al.Advanced.BucketName) == "" {
+		return errors.New(`"bucket_name" cannot be empty`)
+	}
+	return nil
+}
+
+// Validate returns nil if InternalHTTPConfig is configured correctly.
+func (c *InternalHTTPConfig) Validate() error {
+	for idx, ip := range c.AllowedSourceIPs {
+		if err := ip.Validate(); err != nil {
+			return fmt.Errorf(`validate "allowed_source_ips[%d]": %w`, idx, err)
+		}
+	}
+	return nil
+}
+
+// Validate returns nil if EnvironmentCICDConfig is configured correctly.
+func (c *EnvironmentCICDConfig) Validate() error {
+	if err := c.GitHubActions.Validate(); err != nil {
+		return fmt.Errorf(`validate "github_actions": %w`, err)
+	}
+	return nil
+}
+
+var githubRepositoryRegExp = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+
+// Validate returns nil if GitHubActionsConfig is configured correctly.
+func (c *GitHubActionsConfig) Validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	if c.Repository == nil {
+		return &errFieldMustBeSpecified{
+			missingField:      "repository",
+			conditionalFields: []string{"create_oidc_provider"},
+		}
+	}
+	if !githubRepositoryRegExp.MatchString(aws.StringValue(c.Repository)) {
+		return fmt.Errorf(`validate "repository": %s must be of the form "owner/name"`, aws.StringValue(c.Repository))
+	}
+	return nil
+}
+
 // Validate returns nil if ImageWithPortAndHealthcheck is configured correctly.
 func (i *ImageWithPortAndHealthcheck) Validate() error {
 	var err error
@@ -329,9 +686,39 @@ func (i *Image) Validate() error {
 	if err = i.DependsOn.Validate(); err != nil {
 		return fmt.Errorf(`validate "depends_on": %w`, err)
 	}
+	if err = i.Repository.Validate(); err != nil {
+		return fmt.Errorf(`validate "repository": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if ImageRepository is configured correctly.
+func (r ImageRepository) Validate() error {
+	if r.KeepImages != nil && aws.IntValue(r.KeepImages) < 1 {
+		return errors.New(`"keep_images" must be a positive number`)
+	}
+	if r.UntaggedExpiryDays != nil && aws.IntValue(r.UntaggedExpiryDays) < 1 {
+		return errors.New(`"untagged_expiry_days" must be a positive number of days`)
+	}
+	if err := r.ScanFindings.Validate(); err != nil {
+		return fmt.Errorf(`validate "scan_findings": %w`, err)
+	}
 	return nil
 }
 
+// Validate returns nil if ImageScanFindings is configured correctly.
+func (f ImageScanFindings) Validate() error {
+	if f.Severity == nil {
+		return nil
+	}
+	for _, severity := range ImageScanSeverities {
+		if strings.EqualFold(aws.StringValue(f.Severity), severity) {
+			return nil
+		}
+	}
+	return fmt.Errorf(`"severity" %s must be one of %s`, aws.StringValue(f.Severity), strings.Join(ImageScanSeverities, ", "))
+}
+
 // Validate returns nil if DependsOn is configured correctly.
 func (d *DependsOn) Validate() error {
 	if d == nil {
@@ -396,6 +783,37 @@ func (*CommandOverride) Validate() error {
 	return nil
 }
 
+// Validate returns nil if RoutingRuleConfiguration is configured correctly.
+func (r *RoutingRuleConfiguration) Validate() error {
+	if err := r.RoutingRule.Validate(); err != nil {
+		return err
+	}
+	if r.IsInternal() && !r.Alias.IsEmpty() {
+		return &errFieldMutualExclusive{
+			firstField:  "internal",
+			secondField: "alias",
+		}
+	}
+	for ind, rule := range r.AdditionalRules {
+		if rule.Internal != nil {
+			return fmt.Errorf(`validate "http[%d]": "internal" can only be specified in the first rule`, ind+1)
+		}
+		if r.IsInternal() && !rule.Alias.IsEmpty() {
+			return fmt.Errorf(`validate "http[%d]": "alias" cannot be specified for an internal service`, ind+1)
+		}
+		// The index of additional rules starts at 1 since the main rule is the first of the list.
+		if rule.Path == nil {
+			return fmt.Errorf(`validate "http[%d]": %w`, ind+1, &errFieldMustBeSpecified{
+				missingField: "path",
+			})
+		}
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf(`validate "http[%d]": %w`, ind+1, err)
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if RoutingRule is configured correctly.
 func (r *RoutingRule) Validate() error {
 	var err error
@@ -416,10 +834,144 @@ func (r *RoutingRule) Validate() error {
 			return fmt.Errorf(`validate "allowed_source_ips[%d]": %w`, ind, err)
 		}
 	}
+	for name, values := range r.Headers {
+		if len(values) == 0 {
+			return fmt.Errorf(`"headers[%s]" must have at least one value`, name)
+		}
+	}
+	for key, value := range r.QueryStrings {
+		if value == "" {
+			return fmt.Errorf(`"query_strings[%s]" must have a value`, key)
+		}
+	}
 
 	return nil
 }
 
+// Validate returns nil if DeploymentConfiguration is configured correctly.
+func (d *DeploymentConfiguration) Validate() error {
+	if d.IsEmpty() {
+		return nil
+	}
+	if d.MinHealthyPercent != nil && (aws.IntValue(d.MinHealthyPercent) < 0 || aws.IntValue(d.MinHealthyPercent) > 100) {
+		return fmt.Errorf(`"minimum_healthy_percent" must be between 0 and 100`)
+	}
+	if d.MaxPercent != nil && aws.IntValue(d.MaxPercent) < 100 {
+		return fmt.Errorf(`"maximum_percent" must be greater than or equal to 100`)
+	}
+	return nil
+}
+
+// Validate returns nil if LBWebServiceDeploymentConfiguration is configured correctly.
+func (d *LBWebServiceDeploymentConfiguration) Validate() error {
+	if d.IsEmpty() {
+		return nil
+	}
+	if err := d.DeploymentConfiguration.Validate(); err != nil {
+		return err
+	}
+	if d.BlueGreen.IsEmpty() {
+		return nil
+	}
+	if d.MinHealthyPercent != nil || d.MaxPercent != nil || d.Rollback != nil {
+		return errors.New(`"blue_green" cannot be specified with rolling update fields "minimum_healthy_percent", "maximum_percent", or "rollback"`)
+	}
+	if err := d.BlueGreen.Validate(); err != nil {
+		return fmt.Errorf(`validate "blue_green": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if BlueGreenDeployment is configured correctly.
+func (b *BlueGreenDeployment) Validate() error {
+	if b.IsEmpty() {
+		return nil
+	}
+	shifting := TrafficShiftingAllAtOnce
+	if b.TrafficShifting != nil {
+		shifting = aws.StringValue(b.TrafficShifting)
+	}
+	isValidShifting := false
+	for _, t := range trafficShiftingTypes {
+		if shifting == t {
+			isValidShifting = true
+			break
+		}
+	}
+	if !isValidShifting {
+		return fmt.Errorf(`"traffic_shifting" %s must be one of %s`, shifting, strings.Join(trafficShiftingTypes, ", "))
+	}
+	if shifting == TrafficShiftingAllAtOnce {
+		if b.Percentage != nil || b.Interval != nil {
+			return fmt.Errorf(`"percentage" and "interval" cannot be specified when "traffic_shifting" is %s`, TrafficShiftingAllAtOnce)
+		}
+	} else {
+		if b.Percentage == nil || b.Interval == nil {
+			return fmt.Errorf(`"percentage" and "interval" must be specified when "traffic_shifting" is %s`, shifting)
+		}
+	}
+	if b.Percentage != nil && (aws.IntValue(b.Percentage) < 1 || aws.IntValue(b.Percentage) > 99) {
+		return errors.New(`"percentage" must be between 1 and 99`)
+	}
+	if err := validateWholeMinutes("interval", b.Interval); err != nil {
+		return err
+	}
+	if err := validateWholeMinutes("bake_time", b.BakeTime); err != nil {
+		return err
+	}
+	if b.TestListenerPort != nil && (aws.IntValue(b.TestListenerPort) < 1 || aws.IntValue(b.TestListenerPort) > 65535) {
+		return errors.New(`"test_listener_port" must be between 1 and 65535`)
+	}
+	return nil
+}
+
+// validateWholeMinutes returns an error if the duration is set but is not a positive whole number of minutes.
+func validateWholeMinutes(field string, d *time.Duration) error {
+	if d == nil {
+		return nil
+	}
+	if *d < time.Minute || *d%time.Minute != 0 {
+		return fmt.Errorf(`"%s" must be a whole number of minutes, such as "5m"`, field)
+	}
+	return nil
+}
+
+// Validate returns nil if NetworkLoadBalancerConfiguration is configured correctly.
+func (c *NetworkLoadBalancerConfiguration) Validate() error {
+	if c.IsEmpty() {
+		return nil
+	}
+	_, protocol, err := c.ListenerPortAndProtocol()
+	if err != nil {
+		return fmt.Errorf(`validate "port": %w`, err)
+	}
+	if c.SSLPolicy != nil && protocol != NLBListenerProtocolTLS {
+		return fmt.Errorf(`"ssl_policy" can only be specified when the protocol of "port" is %s`, NLBListenerProtocolTLS)
+	}
+	if protocol == NLBListenerProtocolUDP && c.HealthCheck.Port == nil {
+		// Target groups are health checked over TCP, which a container listening on a UDP port doesn't answer.
+		return fmt.Errorf(`"healthcheck.port" must be specified when the protocol of "port" is %s: the health checks of the network load balancer use TCP`, NLBListenerProtocolUDP)
+	}
+	if c.TargetPort != nil && (aws.IntValue(c.TargetPort) < 1 || aws.IntValue(c.TargetPort) > 65535) {
+		return fmt.Errorf(`"target_port" must be a number between 1 and 65535`)
+	}
+	if err := c.HealthCheck.Validate(); err != nil {
+		return fmt.Errorf(`validate "healthcheck": %w`, err)
+	}
+	return nil
+}
+
+// Validate returns nil if NLBHealthCheckArgs is configured correctly.
+func (h *NLBHealthCheckArgs) Validate() error {
+	if h.isEmpty() {
+		return nil
+	}
+	if h.Port != nil && (aws.IntValue(h.Port) < 1 || aws.IntValue(h.Port) > 65535) {
+		return fmt.Errorf(`"port" must be a number between 1 and 65535`)
+	}
+	return nil
+}
+
 // Validate returns nil if HealthCheckArgsOrString is configured correctly.
 func (h *HealthCheckArgsOrString) Validate() error {
 	if h.IsEmpty() {
@@ -475,9 +1027,46 @@ func (p *PlatformArgsOrString) Validate() error {
 	if err := p.PlatformString.Validate(); err != nil {
 		return err
 	}
+	seen := make(map[PlatformString]bool, len(p.PlatformList))
+	for i, platform := range p.PlatformList {
+		if err := p.PlatformList[i].Validate(); err != nil {
+			return err
+		}
+		if seen[platform] {
+			return fmt.Errorf("platform %s is specified more than once", platform)
+		}
+		seen[platform] = true
+	}
 	return p.PlatformArgs.Validate()
 }
 
+// validatePlatformOverride returns nil if an environment override of a multi-architecture "platform" lists the same platforms.
+// Every environment then builds the same image, and the order of the list only picks the platform that the tasks run on.
+func validatePlatformOverride(platform, override PlatformArgsOrString) error {
+	if len(platform.PlatformList) == 0 || override.IsZero() {
+		return nil
+	}
+	want, got := platform.platforms(), override.platforms()
+	sort.Strings(want)
+	sort.Strings(got)
+	if strings.Join(want, ",") != strings.Join(got, ",") {
+		return fmt.Errorf("%s must list the same platforms as %s in any order; the tasks run on the first platform of the list",
+			english.WordSeries(override.platforms(), "and"), english.WordSeries(platform.platforms(), "and"))
+	}
+	return nil
+}
+
+// validateAppRunnerPlatform returns nil if App Runner can run the images built for the platform.
+func validateAppRunnerPlatform(p PlatformArgsOrString) error {
+	supported := dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Amd64Arch)
+	for _, platform := range p.platforms() {
+		if platform != supported {
+			return fmt.Errorf("platform %s is not supported by App Runner; the valid platform is %s", platform, supported)
+		}
+	}
+	return nil
+}
+
 // Validate returns nil if PlatformString is configured correctly.
 func (p *PlatformString) Validate() error {
 	if p == nil {
@@ -689,6 +1278,16 @@ func (s *Storage) Validate() error {
 			hasManagedVolume = true
 		}
 	}
+	shared := make(map[string]bool)
+	for _, name := range s.Shared {
+		if name == "" {
+			return fmt.Errorf(`validate "shared": names of environment addons must not be empty`)
+		}
+		if shared[name] {
+			return fmt.Errorf(`validate "shared": environment addon %s is specified more than once`, name)
+		}
+		shared[name] = true
+	}
 	return nil
 }
 
@@ -787,6 +1386,9 @@ func (l *Logging) Validate() error {
 
 // Validate returns nil if SidecarConfig is configured correctly.
 func (s *SidecarConfig) Validate() error {
+	if err := s.Image.Build.Validate(); err != nil {
+		return fmt.Errorf(`validate "image.build": %w`, err)
+	}
 	for ind, mp := range s.MountPoints {
 		if err := mp.Validate(); err != nil {
 			return fmt.Errorf(`validate "mount_points[%d]": %w`, ind, err)
@@ -1083,6 +1685,17 @@ func validatePubSubName(name string) error {
 	return nil
 }
 
+// validateBuiltSidecarName returns nil if the name of a sidecar built from a Dockerfile can be used for its ECR repository.
+func validateBuiltSidecarName(name string, sidecar *SidecarConfig) error {
+	if sidecar == nil || !sidecar.Image.BuildRequired() {
+		return nil
+	}
+	if !isValidSubSvcName(name) {
+		return fmt.Errorf("sidecar name %s is invalid for a sidecar built from a Dockerfile: names must start with a letter, contain only lower-case letters, numbers, and hyphens, and have no consecutive or trailing hyphen", name)
+	}
+	return nil
+}
+
 func isValidSubSvcName(name string) bool {
 	if !awsNameRegexp.MatchString(name) {
 		return false
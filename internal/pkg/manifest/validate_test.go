@@ -47,9 +47,11 @@ func TestLoadBalancedWebServiceConfig_Validate(t *testing.T) {
 			lbConfig: LoadBalancedWebService{
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
 					ImageConfig: testImageConfig,
-					RoutingRule: RoutingRule{
-						TargetContainer:          aws.String("mockTargetContainer"),
-						TargetContainerCamelCase: aws.String("mockTargetContainer"),
+					RoutingRule: RoutingRuleConfiguration{
+						RoutingRule: RoutingRule{
+							TargetContainer:          aws.String("mockTargetContainer"),
+							TargetContainerCamelCase: aws.String("mockTargetContainer"),
+						},
 					},
 				},
 			},
@@ -70,6 +72,43 @@ func TestLoadBalancedWebServiceConfig_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "sidecars[foo]": `,
 		},
+		"error if an environment override of a multi-architecture platform builds other platforms": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					TaskConfig: TaskConfig{
+						Platform: PlatformArgsOrString{
+							PlatformList: []PlatformString{"linux/amd64", "linux/arm64"},
+						},
+					},
+				},
+				Environments: map[string]*LoadBalancedWebServiceConfig{
+					"prod": {
+						TaskConfig: TaskConfig{
+							Platform: PlatformArgsOrString{
+								PlatformString: (*PlatformString)(aws.String("linux/arm64")),
+							},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "environments[prod].platform": `,
+		},
+		"error if a sidecar built from a Dockerfile has an invalid name": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					Sidecars: map[string]*SidecarConfig{
+						"auth_proxy": {
+							Image: SidecarImage{
+								Build: BuildArgsOrString{BuildString: aws.String("proxy/Dockerfile")},
+							},
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "sidecars[auth_proxy]": sidecar name auth_proxy is invalid for a sidecar built from a Dockerfile`,
+		},
 		"error if fail to validate network": {
 			lbConfig: LoadBalancedWebService{
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
@@ -109,6 +148,59 @@ func TestLoadBalancedWebServiceConfig_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "taskdef_overrides[0]": `,
 		},
+		"error if blue_green is specified with nlb": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("443/tcp"),
+					},
+					DeployConfig: LBWebServiceDeploymentConfiguration{
+						BlueGreen: BlueGreenDeployment{
+							TrafficShifting: aws.String("all_at_once"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "deployment.blue_green" and "nlb"`),
+		},
+		"error if blue_green is specified with more than one http rule": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfiguration{
+						AdditionalRules: []RoutingRule{
+							{Path: aws.String("v2")},
+						},
+					},
+					DeployConfig: LBWebServiceDeploymentConfiguration{
+						BlueGreen: BlueGreenDeployment{
+							TrafficShifting: aws.String("all_at_once"),
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`"deployment.blue_green" cannot be specified with more than one "http" rule`),
+		},
+		"error if there are too many target groups": {
+			lbConfig: LoadBalancedWebService{
+				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+					ImageConfig: testImageConfig,
+					RoutingRule: RoutingRuleConfiguration{
+						AdditionalRules: []RoutingRule{
+							{Path: aws.String("v1")},
+							{Path: aws.String("v2")},
+							{Path: aws.String("v3")},
+							{Path: aws.String("v4")},
+						},
+					},
+					NLBConfig: NetworkLoadBalancerConfiguration{
+						Port: aws.String("443/tcp"),
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`a service can have at most 5 target groups: one for each "http" rule and one for "nlb"`),
+		},
 		"error if name is not set": {
 			lbConfig: LoadBalancedWebService{
 				LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
@@ -318,6 +410,27 @@ func TestRequestDrivenWebServiceConfig_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf(`"name" must be specified`),
 		},
+		"error if platform is not supported by App Runner": {
+			config: RequestDrivenWebService{
+				Workload: Workload{
+					Name: aws.String("mockName"),
+				},
+				RequestDrivenWebServiceConfig: RequestDrivenWebServiceConfig{
+					ImageConfig: ImageWithPort{
+						Image: Image{
+							Build: BuildArgsOrString{BuildString: aws.String("mockBuild")},
+						},
+						Port: uint16P(80),
+					},
+					InstanceConfig: AppRunnerInstanceConfig{
+						Platform: PlatformArgsOrString{
+							PlatformList: []PlatformString{"linux/amd64", "linux/arm64"},
+						},
+					},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "platform": platform linux/arm64 is not supported by App Runner; the valid platform is linux/amd64`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -729,6 +842,22 @@ func TestRoutingRule_Validate(t *testing.T) {
 			},
 			wantedErrorMsgPrefix: `validate "allowed_source_ips[1]": `,
 		},
+		"error if a header has no values": {
+			RoutingRule: RoutingRule{
+				Headers: map[string][]string{
+					"X-Canary": {},
+				},
+			},
+			wantedError: fmt.Errorf(`"headers[X-Canary]" must have at least one value`),
+		},
+		"error if a query string has no value": {
+			RoutingRule: RoutingRule{
+				QueryStrings: map[string]string{
+					"version": "",
+				},
+			},
+			wantedError: fmt.Errorf(`"query_strings[version]" must have a value`),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -748,6 +877,359 @@ func TestRoutingRule_Validate(t *testing.T) {
 	}
 }
 
+func TestRoutingRuleConfiguration_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in RoutingRuleConfiguration
+
+		wantedErrorMsgPrefix string
+		wantedError          error
+	}{
+		"error if the main rule is invalid": {
+			in: RoutingRuleConfiguration{
+				RoutingRule: RoutingRule{
+					TargetContainer:          aws.String("mockContainer"),
+					TargetContainerCamelCase: aws.String("mockContainer"),
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "target_container" and "targetContainer"`),
+		},
+		"error if an additional rule has no path": {
+			in: RoutingRuleConfiguration{
+				AdditionalRules: []RoutingRule{
+					{Path: aws.String("v1")},
+					{Alias: Alias{String: aws.String("example.com")}},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http[2]": "path" must be specified`),
+		},
+		"error if an additional rule is invalid": {
+			in: RoutingRuleConfiguration{
+				AdditionalRules: []RoutingRule{
+					{
+						Path: aws.String("v1"),
+						QueryStrings: map[string]string{
+							"version": "",
+						},
+					},
+				},
+			},
+			wantedErrorMsgPrefix: `validate "http[1]": `,
+		},
+		"error if an internal service has an alias": {
+			in: RoutingRuleConfiguration{
+				RoutingRule: RoutingRule{
+					Internal: aws.Bool(true),
+					Alias:    Alias{String: aws.String("example.com")},
+				},
+			},
+			wantedError: fmt.Errorf(`must specify one, not both, of "internal" and "alias"`),
+		},
+		"error if an additional rule of an internal service has an alias": {
+			in: RoutingRuleConfiguration{
+				RoutingRule: RoutingRule{
+					Internal: aws.Bool(true),
+				},
+				AdditionalRules: []RoutingRule{
+					{Path: aws.String("v1"), Alias: Alias{String: aws.String("example.com")}},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http[1]": "alias" cannot be specified for an internal service`),
+		},
+		"error if an additional rule is internal": {
+			in: RoutingRuleConfiguration{
+				AdditionalRules: []RoutingRule{
+					{Path: aws.String("v1"), Internal: aws.Bool(true)},
+				},
+			},
+			wantedError: fmt.Errorf(`validate "http[1]": "internal" can only be specified in the first rule`),
+		},
+		"valid list of rules": {
+			in: RoutingRuleConfiguration{
+				RoutingRule: RoutingRule{
+					Path: aws.String("api"),
+				},
+				AdditionalRules: []RoutingRule{
+					{
+						Path: aws.String("v2"),
+						Headers: map[string][]string{
+							"X-Canary": {"true"},
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			if tc.wantedErrorMsgPrefix != "" {
+				require.Error(t, gotErr)
+				require.Contains(t, gotErr.Error(), tc.wantedErrorMsgPrefix)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestNetworkLoadBalancerConfiguration_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		nlb NetworkLoadBalancerConfiguration
+
+		wantedError error
+	}{
+		"success if empty": {
+			nlb: NetworkLoadBalancerConfiguration{},
+		},
+		"error if port is not specified": {
+			nlb: NetworkLoadBalancerConfiguration{
+				TargetPort: aws.Int(80),
+			},
+			wantedError: errors.New(`validate "port": "port" must be specified`),
+		},
+		"error if protocol is invalid": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Port: aws.String("443/http"),
+			},
+			wantedError: errors.New(`validate "port": invalid protocol http; valid protocols include TCP, UDP and TLS`),
+		},
+		"error if ssl_policy is specified without tls": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Port:      aws.String("443"),
+				SSLPolicy: aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+			},
+			wantedError: errors.New(`"ssl_policy" can only be specified when the protocol of "port" is TLS`),
+		},
+		"error if target_port is out of range": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Port:       aws.String("443"),
+				TargetPort: aws.Int(70000),
+			},
+			wantedError: errors.New(`"target_port" must be a number between 1 and 65535`),
+		},
+		"error if health check port is out of range": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Port: aws.String("443"),
+				HealthCheck: NLBHealthCheckArgs{
+					Port: aws.Int(0),
+				},
+			},
+			wantedError: errors.New(`validate "healthcheck": "port" must be a number between 1 and 65535`),
+		},
+		"error if a udp listener doesn't specify a health check port": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Port: aws.String("53/udp"),
+			},
+			wantedError: errors.New(`"healthcheck.port" must be specified when the protocol of "port" is UDP: the health checks of the network load balancer use TCP`),
+		},
+		"success with udp listener and a health check port": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Port: aws.String("53/udp"),
+				HealthCheck: NLBHealthCheckArgs{
+					Port: aws.Int(8080),
+				},
+			},
+		},
+		"success with tls listener": {
+			nlb: NetworkLoadBalancerConfiguration{
+				Port:      aws.String("443/tls"),
+				SSLPolicy: aws.String("ELBSecurityPolicy-TLS13-1-2-2021-06"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.nlb.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestDeploymentConfiguration_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in DeploymentConfiguration
+
+		wantedError error
+	}{
+		"success if empty": {
+			in: DeploymentConfiguration{},
+		},
+		"error if minimum_healthy_percent is out of range": {
+			in: DeploymentConfiguration{
+				MinHealthyPercent: aws.Int(101),
+			},
+			wantedError: errors.New(`"minimum_healthy_percent" must be between 0 and 100`),
+		},
+		"error if maximum_percent is less than 100": {
+			in: DeploymentConfiguration{
+				MaxPercent: aws.Int(50),
+			},
+			wantedError: errors.New(`"maximum_percent" must be greater than or equal to 100`),
+		},
+		"success": {
+			in: DeploymentConfiguration{
+				MinHealthyPercent: aws.Int(0),
+				MaxPercent:        aws.Int(100),
+				Rollback:          aws.Bool(false),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestLBWebServiceDeploymentConfiguration_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in LBWebServiceDeploymentConfiguration
+
+		wantedError error
+	}{
+		"success if empty": {
+			in: LBWebServiceDeploymentConfiguration{},
+		},
+		"error if rolling update configuration is invalid": {
+			in: LBWebServiceDeploymentConfiguration{
+				DeploymentConfiguration: DeploymentConfiguration{
+					MaxPercent: aws.Int(50),
+				},
+			},
+			wantedError: errors.New(`"maximum_percent" must be greater than or equal to 100`),
+		},
+		"error if blue_green is specified with rolling update fields": {
+			in: LBWebServiceDeploymentConfiguration{
+				DeploymentConfiguration: DeploymentConfiguration{
+					Rollback: aws.Bool(true),
+				},
+				BlueGreen: BlueGreenDeployment{
+					TrafficShifting: aws.String("all_at_once"),
+				},
+			},
+			wantedError: errors.New(`"blue_green" cannot be specified with rolling update fields "minimum_healthy_percent", "maximum_percent", or "rollback"`),
+		},
+		"error if blue_green is invalid": {
+			in: LBWebServiceDeploymentConfiguration{
+				BlueGreen: BlueGreenDeployment{
+					TrafficShifting: aws.String("gradual"),
+				},
+			},
+			wantedError: errors.New(`validate "blue_green": "traffic_shifting" gradual must be one of all_at_once, linear, canary`),
+		},
+		"success with blue_green": {
+			in: LBWebServiceDeploymentConfiguration{
+				BlueGreen: BlueGreenDeployment{
+					TrafficShifting: aws.String("canary"),
+					Percentage:      aws.Int(10),
+					Interval:        durationp(5 * time.Minute),
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestBlueGreenDeployment_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in BlueGreenDeployment
+
+		wantedError error
+	}{
+		"success if empty": {
+			in: BlueGreenDeployment{},
+		},
+		"error if percentage is set for all_at_once": {
+			in: BlueGreenDeployment{
+				Percentage: aws.Int(10),
+			},
+			wantedError: errors.New(`"percentage" and "interval" cannot be specified when "traffic_shifting" is all_at_once`),
+		},
+		"error if interval is missing for linear": {
+			in: BlueGreenDeployment{
+				TrafficShifting: aws.String("linear"),
+				Percentage:      aws.Int(10),
+			},
+			wantedError: errors.New(`"percentage" and "interval" must be specified when "traffic_shifting" is linear`),
+		},
+		"error if percentage is out of range": {
+			in: BlueGreenDeployment{
+				TrafficShifting: aws.String("linear"),
+				Percentage:      aws.Int(100),
+				Interval:        durationp(time.Minute),
+			},
+			wantedError: errors.New(`"percentage" must be between 1 and 99`),
+		},
+		"error if interval is not a whole number of minutes": {
+			in: BlueGreenDeployment{
+				TrafficShifting: aws.String("canary"),
+				Percentage:      aws.Int(10),
+				Interval:        durationp(90 * time.Second),
+			},
+			wantedError: errors.New(`"interval" must be a whole number of minutes, such as "5m"`),
+		},
+		"error if bake_time is less than a minute": {
+			in: BlueGreenDeployment{
+				BakeTime: durationp(30 * time.Second),
+			},
+			wantedError: errors.New(`"bake_time" must be a whole number of minutes, such as "5m"`),
+		},
+		"error if test_listener_port is out of range": {
+			in: BlueGreenDeployment{
+				TestListenerPort: aws.Int(70000),
+			},
+			wantedError: errors.New(`"test_listener_port" must be between 1 and 65535`),
+		},
+		"success": {
+			in: BlueGreenDeployment{
+				TrafficShifting:  aws.String("linear"),
+				Percentage:       aws.Int(20),
+				Interval:         durationp(2 * time.Minute),
+				TestListenerPort: aws.Int(8080),
+				BakeTime:         durationp(10 * time.Minute),
+				Alarms:           []string{"api-5xx"},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != nil {
+				require.EqualError(t, gotErr, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
 func TestIPNet_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     IPNet
@@ -834,7 +1316,42 @@ func TestPlatformString_Validate(t *testing.T) {
 	}{
 		"error if platform string is invalid": {
 			in:     PlatformString("foobar"),
-			wanted: fmt.Errorf("platform foobar is invalid; the valid platform is: linux/amd64"),
+			wanted: fmt.Errorf("platform foobar is invalid; valid platforms are: linux/amd64 and linux/arm64"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.in.Validate()
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+func TestPlatformArgsOrString_Validate(t *testing.T) {
+	testCases := map[string]struct {
+		in     PlatformArgsOrString
+		wanted error
+	}{
+		"error if a platform of the list is invalid": {
+			in: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/amd64", "foobar"},
+			},
+			wanted: fmt.Errorf("platform foobar is invalid; valid platforms are: linux/amd64 and linux/arm64"),
+		},
+		"error if a platform of the list is specified more than once": {
+			in: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/arm64", "linux/amd64", "linux/arm64"},
+			},
+			wanted: fmt.Errorf("platform linux/arm64 is specified more than once"),
+		},
+		"success with a list of platforms": {
+			in: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/arm64", "linux/amd64"},
+			},
 		},
 	}
 	for name, tc := range testCases {
@@ -849,6 +1366,57 @@ func TestPlatformString_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_validatePlatformOverride(t *testing.T) {
+	testCases := map[string]struct {
+		platform PlatformArgsOrString
+		override PlatformArgsOrString
+		wanted   error
+	}{
+		"success if the platform is not a list": {
+			platform: PlatformArgsOrString{
+				PlatformString: (*PlatformString)(aws.String("linux/amd64")),
+			},
+			override: PlatformArgsOrString{
+				PlatformString: (*PlatformString)(aws.String("linux/arm64")),
+			},
+		},
+		"success if the environment does not override the platform": {
+			platform: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/amd64", "linux/arm64"},
+			},
+		},
+		"success if the environment reorders the platforms": {
+			platform: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/amd64", "linux/arm64"},
+			},
+			override: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/arm64", "linux/amd64"},
+			},
+		},
+		"error if the environment drops a platform": {
+			platform: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/amd64", "linux/arm64"},
+			},
+			override: PlatformArgsOrString{
+				PlatformString: (*PlatformString)(aws.String("linux/arm64")),
+			},
+			wanted: errors.New("linux/arm64 must list the same platforms as linux/amd64 and linux/arm64 in any order; the tasks run on the first platform of the list"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validatePlatformOverride(tc.platform, tc.override)
+
+			if tc.wanted != nil {
+				require.EqualError(t, err, tc.wanted.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestPlatformArgs_Validate(t *testing.T) {
 	testCases := map[string]struct {
 		in     PlatformArgs
@@ -872,7 +1440,7 @@ func TestPlatformArgs_Validate(t *testing.T) {
 				OSFamily: aws.String("linux"),
 				Arch:     aws.String("bar"),
 			},
-			wanted: fmt.Errorf("architecture bar is invalid; the valid architecture is: amd64"),
+			wanted: fmt.Errorf("architecture bar is invalid; valid architectures are: amd64 and arm64"),
 		},
 	}
 	for name, tc := range testCases {
@@ -1222,8 +1790,15 @@ func TestStorage_Validate(t *testing.T) {
 			},
 			wantedError: fmt.Errorf("cannot specify more than one managed volume per service"),
 		},
+		"error if a shared environment addon is specified more than once": {
+			Storage: Storage{
+				Shared: []string{"orders", "jobs", "orders"},
+			},
+			wantedError: fmt.Errorf(`validate "shared": environment addon orders is specified more than once`),
+		},
 		"valid": {
 			Storage: Storage{
+				Shared: []string{"orders", "jobs"},
 				Volumes: map[string]*Volume{
 					"foo": {
 						EFS: EFSConfigOrBool{
@@ -1810,3 +2385,440 @@ func TestValidateContainerDeps(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironment_Validate(t *testing.T) {
+	mockIPNet := IPNet("10.0.0.0/16")
+	testCases := map[string]struct {
+		in          Environment
+		wantedError string
+	}{
+		"error if name is not specified": {
+			in:          Environment{},
+			wantedError: `"name" must be specified`,
+		},
+		"error if both id and cidr are specified for the VPC": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							ID:   aws.String("vpc-1234"),
+							CIDR: &mockIPNet,
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": must specify one, not both, of "id" and "cidr"`,
+		},
+		"error if an imported subnet doesn't have an id": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							ID: aws.String("vpc-1234"),
+							Subnets: SubnetsConfiguration{
+								Private: []SubnetConfiguration{{SubnetID: aws.String("priv1")}, {}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate "subnets.private[1]": "id" must be specified if "network.vpc.id" is specified`,
+		},
+		"error if fewer than two private subnets are imported": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							ID: aws.String("vpc-1234"),
+							Subnets: SubnetsConfiguration{
+								Private: []SubnetConfiguration{{SubnetID: aws.String("priv1")}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": at least two private subnets must be imported`,
+		},
+		"error if the subnets of a managed VPC are specified without the VPC cidr": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							Subnets: SubnetsConfiguration{
+								Public: []SubnetConfiguration{{CIDR: ipNetP("10.0.0.0/24")}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": "cidr" must be specified if "subnets" is specified`,
+		},
+		"error if a subnet cidr is invalid": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR: &mockIPNet,
+							Subnets: SubnetsConfiguration{
+								Public: []SubnetConfiguration{{CIDR: ipNetP("10.0.0.0")}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate "subnets.public[0]": validate "cidr": parse IPNet 10.0.0.0: invalid CIDR address: 10.0.0.0`,
+		},
+		"error if the github actions oidc provider is created without a repository": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					CICD: EnvironmentCICDConfig{
+						GitHubActions: GitHubActionsConfig{
+							CreateOIDCProvider: aws.Bool(true),
+						},
+					},
+				},
+			},
+			wantedError: `validate "cicd": validate "github_actions": "repository" must be specified if "create_oidc_provider" is specified`,
+		},
+		"error if the github actions repository is not owner/name": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					CICD: EnvironmentCICDConfig{
+						GitHubActions: GitHubActionsConfig{
+							Repository: aws.String("https://github.com/aws/copilot-cli"),
+						},
+					},
+				},
+			},
+			wantedError: `validate "cicd": validate "github_actions": validate "repository": https://github.com/aws/copilot-cli must be of the form "owner/name"`,
+		},
+		"error if a managed VPC has fewer than two subnets of each type": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR: &mockIPNet,
+							Subnets: SubnetsConfiguration{
+								Public:  []SubnetConfiguration{{CIDR: ipNetP("10.0.0.0/24")}, {CIDR: ipNetP("10.0.1.0/24")}},
+								Private: []SubnetConfiguration{{CIDR: ipNetP("10.0.2.0/24")}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": at least two public subnets and two private subnets must be specified`,
+		},
+		"error if an allowed source ip is invalid": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							AllowedSourceIPs: []IPNet{"10.0.0.0"},
+						},
+					},
+				},
+			},
+			wantedError: `validate "http": validate "public": validate "allowed_source_ips[0]": parse IPNet 10.0.0.0: invalid CIDR address: 10.0.0.0`,
+		},
+		"error if the flow logs destination is invalid": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							FlowLogs: FlowLogsArgsOrBool{
+								Advanced: FlowLogsArgs{
+									Destination: aws.String("kinesis"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate "flow_logs": "destination" kinesis must be one of cloudwatch, s3`,
+		},
+		"error if the flow logs retention is not positive": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							FlowLogs: FlowLogsArgsOrBool{
+								Advanced: FlowLogsArgs{
+									Retention: aws.Int(0),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate "flow_logs": "retention" must be a positive number of days`,
+		},
+		"error if the flow logs retention is not supported by CloudWatch Logs": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							FlowLogs: FlowLogsArgsOrBool{
+								Advanced: FlowLogsArgs{
+									Retention: aws.Int(45),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": validate "flow_logs": "retention" 45 must be one of 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653 for the cloudwatch destination`,
+		},
+		"valid flow logs retention for the s3 destination": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							FlowLogs: FlowLogsArgsOrBool{
+								Advanced: FlowLogsArgs{
+									Destination: aws.String("s3"),
+									Retention:   aws.Int(45),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"error if the access logs bucket name is empty": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							AccessLogs: ELBAccessLogsArgsOrBool{
+								Advanced: ELBAccessLogsArgs{
+									BucketName: aws.String(""),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "http": validate "public": validate "access_logs": "bucket_name" cannot be empty`,
+		},
+		"error if the web ACL is not a WAFv2 ARN": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							WebACL: aws.String("arn:aws:waf::123456789012:webacl/1234"),
+						},
+					},
+				},
+			},
+			wantedError: `validate "http": validate "public": validate "web_acl": "arn:aws:waf::123456789012:webacl/1234" is not a WAFv2 web ACL ARN`,
+		},
+		"valid with flow logs only": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							FlowLogs: FlowLogsArgsOrBool{
+								Enabled: aws.Bool(true),
+							},
+						},
+					},
+				},
+			},
+		},
+		"error if a certificate is not an ARN": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							Certificates: []string{"mycert"},
+						},
+					},
+				},
+			},
+			wantedError: `validate "http": validate "public": parse "certificates[0]": arn: invalid prefix`,
+		},
+		"error if a certificate is not an ACM certificate": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							Certificates: []string{
+								"arn:aws:acm:us-west-2:123456789012:certificate/abc",
+								"arn:aws:iam::123456789012:server-certificate/mycert",
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "http": validate "public": validate "certificates[1]": "arn:aws:iam::123456789012:server-certificate/mycert" is not an ACM certificate ARN`,
+		},
+		"error if http.public is specified in a private_only environment": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							PrivateOnly: aws.Bool(true),
+						},
+					},
+					HTTPConfig: EnvironmentHTTPConfig{
+						Public: PublicHTTPConfig{
+							Certificates: []string{"arn:aws:acm:us-west-2:123456789012:certificate/abc"},
+						},
+					},
+				},
+			},
+			wantedError: `"http.public" cannot be specified in a "private_only" environment`,
+		},
+		"error if private_only is specified for an imported VPC": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							ID:          aws.String("vpc-1234"),
+							PrivateOnly: aws.Bool(true),
+							Subnets: SubnetsConfiguration{
+								Private: []SubnetConfiguration{{SubnetID: aws.String("priv1")}, {SubnetID: aws.String("priv2")}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": "private_only" cannot be specified when importing a VPC`,
+		},
+		"error if a private_only VPC has public subnets": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR:        &mockIPNet,
+							PrivateOnly: aws.Bool(true),
+							Subnets: SubnetsConfiguration{
+								Public:  []SubnetConfiguration{{CIDR: ipNetP("10.0.0.0/24")}, {CIDR: ipNetP("10.0.1.0/24")}},
+								Private: []SubnetConfiguration{{CIDR: ipNetP("10.0.2.0/24")}, {CIDR: ipNetP("10.0.3.0/24")}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": "subnets.public" cannot be specified in a "private_only" VPC`,
+		},
+		"error if a private_only VPC has fewer than two private subnets": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR:        &mockIPNet,
+							PrivateOnly: aws.Bool(true),
+							Subnets: SubnetsConfiguration{
+								Private: []SubnetConfiguration{{CIDR: ipNetP("10.0.2.0/24")}},
+							},
+						},
+					},
+				},
+			},
+			wantedError: `validate "network": validate "vpc": at least two private subnets must be specified`,
+		},
+		"error if an internal allowed source ip is invalid": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					HTTPConfig: EnvironmentHTTPConfig{
+						Internal: InternalHTTPConfig{
+							AllowedSourceIPs: []IPNet{"10.0.0.0"},
+						},
+					},
+				},
+			},
+			wantedError: `validate "http": validate "internal": validate "allowed_source_ips[0]": parse IPNet 10.0.0.0: invalid CIDR address: 10.0.0.0`,
+		},
+		"valid private_only VPC with the default subnets": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							PrivateOnly: aws.Bool(true),
+						},
+					},
+				},
+			},
+		},
+		"valid private_only VPC with custom subnets": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							CIDR:        &mockIPNet,
+							PrivateOnly: aws.Bool(true),
+							Subnets: SubnetsConfiguration{
+								Private: []SubnetConfiguration{{CIDR: ipNetP("10.0.2.0/24")}, {CIDR: ipNetP("10.0.3.0/24")}},
+							},
+						},
+					},
+				},
+			},
+		},
+		"valid imported VPC": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					Network: EnvironmentNetworkConfig{
+						VPC: EnvironmentVPCConfig{
+							ID: aws.String("vpc-1234"),
+							Subnets: SubnetsConfiguration{
+								Private: []SubnetConfiguration{{SubnetID: aws.String("priv1")}, {SubnetID: aws.String("priv2")}},
+							},
+						},
+					},
+				},
+			},
+		},
+		"valid github actions role": {
+			in: Environment{
+				Workload: Workload{Name: aws.String("test")},
+				EnvironmentConfig: EnvironmentConfig{
+					CICD: EnvironmentCICDConfig{
+						GitHubActions: GitHubActionsConfig{
+							Repository:         aws.String("aws/copilot-cli"),
+							CreateOIDCProvider: aws.Bool(true),
+						},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gotErr := tc.in.Validate()
+
+			if tc.wantedError != "" {
+				require.EqualError(t, gotErr, tc.wantedError)
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
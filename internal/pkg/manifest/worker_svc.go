@@ -40,6 +40,7 @@ type WorkerServiceConfig struct {
 	Sidecars         map[string]*SidecarConfig `yaml:"sidecars"` // NOTE: keep the pointers because `mergo` doesn't automatically deep merge map's value unless it's a pointer type.
 	Subscribe        SubscribeConfig           `yaml:"subscribe"`
 	Network          NetworkConfig             `yaml:"network"`
+	DeployConfig     DeploymentConfiguration   `yaml:"deployment"`
 	TaskDefOverrides []OverrideRule            `yaml:"taskdef_overrides"`
 }
 
@@ -165,6 +166,16 @@ func (s *WorkerService) BuildArgs(wsRoot string) *DockerBuildArgs {
 	return s.ImageConfig.Image.BuildConfig(wsRoot)
 }
 
+// ImageRepository returns the configuration of the ECR repository storing the images built from a Dockerfile.
+func (s *WorkerService) ImageRepository() ImageRepository {
+	return s.ImageConfig.Image.Repository
+}
+
+// SidecarBuildArgs returns the docker build arguments of the sidecars built from a Dockerfile, keyed by sidecar name.
+func (s *WorkerService) SidecarBuildArgs(wsRoot string) map[string]*DockerBuildArgs {
+	return sidecarBuildArgs(s.Sidecars, wsRoot)
+}
+
 // Subscriptions returns a list of TopicSubscriotion objects which represent the SNS topics the service
 // receives messages from.
 func (s *WorkerService) Subscriptions() []TopicSubscription {
@@ -26,6 +26,13 @@ const (
 	defaultDockerfileName = "Dockerfile"
 )
 
+// Traffic shifting options of a blue/green deployment.
+const (
+	TrafficShiftingAllAtOnce = "all_at_once"
+	TrafficShiftingLinear    = "linear"
+	TrafficShiftingCanary    = "canary"
+)
+
 var (
 	// AWS VPC subnet placement options.
 	PublicSubnetPlacement  = Placement("public")
@@ -37,19 +44,29 @@ var (
 	// All placement options.
 	subnetPlacements = []string{string(PublicSubnetPlacement), string(PrivateSubnetPlacement)}
 
-	validPlatforms        = []string{dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Amd64Arch)}
+	trafficShiftingTypes = []string{TrafficShiftingAllAtOnce, TrafficShiftingLinear, TrafficShiftingCanary}
+
+	// ImageScanSeverities holds the severities of the findings of an image scan, from the lowest to the highest.
+	ImageScanSeverities = []string{"INFORMATIONAL", "LOW", "MEDIUM", "HIGH", "CRITICAL"}
+
+	validPlatforms = []string{
+		dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Amd64Arch),
+		dockerengine.DockerBuildPlatform(dockerengine.LinuxOS, dockerengine.Arm64Arch),
+	}
 	validOperatingSystems = []string{dockerengine.LinuxOS}
-	validArchitectures    = []string{dockerengine.Amd64Arch}
+	validArchitectures    = []string{dockerengine.Amd64Arch, dockerengine.Arm64Arch}
 
 	// Error definitions.
 	errUnmarshalBuildOpts    = errors.New("unable to unmarshal build field into string or compose-style map")
-	errUnmarshalPlatformOpts = errors.New("unable to unmarshal platform field into string or compose-style map")
+	errUnmarshalSidecarImage = errors.New(`unable to unmarshal "image" into string or build configuration`)
+	errUnmarshalPlatformOpts = errors.New("unable to unmarshal platform field into string, list of strings or compose-style map")
 	errUnmarshalCountOpts    = errors.New(`unable to unmarshal "count" field to an integer or autoscaling configuration`)
 	errUnmarshalRangeOpts    = errors.New(`unable to unmarshal "range" field`)
 	errUnmarshalExec         = errors.New(`unable to unmarshal "exec" field into boolean or exec configuration`)
 	errUnmarshalEntryPoint   = errors.New(`unable to unmarshal "entrypoint" into string or slice of strings`)
 	errUnmarshalAlias        = errors.New(`unable to unmarshal "alias" into string or slice of strings`)
 	errUnmarshalCommand      = errors.New(`unable to unmarshal "command" into string or slice of strings`)
+	errUnmarshalSecret       = errors.New(`unable to unmarshal "secrets" entry into string or map with "secretsmanager" key`)
 )
 
 // WorkloadManifest represents a workload manifest.
@@ -87,6 +104,7 @@ type Image struct {
 	Credentials  *string           `yaml:"credentials"`     // ARN of the secret containing the private repository credentials.
 	DockerLabels map[string]string `yaml:"labels,flow"`     // Apply Docker labels to the container at runtime.
 	DependsOn    DependsOn         `yaml:"depends_on,flow"` // Add any sidecar dependencies.
+	Repository   ImageRepository   `yaml:"repository"`      // Configure the ECR repository of the images built from a Dockerfile.
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the Image
@@ -137,6 +155,26 @@ type ImageWithOptionalPort struct {
 	Port  *uint16 `yaml:"port"`
 }
 
+// ImageRepository holds the configuration of the ECR repository storing the images built from a Dockerfile.
+type ImageRepository struct {
+	KeepImages         *int              `yaml:"keep_images"`          // Number of images to retain in the repository.
+	UntaggedExpiryDays *int              `yaml:"untagged_expiry_days"` // Number of days after which untagged images expire.
+	ScanOnPush         *bool             `yaml:"scan_on_push"`
+	ImmutableTags      *bool             `yaml:"immutable_tags"`
+	ScanFindings       ImageScanFindings `yaml:"scan_findings"`
+}
+
+// HasRepositorySettings returns true if any setting of the repository itself is configured.
+func (r ImageRepository) HasRepositorySettings() bool {
+	return r.KeepImages != nil || r.UntaggedExpiryDays != nil || r.ScanOnPush != nil || r.ImmutableTags != nil
+}
+
+// ImageScanFindings holds the configuration to report the findings of the scan of a pushed image.
+type ImageScanFindings struct {
+	Severity *string `yaml:"severity"` // Minimum severity of the findings to report.
+	Block    *bool   `yaml:"block"`    // Fail the deployment if any finding is reported.
+}
+
 // GetLocation returns the location of the image.
 func (i Image) GetLocation() string {
 	return aws.StringValue(i.Location)
@@ -440,11 +478,11 @@ func (lc *Logging) GetEnableMetadata() *string {
 // SidecarConfig represents the configurable options for setting up a sidecar container.
 type SidecarConfig struct {
 	Port          *string              `yaml:"port"`
-	Image         *string              `yaml:"image"`
+	Image         SidecarImage         `yaml:"image"`
 	Essential     *bool                `yaml:"essential"`
 	CredsParam    *string              `yaml:"credentialsParameter"`
 	Variables     map[string]string    `yaml:"variables"`
-	Secrets       map[string]string    `yaml:"secrets"`
+	Secrets       map[string]Secret    `yaml:"secrets"`
 	MountPoints   []SidecarMountPoint  `yaml:"mount_points"`
 	DockerLabels  map[string]string    `yaml:"labels"`
 	DependsOn     DependsOn            `yaml:"depends_on"`
@@ -452,6 +490,74 @@ type SidecarConfig struct {
 	ImageOverride `yaml:",inline"`
 }
 
+// SidecarImage represents the image of a sidecar container.
+// It's either the location of an existing image or the configuration to build one from a Dockerfile.
+type SidecarImage struct {
+	Location *string
+	Build    BuildArgsOrString
+}
+
+// UnmarshalYAML overrides the default YAML unmarshaling logic for the SidecarImage
+// struct, allowing it to be unmarshaled into a string or a map with a "build" field.
+// This method implements the yaml.Unmarshaler (v3) interface.
+func (s *SidecarImage) UnmarshalYAML(value *yaml.Node) error {
+	var image struct {
+		Build BuildArgsOrString `yaml:"build"`
+	}
+	if err := value.Decode(&image); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if !image.Build.isEmpty() {
+		// Unmarshaled successfully to s.Build, unset s.Location, and return.
+		s.Build = image.Build
+		s.Location = nil
+		return nil
+	}
+
+	if err := value.Decode(&s.Location); err != nil {
+		return errUnmarshalSidecarImage
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml(v3) interface. It writes the build configuration only if the image is built from a Dockerfile.
+func (s SidecarImage) MarshalYAML() (interface{}, error) {
+	if s.Build.isEmpty() {
+		return s.Location, nil
+	}
+	type image struct {
+		Build interface{} `yaml:"build"`
+	}
+	if s.Build.BuildString != nil {
+		return image{Build: s.Build.BuildString}, nil
+	}
+	return image{Build: s.Build.BuildArgs}, nil
+}
+
+// IsEmpty returns true if neither an image location nor a build configuration is specified.
+func (s *SidecarImage) IsEmpty() bool {
+	return s.Location == nil && s.Build.isEmpty()
+}
+
+// BuildRequired returns true if the sidecar image needs to be built from a Dockerfile.
+func (s *SidecarImage) BuildRequired() bool {
+	return !s.Build.isEmpty()
+}
+
+// BuildConfig returns the build arguments of the sidecar image. The paths are resolved the same way as the main container's image.
+func (s *SidecarImage) BuildConfig(rootDirectory string) *DockerBuildArgs {
+	image := Image{
+		Build: s.Build,
+	}
+	return image.BuildConfig(rootDirectory)
+}
+
 // TaskConfig represents the resource boundaries and environment variables for the containers in the task.
 type TaskConfig struct {
 	CPU            *int                 `yaml:"cpu"`
@@ -460,17 +566,90 @@ type TaskConfig struct {
 	Count          Count                `yaml:"count"`
 	ExecuteCommand ExecuteCommand       `yaml:"exec"`
 	Variables      map[string]string    `yaml:"variables"`
-	Secrets        map[string]string    `yaml:"secrets"`
+	Secrets        map[string]Secret    `yaml:"secrets"`
 	Storage        Storage              `yaml:"storage"`
 }
 
-// TaskPlatform returns the platform for the service.
+// Secret represents the source of a secret injected in a container.
+// It's either the name or ARN of an SSM parameter or the ARN of a Secrets Manager secret,
+// or a map with the name of a Secrets Manager secret.
+type Secret struct {
+	from               *string
+	fromSecretsManager secretsManagerSecret
+}
+
+// secretsManagerSecret represents the name of a secret in AWS Secrets Manager.
+type secretsManagerSecret struct {
+	Name *string `yaml:"secretsmanager"`
+}
+
+// SecretFromString returns a Secret referencing the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret.
+func SecretFromString(from string) Secret {
+	return Secret{
+		from: aws.String(from),
+	}
+}
+
+// SecretFromSecretsManager returns a Secret referencing the name of a secret in AWS Secrets Manager.
+func SecretFromSecretsManager(name string) Secret {
+	return Secret{
+		fromSecretsManager: secretsManagerSecret{
+			Name: aws.String(name),
+		},
+	}
+}
+
+// IsSecretsManagerName returns true if the secret is referenced by its name in AWS Secrets Manager.
+func (s Secret) IsSecretsManagerName() bool {
+	return s.fromSecretsManager.Name != nil
+}
+
+// Value returns the name or ARN referenced by the secret.
+func (s Secret) Value() string {
+	if s.IsSecretsManagerName() {
+		return aws.StringValue(s.fromSecretsManager.Name)
+	}
+	return aws.StringValue(s.from)
+}
+
+// UnmarshalYAML implements the yaml(v3) interface. It allows secrets to be specified as a string
+// or as a map with the name of a Secrets Manager secret alternately.
+func (s *Secret) UnmarshalYAML(value *yaml.Node) error {
+	if err := value.Decode(&s.fromSecretsManager); err != nil {
+		switch err.(type) {
+		case *yaml.TypeError:
+			break
+		default:
+			return err
+		}
+	}
+
+	if s.fromSecretsManager.Name != nil {
+		// Unmarshaled successfully to s.fromSecretsManager, unset s.from, and return.
+		s.from = nil
+		return nil
+	}
+
+	if err := value.Decode(&s.from); err != nil {
+		return errUnmarshalSecret
+	}
+	return nil
+}
+
+// TaskPlatform returns the platform that the tasks of the workload run on.
+// If the image is built for several platforms, the first one takes precedence and sets the task's runtime platform,
+// the others are only added to the manifest list of the image.
 func (t *TaskConfig) TaskPlatform() (*string, error) {
-	if t.Platform.PlatformString == nil {
+	platforms := t.Platform.platforms()
+	if len(platforms) == 0 {
 		return nil, nil
 	}
-	val := string(*t.Platform.PlatformString)
-	return &val, nil
+	return &platforms[0], nil
+}
+
+// BuildPlatforms returns the platforms to build the image of the workload for.
+func (t *TaskConfig) BuildPlatforms() []string {
+	return t.Platform.platforms()
 }
 
 // PublishConfig represents the configurable options for setting up publishers.
@@ -493,6 +672,11 @@ func (c *NetworkConfig) IsEmpty() bool {
 	return c.VPC.isEmpty()
 }
 
+// IsPublic returns true if the tasks are placed in the public subnets of the environment, which is the default.
+func (c *NetworkConfig) IsPublic() bool {
+	return c.VPC.Placement == nil || *c.VPC.Placement == PublicSubnetPlacement
+}
+
 // UnmarshalYAML ensures that a NetworkConfig always defaults to public subnets.
 // If the user specified a placement that's not valid then throw an error.
 func (c *NetworkConfig) UnmarshalYAML(value *yaml.Node) error {
@@ -624,10 +808,48 @@ func (hc *ContainerHealthCheck) ApplyIfNotSet(other *ContainerHealthCheck) {
 type PlatformString string
 
 // PlatformArgsOrString is a custom type which supports unmarshaling yaml which
-// can either be of type string or type PlatformArgs.
+// can either be of type string, a list of strings or type PlatformArgs.
 type PlatformArgsOrString struct {
 	*PlatformString
 	PlatformArgs PlatformArgs
+	PlatformList []PlatformString // Platforms to build a multi-architecture image for.
+}
+
+// platforms returns the configured platforms as "os/arch" strings.
+func (p *PlatformArgsOrString) platforms() []string {
+	switch {
+	case len(p.PlatformList) != 0:
+		out := make([]string, len(p.PlatformList))
+		for i, platform := range p.PlatformList {
+			out[i] = string(platform)
+		}
+		return out
+	case p.PlatformString != nil:
+		return []string{string(*p.PlatformString)}
+	case p.PlatformArgs.bothSpecified():
+		return []string{dockerengine.DockerBuildPlatform(aws.StringValue(p.PlatformArgs.OSFamily), aws.StringValue(p.PlatformArgs.Arch))}
+	}
+	return nil
+}
+
+// MarshalYAML overrides the default YAML marshaling logic for the PlatformArgsOrString struct.
+// This method implements the yaml.Marshaler interface.
+func (p PlatformArgsOrString) MarshalYAML() (interface{}, error) {
+	switch {
+	case len(p.PlatformList) != 0:
+		return p.PlatformList, nil
+	case p.PlatformString != nil:
+		return p.PlatformString, nil
+	case !p.PlatformArgs.isEmpty():
+		return p.PlatformArgs, nil
+	}
+	return nil, nil
+}
+
+// IsZero returns true if no platform is configured.
+// This method implements the yaml.IsZeroer interface so that "omitempty" skips an empty platform.
+func (p PlatformArgsOrString) IsZero() bool {
+	return p.PlatformString == nil && p.PlatformArgs.isEmpty() && len(p.PlatformList) == 0
 }
 
 // UnmarshalYAML overrides the default YAML unmarshaling logic for the PlatformArgsOrString
@@ -646,6 +868,19 @@ func (p *PlatformArgsOrString) UnmarshalYAML(value *yaml.Node) error {
 	if !p.PlatformArgs.isEmpty() {
 		// Unmarshaled successfully to p.PlatformArgs, unset p.PlatformString, and return.
 		p.PlatformString = nil
+		p.PlatformList = nil
+		return nil
+	}
+	if value.Kind == yaml.SequenceNode {
+		if err := value.Decode(&p.PlatformList); err != nil {
+			return errUnmarshalPlatformOpts
+		}
+		p.PlatformString = nil
+		for i := range p.PlatformList {
+			if err := validatePlatform(&p.PlatformList[i]); err != nil {
+				return fmt.Errorf("validate platform: %w", err)
+			}
+		}
 		return nil
 	}
 	if err := value.Decode(&p.PlatformString); err != nil {
@@ -719,6 +954,17 @@ func requiresBuild(image Image) (bool, error) {
 	return false, nil
 }
 
+func sidecarBuildArgs(sidecars map[string]*SidecarConfig, wsRoot string) map[string]*DockerBuildArgs {
+	args := make(map[string]*DockerBuildArgs)
+	for name, sidecar := range sidecars {
+		if sidecar == nil || !sidecar.Image.BuildRequired() {
+			continue
+		}
+		args[name] = sidecar.Image.BuildConfig(wsRoot)
+	}
+	return args
+}
+
 func dockerfileBuildRequired(workloadType string, svc interface{}) (bool, error) {
 	type manifest interface {
 		BuildRequired() (bool, error)
@@ -317,6 +317,141 @@ func TestBuildArgs_UnmarshalYAML(t *testing.T) {
 	}
 }
 
+func TestSidecarImage_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedStruct SidecarImage
+		wantedError  error
+	}{
+		"image location": {
+			inContent: []byte(`image: 123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon`),
+			wantedStruct: SidecarImage{
+				Location: aws.String("123456789012.dkr.ecr.us-east-2.amazonaws.com/xray-daemon"),
+			},
+		},
+		"build string": {
+			inContent: []byte(`image:
+  build: proxy/Dockerfile`),
+			wantedStruct: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("proxy/Dockerfile"),
+				},
+			},
+		},
+		"build args": {
+			inContent: []byte(`image:
+  build:
+    dockerfile: proxy/Dockerfile
+    context: proxy
+    target: prod
+    args:
+      arg: value
+    cache_from:
+      - foo/bar:latest`),
+			wantedStruct: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Dockerfile: aws.String("proxy/Dockerfile"),
+						Context:    aws.String("proxy"),
+						Target:     aws.String("prod"),
+						Args: map[string]string{
+							"arg": "value",
+						},
+						CacheFrom: []string{"foo/bar:latest"},
+					},
+				},
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`image:
+  location: nginx`),
+			wantedError: errUnmarshalSidecarImage,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var sidecar SidecarConfig
+			err := yaml.Unmarshal(tc.inContent, &sidecar)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedStruct, sidecar.Image)
+			}
+		})
+	}
+}
+
+func TestSidecarImage_BuildConfig(t *testing.T) {
+	testCases := map[string]struct {
+		in     SidecarImage
+		wanted *DockerBuildArgs
+	}{
+		"dockerfile path only": {
+			in: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildString: aws.String("proxy/Dockerfile"),
+				},
+			},
+			wanted: &DockerBuildArgs{
+				Dockerfile: aws.String("/root/proxy/Dockerfile"),
+				Context:    aws.String("/root/proxy"),
+			},
+		},
+		"context and target": {
+			in: SidecarImage{
+				Build: BuildArgsOrString{
+					BuildArgs: DockerBuildArgs{
+						Context: aws.String("proxy"),
+						Target:  aws.String("prod"),
+					},
+				},
+			},
+			wanted: &DockerBuildArgs{
+				Dockerfile: aws.String("/root/proxy/Dockerfile"),
+				Context:    aws.String("/root/proxy"),
+				Target:     aws.String("prod"),
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, tc.in.BuildConfig("/root"))
+		})
+	}
+}
+
+func TestLoadBalancedWebService_SidecarBuildArgs(t *testing.T) {
+	mft := LoadBalancedWebService{
+		LoadBalancedWebServiceConfig: LoadBalancedWebServiceConfig{
+			Sidecars: map[string]*SidecarConfig{
+				"xray": {
+					Image: SidecarImage{
+						Location: aws.String("amazon/aws-xray-daemon"),
+					},
+				},
+				"proxy": {
+					Image: SidecarImage{
+						Build: BuildArgsOrString{
+							BuildString: aws.String("proxy/Dockerfile"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := mft.SidecarBuildArgs("/ws")
+
+	require.Equal(t, map[string]*DockerBuildArgs{
+		"proxy": {
+			Dockerfile: aws.String("/ws/proxy/Dockerfile"),
+			Context:    aws.String("/ws/proxy"),
+		},
+	}, got)
+}
+
 func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 	mockPlatformStr := PlatformString("linux/amd64")
 	testCases := map[string]struct {
@@ -357,6 +492,16 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
   archie: leg64`),
 			wantedError: errUnmarshalPlatformOpts,
 		},
+		"list of platforms": {
+			inContent: []byte(`platform: [linux/amd64, linux/arm64]`),
+			wantedStruct: PlatformArgsOrString{
+				PlatformList: []PlatformString{"linux/amd64", "linux/arm64"},
+			},
+		},
+		"error if a platform in the list is invalid": {
+			inContent:   []byte(`platform: [linux/amd64, windows/amd64]`),
+			wantedError: errors.New("validate platform: platform windows/amd64 is invalid; valid platforms are: linux/amd64 and linux/arm64"),
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -369,7 +514,124 @@ func TestPlatformArgsOrString_UnmarshalYAML(t *testing.T) {
 				require.Equal(t, tc.wantedStruct.PlatformString, p.Platform.PlatformString)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.OSFamily, p.Platform.PlatformArgs.OSFamily)
 				require.Equal(t, tc.wantedStruct.PlatformArgs.Arch, p.Platform.PlatformArgs.Arch)
+				require.Equal(t, tc.wantedStruct.PlatformList, p.Platform.PlatformList)
+			}
+		})
+	}
+}
+
+func TestSecret_UnmarshalYAML(t *testing.T) {
+	testCases := map[string]struct {
+		inContent []byte
+
+		wantedSecrets map[string]Secret
+		wantedError   error
+	}{
+		"ssm parameter name": {
+			inContent: []byte(`secrets:
+  GITHUB_TOKEN: GH_TOKEN_SECRET`),
+			wantedSecrets: map[string]Secret{
+				"GITHUB_TOKEN": SecretFromString("GH_TOKEN_SECRET"),
+			},
+		},
+		"secrets manager name": {
+			inContent: []byte(`secrets:
+  DB_PASSWORD:
+    secretsmanager: copilot/phonetool/test/secrets/db_password`),
+			wantedSecrets: map[string]Secret{
+				"DB_PASSWORD": SecretFromSecretsManager("copilot/phonetool/test/secrets/db_password"),
+			},
+		},
+		"error if unmarshalable": {
+			inContent: []byte(`secrets:
+  DB_PASSWORD:
+    - copilot/phonetool/test/secrets/db_password`),
+			wantedError: errUnmarshalSecret,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var task TaskConfig
+			err := yaml.Unmarshal(tc.inContent, &task)
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
 			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedSecrets, task.Secrets)
+		})
+	}
+}
+
+func TestSecret_Value(t *testing.T) {
+	testCases := map[string]struct {
+		in Secret
+
+		wantedValue            string
+		wantedIsSecretsManager bool
+	}{
+		"ssm parameter name": {
+			in:          SecretFromString("GH_TOKEN_SECRET"),
+			wantedValue: "GH_TOKEN_SECRET",
+		},
+		"secrets manager arn": {
+			in:          SecretFromString("arn:aws:secretsmanager:us-west-2:111122223333:secret:db_password-Ab12Cd"),
+			wantedValue: "arn:aws:secretsmanager:us-west-2:111122223333:secret:db_password-Ab12Cd",
+		},
+		"secrets manager name": {
+			in:                     SecretFromSecretsManager("copilot/phonetool/test/secrets/db_password"),
+			wantedValue:            "copilot/phonetool/test/secrets/db_password",
+			wantedIsSecretsManager: true,
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wantedValue, tc.in.Value())
+			require.Equal(t, tc.wantedIsSecretsManager, tc.in.IsSecretsManagerName())
+		})
+	}
+}
+
+func TestTaskConfig_Platforms(t *testing.T) {
+	arm := PlatformString("linux/arm64")
+	testCases := map[string]struct {
+		in PlatformArgsOrString
+
+		wantedTaskPlatform   *string
+		wantedBuildPlatforms []string
+	}{
+		"no platform": {},
+		"platform string": {
+			in: PlatformArgsOrString{PlatformString: &arm},
+
+			wantedTaskPlatform:   aws.String("linux/arm64"),
+			wantedBuildPlatforms: []string{"linux/arm64"},
+		},
+		"platform args": {
+			in: PlatformArgsOrString{PlatformArgs: PlatformArgs{
+				OSFamily: aws.String("linux"),
+				Arch:     aws.String("arm64"),
+			}},
+
+			wantedTaskPlatform:   aws.String("linux/arm64"),
+			wantedBuildPlatforms: []string{"linux/arm64"},
+		},
+		"tasks run on the first platform of the list": {
+			in: PlatformArgsOrString{PlatformList: []PlatformString{"linux/amd64", "linux/arm64"}},
+
+			wantedTaskPlatform:   aws.String("linux/amd64"),
+			wantedBuildPlatforms: []string{"linux/amd64", "linux/arm64"},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			task := TaskConfig{Platform: tc.in}
+
+			got, err := task.TaskPlatform()
+
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedTaskPlatform, got)
+			require.Equal(t, tc.wantedBuildPlatforms, task.BuildPlatforms())
 		})
 	}
 }
@@ -48,6 +48,21 @@ func (mr *MockContainerLoginBuildPusherMockRecorder) Build(args interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).Build), args)
 }
 
+// BuildAndPushManifestList mocks base method.
+func (m *MockContainerLoginBuildPusher) BuildAndPushManifestList(args *dockerengine.BuildArguments) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildAndPushManifestList", args)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildAndPushManifestList indicates an expected call of BuildAndPushManifestList.
+func (mr *MockContainerLoginBuildPusherMockRecorder) BuildAndPushManifestList(args interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildAndPushManifestList", reflect.TypeOf((*MockContainerLoginBuildPusher)(nil).BuildAndPushManifestList), args)
+}
+
 // IsEcrCredentialHelperEnabled mocks base method.
 func (m *MockContainerLoginBuildPusher) IsEcrCredentialHelperEnabled(uri string) bool {
 	m.ctrl.T.Helper()
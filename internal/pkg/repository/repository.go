@@ -15,6 +15,7 @@ type ContainerLoginBuildPusher interface {
 	Build(args *dockerengine.BuildArguments) error
 	Login(uri, username, password string) error
 	Push(uri string, tags ...string) (digest string, err error)
+	BuildAndPushManifestList(args *dockerengine.BuildArguments) (digest string, err error)
 	IsEcrCredentialHelperEnabled(uri string) bool
 }
 
@@ -47,33 +48,66 @@ func New(name string, registry Registry) (*Repository, error) {
 }
 
 // BuildAndPush builds the image from Dockerfile and pushes it to the repository with tags.
+// If the image is built for several platforms, a manifest list is pushed and its digest is returned.
 func (r *Repository) BuildAndPush(docker ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (digest string, err error) {
 	if args.URI == "" {
 		args.URI = r.uri
 	}
+	if len(args.Platforms) > 1 {
+		return r.buildAndPushManifestList(docker, args)
+	}
 	if err := docker.Build(args); err != nil {
 		return "", fmt.Errorf("build Dockerfile at %s: %w", args.Dockerfile, err)
 	}
-
-	// Perform docker login only if credStore attribute value != ecr-login
-	if !docker.IsEcrCredentialHelperEnabled(args.URI) {
-		username, password, err := r.registry.Auth()
+	if err := r.login(docker, args.URI); err != nil {
+		return "", err
+	}
+	if !args.ExcludeLatest {
+		digest, err = docker.Push(args.URI, args.Tags...)
 		if err != nil {
-			return "", fmt.Errorf("get auth: %w", err)
+			return "", fmt.Errorf("push to repo %s: %w", r.name, err)
 		}
-
-		if err := docker.Login(args.URI, username, password); err != nil {
-			return "", fmt.Errorf("login to repo %s: %w", r.name, err)
+		return digest, nil
+	}
+	if len(args.Tags) == 0 {
+		return "", fmt.Errorf("push to repo %s: the image must be tagged with at least one tag other than \"latest\"", r.name)
+	}
+	for _, tag := range args.Tags {
+		digest, err = docker.Push(fmt.Sprintf("%s:%s", args.URI, tag))
+		if err != nil {
+			return "", fmt.Errorf("push to repo %s: %w", r.name, err)
 		}
 	}
+	return digest, nil
+}
 
-	digest, err = docker.Push(args.URI, args.Tags...)
+// buildAndPushManifestList logs in to the repository first, since the images are pushed while they are built.
+func (r *Repository) buildAndPushManifestList(docker ContainerLoginBuildPusher, args *dockerengine.BuildArguments) (string, error) {
+	if err := r.login(docker, args.URI); err != nil {
+		return "", err
+	}
+	digest, err := docker.BuildAndPushManifestList(args)
 	if err != nil {
-		return "", fmt.Errorf("push to repo %s: %w", r.name, err)
+		return "", fmt.Errorf("build Dockerfile at %s and push to repo %s: %w", args.Dockerfile, r.name, err)
 	}
 	return digest, nil
 }
 
+func (r *Repository) login(docker ContainerLoginBuildPusher, uri string) error {
+	// Perform docker login only if credStore attribute value != ecr-login
+	if docker.IsEcrCredentialHelperEnabled(uri) {
+		return nil
+	}
+	username, password, err := r.registry.Auth()
+	if err != nil {
+		return fmt.Errorf("get auth: %w", err)
+	}
+	if err := docker.Login(uri, username, password); err != nil {
+		return fmt.Errorf("login to repo %s: %w", r.name, err)
+	}
+	return nil
+}
+
 // URI returns the uri of the repository.
 func (r *Repository) URI() string {
 	return r.uri
@@ -144,3 +144,147 @@ func TestRepository_BuildAndPush(t *testing.T) {
 		})
 	}
 }
+
+func TestRepository_BuildAndPush_ExcludeLatest(t *testing.T) {
+	testCases := map[string]struct {
+		inTags       []string
+		inMockDocker func(m *mocks.MockContainerLoginBuildPusher)
+
+		wantedError  error
+		wantedDigest string
+	}{
+		"pushes each tag without latest": {
+			inTags: []string{"tag1", "tag2"},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Return(nil)
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(true)
+				m.EXPECT().Push("mockRepoURI:tag1").Return("sha256:abc", nil)
+				m.EXPECT().Push("mockRepoURI:tag2").Return("sha256:abc", nil)
+			},
+			wantedDigest: "sha256:abc",
+		},
+		"errors if the image isn't tagged": {
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Return(nil)
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(true)
+				m.EXPECT().Push(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New(`push to repo my-repo: the image must be tagged with at least one tag other than "latest"`),
+		},
+		"wraps the push error": {
+			inTags: []string{"tag1"},
+			inMockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().Build(gomock.Any()).Return(nil)
+				m.EXPECT().IsEcrCredentialHelperEnabled("mockRepoURI").Return(true)
+				m.EXPECT().Push("mockRepoURI:tag1").Return("", errors.New("tag already exists"))
+			},
+			wantedError: errors.New("push to repo my-repo: tag already exists"),
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			tc.inMockDocker(mockDocker)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mocks.NewMockRegistry(ctrl),
+				uri:      "mockRepoURI",
+			}
+
+			digest, err := repo.BuildAndPush(mockDocker, &dockerengine.BuildArguments{
+				Dockerfile:    "path/to/dockerfile",
+				Tags:          tc.inTags,
+				ExcludeLatest: true,
+			})
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedDigest, digest)
+			}
+		})
+	}
+}
+
+func TestRepository_BuildAndPush_MultiPlatform(t *testing.T) {
+	const mockRepoURI = "mockRepoURI"
+	testCases := map[string]struct {
+		mockRegistry func(m *mocks.MockRegistry)
+		mockDocker   func(m *mocks.MockContainerLoginBuildPusher)
+
+		wantedError  error
+		wantedDigest string
+	}{
+		"failed to login": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(false)
+				m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(errors.New("error logging in"))
+				m.EXPECT().BuildAndPushManifestList(gomock.Any()).Times(0)
+			},
+			wantedError: errors.New("login to repo my-repo: error logging in"),
+		},
+		"failed to build and push": {
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(true)
+				m.EXPECT().BuildAndPushManifestList(gomock.Any()).Return("", errors.New("some error"))
+			},
+			wantedError: errors.New("build Dockerfile at path/to/dockerfile and push to repo my-repo: some error"),
+		},
+		"logs in before building and pushing the manifest list": {
+			mockRegistry: func(m *mocks.MockRegistry) {
+				m.EXPECT().Auth().Return("my-name", "my-pwd", nil)
+			},
+			mockDocker: func(m *mocks.MockContainerLoginBuildPusher) {
+				gomock.InOrder(
+					m.EXPECT().IsEcrCredentialHelperEnabled(mockRepoURI).Return(false),
+					m.EXPECT().Login(mockRepoURI, "my-name", "my-pwd").Return(nil),
+					m.EXPECT().BuildAndPushManifestList(&dockerengine.BuildArguments{
+						URI:        mockRepoURI,
+						Dockerfile: "path/to/dockerfile",
+						Tags:       []string{"tag1"},
+						Platforms:  []string{"linux/amd64", "linux/arm64"},
+					}).Return("sha256:1234", nil),
+				)
+				m.EXPECT().Build(gomock.Any()).Times(0)
+				m.EXPECT().Push(gomock.Any(), gomock.Any()).Times(0)
+			},
+			wantedDigest: "sha256:1234",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRegistry := mocks.NewMockRegistry(ctrl)
+			mockDocker := mocks.NewMockContainerLoginBuildPusher(ctrl)
+			if tc.mockRegistry != nil {
+				tc.mockRegistry(mockRegistry)
+			}
+			tc.mockDocker(mockDocker)
+			repo := &Repository{
+				name:     "my-repo",
+				registry: mockRegistry,
+				uri:      mockRepoURI,
+			}
+
+			digest, err := repo.BuildAndPush(mockDocker, &dockerengine.BuildArguments{
+				Dockerfile: "path/to/dockerfile",
+				Tags:       []string{"tag1"},
+				Platforms:  []string{"linux/amd64", "linux/arm64"},
+			})
+
+			if tc.wantedError != nil {
+				require.EqualError(t, err, tc.wantedError.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedDigest, digest)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+)
+
+// CodeDeployDeploymentDescriber is the interface to describe a CodeDeploy deployment.
+type CodeDeployDeploymentDescriber interface {
+	Deployment(id string) (*codedeploy.Deployment, error)
+}
+
+// CodeDeployDeploymentStreamer is a Streamer for CodeDeploy deployment descriptions until the deployment is completed.
+type CodeDeployDeploymentStreamer struct {
+	client       CodeDeployDeploymentDescriber
+	clock        clock
+	rand         func(n int) int
+	deploymentID string
+
+	subscribers   []chan codedeploy.Deployment
+	once          sync.Once
+	done          chan struct{}
+	isDone        bool
+	eventsToFlush []codedeploy.Deployment
+	mu            sync.Mutex
+
+	retries int
+}
+
+// NewCodeDeployDeploymentStreamer creates a new CodeDeployDeploymentStreamer that streams
+// deployment descriptions until the deployment reaches a terminal status.
+func NewCodeDeployDeploymentStreamer(client CodeDeployDeploymentDescriber, deploymentID string) *CodeDeployDeploymentStreamer {
+	return &CodeDeployDeploymentStreamer{
+		client:       client,
+		clock:        realClock{},
+		rand:         rand.Intn,
+		deploymentID: deploymentID,
+		done:         make(chan struct{}),
+	}
+}
+
+// Subscribe returns a read-only channel that will receive deployment descriptions from the CodeDeployDeploymentStreamer.
+func (s *CodeDeployDeploymentStreamer) Subscribe() <-chan codedeploy.Deployment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := make(chan codedeploy.Deployment)
+	s.subscribers = append(s.subscribers, c)
+	if s.isDone {
+		// If the streamer is already done streaming, any new subscription requests should just return a closed channel.
+		close(c)
+	}
+	return c
+}
+
+// Fetch retrieves and stores the latest description of the deployment until the deployment is done.
+// If an error occurs from get deployment, returns a wrapped err.
+// Otherwise, returns the time the next Fetch should be attempted.
+func (s *CodeDeployDeploymentStreamer) Fetch() (next time.Time, err error) {
+	out, err := s.client.Deployment(s.deploymentID)
+	if err != nil {
+		if request.IsErrorThrottle(err) {
+			s.retries += 1
+			return nextFetchDate(s.clock, s.rand, s.retries), nil
+		}
+		return next, fmt.Errorf("fetch deployment description: %w", err)
+	}
+	s.retries = 0
+	s.eventsToFlush = append(s.eventsToFlush, *out)
+	if out.IsDone() {
+		// The deployment reached a terminal status, notify that there is no need for another Fetch call.
+		s.once.Do(func() {
+			close(s.done)
+		})
+	}
+	return nextFetchDate(s.clock, s.rand, 0), nil
+}
+
+// Notify flushes all new events to the streamer's subscribers.
+func (s *CodeDeployDeploymentStreamer) Notify() {
+	// Copy current list of subscribers over, so that we can we add more subscribers while
+	// notifying previous subscribers of older events.
+	s.mu.Lock()
+	var subs []chan codedeploy.Deployment
+	subs = append(subs, s.subscribers...)
+	s.mu.Unlock()
+
+	for _, event := range s.eventsToFlush {
+		for _, sub := range subs {
+			sub <- event
+		}
+	}
+	s.eventsToFlush = nil // reset after flushing all events.
+}
+
+// Close closes all subscribed channels notifying them that no more events will be sent.
+func (s *CodeDeployDeploymentStreamer) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, sub := range s.subscribers {
+		close(sub)
+	}
+	s.isDone = true
+}
+
+// Done returns a channel that's closed when there are no more events that can be fetched.
+func (s *CodeDeployDeploymentStreamer) Done() <-chan struct{} {
+	return s.done
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package stream
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCodeDeploy struct {
+	out *codedeploy.Deployment
+	err error
+}
+
+func (m mockCodeDeploy) Deployment(id string) (*codedeploy.Deployment, error) {
+	return m.out, m.err
+}
+
+func TestCodeDeployDeploymentStreamer_Subscribe(t *testing.T) {
+	t.Run("allow new subscriptions if streamer is still active", func(t *testing.T) {
+		// GIVEN
+		streamer := &CodeDeployDeploymentStreamer{}
+
+		// WHEN
+		_ = streamer.Subscribe()
+		_ = streamer.Subscribe()
+
+		// THEN
+		require.Equal(t, 2, len(streamer.subscribers), "expected number of subscribers to match")
+	})
+	t.Run("new subscriptions on a finished streamer should return closed channels", func(t *testing.T) {
+		// GIVEN
+		streamer := &CodeDeployDeploymentStreamer{isDone: true}
+
+		// WHEN
+		ch := streamer.Subscribe()
+		_, ok := <-ch
+
+		// THEN
+		require.False(t, ok, "channel should be closed")
+	})
+}
+
+func TestCodeDeployDeploymentStreamer_Fetch(t *testing.T) {
+	t.Run("returns a wrapped error on get deployment call failure", func(t *testing.T) {
+		// GIVEN
+		m := mockCodeDeploy{
+			err: errors.New("some error"),
+		}
+		streamer := NewCodeDeployDeploymentStreamer(m, "d-1234")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.EqualError(t, err, "fetch deployment description: some error")
+	})
+	t.Run("stores the deployment while traffic is shifting", func(t *testing.T) {
+		// GIVEN
+		deployment := &codedeploy.Deployment{
+			ID:     "d-1234",
+			Status: codedeploy.DeploymentStatusInProgress,
+			TaskSets: []codedeploy.TaskSet{
+				{
+					Label:         codedeploy.TaskSetLabelBlue,
+					TrafficWeight: 90,
+				},
+				{
+					Label:         codedeploy.TaskSetLabelGreen,
+					TrafficWeight: 10,
+				},
+			},
+		}
+		streamer := NewCodeDeployDeploymentStreamer(mockCodeDeploy{out: deployment}, "d-1234")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []codedeploy.Deployment{*deployment}, streamer.eventsToFlush)
+		select {
+		case <-streamer.Done():
+			require.Fail(t, "streamer should not be done")
+		default:
+		}
+	})
+	t.Run("is done once the deployment reaches a terminal status", func(t *testing.T) {
+		// GIVEN
+		deployment := &codedeploy.Deployment{
+			ID:     "d-1234",
+			Status: codedeploy.DeploymentStatusSucceeded,
+		}
+		streamer := NewCodeDeployDeploymentStreamer(mockCodeDeploy{out: deployment}, "d-1234")
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		select {
+		case <-streamer.Done():
+		default:
+			require.Fail(t, "streamer should be done")
+		}
+	})
+}
+
+func TestCodeDeployDeploymentStreamer_Notify(t *testing.T) {
+	// GIVEN
+	wantedEvents := []codedeploy.Deployment{
+		{
+			Status: codedeploy.DeploymentStatusInProgress,
+		},
+		{
+			Status: codedeploy.DeploymentStatusBaking,
+		},
+	}
+	sub := make(chan codedeploy.Deployment, 2)
+	streamer := &CodeDeployDeploymentStreamer{
+		subscribers:   []chan codedeploy.Deployment{sub},
+		eventsToFlush: wantedEvents,
+		clock:         fakeClock{fakeNow: time.Now()},
+		rand:          func(n int) int { return n },
+	}
+
+	// WHEN
+	streamer.Notify()
+	close(sub) // Close the channel to stop expecting to receive new events.
+
+	// THEN
+	var actualEvents []codedeploy.Deployment
+	for event := range sub {
+		actualEvents = append(actualEvents, event)
+	}
+	require.ElementsMatch(t, wantedEvents, actualEvents)
+}
+
+func TestCodeDeployDeploymentStreamer_Close(t *testing.T) {
+	// GIVEN
+	streamer := &CodeDeployDeploymentStreamer{}
+	c := streamer.Subscribe()
+
+	// WHEN
+	streamer.Close()
+
+	// THEN
+	_, isOpen := <-c
+	require.False(t, isOpen, "expected subscribed channels to be closed")
+	require.True(t, streamer.isDone, "should mark the streamer that it won't allow new subscribers")
+}
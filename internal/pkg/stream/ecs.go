@@ -21,6 +21,8 @@ const (
 	rollOutCompleted           = "COMPLETED"
 	rollOutFailed              = "FAILED"
 	rollOutEmpty               = ""
+
+	ecsDeploymentController = "ECS"
 )
 
 var ecsEventFailureKeywords = []string{"fail", "unhealthy", "error", "throttle", "unable", "missing"}
@@ -47,6 +49,15 @@ func (d ECSDeployment) isPrimary() bool {
 	return d.Status == ecsPrimaryDeploymentStatus
 }
 
+// isRolledBack returns true if the deployment started after startTime failed and was replaced by the circuit breaker.
+func (d ECSDeployment) isRolledBack(startTime time.Time) bool {
+	if d.isPrimary() {
+		// Without a rollback, a failed deployment remains the primary deployment.
+		return false
+	}
+	return d.RolloutState == rollOutFailed && !d.CreatedAt.Before(startTime)
+}
+
 func (d ECSDeployment) done() bool {
 	switch d.RolloutState {
 	case rollOutFailed:
@@ -60,8 +71,9 @@ func (d ECSDeployment) done() bool {
 
 // ECSService is a description of an ECS service.
 type ECSService struct {
-	Deployments         []ECSDeployment
-	LatestFailureEvents []string
+	Deployments             []ECSDeployment
+	LatestFailureEvents     []string
+	RollbackTaskDefRevision string // Task definition revision restored by the circuit breaker, empty if the deployment was not rolled back.
 }
 
 // ECSDeploymentStreamer is a Streamer for ECSService descriptions until the deployment is completed.
@@ -126,6 +138,13 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 		return next, fmt.Errorf("fetch service description: %w", err)
 	}
 	s.retries = 0
+	if out.DeploymentController != nil && aws.StringValue(out.DeploymentController.Type) != ecsDeploymentController {
+		// Deployments of services with an external controller, such as CodeDeploy, are not tracked by ECS.
+		s.once.Do(func() {
+			close(s.done)
+		})
+		return next, nil
+	}
 	var deployments []ECSDeployment
 	for _, deployment := range out.Deployments {
 		status := aws.StringValue(deployment.Status)
@@ -167,8 +186,9 @@ func (s *ECSDeploymentStreamer) Fetch() (next time.Time, err error) {
 		s.pastEventIDs[id] = true
 	}
 	s.eventsToFlush = append(s.eventsToFlush, ECSService{
-		Deployments:         deployments,
-		LatestFailureEvents: failureMsgs,
+		Deployments:             deployments,
+		LatestFailureEvents:     failureMsgs,
+		RollbackTaskDefRevision: rollbackRevision(deployments, s.deploymentCreationTime),
 	})
 	return nextFetchDate(s.clock, s.rand, 0), nil
 }
@@ -223,6 +243,25 @@ func isFailureServiceEvent(msg string) bool {
 	return false
 }
 
+// rollbackRevision returns the task definition revision of the primary deployment if the deployment
+// started after startTime was rolled back by the circuit breaker. Otherwise, returns an empty string.
+func rollbackRevision(deployments []ECSDeployment, startTime time.Time) string {
+	var primary *ECSDeployment
+	var rolledBack bool
+	for i, d := range deployments {
+		if d.isPrimary() {
+			primary = &deployments[i]
+		}
+		if d.isRolledBack(startTime) {
+			rolledBack = true
+		}
+	}
+	if !rolledBack || primary == nil {
+		return ""
+	}
+	return primary.TaskDefRevision
+}
+
 func isDeploymentDone(d ECSDeployment, startTime time.Time) bool {
 	if !d.isPrimary() {
 		return false
@@ -62,6 +62,29 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		// THEN
 		require.EqualError(t, err, "fetch service description: some error")
 	})
+	t.Run("is done immediately if the service deployments are not controlled by ECS", func(t *testing.T) {
+		// GIVEN
+		m := mockECS{
+			out: &ecs.Service{
+				DeploymentController: &awsecs.DeploymentController{
+					Type: aws.String("CODE_DEPLOY"),
+				},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", time.Now())
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, streamer.eventsToFlush)
+		select {
+		case <-streamer.Done():
+		default:
+			require.Fail(t, "streamer should be done")
+		}
+	})
 	t.Run("stores events until deployment is done", func(t *testing.T) {
 		// GIVEN
 		oldStartDate := time.Date(2020, time.November, 23, 17, 0, 0, 0, time.UTC)
@@ -192,6 +215,49 @@ func TestECSDeploymentStreamer_Fetch(t *testing.T) {
 		_, isOpen := <-streamer.Done()
 		require.False(t, isOpen, "there should be no more work to do since the deployment is completed")
 	})
+	t.Run("stores the rolled back revision if the circuit breaker rolled back the deployment", func(t *testing.T) {
+		// GIVEN
+		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
+		m := mockECS{
+			out: &ecs.Service{
+				Deployments: []*awsecs.Deployment{
+					{
+						DesiredCount:   aws.Int64(10),
+						FailedTasks:    aws.Int64(0),
+						PendingCount:   aws.Int64(0),
+						RolloutState:   aws.String("COMPLETED"),
+						RunningCount:   aws.Int64(10),
+						Status:         aws.String("PRIMARY"),
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:1"),
+						CreatedAt:      aws.Time(startDate.Add(10 * time.Minute)),
+						UpdatedAt:      aws.Time(startDate.Add(15 * time.Minute)),
+					},
+					{
+						DesiredCount:   aws.Int64(10),
+						FailedTasks:    aws.Int64(10),
+						PendingCount:   aws.Int64(0),
+						RolloutState:   aws.String("FAILED"),
+						RunningCount:   aws.Int64(0),
+						Status:         aws.String("ACTIVE"),
+						TaskDefinition: aws.String("arn:aws:ecs:us-west-2:1111:task-definition/myapp-test-mysvc:2"),
+						CreatedAt:      aws.Time(startDate),
+						UpdatedAt:      aws.Time(startDate.Add(10 * time.Minute)),
+					},
+				},
+			},
+		}
+		streamer := NewECSDeploymentStreamer(m, "my-cluster", "my-svc", startDate)
+
+		// WHEN
+		_, err := streamer.Fetch()
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, 1, len(streamer.eventsToFlush), "should have only event to flush")
+		require.Equal(t, "1", streamer.eventsToFlush[0].RollbackTaskDefRevision)
+		_, isOpen := <-streamer.Done()
+		require.False(t, isOpen, "there should be no more work to do since the rollback is completed")
+	})
 	t.Run("stores only failure event messages", func(t *testing.T) {
 		// GIVEN
 		startDate := time.Date(2020, time.November, 23, 18, 0, 0, 0, time.UTC)
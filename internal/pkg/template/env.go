@@ -21,10 +21,15 @@ var (
 		"cfn-execution-role",
 		"custom-resources",
 		"custom-resources-role",
+		"elb-access-logs",
 		"environment-manager-role",
+		"flow-logs",
+		"github-actions-role",
+		"internal-alb",
 		"lambdas",
 		"vpc-resources",
 		"nat-gateways",
+		"vpc-endpoints",
 	}
 )
 
@@ -39,10 +44,50 @@ type EnvOpts struct {
 	CustomDomainLambda        string
 	ScriptBucketName          string
 
-	ImportVPC *config.ImportVPC
-	VPCConfig *config.AdjustVPC
+	ImportVPC   *config.ImportVPC
+	VPCConfig   *config.AdjustVPC
+	PrivateOnly bool // Whether the VPC created by Copilot has no internet access and reaches AWS services through VPC endpoints.
+	FlowLogs    *FlowLogsOpts
 
-	LatestVersion string
+	PublicHTTPConfig   HTTPConfig
+	InternalHTTPConfig HTTPConfig
+	Telemetry          *Telemetry
+	GitHubActions      *GitHubActionsOpts
+
+	SerializedManifest string // The environment manifest that the template is generated from, if any.
+	LatestVersion      string
+}
+
+// HTTPConfig represents the configuration of a load balancer of an environment.
+type HTTPConfig struct {
+	AllowedSourceIPs []string
+	SSLPolicy        *string
+	ImportedCertARNs []string // ARNs of existing ACM certificates to serve HTTPS with, instead of a certificate validated by Copilot.
+	ELBAccessLogs    *ELBAccessLogsOpts
+	WebACLArn        string // ARN of the WAFv2 web ACL associated with the load balancer.
+}
+
+// ELBAccessLogsOpts holds the configuration of the access logs of a load balancer.
+type ELBAccessLogsOpts struct {
+	BucketName string // Name of an existing bucket. If empty, the environment creates a bucket.
+	Prefix     string
+}
+
+// FlowLogsOpts holds the configuration of the flow logs of the VPC of an environment.
+type FlowLogsOpts struct {
+	Destination string // Either "cloudwatch" or "s3".
+	Retention   int    // Number of days to keep the flow logs for.
+}
+
+// GitHubActionsOpts holds the configuration of the role that GitHub Actions workflows assume to deploy to an environment.
+type GitHubActionsOpts struct {
+	Repository         string // The "owner/name" of the GitHub repository.
+	CreateOIDCProvider bool
+}
+
+// Telemetry represents optional observability and monitoring configuration.
+type Telemetry struct {
+	EnableContainerInsights bool
 }
 
 // ParseEnv parses an environment's CloudFormation template with the specified data object and returns its content.
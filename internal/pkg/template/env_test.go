@@ -6,7 +6,9 @@ package template
 import (
 	"testing"
 
+	"github.com/aws/copilot-cli/internal/pkg/config"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
 )
 
 func TestTemplate_ParseEnv(t *testing.T) {
@@ -19,9 +21,14 @@ func TestTemplate_ParseEnv(t *testing.T) {
 				"templates/environment/partials/custom-resources.yml":         []byte("custom-resources"),
 				"templates/environment/partials/custom-resources-role.yml":    []byte("custom-resources-role"),
 				"templates/environment/partials/environment-manager-role.yml": []byte("environment-manager-role"),
+				"templates/environment/partials/github-actions-role.yml":      []byte("github-actions-role"),
 				"templates/environment/partials/lambdas.yml":                  []byte("lambdas"),
 				"templates/environment/partials/vpc-resources.yml":            []byte("vpc-resources"),
 				"templates/environment/partials/nat-gateways.yml":             []byte("nat-gateways"),
+				"templates/environment/partials/vpc-endpoints.yml":            []byte("vpc-endpoints"),
+				"templates/environment/partials/internal-alb.yml":             []byte("internal-alb"),
+				"templates/environment/partials/elb-access-logs.yml":          []byte("elb-access-logs"),
+				"templates/environment/partials/flow-logs.yml":                []byte("flow-logs"),
 			},
 		},
 	}
@@ -33,3 +40,62 @@ func TestTemplate_ParseEnv(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "test", c.String())
 }
+
+func TestTemplate_ParseEnvGitHubActionsRole(t *testing.T) {
+	type statement struct {
+		Sid    string    `yaml:"Sid"`
+		Action yaml.Node `yaml:"Action"`
+	}
+	type cfn struct {
+		Resources struct {
+			GitHubActionsRole struct {
+				Properties struct {
+					Policies []struct {
+						PolicyDocument struct {
+							Statement []statement `yaml:"Statement"`
+						} `yaml:"PolicyDocument"`
+					} `yaml:"Policies"`
+				} `yaml:"Properties"`
+			} `yaml:"GitHubActionsRole"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+
+	// WHEN
+	content, err := tpl.ParseEnv(&EnvOpts{
+		VPCConfig: &config.AdjustVPC{
+			CIDR:               "10.0.0.0/16",
+			PrivateSubnetCIDRs: []string{"10.0.2.0/24", "10.0.3.0/24"},
+			PublicSubnetCIDRs:  []string{"10.0.0.0/24", "10.0.1.0/24"},
+		},
+		GitHubActions: &GitHubActionsOpts{
+			Repository: "aws/copilot-cli",
+		},
+	}, WithFuncs(map[string]interface{}{
+		"inc": IncFunc,
+	}))
+
+	// THEN
+	require.NoError(t, err)
+	var actual cfn
+	require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual))
+	require.Len(t, actual.Resources.GitHubActionsRole.Properties.Policies, 1)
+	var ecrActions []string
+	for _, s := range actual.Resources.GitHubActionsRole.Properties.Policies[0].PolicyDocument.Statement {
+		if s.Sid == "ECR" {
+			require.NoError(t, s.Action.Decode(&ecrActions))
+		}
+	}
+	// svc deploy pulls cache_from images, pushes the built images and reads their scan findings.
+	for _, action := range []string{
+		"ecr:BatchGetImage",
+		"ecr:GetDownloadUrlForLayer",
+		"ecr:PutImage",
+		"ecr:DescribeImages",
+		"ecr:DescribeImageScanFindings",
+	} {
+		require.Contains(t, ecrActions, action)
+	}
+}
@@ -110,6 +110,23 @@ func TestTemplate_ParseLoadBalancedWebService(t *testing.T) {
 				ServiceDiscoveryEndpoint: "test.app.local",
 			},
 		},
+		"renders a valid template with environment addons": {
+			opts: template.WorkloadOpts{
+				HTTPHealthCheck: defaultHttpHealthCheck,
+				NestedStack: &template.WorkloadNestedStackOpts{
+					StackName:       "AddonsStack",
+					VariableOutputs: []string{"TableName"},
+					PolicyOutputs:   []string{"TablePolicy"},
+				},
+				EnvAddons: &template.WorkloadEnvAddonsOpts{
+					VariableOutputs:      []string{"OrdersTableName", "ClusterSecurityGroup"},
+					SecretOutputs:        []string{"ClusterSecret"},
+					PolicyOutputs:        []string{"OrdersTableAccessPolicy"},
+					SecurityGroupOutputs: []string{"ClusterSecurityGroup"},
+				},
+				ServiceDiscoveryEndpoint: "test.app.local",
+			},
+		},
 		"renders a valid template with private subnet placement": {
 			opts: template.WorkloadOpts{
 				HTTPHealthCheck: defaultHttpHealthCheck,
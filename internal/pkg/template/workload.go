@@ -6,6 +6,7 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/dustin/go-humanize/english"
@@ -75,9 +76,64 @@ var (
 		"accessrole",
 		"publish",
 		"subscribe",
+		"nlb",
+		"target-group-properties",
+		"blue-green",
+		"http-rule-conditions",
+		"http-rules",
 	}
 )
 
+// Secret is the source of a secret injected in a container.
+type Secret interface {
+	RequiresSub() bool
+	ValueFrom() string
+}
+
+// SecretFromPlainSSMOrARN returns a Secret referencing the name or ARN of an SSM parameter, or the ARN of a Secrets Manager secret.
+func SecretFromPlainSSMOrARN(value string) plainSSMOrSecretARN {
+	return plainSSMOrSecretARN{
+		value: value,
+	}
+}
+
+// SecretFromSecretsManager returns a Secret referencing the name of a secret in AWS Secrets Manager.
+func SecretFromSecretsManager(name string) secretsManagerName {
+	return secretsManagerName{
+		value: name,
+	}
+}
+
+// plainSSMOrSecretARN is a Secret used as is in the container definition.
+type plainSSMOrSecretARN struct {
+	value string
+}
+
+// RequiresSub returns false since the name or ARN doesn't reference any pseudo parameter.
+func (s plainSSMOrSecretARN) RequiresSub() bool {
+	return false
+}
+
+// ValueFrom returns the name or ARN of the secret.
+func (s plainSSMOrSecretARN) ValueFrom() string {
+	return s.value
+}
+
+// secretsManagerName is a Secret whose ARN is built from its name in the stack's partition, region and account.
+type secretsManagerName struct {
+	value string
+}
+
+// RequiresSub returns true since the ARN of the secret references pseudo parameters.
+func (s secretsManagerName) RequiresSub() bool {
+	return true
+}
+
+// ValueFrom returns the partial ARN of the secret.
+func (s secretsManagerName) ValueFrom() string {
+	return fmt.Sprintf("arn:${AWS::Partition}:secretsmanager:${AWS::Region}:${AWS::AccountId}:secret:%s", s.value)
+}
+
 // WorkloadNestedStackOpts holds configuration that's needed if the workload stack has a nested stack.
 type WorkloadNestedStackOpts struct {
 	StackName string
@@ -88,6 +144,14 @@ type WorkloadNestedStackOpts struct {
 	SecurityGroupOutputs []string
 }
 
+// WorkloadEnvAddonsOpts holds the outputs of the environment addons that are imported by the workload.
+type WorkloadEnvAddonsOpts struct {
+	VariableOutputs      []string
+	SecretOutputs        []string
+	PolicyOutputs        []string
+	SecurityGroupOutputs []string
+}
+
 // SidecarOpts holds configuration that's needed if the service has sidecar containers.
 type SidecarOpts struct {
 	Name         *string
@@ -97,7 +161,7 @@ type SidecarOpts struct {
 	Protocol     *string
 	CredsParam   *string
 	Variables    map[string]string
-	Secrets      map[string]string
+	Secrets      map[string]Secret
 	MountPoints  []*MountPoint
 	DockerLabels map[string]string
 	DependsOn    map[string]string
@@ -182,6 +246,89 @@ type HTTPHealthCheckOpts struct {
 	GracePeriod         *int64
 }
 
+// HTTPRuleOpts holds configuration that's needed for an additional HTTP listener rule of a load balanced web service.
+type HTTPRuleOpts struct {
+	Index               int // Position of the rule among the additional rules, starting at 1. Used to name the rule's resources.
+	Path                string
+	Aliases             []string
+	TargetContainer     string
+	TargetPort          string
+	HTTPHealthCheck     HTTPHealthCheckOpts
+	DeregistrationDelay *int64
+	Stickiness          bool
+	AllowedSourceIps    []string
+	Conditions          *HTTPConditionOpts
+}
+
+// PathPatterns returns the values of the path-pattern condition of the listener rule.
+func (r HTTPRuleOpts) PathPatterns() []string {
+	path := strings.Trim(r.Path, "/")
+	if path == "" {
+		return []string{"/*"}
+	}
+	return []string{fmt.Sprintf("/%s", path), fmt.Sprintf("/%s/*", path)}
+}
+
+// HTTPConditionOpts holds the HTTP header and query string conditions of a listener rule.
+type HTTPConditionOpts struct {
+	Headers      []HTTPHeaderOpts
+	QueryStrings []QueryStringOpts
+}
+
+// HTTPHeaderOpts holds configuration for a listener rule condition on an HTTP header.
+type HTTPHeaderOpts struct {
+	Name   string
+	Values []string
+}
+
+// QueryStringOpts holds configuration for a listener rule condition on a query string parameter.
+type QueryStringOpts struct {
+	Key   string
+	Value string
+}
+
+// NetworkLoadBalancer holds configuration that's needed for a Network Load Balancer.
+type NetworkLoadBalancer struct {
+	PublicSubnetCIDRs        []string
+	Listener                 NetworkLoadBalancerListener
+	MainContainerPortMapping *PortMapping // Additional port mapping of the main container if the listener targets it on a different port or protocol.
+}
+
+// NetworkLoadBalancerListener holds configuration that's needed for a Network Load Balancer listener.
+type NetworkLoadBalancerListener struct {
+	Port            string
+	Protocol        string
+	TargetContainer string
+	TargetPort      string
+	SSLPolicy       *string
+	Stickiness      *bool
+	HealthCheck     NLBHealthCheck
+}
+
+// TargetProtocol returns the protocol of the listener's target group.
+// TLS connections are terminated by the load balancer and forwarded to the targets over TCP.
+func (l NetworkLoadBalancerListener) TargetProtocol() string {
+	if l.Protocol == "TLS" {
+		return "TCP"
+	}
+	return l.Protocol
+}
+
+// NLBHealthCheck holds configuration for Network Load Balancer health check.
+type NLBHealthCheck struct {
+	Port               string // The port used by the health check, "traffic-port" if empty.
+	HealthyThreshold   *int64
+	UnhealthyThreshold *int64
+	Timeout            *int64
+	Interval           *int64
+}
+
+// PortMapping holds a port and its protocol.
+type PortMapping struct {
+	Port     string
+	Protocol string
+}
+
 // AdvancedCount holds configuration for autoscaling and capacity provider
 // parameters.
 type AdvancedCount struct {
@@ -226,6 +373,12 @@ type AutoscalingQueueDelayOpts struct {
 // ExecuteCommandOpts holds configuration that's needed for ECS Execute Command.
 type ExecuteCommandOpts struct{}
 
+// RuntimePlatformOpts holds the operating system family and CPU architecture that the tasks run on.
+type RuntimePlatformOpts struct {
+	OS   string
+	Arch string
+}
+
 // StateMachineOpts holds configuration needed for State Machine retries and timeout.
 type StateMachineOpts struct {
 	Timeout *int
@@ -292,6 +445,52 @@ type NetworkOpts struct {
 	SecurityGroups []string
 }
 
+// DeploymentConfigurationOpts holds the rolling update configuration of an ECS service.
+type DeploymentConfigurationOpts struct {
+	MinHealthyPercent int
+	MaxPercent        int
+	Rollback          bool // Whether the deployment circuit breaker rolls back to the last completed deployment.
+}
+
+// DefaultDeploymentConfigurationOpts returns the rolling update configuration used when the manifest doesn't override it.
+func DefaultDeploymentConfigurationOpts() *DeploymentConfigurationOpts {
+	return &DeploymentConfigurationOpts{
+		MinHealthyPercent: 100,
+		MaxPercent:        200,
+		Rollback:          true,
+	}
+}
+
+// Traffic routing types of a blue/green deployment.
+const (
+	BlueGreenTrafficAllAtOnce = "AllAtOnce"
+	BlueGreenTrafficLinear    = "TimeBasedLinear"
+	BlueGreenTrafficCanary    = "TimeBasedCanary"
+)
+
+// Logical IDs of the target groups that CodeDeploy swaps the production traffic between.
+const (
+	BlueGreenBlueTargetGroup  = "TargetGroup"
+	BlueGreenGreenTargetGroup = "GreenTargetGroup"
+)
+
+// BlueGreenOpts holds the configuration of a blue/green deployment of an ECS service with CodeDeploy.
+type BlueGreenOpts struct {
+	TrafficRouting         string // One of BlueGreenTrafficAllAtOnce, BlueGreenTrafficLinear or BlueGreenTrafficCanary.
+	StepPercentage         int    // Percentage of traffic shifted at each step, only used by linear and canary routing.
+	StepIntervalMins       int    // Minutes between each step, only used by linear and canary routing.
+	TestListenerPort       int    // Port of the test listener on the load balancer, 0 if there is no test listener.
+	TerminationWaitMins    int    // Minutes to keep the original task set after traffic is shifted.
+	Alarms                 []string
+	DeployedTaskDefinition string // ARN of the task definition serving traffic, empty if the service isn't deployed yet.
+	TrafficTargetGroup     string // Logical ID of the target group serving traffic, BlueGreenBlueTargetGroup if empty.
+}
+
+// IsAllAtOnce returns true if all the traffic is shifted at once to the replacement task set.
+func (b *BlueGreenOpts) IsAllAtOnce() bool {
+	return b.TrafficRouting == BlueGreenTrafficAllAtOnce
+}
+
 func defaultNetworkOpts() *NetworkOpts {
 	return &NetworkOpts{
 		AssignPublicIP: EnablePublicIP,
@@ -303,10 +502,11 @@ func defaultNetworkOpts() *NetworkOpts {
 type WorkloadOpts struct {
 	// Additional options that are common between **all** workload templates.
 	Variables                map[string]string
-	Secrets                  map[string]string
+	Secrets                  map[string]Secret
 	Aliases                  []string
 	Tags                     map[string]string        // Used by App Runner workloads to tag App Runner service resources
 	NestedStack              *WorkloadNestedStackOpts // Outputs from nested stacks such as the addons stack.
+	EnvAddons                *WorkloadEnvAddonsOpts   // Outputs imported from the environment addons.
 	Sidecars                 []*SidecarOpts
 	LogConfig                *LogConfigOpts
 	Autoscaling              *AutoscalingOpts
@@ -314,7 +514,9 @@ type WorkloadOpts struct {
 	DesiredCountOnSpot       *int
 	Storage                  *StorageOpts
 	Network                  *NetworkOpts
+	DeploymentConfiguration  *DeploymentConfigurationOpts
 	ExecuteCommand           *ExecuteCommandOpts
+	Platform                 *RuntimePlatformOpts
 	EntryPoint               []string
 	Command                  []string
 	DomainAlias              string
@@ -329,6 +531,11 @@ type WorkloadOpts struct {
 	HTTPHealthCheck     HTTPHealthCheckOpts
 	DeregistrationDelay *int64
 	AllowedSourceIps    []string
+	HTTPConditions      *HTTPConditionOpts
+	AdditionalHTTPRules []HTTPRuleOpts
+	NLB                 *NetworkLoadBalancer
+	BlueGreen           *BlueGreenOpts
+	InternalALB         bool // Whether the service is behind the internal load balancer of the environment.
 
 	// Lambda functions.
 	RulePriorityLambda             string
@@ -364,12 +571,24 @@ type ParseRequestDrivenWebServiceInput struct {
 	AppDNSName           *string
 }
 
+// TrafficTargetGroup returns the logical ID of the target group that the load balancer forwards the traffic of the service to.
+// A blue/green deployment moves the traffic to the other target group once it succeeds.
+func (o WorkloadOpts) TrafficTargetGroup() string {
+	if o.BlueGreen == nil || o.BlueGreen.TrafficTargetGroup == "" {
+		return BlueGreenBlueTargetGroup
+	}
+	return o.BlueGreen.TrafficTargetGroup
+}
+
 // ParseLoadBalancedWebService parses a load balanced web service's CloudFormation template
 // with the specified data object and returns its content.
 func (t *Template) ParseLoadBalancedWebService(data WorkloadOpts) (*Content, error) {
 	if data.Network == nil {
 		data.Network = defaultNetworkOpts()
 	}
+	if data.DeploymentConfiguration == nil {
+		data.DeploymentConfiguration = DefaultDeploymentConfigurationOpts()
+	}
 	return t.parseSvc(lbWebSvcTplName, data, withSvcParsingFuncs())
 }
 
@@ -384,6 +603,9 @@ func (t *Template) ParseBackendService(data WorkloadOpts) (*Content, error) {
 	if data.Network == nil {
 		data.Network = defaultNetworkOpts()
 	}
+	if data.DeploymentConfiguration == nil {
+		data.DeploymentConfiguration = DefaultDeploymentConfigurationOpts()
+	}
 	return t.parseSvc(backendSvcTplName, data, withSvcParsingFuncs())
 }
 
@@ -392,6 +614,9 @@ func (t *Template) ParseWorkerService(data WorkloadOpts) (*Content, error) {
 	if data.Network == nil {
 		data.Network = defaultNetworkOpts()
 	}
+	if data.DeploymentConfiguration == nil {
+		data.DeploymentConfiguration = DefaultDeploymentConfigurationOpts()
+	}
 	return t.parseSvc(workerSvcTplName, data, withSvcParsingFuncs())
 }
 
@@ -438,18 +663,20 @@ func (t *Template) parseWkld(name, wkldDirName string, data interface{}, options
 func withSvcParsingFuncs() ParseOption {
 	return func(t *template.Template) *template.Template {
 		return t.Funcs(map[string]interface{}{
-			"toSnakeCase":         ToSnakeCaseFunc,
-			"hasSecrets":          hasSecrets,
-			"fmtSlice":            FmtSliceFunc,
-			"quoteSlice":          QuoteSliceFunc,
-			"randomUUID":          randomUUIDFunc,
-			"jsonMountPoints":     generateMountPointJSON,
-			"jsonSNSTopics":       generateSNSJSON,
-			"jsonQueueURIs":       generateQueueURIJSON,
-			"envControllerParams": envControllerParameters,
-			"logicalIDSafe":       StripNonAlphaNumFunc,
-			"wordSeries":          english.WordSeries,
-			"pluralWord":          english.PluralWord,
+			"toSnakeCase":          ToSnakeCaseFunc,
+			"hasSecrets":           hasSecrets,
+			"hasManagedPolicies":   hasManagedPolicies,
+			"fmtSlice":             FmtSliceFunc,
+			"quoteSlice":           QuoteSliceFunc,
+			"randomUUID":           randomUUIDFunc,
+			"jsonMountPoints":      generateMountPointJSON,
+			"jsonSNSTopics":        generateSNSJSON,
+			"jsonQueueURIs":        generateQueueURIJSON,
+			"envControllerParams":  envControllerParameters,
+			"envControllerAliases": envControllerAliases,
+			"logicalIDSafe":        StripNonAlphaNumFunc,
+			"wordSeries":           english.WordSeries,
+			"pluralWord":           english.PluralWord,
 		})
 	}
 }
@@ -461,6 +688,19 @@ func hasSecrets(opts WorkloadOpts) bool {
 	if opts.NestedStack != nil && (len(opts.NestedStack.SecretOutputs) > 0) {
 		return true
 	}
+	if opts.EnvAddons != nil && (len(opts.EnvAddons.SecretOutputs) > 0) {
+		return true
+	}
+	return false
+}
+
+func hasManagedPolicies(opts WorkloadOpts) bool {
+	if opts.NestedStack != nil && (len(opts.NestedStack.PolicyOutputs) > 0) {
+		return true
+	}
+	if opts.EnvAddons != nil && (len(opts.EnvAddons.PolicyOutputs) > 0) {
+		return true
+	}
 	return false
 }
 
@@ -476,7 +716,11 @@ func randomUUIDFunc() (string, error) {
 func envControllerParameters(o WorkloadOpts) []string {
 	parameters := []string{}
 	if o.WorkloadType == "Load Balanced Web Service" {
-		parameters = append(parameters, []string{"ALBWorkloads,", "Aliases,"}...) // YAML needs the comma separator; resolved in EnvContr.
+		if o.InternalALB {
+			parameters = append(parameters, "InternalALBWorkloads,") // YAML needs the comma separator; resolved in EnvContr.
+		} else {
+			parameters = append(parameters, []string{"ALBWorkloads,", "Aliases,"}...) // YAML needs the comma separator; resolved in EnvContr.
+		}
 	}
 	if o.Network.SubnetsType == PrivateSubnetsPlacement {
 		parameters = append(parameters, "NATWorkloads,") // YAML needs the comma separator; resolved in EnvContr.
@@ -487,6 +731,26 @@ func envControllerParameters(o WorkloadOpts) []string {
 	return parameters
 }
 
+// envControllerAliases returns the aliases of all the HTTP listener rules of the workload without duplicates.
+func envControllerAliases(o WorkloadOpts) []string {
+	var aliases []string
+	seen := make(map[string]bool)
+	add := func(vals []string) {
+		for _, v := range vals {
+			if seen[v] {
+				continue
+			}
+			seen[v] = true
+			aliases = append(aliases, v)
+		}
+	}
+	add(o.Aliases)
+	for _, rule := range o.AdditionalHTTPRules {
+		add(rule.Aliases)
+	}
+	return aliases
+}
+
 // ARN determines the arn for a topic using the SNSTopic name and account information
 func (t Topic) ARN() string {
 	return fmt.Sprintf(snsARNPattern, t.Partition, t.Region, t.AccountID, t.App, t.Env, t.Svc, aws.StringValue(t.Name))
@@ -54,6 +54,11 @@ func TestTemplate_ParseSvc(t *testing.T) {
 					"templates/workloads/partials/cf/accessrole.yml":                      []byte("accessrole"),
 					"templates/workloads/partials/cf/publish.yml":                         []byte("publish"),
 					"templates/workloads/partials/cf/subscribe.yml":                       []byte("subscribe"),
+					"templates/workloads/partials/cf/nlb.yml":                             []byte("nlb"),
+					"templates/workloads/partials/cf/target-group-properties.yml":         []byte("target-group-properties"),
+					"templates/workloads/partials/cf/blue-green.yml":                      []byte("blue-green"),
+					"templates/workloads/partials/cf/http-rule-conditions.yml":            []byte("http-rule-conditions"),
+					"templates/workloads/partials/cf/http-rules.yml":                      []byte("http-rules"),
 				}
 			},
 			wantedContent: `  loggroup
@@ -81,6 +86,11 @@ func TestTemplate_ParseSvc(t *testing.T) {
   accessrole
   publish
   subscribe
+  nlb
+  target-group-properties
+  blue-green
+  http-rule-conditions
+  http-rules
 `,
 		},
 	}
@@ -116,14 +126,14 @@ func TestHasSecrets(t *testing.T) {
 		},
 		"no secrets": {
 			in: WorkloadOpts{
-				Secrets: map[string]string{},
+				Secrets: map[string]Secret{},
 			},
 			wanted: false,
 		},
 		"service has secrets": {
 			in: WorkloadOpts{
-				Secrets: map[string]string{
-					"hello": "world",
+				Secrets: map[string]Secret{
+					"hello": SecretFromPlainSSMOrARN("world"),
 				},
 			},
 			wanted: true,
@@ -136,6 +146,14 @@ func TestHasSecrets(t *testing.T) {
 			},
 			wanted: true,
 		},
+		"environment addons have secrets": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					SecretOutputs: []string{"MySecretArn"},
+				},
+			},
+			wanted: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -145,6 +163,85 @@ func TestHasSecrets(t *testing.T) {
 	}
 }
 
+func TestHasManagedPolicies(t *testing.T) {
+	testCases := map[string]struct {
+		in     WorkloadOpts
+		wanted bool
+	}{
+		"no nested stack or environment addons": {
+			in:     WorkloadOpts{},
+			wanted: false,
+		},
+		"nested without policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					VariableOutputs: []string{"MyTable"},
+				},
+			},
+			wanted: false,
+		},
+		"nested has policies": {
+			in: WorkloadOpts{
+				NestedStack: &WorkloadNestedStackOpts{
+					PolicyOutputs: []string{"MyTablePolicy"},
+				},
+			},
+			wanted: true,
+		},
+		"environment addons have policies": {
+			in: WorkloadOpts{
+				EnvAddons: &WorkloadEnvAddonsOpts{
+					PolicyOutputs: []string{"MyTablePolicy"},
+				},
+			},
+			wanted: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, hasManagedPolicies(tc.in))
+		})
+	}
+}
+
+func TestTemplate_ParseSecrets(t *testing.T) {
+	testCases := map[string]struct {
+		input map[string]Secret
+
+		wantedValueFrom string
+	}{
+		"should render the name of an SSM parameter as is": {
+			input: map[string]Secret{
+				"GITHUB_TOKEN": SecretFromPlainSSMOrARN("GH_TOKEN_SECRET"),
+			},
+			wantedValueFrom: "ValueFrom: GH_TOKEN_SECRET",
+		},
+		"should render the ARN of a Secrets Manager secret from its name": {
+			input: map[string]Secret{
+				"DB_PASSWORD": SecretFromSecretsManager("copilot/phonetool/test/secrets/db_password"),
+			},
+			wantedValueFrom: "ValueFrom: !Sub 'arn:${AWS::Partition}:secretsmanager:${AWS::Region}:${AWS::AccountId}:secret:copilot/phonetool/test/secrets/db_password'",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				Secrets: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse load balanced web service")
+			require.Contains(t, content.String(), tc.wantedValueFrom)
+		})
+	}
+}
+
 func TestTemplate_ParseNetwork(t *testing.T) {
 	type cfn struct {
 		Resources struct {
@@ -236,3 +333,570 @@ func TestTemplate_ParseNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplate_ParseDeploymentConfiguration(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					DeploymentConfiguration map[interface{}]interface{} `yaml:"DeploymentConfiguration"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+		} `yaml:"Resources"`
+	}
+
+	testCases := map[string]struct {
+		input *DeploymentConfigurationOpts
+
+		wantedDeploymentConfig string
+	}{
+		"should render rolling update with rollback by default": {
+			input: nil,
+			wantedDeploymentConfig: `
+ DeploymentCircuitBreaker:
+   Enable: true
+   Rollback: true
+ MinimumHealthyPercent: 100
+ MaximumPercent: 200
+`,
+		},
+		"should render custom rolling update configuration": {
+			input: &DeploymentConfigurationOpts{
+				MinHealthyPercent: 50,
+				MaxPercent:        100,
+				Rollback:          false,
+			},
+			wantedDeploymentConfig: `
+ DeploymentCircuitBreaker:
+   Enable: true
+   Rollback: false
+ MinimumHealthyPercent: 50
+ MaximumPercent: 100
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+			wanted := make(map[interface{}]interface{})
+			err := yaml.Unmarshal([]byte(tc.wantedDeploymentConfig), &wanted)
+			require.NoError(t, err, "unmarshal wanted config")
+
+			// WHEN
+			content, err := tpl.ParseBackendService(WorkloadOpts{
+				DeploymentConfiguration: tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err, "parse backend service")
+			var actual cfn
+			err = yaml.Unmarshal(content.Bytes(), &actual)
+			require.NoError(t, err, "unmarshal actual config")
+			require.Equal(t, wanted, actual.Resources.Service.Properties.DeploymentConfiguration)
+		})
+	}
+}
+
+func TestTemplate_ParseNLB(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					LoadBalancers []map[string]interface{} `yaml:"LoadBalancers"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+			NLBListener struct {
+				Properties map[string]interface{} `yaml:"Properties"`
+			} `yaml:"NLBListener"`
+			NLBTargetGroup struct {
+				Properties map[string]interface{} `yaml:"Properties"`
+			} `yaml:"NLBTargetGroup"`
+			NLBSecurityGroup struct {
+				Properties struct {
+					SecurityGroupIngress []map[string]interface{} `yaml:"SecurityGroupIngress"`
+				} `yaml:"Properties"`
+			} `yaml:"NLBSecurityGroup"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+	deregistrationDelay := int64(60)
+
+	// WHEN
+	content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+		WorkloadType:        "Load Balanced Web Service",
+		DeregistrationDelay: &deregistrationDelay,
+		NLB: &NetworkLoadBalancer{
+			PublicSubnetCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+			Listener: NetworkLoadBalancerListener{
+				Port:            "443",
+				Protocol:        "TLS",
+				TargetContainer: "api",
+				TargetPort:      "8443",
+			},
+			MainContainerPortMapping: &PortMapping{
+				Port:     "8443",
+				Protocol: "tcp",
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	var actual cfn
+	require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual))
+	require.Equal(t, []map[string]interface{}{
+		{
+			"ContainerName":  "TargetContainer",
+			"ContainerPort":  "TargetPort",
+			"TargetGroupArn": "TargetGroup",
+		},
+		{
+			"ContainerName":  "api",
+			"ContainerPort":  8443,
+			"TargetGroupArn": "NLBTargetGroup",
+		},
+	}, actual.Resources.Service.Properties.LoadBalancers)
+	require.Equal(t, "TLS", actual.Resources.NLBListener.Properties["Protocol"])
+	require.Equal(t, "ELBSecurityPolicy-TLS13-1-2-2021-06", actual.Resources.NLBListener.Properties["SslPolicy"])
+	require.Equal(t, "TCP", actual.Resources.NLBTargetGroup.Properties["Protocol"])
+	require.Len(t, actual.Resources.NLBSecurityGroup.Properties.SecurityGroupIngress, 2)
+	require.Contains(t, string(content.Bytes()), "- !Ref NLBSecurityGroup")
+}
+
+func TestTemplate_ParseNLBUDPIngress(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			NLBSecurityGroup struct {
+				Properties struct {
+					SecurityGroupIngress []map[string]interface{} `yaml:"SecurityGroupIngress"`
+				} `yaml:"Properties"`
+			} `yaml:"NLBSecurityGroup"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+	deregistrationDelay := int64(60)
+
+	// WHEN
+	content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+		WorkloadType:        "Load Balanced Web Service",
+		DeregistrationDelay: &deregistrationDelay,
+		NLB: &NetworkLoadBalancer{
+			PublicSubnetCIDRs: []string{"10.0.0.0/24", "10.0.1.0/24"},
+			Listener: NetworkLoadBalancerListener{
+				Port:            "53",
+				Protocol:        "UDP",
+				TargetContainer: "dns",
+				TargetPort:      "53",
+				HealthCheck: NLBHealthCheck{
+					Port: "8080",
+				},
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	var actual cfn
+	require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual))
+	require.Equal(t, []map[string]interface{}{
+		{
+			"CidrIp":      "0.0.0.0/0",
+			"Description": "Ingress to allow UDP traffic from clients through the network load balancer",
+			"FromPort":    53,
+			"ToPort":      53,
+			"IpProtocol":  "udp",
+		},
+		{
+			"CidrIp":      "10.0.0.0/24",
+			"Description": "Ingress to allow health checks from the network load balancer in a public subnet",
+			"FromPort":    8080,
+			"ToPort":      8080,
+			"IpProtocol":  "tcp",
+		},
+		{
+			"CidrIp":      "10.0.1.0/24",
+			"Description": "Ingress to allow health checks from the network load balancer in a public subnet",
+			"FromPort":    8080,
+			"ToPort":      8080,
+			"IpProtocol":  "tcp",
+		},
+	}, actual.Resources.NLBSecurityGroup.Properties.SecurityGroupIngress)
+}
+
+func TestTemplate_ParseAdditionalHTTPRules(t *testing.T) {
+	type listenerRule struct {
+		Properties struct {
+			Conditions []map[string]interface{} `yaml:"Conditions"`
+			Priority   string                   `yaml:"Priority"`
+		} `yaml:"Properties"`
+	}
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					LoadBalancers []map[string]interface{} `yaml:"LoadBalancers"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+			EnvControllerAction struct {
+				Properties struct {
+					Aliases []string `yaml:"Aliases"`
+				} `yaml:"Properties"`
+			} `yaml:"EnvControllerAction"`
+			HTTPRulePriorityAction struct {
+				Properties struct {
+					RulePath     []string `yaml:"RulePath"`
+					TargetGroups []string `yaml:"TargetGroups"`
+					RootPathLast bool     `yaml:"RootPathLast"`
+				} `yaml:"Properties"`
+			} `yaml:"HTTPRulePriorityAction"`
+			HTTPSListenerRule  listenerRule `yaml:"HTTPSListenerRule"`
+			HTTPSListenerRule1 listenerRule `yaml:"HTTPSListenerRule1"`
+			HTTPListenerRule1  listenerRule `yaml:"HTTPListenerRule1"`
+			TargetGroup1       struct {
+				Properties map[string]interface{} `yaml:"Properties"`
+			} `yaml:"TargetGroup1"`
+		} `yaml:"Resources"`
+	}
+
+	// GIVEN
+	tpl := New()
+	deregistrationDelay := int64(60)
+
+	// WHEN
+	content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+		WorkloadType:        "Load Balanced Web Service",
+		Aliases:             []string{"example.com"},
+		DeregistrationDelay: &deregistrationDelay,
+		HTTPConditions: &HTTPConditionOpts{
+			QueryStrings: []QueryStringOpts{{Key: "version", Value: "2"}},
+		},
+		AdditionalHTTPRules: []HTTPRuleOpts{
+			{
+				Index:           1,
+				Path:            "v2",
+				Aliases:         []string{"api.example.com", "example.com"},
+				TargetContainer: "envoy",
+				TargetPort:      "8080",
+				HTTPHealthCheck: HTTPHealthCheckOpts{
+					HealthCheckPath: "/healthz",
+				},
+				DeregistrationDelay: &deregistrationDelay,
+				Conditions: &HTTPConditionOpts{
+					Headers: []HTTPHeaderOpts{{Name: "X-Canary", Values: []string{"true", "yes"}}},
+				},
+			},
+		},
+	})
+
+	// THEN
+	require.NoError(t, err)
+	var actual cfn
+	require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual))
+	require.Equal(t, []map[string]interface{}{
+		{
+			"ContainerName":  "TargetContainer",
+			"ContainerPort":  "TargetPort",
+			"TargetGroupArn": "TargetGroup",
+		},
+		{
+			"ContainerName":  "envoy",
+			"ContainerPort":  8080,
+			"TargetGroupArn": "TargetGroup1",
+		},
+	}, actual.Resources.Service.Properties.LoadBalancers)
+	require.Equal(t, []string{"example.com", "api.example.com"}, actual.Resources.EnvControllerAction.Properties.Aliases)
+	require.Equal(t, []string{"RulePath", "v2"}, actual.Resources.HTTPRulePriorityAction.Properties.RulePath)
+	require.Equal(t, []string{"TargetGroup", "TargetGroup1"}, actual.Resources.HTTPRulePriorityAction.Properties.TargetGroups)
+	require.True(t, actual.Resources.HTTPRulePriorityAction.Properties.RootPathLast)
+	require.Equal(t, "/healthz", actual.Resources.TargetGroup1.Properties["HealthCheckPath"])
+	require.Equal(t, 8080, actual.Resources.TargetGroup1.Properties["Port"])
+
+	require.Contains(t, actual.Resources.HTTPSListenerRule.Properties.Conditions, map[string]interface{}{
+		"Field": "query-string",
+		"QueryStringConfig": map[string]interface{}{
+			"Values": []interface{}{
+				map[string]interface{}{"Key": "version", "Value": "2"},
+			},
+		},
+	})
+	require.Equal(t, "HTTPSRulePriorityAction.Priority1", actual.Resources.HTTPSListenerRule1.Properties.Priority)
+	require.Equal(t, []map[string]interface{}{
+		{
+			"Field": "host-header",
+			"HostHeaderConfig": map[string]interface{}{
+				"Values": []interface{}{"api.example.com", "example.com"},
+			},
+		},
+		{
+			"Field": "path-pattern",
+			"PathPatternConfig": map[string]interface{}{
+				"Values": []interface{}{"/v2", "/v2/*"},
+			},
+		},
+		{
+			"Field": "http-header",
+			"HttpHeaderConfig": map[string]interface{}{
+				"HttpHeaderName": "X-Canary",
+				"Values":         []interface{}{"true", "yes"},
+			},
+		},
+	}, actual.Resources.HTTPSListenerRule1.Properties.Conditions)
+	require.Equal(t, "HTTPRulePriorityAction.Priority1", actual.Resources.HTTPListenerRule1.Properties.Priority)
+	require.Len(t, actual.Resources.HTTPListenerRule1.Properties.Conditions, 2)
+}
+
+func TestHTTPRuleOpts_PathPatterns(t *testing.T) {
+	testCases := map[string]struct {
+		path   string
+		wanted []string
+	}{
+		"root path": {
+			path:   "/",
+			wanted: []string{"/*"},
+		},
+		"path without a leading slash": {
+			path:   "api",
+			wanted: []string{"/api", "/api/*"},
+		},
+		"path with a leading slash": {
+			path:   "/api/v2",
+			wanted: []string{"/api/v2", "/api/v2/*"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.wanted, HTTPRuleOpts{Path: tc.path}.PathPatterns())
+		})
+	}
+}
+
+func TestTemplate_ParseBlueGreen(t *testing.T) {
+	type cfn struct {
+		Resources struct {
+			Service struct {
+				Properties struct {
+					TaskDefinition          string                 `yaml:"TaskDefinition"`
+					DeploymentController    map[string]interface{} `yaml:"DeploymentController"`
+					DeploymentConfiguration map[string]interface{} `yaml:"DeploymentConfiguration"`
+					ServiceRegistries       []interface{}          `yaml:"ServiceRegistries"`
+				} `yaml:"Properties"`
+			} `yaml:"Service"`
+			GreenTargetGroup struct {
+				Properties map[string]interface{} `yaml:"Properties"`
+			} `yaml:"GreenTargetGroup"`
+			HTTPListenerRule struct {
+				Properties struct {
+					Actions []map[string]interface{} `yaml:"Actions"`
+				} `yaml:"Properties"`
+			} `yaml:"HTTPListenerRule"`
+			TestListener struct {
+				Properties map[string]interface{} `yaml:"Properties"`
+			} `yaml:"TestListener"`
+			TestListenerSecurityGroupIngress struct {
+				Properties map[string]interface{} `yaml:"Properties"`
+			} `yaml:"TestListenerSecurityGroupIngress"`
+			CodeDeployDeploymentConfig struct {
+				Properties struct {
+					TrafficRoutingConfig map[string]interface{} `yaml:"TrafficRoutingConfig"`
+				} `yaml:"Properties"`
+			} `yaml:"CodeDeployDeploymentConfig"`
+			CodeDeployDeploymentGroup struct {
+				Properties struct {
+					DeploymentConfigName             string                 `yaml:"DeploymentConfigName"`
+					BlueGreenDeploymentConfiguration map[string]interface{} `yaml:"BlueGreenDeploymentConfiguration"`
+					AlarmConfiguration               map[string]interface{} `yaml:"AlarmConfiguration"`
+					AutoRollbackConfiguration        map[string]interface{} `yaml:"AutoRollbackConfiguration"`
+				} `yaml:"Properties"`
+			} `yaml:"CodeDeployDeploymentGroup"`
+		} `yaml:"Resources"`
+		Outputs map[string]interface{} `yaml:"Outputs"`
+	}
+	deregistrationDelay := int64(60)
+
+	testCases := map[string]struct {
+		input *BlueGreenOpts
+
+		wantedTaskDefinition     string
+		wantedTrafficTargetGroup string
+		wantedConfigName         string
+		wantedRoutingConfig      map[string]interface{}
+		wantedRollbackEvents     []interface{}
+		wantedTestListener       bool
+	}{
+		"should render all at once traffic shifting for a new service": {
+			input: &BlueGreenOpts{
+				TrafficRouting:      BlueGreenTrafficAllAtOnce,
+				TerminationWaitMins: 5,
+			},
+			wantedTaskDefinition:     "TaskDefinition",
+			wantedTrafficTargetGroup: "TargetGroup",
+			wantedConfigName:         "CodeDeployDefault.ECSAllAtOnce",
+			wantedRollbackEvents:     []interface{}{"DEPLOYMENT_FAILURE"},
+		},
+		"should render canary traffic shifting with a test listener and alarms for a deployed service": {
+			input: &BlueGreenOpts{
+				TrafficRouting:         BlueGreenTrafficCanary,
+				StepPercentage:         10,
+				StepIntervalMins:       5,
+				TestListenerPort:       8080,
+				TerminationWaitMins:    30,
+				Alarms:                 []string{"HighLatency", "5xxErrors"},
+				DeployedTaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:3",
+			},
+			wantedTaskDefinition:     "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:3",
+			wantedTrafficTargetGroup: "TargetGroup",
+			wantedConfigName:         "CodeDeployDeploymentConfig",
+			wantedRoutingConfig: map[string]interface{}{
+				"Type": "TimeBasedCanary",
+				"TimeBasedCanary": map[string]interface{}{
+					"CanaryInterval":   5,
+					"CanaryPercentage": 10,
+				},
+			},
+			wantedRollbackEvents: []interface{}{"DEPLOYMENT_FAILURE", "DEPLOYMENT_STOP_ON_ALARM"},
+			wantedTestListener:   true,
+		},
+		"should keep forwarding traffic to the green target group on the deployment after a swap": {
+			input: &BlueGreenOpts{
+				TrafficRouting:         BlueGreenTrafficAllAtOnce,
+				TestListenerPort:       8080,
+				TerminationWaitMins:    5,
+				DeployedTaskDefinition: "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:4",
+				TrafficTargetGroup:     BlueGreenGreenTargetGroup,
+			},
+			wantedTaskDefinition:     "arn:aws:ecs:us-west-2:1111:task-definition/phonetool-test-api:4",
+			wantedTrafficTargetGroup: "GreenTargetGroup",
+			wantedConfigName:         "CodeDeployDefault.ECSAllAtOnce",
+			wantedRollbackEvents:     []interface{}{"DEPLOYMENT_FAILURE"},
+			wantedTestListener:       true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				WorkloadType:        "Load Balanced Web Service",
+				DeregistrationDelay: &deregistrationDelay,
+				BlueGreen:           tc.input,
+			})
+
+			// THEN
+			require.NoError(t, err)
+			var actual cfn
+			require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual))
+			props := actual.Resources.Service.Properties
+			require.Equal(t, tc.wantedTaskDefinition, props.TaskDefinition)
+			require.Equal(t, map[string]interface{}{"Type": "CODE_DEPLOY"}, props.DeploymentController)
+			require.Nil(t, props.DeploymentConfiguration, "circuit breaker is not supported with CodeDeploy")
+			require.Len(t, props.ServiceRegistries, 1, "the service keeps its service discovery name")
+			require.Equal(t, "HTTP", actual.Resources.GreenTargetGroup.Properties["Protocol"])
+			require.Equal(t, tc.wantedTrafficTargetGroup, actual.Resources.HTTPListenerRule.Properties.Actions[0]["TargetGroupArn"])
+			require.Equal(t, tc.wantedTestListener, actual.Resources.TestListener.Properties != nil)
+			if tc.wantedTestListener {
+				require.Equal(t, []interface{}{
+					map[string]interface{}{
+						"TargetGroupArn": tc.wantedTrafficTargetGroup,
+						"Type":           "forward",
+					},
+				}, actual.Resources.TestListener.Properties["DefaultActions"])
+				ingress := actual.Resources.TestListenerSecurityGroupIngress.Properties
+				require.Equal(t, tc.input.TestListenerPort, ingress["FromPort"])
+				require.Equal(t, tc.input.TestListenerPort, ingress["ToPort"])
+				require.Equal(t, "0.0.0.0/0", ingress["CidrIp"])
+			}
+
+			group := actual.Resources.CodeDeployDeploymentGroup.Properties
+			require.Equal(t, tc.wantedConfigName, group.DeploymentConfigName)
+			require.Equal(t, tc.wantedRoutingConfig, actual.Resources.CodeDeployDeploymentConfig.Properties.TrafficRoutingConfig)
+			require.Equal(t, map[string]interface{}{
+				"Action":                       "TERMINATE",
+				"TerminationWaitTimeInMinutes": tc.input.TerminationWaitMins,
+			}, group.BlueGreenDeploymentConfiguration["TerminateBlueInstancesOnDeploymentSuccess"])
+			require.Equal(t, tc.wantedRollbackEvents, group.AutoRollbackConfiguration["Events"])
+			require.Equal(t, len(tc.input.Alarms) > 0, group.AlarmConfiguration != nil)
+			require.Contains(t, actual.Outputs, "TaskDefinitionArn")
+			require.Contains(t, actual.Outputs, "GreenTargetGroupArn")
+		})
+	}
+}
+
+func TestTemplate_ParseInternalALB(t *testing.T) {
+	type listenerRule struct {
+		Properties struct {
+			ListenerArn string `yaml:"ListenerArn"`
+		} `yaml:"Properties"`
+	}
+	type cfn struct {
+		Resources struct {
+			EnvControllerAction struct {
+				Properties struct {
+					Parameters []string `yaml:"Parameters"`
+				} `yaml:"Properties"`
+			} `yaml:"EnvControllerAction"`
+			HTTPRulePriorityAction listenerRule `yaml:"HTTPRulePriorityAction"`
+			HTTPListenerRule       listenerRule `yaml:"HTTPListenerRule"`
+			HTTPListenerRule1      listenerRule `yaml:"HTTPListenerRule1"`
+		} `yaml:"Resources"`
+	}
+	deregistrationDelay := int64(60)
+
+	testCases := map[string]struct {
+		inInternalALB bool
+
+		wantedParameters  []string
+		wantedListenerArn string
+	}{
+		"should attach the service to the public load balancer": {
+			wantedParameters:  []string{"ALBWorkloads", "Aliases"},
+			wantedListenerArn: "EnvControllerAction.HTTPListenerArn",
+		},
+		"should attach the service to the internal load balancer": {
+			inInternalALB:     true,
+			wantedParameters:  []string{"InternalALBWorkloads"},
+			wantedListenerArn: "EnvControllerAction.InternalHTTPListenerArn",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			tpl := New()
+
+			// WHEN
+			content, err := tpl.ParseLoadBalancedWebService(WorkloadOpts{
+				WorkloadType:        "Load Balanced Web Service",
+				DeregistrationDelay: &deregistrationDelay,
+				InternalALB:         tc.inInternalALB,
+				AdditionalHTTPRules: []HTTPRuleOpts{
+					{
+						Index:               1,
+						Path:                "admin",
+						TargetContainer:     "frontend",
+						TargetPort:          "80",
+						DeregistrationDelay: &deregistrationDelay,
+					},
+				},
+			})
+
+			// THEN
+			require.NoError(t, err)
+			var actual cfn
+			require.NoError(t, yaml.Unmarshal(content.Bytes(), &actual))
+			require.Equal(t, tc.wantedParameters, actual.Resources.EnvControllerAction.Properties.Parameters)
+			require.Equal(t, tc.wantedListenerArn, actual.Resources.HTTPRulePriorityAction.Properties.ListenerArn)
+			require.Equal(t, tc.wantedListenerArn, actual.Resources.HTTPListenerRule.Properties.ListenerArn)
+			require.Equal(t, tc.wantedListenerArn, actual.Resources.HTTPListenerRule1.Properties.ListenerArn)
+		})
+	}
+}
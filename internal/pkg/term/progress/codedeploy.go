@@ -0,0 +1,169 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/aws/copilot-cli/internal/pkg/term/color"
+)
+
+// CodeDeploySubscriber is the interface to subscribe channels to CodeDeploy deployment descriptions.
+type CodeDeploySubscriber interface {
+	Subscribe() <-chan codedeploy.Deployment
+}
+
+// ListeningTrafficShiftRenderer renders the traffic shifted between the task sets of a blue/green deployment.
+func ListeningTrafficShiftRenderer(streamer CodeDeploySubscriber, description string, opts RenderOptions) DynamicRenderer {
+	c := &trafficShiftComponent{
+		description: description,
+		padding:     opts.Padding,
+		stream:      streamer.Subscribe(),
+		done:        make(chan struct{}),
+	}
+	go c.Listen()
+	return c
+}
+
+type trafficShiftComponent struct {
+	// Data to render.
+	deployment *codedeploy.Deployment
+
+	// Style configuration for the component.
+	description string
+	padding     int
+
+	stream <-chan codedeploy.Deployment // Channel where deployment descriptions are received.
+	done   chan struct{}                // Channel that's closed when there are no more events to listen on.
+	mu     sync.Mutex                   // Lock used to mutate data to render.
+}
+
+// Listen updates the deployment as events are streamed.
+func (c *trafficShiftComponent) Listen() {
+	for ev := range c.stream {
+		ev := ev
+		c.mu.Lock()
+		c.deployment = &ev
+		c.mu.Unlock()
+	}
+	close(c.done)
+}
+
+// Render prints the status of the deployment, followed with the task sets as a tableComponent,
+// and the failure message if the deployment did not succeed.
+func (c *trafficShiftComponent) Render(out io.Writer) (numLines int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	buf := new(bytes.Buffer)
+
+	status := "[pending]"
+	if c.deployment != nil {
+		status = prettifyCodeDeployStatus(c.deployment.Status)
+	}
+	components := []Renderer{
+		&singleLineComponent{
+			Text:    fmt.Sprintf("- %s %s", c.description, status),
+			Padding: c.padding,
+		},
+	}
+	nl, err := renderComponents(buf, components)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	nl, err = c.renderTaskSets(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	nl, err = c.renderFailureMsg(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
+	if _, err := buf.WriteTo(out); err != nil {
+		return 0, fmt.Errorf("render traffic shift component to writer: %w", err)
+	}
+	return numLines, nil
+}
+
+// Done returns a channel that's closed when there are no more events to listen.
+func (c *trafficShiftComponent) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *trafficShiftComponent) renderTaskSets(out io.Writer) (numLines int, err error) {
+	if c.deployment == nil {
+		return 0, nil
+	}
+	header := []string{"", "Traffic", "Desired", "Running", "Pending"}
+	var rows [][]string
+	for _, ts := range c.deployment.TaskSets {
+		rows = append(rows, []string{
+			ts.Label,
+			fmt.Sprintf("%.0f%%", ts.TrafficWeight),
+			strconv.Itoa(ts.DesiredCount),
+			strconv.Itoa(ts.RunningCount),
+			strconv.Itoa(ts.PendingCount),
+		})
+	}
+	table := newTableComponent(color.Faint.Sprintf("Task sets"), header, rows)
+	table.Padding = c.padding + nestedComponentPadding
+	nl, err := table.Render(out)
+	if err != nil {
+		return 0, fmt.Errorf("render task sets table: %w", err)
+	}
+	return nl, nil
+}
+
+func (c *trafficShiftComponent) renderFailureMsg(out io.Writer) (numLines int, err error) {
+	if c.deployment == nil || c.deployment.ErrorMessage == "" {
+		return 0, nil
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the failure message.
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%s%s", color.DullRed.Sprintf("✘ "), color.Faint.Sprintf("Deployment failed")),
+			Padding: c.padding + nestedComponentPadding,
+		},
+	}
+	for i, truncatedMsg := range splitByLength(c.deployment.ErrorMessage, maxCellLength) {
+		pretty := fmt.Sprintf("  %s", truncatedMsg)
+		if i == 0 {
+			pretty = fmt.Sprintf("- %s", truncatedMsg)
+		}
+		components = append(components, &singleLineComponent{
+			Text:    pretty,
+			Padding: c.padding + 2*nestedComponentPadding,
+		})
+	}
+	return renderComponents(out, components)
+}
+
+// prettifyCodeDeployStatus transforms a CodeDeploy status such as "InProgress" to "[in progress]".
+func prettifyCodeDeployStatus(status string) string {
+	var words []string
+	var word strings.Builder
+	for _, r := range status {
+		if unicode.IsUpper(r) && word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+		word.WriteRune(unicode.ToLower(r))
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return fmt.Sprintf("[%s]", strings.Join(words, " "))
+}
@@ -0,0 +1,113 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package progress
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/codedeploy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrafficShiftComponent_Listen(t *testing.T) {
+	// GIVEN
+	events := make(chan codedeploy.Deployment)
+	done := make(chan struct{})
+	c := &trafficShiftComponent{
+		stream: events,
+		done:   done,
+	}
+
+	// WHEN
+	go c.Listen()
+	go func() {
+		events <- codedeploy.Deployment{
+			Status: codedeploy.DeploymentStatusInProgress,
+		}
+		events <- codedeploy.Deployment{
+			Status: codedeploy.DeploymentStatusSucceeded,
+		}
+		close(events)
+	}()
+
+	// THEN
+	<-done // Listen should have closed the channel.
+	require.Equal(t, &codedeploy.Deployment{
+		Status: codedeploy.DeploymentStatusSucceeded,
+	}, c.deployment, "expected only the latest deployment to be stored")
+}
+
+func TestTrafficShiftComponent_Render(t *testing.T) {
+	testCases := map[string]struct {
+		inDeployment *codedeploy.Deployment
+
+		wantedNumLines int
+		wantedOut      string
+	}{
+		"should render a pending status before the deployment is described": {
+			wantedNumLines: 1,
+			wantedOut: `- Shifting traffic [pending]
+`,
+		},
+		"should render the traffic routed to each task set": {
+			inDeployment: &codedeploy.Deployment{
+				Status: codedeploy.DeploymentStatusInProgress,
+				TaskSets: []codedeploy.TaskSet{
+					{
+						Label:         codedeploy.TaskSetLabelBlue,
+						TrafficWeight: 90,
+						DesiredCount:  2,
+						RunningCount:  2,
+					},
+					{
+						Label:         codedeploy.TaskSetLabelGreen,
+						TrafficWeight: 10,
+						DesiredCount:  2,
+						RunningCount:  1,
+						PendingCount:  1,
+					},
+				},
+			},
+			wantedNumLines: 5,
+			wantedOut: `- Shifting traffic [in progress]
+  Task sets
+           Traffic  Desired  Running  Pending
+    Blue   90%      2        2        0
+    Green  10%      2        1        1
+`,
+		},
+		"should render the failure message": {
+			inDeployment: &codedeploy.Deployment{
+				Status:       codedeploy.DeploymentStatusStopped,
+				ErrorMessage: "One or more alarms have been activated.",
+			},
+			wantedNumLines: 4,
+			wantedOut: `- Shifting traffic [stopped]
+
+  ✘ Deployment failed
+    - One or more alarms have been activated.
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			buf := new(strings.Builder)
+			c := &trafficShiftComponent{
+				description: "Shifting traffic",
+				deployment:  tc.inDeployment,
+			}
+
+			// WHEN
+			nl, err := c.Render(buf)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedNumLines, nl, "number of lines expected did not match")
+			require.Equal(t, tc.wantedOut, buf.String(), "the content written did not match")
+		})
+	}
+}
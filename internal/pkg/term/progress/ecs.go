@@ -38,8 +38,9 @@ func ListeningRollingUpdateRenderer(streamer ECSServiceSubscriber, opts RenderOp
 
 type rollingUpdateComponent struct {
 	// Data to render.
-	deployments []stream.ECSDeployment
-	failureMsgs []string
+	deployments      []stream.ECSDeployment
+	failureMsgs      []string
+	rollbackRevision string // Task definition revision that the circuit breaker rolled back to.
 
 	// Style configuration for the component.
 	padding           int
@@ -55,6 +56,9 @@ func (c *rollingUpdateComponent) Listen() {
 	for ev := range c.stream {
 		c.mu.Lock()
 		c.deployments = ev.Deployments
+		if ev.RollbackTaskDefRevision != "" {
+			c.rollbackRevision = ev.RollbackTaskDefRevision
+		}
 		c.failureMsgs = append(c.failureMsgs, ev.LatestFailureEvents...)
 		if len(c.failureMsgs) > c.maxLenFailureMsgs {
 			c.failureMsgs = c.failureMsgs[len(c.failureMsgs)-c.maxLenFailureMsgs:]
@@ -76,6 +80,12 @@ func (c *rollingUpdateComponent) Render(out io.Writer) (numLines int, err error)
 	}
 	numLines += nl
 
+	nl, err = c.renderRollback(buf)
+	if err != nil {
+		return 0, err
+	}
+	numLines += nl
+
 	nl, err = c.renderFailureMsgs(buf)
 	if err != nil {
 		return 0, err
@@ -116,6 +126,20 @@ func (c *rollingUpdateComponent) renderDeployments(out io.Writer) (numLines int,
 	return nl, err
 }
 
+func (c *rollingUpdateComponent) renderRollback(out io.Writer) (numLines int, err error) {
+	if c.rollbackRevision == "" {
+		return 0, nil
+	}
+	components := []Renderer{
+		&singleLineComponent{}, // Add an empty line before rendering the rollback.
+		&singleLineComponent{
+			Text:    fmt.Sprintf("%sDeployment failed: rolled back to task definition revision %s", color.DullRed.Sprint("✘ "), c.rollbackRevision),
+			Padding: c.padding,
+		},
+	}
+	return renderComponents(out, components)
+}
+
 func (c *rollingUpdateComponent) renderFailureMsgs(out io.Writer) (numLines int, err error) {
 	if len(c.failureMsgs) == 0 {
 		return 0, nil
@@ -55,7 +55,8 @@ func TestRollingUpdateComponent_Listen(t *testing.T) {
 						RolloutState:    "COMPLETED",
 					},
 				},
-				LatestFailureEvents: []string{"event4"},
+				LatestFailureEvents:     []string{"event4"},
+				RollbackTaskDefRevision: "1",
 			}
 			close(events)
 		}()
@@ -72,6 +73,7 @@ func TestRollingUpdateComponent_Listen(t *testing.T) {
 			},
 		}, c.deployments, "expected only the latest deployment to be stored")
 		require.Equal(t, []string{"event3", "event4"}, c.failureMsgs, "expected max len failure msgs to be respected")
+		require.Equal(t, "1", c.rollbackRevision, "expected the rolled back revision to be stored")
 	})
 }
 
@@ -79,6 +81,7 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 	testCases := map[string]struct {
 		inDeployments []stream.ECSDeployment
 		inFailureMsgs []string
+		inRollback    string
 
 		wantedNumLines int
 		wantedOut      string
@@ -119,6 +122,30 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
   - (service webapp-test-frontend-Service-ss036XlczgjO) (port 80) is unhea
     lthy in (target-group arn:aws:elasticloadbalancing:us-west-2:1111: tar
     getgroup/aaaaaaaaaaaa) due to (reason some-error).
+`,
+		},
+		"should render the rolled back revision": {
+			inDeployments: []stream.ECSDeployment{
+				{
+					Status:          "PRIMARY",
+					TaskDefRevision: "1",
+					DesiredCount:    10,
+					RunningCount:    10,
+					RolloutState:    "COMPLETED",
+				},
+			},
+			inFailureMsgs: []string{"(service my-svc) (task 1234) failed container health checks."},
+			inRollback:    "1",
+
+			wantedNumLines: 8,
+			wantedOut: `Deployments
+           Revision  Rollout      Desired  Running  Failed  Pending
+  PRIMARY  1         [completed]  10       10       0       0
+
+✘ Deployment failed: rolled back to task definition revision 1
+
+✘ Latest failure event
+  - (service my-svc) (task 1234) failed container health checks.
 `,
 		},
 		"should render multiple failure messages in reverse order": {
@@ -140,8 +167,9 @@ func TestRollingUpdateComponent_Render(t *testing.T) {
 			// GIVEN
 			buf := new(strings.Builder)
 			c := &rollingUpdateComponent{
-				deployments: tc.inDeployments,
-				failureMsgs: tc.inFailureMsgs,
+				deployments:      tc.inDeployments,
+				failureMsgs:      tc.inFailureMsgs,
+				rollbackRevision: tc.inRollback,
 			}
 
 			// WHEN
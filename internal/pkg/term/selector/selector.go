@@ -37,6 +37,8 @@ const (
 	pipelineEscapeOpt = "[No additional environments]"
 
 	fmtCopilotTaskGroup = "copilot-%s"
+	// Task definition family of the tasks of a service, formatted with the app, env and service names.
+	fmtServiceTaskGroup = "%s-%s-%s"
 )
 
 const (
@@ -68,6 +70,7 @@ const (
 	svcNameFinalMsg     = "Service name:"
 	jobNameFinalMsg     = "Job name:"
 	deployedSvcFinalMsg = "Service:"
+	deployedJobFinalMsg = "Job:"
 	taskFinalMsg        = "Task:"
 	workloadFinalMsg    = "Name:"
 	dockerfileFinalMsg  = "Dockerfile:"
@@ -174,6 +177,7 @@ type DeploySelect struct {
 	*Select
 	deployStoreSvc DeployStoreClient
 	svc            string
+	job            string
 	env            string
 	filters        []DeployedServiceFilter
 }
@@ -221,6 +225,7 @@ type TaskSelect struct {
 	defaultCluster bool
 	taskGroup      string
 	taskID         string
+	svc            string
 }
 
 // NewSelect returns a selector that chooses applications or environments.
@@ -298,6 +303,14 @@ func WithTaskID(id string) TaskOpts {
 	}
 }
 
+// WithService sets up the service name for TaskSelect to choose among the tasks of the service
+// instead of one-off tasks. Requires WithAppEnv.
+func WithService(svc string) TaskOpts {
+	return func(in *TaskSelect) {
+		in.svc = svc
+	}
+}
+
 // RunningTask has the user select a running task. Callers can provide either app and env names,
 // or use default cluster.
 func (s *TaskSelect) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.Task, error) {
@@ -311,6 +324,11 @@ func (s *TaskSelect) RunningTask(msg, help string, opts ...TaskOpts) (*awsecs.Ta
 		TaskID:      s.taskID,
 		CopilotOnly: true,
 	}
+	if s.svc != "" {
+		// Tasks of a service aren't tagged as Copilot tasks.
+		filter.TaskGroup = fmt.Sprintf(fmtServiceTaskGroup, s.app, s.env, s.svc)
+		filter.CopilotOnly = false
+	}
 	if s.defaultCluster {
 		tasks, err = s.lister.ListActiveDefaultClusterTasks(filter)
 		if err != nil {
@@ -367,6 +385,13 @@ func WithSvc(svc string) GetDeployedServiceOpts {
 	}
 }
 
+// WithJob sets up the job name for DeploySelect.
+func WithJob(job string) GetDeployedServiceOpts {
+	return func(in *DeploySelect) {
+		in.job = job
+	}
+}
+
 // WithEnv sets up the env name for DeploySelect.
 func WithEnv(env string) GetDeployedServiceOpts {
 	return func(in *DeploySelect) {
@@ -405,6 +430,16 @@ func (s *DeployedService) String() string {
 	return fmt.Sprintf("%s (%s)", s.Svc, s.Env)
 }
 
+// DeployedJob contains the job name and environment name of the deployed job.
+type DeployedJob struct {
+	Name string
+	Env  string
+}
+
+func (j *DeployedJob) String() string {
+	return fmt.Sprintf("%s (%s)", j.Name, j.Env)
+}
+
 // Task has the user select a task. Callers can provide an environment, an app, or a "use default cluster" option
 // to filter the returned tasks.
 func (s *CFTaskSelect) Task(msg, help string, opts ...GetDeployedTaskOpts) (string, error) {
@@ -556,6 +591,79 @@ func (s *DeploySelect) DeployedService(msg, help string, app string, opts ...Get
 	return deployedSvc, nil
 }
 
+// DeployedJob has the user select a deployed job. Callers can provide either a particular environment,
+// a particular job to filter on, or both.
+func (s *DeploySelect) DeployedJob(msg, help string, app string, opts ...GetDeployedServiceOpts) (*DeployedJob, error) {
+	for _, opt := range opts {
+		opt(s)
+	}
+	var err error
+	var envNames []string
+	if s.env != "" {
+		envNames = append(envNames, s.env)
+	} else {
+		envNames, err = s.retrieveEnvironments(app)
+		if err != nil {
+			return nil, fmt.Errorf("list environments: %w", err)
+		}
+	}
+	var jobEnvs []*DeployedJob
+	for _, envName := range envNames {
+		var jobNames []string
+		if s.job != "" {
+			deployed, err := s.deployStoreSvc.IsJobDeployed(app, envName, s.job)
+			if err != nil {
+				return nil, fmt.Errorf("check if job %s is deployed in environment %s: %w", s.job, envName, err)
+			}
+			if !deployed {
+				continue
+			}
+			jobNames = append(jobNames, s.job)
+		} else {
+			jobNames, err = s.deployStoreSvc.ListDeployedJobs(app, envName)
+			if err != nil {
+				return nil, fmt.Errorf("list deployed jobs for environment %s: %w", envName, err)
+			}
+		}
+		for _, jobName := range jobNames {
+			jobEnvs = append(jobEnvs, &DeployedJob{
+				Name: jobName,
+				Env:  envName,
+			})
+		}
+	}
+	if len(jobEnvs) == 0 {
+		return nil, fmt.Errorf("no deployed jobs found in application %s", color.HighlightUserInput(app))
+	}
+	if len(jobEnvs) == 1 {
+		deployedJob := jobEnvs[0]
+		if s.job == "" && s.env == "" {
+			log.Infof("Found only one deployed job %s in environment %s\n", color.HighlightUserInput(deployedJob.Name), color.HighlightUserInput(deployedJob.Env))
+		}
+		if (s.job != "") != (s.env != "") {
+			log.Infof("Job %s found in environment %s\n", color.HighlightUserInput(deployedJob.Name), color.HighlightUserInput(deployedJob.Env))
+		}
+		return deployedJob, nil
+	}
+
+	jobEnvNames := make([]string, len(jobEnvs))
+	jobEnvNameMap := map[string]*DeployedJob{}
+	for i, job := range jobEnvs {
+		jobEnvNames[i] = job.String()
+		jobEnvNameMap[jobEnvNames[i]] = job
+	}
+	jobEnvName, err := s.prompt.SelectOne(
+		msg,
+		help,
+		jobEnvNames,
+		prompt.WithFinalMessage(deployedJobFinalMsg),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("select deployed jobs for application %s: %w", app, err)
+	}
+	return jobEnvNameMap[jobEnvName], nil
+}
+
 func (s *DeploySelect) filterServices(inServices []*DeployedService) ([]*DeployedService, error) {
 	outServices := inServices
 	for _, filter := range s.filters {
@@ -483,6 +483,120 @@ func TestDeploySelect_Service(t *testing.T) {
 	}
 }
 
+func TestDeploySelect_Job(t *testing.T) {
+	const testApp = "mockApp"
+	testCases := map[string]struct {
+		setupMocks func(mocks deploySelectMocks)
+		job        string
+		env        string
+
+		wantErr error
+		wantEnv string
+		wantJob string
+	}{
+		"return error if fail to list deployed jobs": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "test").Return(nil, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("list deployed jobs for environment test: some error"),
+		},
+		"return error if no deployed jobs found": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+				}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "test").Return([]string{}, nil)
+			},
+			wantErr: fmt.Errorf("no deployed jobs found in application %s", testApp),
+		},
+		"return error if fail to check if job passed in by flag is deployed or not": {
+			env: "test",
+			job: "mockJob",
+			setupMocks: func(m deploySelectMocks) {
+				m.deploySvc.EXPECT().IsJobDeployed(testApp, "test", "mockJob").Return(false, errors.New("some error"))
+			},
+			wantErr: fmt.Errorf("check if job mockJob is deployed in environment test: some error"),
+		},
+		"success": {
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+					},
+				}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "test").Return([]string{"mockJob"}, nil)
+				m.deploySvc.EXPECT().ListDeployedJobs(testApp, "prod").Return([]string{"mockJob"}, nil)
+				m.prompt.EXPECT().
+					SelectOne("Select a deployed job", "Help text", []string{"mockJob (test)", "mockJob (prod)"}, gomock.Any()).
+					Return("mockJob (prod)", nil)
+			},
+			wantEnv: "prod",
+			wantJob: "mockJob",
+		},
+		"skip with only one deployed job": {
+			job: "mockJob",
+			setupMocks: func(m deploySelectMocks) {
+				m.configSvc.EXPECT().ListEnvironments(testApp).Return([]*config.Environment{
+					{
+						Name: "test",
+					},
+					{
+						Name: "prod",
+					},
+				}, nil)
+				m.deploySvc.EXPECT().IsJobDeployed(testApp, "test", "mockJob").Return(true, nil)
+				m.deploySvc.EXPECT().IsJobDeployed(testApp, "prod", "mockJob").Return(false, nil)
+			},
+			wantEnv: "test",
+			wantJob: "mockJob",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockdeploySvc := mocks.NewMockDeployStoreClient(ctrl)
+			mockconfigSvc := mocks.NewMockConfigLister(ctrl)
+			mockprompt := mocks.NewMockPrompter(ctrl)
+			mocks := deploySelectMocks{
+				deploySvc: mockdeploySvc,
+				configSvc: mockconfigSvc,
+				prompt:    mockprompt,
+			}
+			tc.setupMocks(mocks)
+
+			sel := DeploySelect{
+				Select: &Select{
+					config: mockconfigSvc,
+					prompt: mockprompt,
+				},
+				deployStoreSvc: mockdeploySvc,
+			}
+
+			gotDeployed, err := sel.DeployedJob("Select a deployed job", "Help text", testApp, WithEnv(tc.env), WithJob(tc.job))
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantJob, gotDeployed.Name)
+				require.Equal(t, tc.wantEnv, gotDeployed.Env)
+			}
+		})
+	}
+}
+
 type workspaceSelectMocks struct {
 	workloadLister *mocks.MockWorkspaceRetriever
 	prompt         *mocks.MockPrompter
@@ -2256,11 +2370,27 @@ func TestTaskSelect_Task(t *testing.T) {
 		setupMocks func(mocks taskSelectMocks)
 		app        string
 		env        string
+		svc        string
 		useDefault bool
 
 		wantErr  error
 		wantTask *awsecs.Task
 	}{
+		"lists the tasks of the service": {
+			app: mockApp,
+			env: mockEnv,
+			svc: "mockSvc",
+			setupMocks: func(m taskSelectMocks) {
+				m.taskLister.EXPECT().ListActiveAppEnvTasks(ecs.ListActiveAppEnvTasksOpts{
+					App: mockApp,
+					Env: mockEnv,
+					ListTasksFilter: ecs.ListTasksFilter{
+						TaskGroup: "mockApp-mockEnv-mockSvc",
+					},
+				}).Return([]*awsecs.Task{mockTask1}, nil)
+			},
+			wantTask: mockTask1,
+		},
 		"return error if fail to list active cluster tasks": {
 			useDefault: true,
 			setupMocks: func(m taskSelectMocks) {
@@ -2370,6 +2500,9 @@ func TestTaskSelect_Task(t *testing.T) {
 			if tc.useDefault {
 				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
 					WithAppEnv(tc.app, tc.env), WithDefault())
+			} else if tc.svc != "" {
+				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
+					WithAppEnv(tc.app, tc.env), WithService(tc.svc))
 			} else {
 				gotTask, err = sel.RunningTask(mockPromptText, mockHelpText,
 					WithAppEnv(tc.app, tc.env))
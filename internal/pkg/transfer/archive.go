@@ -0,0 +1,125 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// writeArchive writes the local file or directory as a tar archive whose top-level entry is named root.
+func writeArchive(w io.Writer, localPath string, info fs.FileInfo, root string) error {
+	tw := tar.NewWriter(w)
+	if !info.IsDir() {
+		if err := writeArchiveFile(tw, localPath, info, root); err != nil {
+			return err
+		}
+		return tw.Close()
+	}
+	err := filepath.WalkDir(localPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return writeArchiveFile(tw, p, info, path.Join(root, filepath.ToSlash(rel)))
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+func writeArchiveFile(tw *tar.Writer, localPath string, info fs.FileInfo, name string) error {
+	if !info.IsDir() && !info.Mode().IsRegular() {
+		// Symbolic links and special files aren't copied.
+		return nil
+	}
+	hdr, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return fmt.Errorf("create header for %s: %w", localPath, err)
+	}
+	hdr.Name = name
+	if info.IsDir() {
+		hdr.Name += "/"
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("write header for %s: %w", localPath, err)
+	}
+	if info.IsDir() {
+		return nil
+	}
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := io.Copy(tw, f); err != nil {
+		return fmt.Errorf("write %s: %w", localPath, err)
+	}
+	return nil
+}
+
+// extractArchive extracts the tar archive whose top-level entry is named root to the local target path.
+func extractArchive(r io.Reader, root, target string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read archive: %w", err)
+		}
+		name := strings.TrimSuffix(path.Clean(hdr.Name), "/")
+		if name != root && !strings.HasPrefix(name, root+"/") {
+			return fmt.Errorf("unexpected entry %s in archive", hdr.Name)
+		}
+		rel := strings.TrimPrefix(strings.TrimPrefix(name, root), "/")
+		if rel == ".." || strings.HasPrefix(rel, "../") {
+			return fmt.Errorf("entry %s is outside of %s", hdr.Name, root)
+		}
+		dst := filepath.Join(target, filepath.FromSlash(rel))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(dst, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := extractArchiveFile(tr, dst, hdr.FileInfo().Mode().Perm()); err != nil {
+				return err
+			}
+		default:
+			// Symbolic links and special files aren't copied.
+		}
+	}
+}
+
+func extractArchiveFile(r io.Reader, dst string, perm fs.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return fmt.Errorf("write %s: %w", dst, err)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	beginMarker    = "<<<copilot-cp-begin>>>"
+	endMarker      = "<<<copilot-cp-end>>>"
+	okMarker       = "<<<copilot-cp-ok>>>"
+	mismatchMarker = "<<<copilot-cp-checksum-mismatch>>>"
+
+	// The terminal of the session reads its input line by line, so the archive is sent in base64 lines.
+	bytesPerLine = 57 // Encoded as 76 characters, the line length of the base64 utility.
+	eotChar      = "\x04"
+
+	maxOutputLines   = 10
+	progressInterval = 1 << 20
+)
+
+// shellCommand returns the command that runs the script in the container.
+// The script is encoded so that it isn't split by the session into arguments.
+func shellCommand(script string) string {
+	return fmt.Sprintf(`/bin/sh -c 'eval "$(echo %s | base64 -d)"'`, base64.StdEncoding.EncodeToString([]byte(script)))
+}
+
+// downloadScript returns the script that writes the checksum and content of the tar archive of the file between markers.
+func downloadScript(dir, base string) string {
+	return fmt.Sprintf(`stty -echo 2>/dev/null
+f=$(mktemp) || exit 1
+if tar cf "$f" -C %s %s; then
+  echo %s
+  sha256sum "$f" | cut -d ' ' -f 1
+  base64 "$f"
+  echo %s
+fi
+rm -f "$f"
+`, quote(dir), quote(base), quote(beginMarker), quote(endMarker))
+}
+
+// uploadScript returns the script that reads a base64 tar archive from its input,
+// and extracts it in the directory if its checksum matches.
+func uploadScript(dir, checksum string) string {
+	return fmt.Sprintf(`stty -echo 2>/dev/null
+f=$(mktemp) || exit 1
+base64 -d > "$f"
+if [ "$(sha256sum "$f" | cut -d ' ' -f 1)" = %s ]; then
+  mkdir -p %s && tar xf "$f" -C %s && echo %s
+else
+  echo %s
+fi
+rm -f "$f"
+`, quote(checksum), quote(dir), quote(dir), quote(okMarker), quote(mismatchMarker))
+}
+
+// quote returns the string quoted for a POSIX shell.
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'"'"'`) + "'"
+}
+
+// encodeLines writes the content of the reader as base64 lines, followed by the end of transmission character
+// that closes the input of the command in the container.
+func encodeLines(w io.Writer, r io.Reader) error {
+	buf := make([]byte, bytesPerLine)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if _, werr := fmt.Fprintln(w, base64.StdEncoding.EncodeToString(buf[:n])); werr != nil {
+				return werr
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, eotChar)
+	return err
+}
+
+// lineWriter calls onLine with each line written to it, without the line endings of the terminal.
+type lineWriter struct {
+	buf    []byte
+	onLine func(line string)
+
+	// Lines that aren't part of the transfer, kept to explain failures.
+	lines []string
+}
+
+// Write implements the io.Writer interface.
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		w.onLine(strings.TrimRight(string(w.buf[:i]), "\r"))
+		w.buf = w.buf[i+1:]
+	}
+	return len(p), nil
+}
+
+// Flush handles the last line if it doesn't end with a line break.
+func (w *lineWriter) Flush() {
+	if len(w.buf) == 0 {
+		return
+	}
+	w.onLine(strings.TrimRight(string(w.buf), "\r"))
+	w.buf = nil
+}
+
+func (w *lineWriter) keep(line string) {
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+	w.lines = append(w.lines, line)
+	if len(w.lines) > maxOutputLines {
+		w.lines = w.lines[1:]
+	}
+}
+
+func (w *lineWriter) output() string {
+	if len(w.lines) == 0 {
+		return "no output from the container"
+	}
+	return strings.Join(w.lines, "\n")
+}
+
+// downloadReceiver decodes the archive written between markers by the download script.
+type downloadReceiver struct {
+	*lineWriter
+	out io.Writer
+
+	begun    bool
+	ended    bool
+	checksum string
+	err      error
+}
+
+func newDownloadReceiver(out io.Writer) *downloadReceiver {
+	r := &downloadReceiver{
+		out: out,
+	}
+	r.lineWriter = &lineWriter{
+		onLine: r.onLine,
+	}
+	return r
+}
+
+func (r *downloadReceiver) onLine(line string) {
+	line = strings.TrimSpace(line)
+	switch {
+	case r.err != nil || r.ended:
+		return
+	case !r.begun:
+		if line == beginMarker {
+			r.begun = true
+			return
+		}
+		r.keep(line)
+	case r.checksum == "":
+		r.checksum = line
+	case line == endMarker:
+		r.ended = true
+	default:
+		data, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			r.err = fmt.Errorf("decode %q: %w", line, err)
+			return
+		}
+		if _, err := r.out.Write(data); err != nil {
+			r.err = err
+		}
+	}
+}
+
+// uploadReceiver looks for the result of the upload script.
+type uploadReceiver struct {
+	*lineWriter
+
+	ok       bool
+	mismatch bool
+}
+
+func newUploadReceiver() *uploadReceiver {
+	r := &uploadReceiver{}
+	r.lineWriter = &lineWriter{
+		onLine: r.onLine,
+	}
+	return r
+}
+
+func (r *uploadReceiver) onLine(line string) {
+	switch strings.TrimSpace(line) {
+	case okMarker:
+		r.ok = true
+	case mismatchMarker:
+		r.mismatch = true
+	default:
+		r.keep(line)
+	}
+}
+
+// progress writes the number of bytes transferred.
+type progress struct {
+	w     io.Writer
+	verb  string
+	total int64
+
+	n       int64
+	printed int64
+}
+
+func newProgress(w io.Writer, verb string, total int64) *progress {
+	return &progress{
+		w:     w,
+		verb:  verb,
+		total: total,
+	}
+}
+
+// Write implements the io.Writer interface.
+func (p *progress) Write(b []byte) (int, error) {
+	p.n += int64(len(b))
+	if p.n-p.printed >= progressInterval {
+		p.print()
+	}
+	return len(b), nil
+}
+
+// Done writes the final number of bytes transferred.
+func (p *progress) Done() {
+	p.print()
+	fmt.Fprintln(p.w)
+}
+
+func (p *progress) print() {
+	p.printed = p.n
+	if p.total > 0 {
+		fmt.Fprintf(p.w, "\r%s %s / %s", p.verb, humanize.Bytes(uint64(p.n)), humanize.Bytes(uint64(p.total)))
+		return
+	}
+	fmt.Fprintf(p.w, "\r%s %s", p.verb, humanize.Bytes(uint64(p.n)))
+}
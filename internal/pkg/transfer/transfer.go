@@ -0,0 +1,158 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package transfer copies files and directories in and out of running containers over ECS Exec sessions.
+package transfer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+)
+
+type commandExecutor interface {
+	ExecuteCommand(in ecs.ExecuteCommandInput) error
+}
+
+// Container identifies a running container to copy files to or from.
+type Container struct {
+	Cluster string
+	Task    string
+	Name    string
+}
+
+// Copier copies files and directories between the local file system and a running container.
+// The files are archived with tar, and streamed as base64 over the session since the session goes through a terminal.
+type Copier struct {
+	executor  commandExecutor
+	container Container
+	progress  io.Writer
+}
+
+// New returns a Copier that runs commands in the container with the executor,
+// and writes the progress of the transfers to the progress writer.
+func New(executor commandExecutor, container Container, progress io.Writer) *Copier {
+	return &Copier{
+		executor:  executor,
+		container: container,
+		progress:  progress,
+	}
+}
+
+// Upload copies the local file or directory to the path in the container.
+// If the remote path ends with "/", the file or directory is copied into that directory.
+// The missing parent directories of the remote path are created.
+func (c *Copier) Upload(localPath, remotePath string) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return fmt.Errorf("stat %s: %w", localPath, err)
+	}
+	target := path.Clean(remotePath)
+	if strings.HasSuffix(remotePath, "/") {
+		target = path.Join(target, filepath.Base(localPath))
+	}
+
+	archive, err := os.CreateTemp("", "copilot-cp-*.tar")
+	if err != nil {
+		return fmt.Errorf("create temporary archive: %w", err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+	hash := sha256.New()
+	if err := writeArchive(io.MultiWriter(archive, hash), localPath, info, path.Base(target)); err != nil {
+		return fmt.Errorf("archive %s: %w", localPath, err)
+	}
+	size, err := archive.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("get size of archive: %w", err)
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind archive: %w", err)
+	}
+
+	bar := newProgress(c.progress, "Uploaded", size)
+	stdin, stdinWriter := io.Pipe()
+	encoded := make(chan struct{})
+	go func() {
+		stdinWriter.CloseWithError(encodeLines(stdinWriter, io.TeeReader(archive, bar)))
+		close(encoded)
+	}()
+	out := newUploadReceiver()
+	err = c.executor.ExecuteCommand(c.commandInput(uploadScript(path.Dir(target), hex.EncodeToString(hash.Sum(nil))), stdin, out))
+	// Unblock the encoder if the session ended before consuming all of the input.
+	stdin.Close()
+	<-encoded
+	out.Flush()
+	bar.Done()
+	if err != nil {
+		return fmt.Errorf("execute command in container %s: %w", c.container.Name, err)
+	}
+	if out.mismatch {
+		return errors.New("checksum of the uploaded archive doesn't match")
+	}
+	if !out.ok {
+		return fmt.Errorf("extract archive to %s: %s", target, out.output())
+	}
+	return nil
+}
+
+// Download copies the file or directory at the path in the container to the local path.
+// If the local path is an existing directory, the file or directory is copied into that directory.
+func (c *Copier) Download(remotePath, localPath string) error {
+	source := path.Clean(remotePath)
+	target := localPath
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		target = filepath.Join(localPath, path.Base(source))
+	}
+
+	archive, err := os.CreateTemp("", "copilot-cp-*.tar")
+	if err != nil {
+		return fmt.Errorf("create temporary archive: %w", err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+	hash := sha256.New()
+	bar := newProgress(c.progress, "Downloaded", 0)
+	out := newDownloadReceiver(io.MultiWriter(archive, hash, bar))
+	err = c.executor.ExecuteCommand(c.commandInput(downloadScript(path.Dir(source), path.Base(source)), nil, out))
+	out.Flush()
+	bar.Done()
+	if err != nil {
+		return fmt.Errorf("execute command in container %s: %w", c.container.Name, err)
+	}
+	if out.err != nil {
+		return fmt.Errorf("receive archive of %s: %w", source, out.err)
+	}
+	if !out.ended {
+		return fmt.Errorf("archive %s: %s", source, out.output())
+	}
+	if got := hex.EncodeToString(hash.Sum(nil)); got != out.checksum {
+		return fmt.Errorf("checksum of the downloaded archive %s doesn't match %s", got, out.checksum)
+	}
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind archive: %w", err)
+	}
+	if err := extractArchive(archive, path.Base(source), target); err != nil {
+		return fmt.Errorf("extract archive to %s: %w", target, err)
+	}
+	return nil
+}
+
+func (c *Copier) commandInput(script string, stdin io.Reader, stdout io.Writer) ecs.ExecuteCommandInput {
+	return ecs.ExecuteCommandInput{
+		Cluster:   c.container.Cluster,
+		Task:      c.container.Task,
+		Container: c.container.Name,
+		Command:   shellCommand(script),
+		Stdin:     stdin,
+		Stdout:    stdout,
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright Amazon.com, Inc. or its affiliates. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package transfer
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/copilot-cli/internal/pkg/aws/ecs"
+	"github.com/aws/copilot-cli/internal/pkg/transfer/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+var mockContainer = Container{
+	Cluster: "mockCluster",
+	Task:    "mockTask",
+	Name:    "mockContainer",
+}
+
+// tarball returns a tar archive of the files keyed by their names.
+func tarball(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	for _, name := range sortedNames(files) {
+		content := files[name]
+		hdr := &tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}
+		if strings.HasSuffix(name, "/") {
+			hdr.Mode, hdr.Size, hdr.Typeflag = 0755, 0, tar.TypeDir
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func sortedNames(files map[string]string) []string {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	// Directories sort before the files they contain.
+	sort.Strings(names)
+	return names
+}
+
+// sessionOutput returns the output of the download script through a terminal.
+func sessionOutput(archive []byte, checksum string) string {
+	var lines []string
+	lines = append(lines, "", "Starting session with SessionId: ecs-execute-command-0123456789", beginMarker, checksum)
+	encoded := base64.StdEncoding.EncodeToString(archive)
+	for len(encoded) > 76 {
+		lines = append(lines, encoded[:76])
+		encoded = encoded[76:]
+	}
+	lines = append(lines, encoded, endMarker, "", "", "Exiting session with sessionId: ecs-execute-command-0123456789.", "")
+	return strings.Join(lines, "\r\n")
+}
+
+func checksumOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestCopier_Download(t *testing.T) {
+	archive := tarball(t, map[string]string{
+		"logs/":          "",
+		"logs/app.log":   "hello",
+		"logs/a/b.log":   "world",
+		"logs/a/":        "",
+		"logs/empty.log": "",
+	})
+	testCases := map[string]struct {
+		inRemotePath  string
+		inExistingDir bool
+		output        string
+		execErr       error
+
+		wantedFiles map[string]string
+		wantedError string
+	}{
+		"downloads the directory to the local path": {
+			inRemotePath: "/var/logs/",
+			output:       sessionOutput(archive, checksumOf(archive)),
+			wantedFiles: map[string]string{
+				"dst/app.log":   "hello",
+				"dst/a/b.log":   "world",
+				"dst/empty.log": "",
+			},
+		},
+		"downloads the directory into an existing local directory": {
+			inRemotePath:  "/var/logs",
+			inExistingDir: true,
+			output:        sessionOutput(archive, checksumOf(archive)),
+			wantedFiles: map[string]string{
+				"dst/logs/app.log": "hello",
+				"dst/logs/a/b.log": "world",
+			},
+		},
+		"returns an error if the checksum doesn't match": {
+			inRemotePath: "/var/logs",
+			output:       sessionOutput(archive, "1234"),
+			wantedError:  fmt.Sprintf("checksum of the downloaded archive %s doesn't match 1234", checksumOf(archive)),
+		},
+		"returns the output of the container if the archive couldn't be created": {
+			inRemotePath: "/var/logs",
+			output:       "\r\nStarting session with SessionId: ecs-execute-command-0123456789\r\ntar: logs: No such file or directory\r\n",
+			wantedError:  "archive /var/logs: Starting session with SessionId: ecs-execute-command-0123456789\ntar: logs: No such file or directory",
+		},
+		"wraps the error from the session": {
+			inRemotePath: "/var/logs",
+			execErr:      errors.New("some error"),
+			wantedError:  "execute command in container mockContainer: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			dir := t.TempDir()
+			dst := filepath.Join(dir, "dst")
+			if tc.inExistingDir {
+				require.NoError(t, os.Mkdir(dst, 0755))
+			}
+			m := mocks.NewMockcommandExecutor(ctrl)
+			m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in ecs.ExecuteCommandInput) error {
+				require.Equal(t, "mockCluster", in.Cluster)
+				require.Equal(t, "mockTask", in.Task)
+				require.Equal(t, "mockContainer", in.Container)
+				require.Nil(t, in.Stdin)
+				_, err := io.WriteString(in.Stdout, tc.output)
+				require.NoError(t, err)
+				return tc.execErr
+			})
+
+			err := New(m, mockContainer, io.Discard).Download(tc.inRemotePath, dst)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+				return
+			}
+			require.NoError(t, err)
+			for name, content := range tc.wantedFiles {
+				got, err := os.ReadFile(filepath.Join(dir, name))
+				require.NoError(t, err)
+				require.Equal(t, content, string(got))
+			}
+		})
+	}
+}
+
+// receiveUpload decodes the archive sent over the input of the session.
+func receiveUpload(t *testing.T, stdin io.Reader) []byte {
+	archive := &bytes.Buffer{}
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == eotChar {
+			break
+		}
+		data, err := base64.StdEncoding.DecodeString(line)
+		require.NoError(t, err)
+		archive.Write(data)
+	}
+	return archive.Bytes()
+}
+
+func TestCopier_Upload(t *testing.T) {
+	testCases := map[string]struct {
+		inLocalPath  string
+		inRemotePath string
+		output       string
+		execErr      error
+
+		wantedDir     string
+		wantedEntries []string
+		wantedError   string
+	}{
+		"uploads the file to the remote path": {
+			inLocalPath:   "src/config.yml",
+			inRemotePath:  "/etc/app/app.yml",
+			output:        okMarker + "\r\n",
+			wantedDir:     "/etc/app",
+			wantedEntries: []string{"app.yml"},
+		},
+		"uploads the directory into the remote directory": {
+			inLocalPath:   "src",
+			inRemotePath:  "/tmp/",
+			output:        okMarker + "\r\n",
+			wantedDir:     "/tmp",
+			wantedEntries: []string{"src/", "src/config.yml", "src/nested/", "src/nested/data.json"},
+		},
+		"returns an error if the checksum doesn't match": {
+			inLocalPath:  "src/config.yml",
+			inRemotePath: "/tmp/",
+			output:       mismatchMarker + "\r\n",
+			wantedError:  "checksum of the uploaded archive doesn't match",
+		},
+		"returns the output of the container if the archive couldn't be extracted": {
+			inLocalPath:  "src/config.yml",
+			inRemotePath: "/etc/app.yml",
+			output:       "tar: can't open 'app.yml': Read-only file system\r\n",
+			wantedError:  "extract archive to /etc/app.yml: tar: can't open 'app.yml': Read-only file system",
+		},
+		"wraps the error from the session": {
+			inLocalPath:  "src/config.yml",
+			inRemotePath: "/tmp/",
+			execErr:      errors.New("some error"),
+			wantedError:  "execute command in container mockContainer: some error",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			dir := t.TempDir()
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, "src", "nested"), 0755))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "config.yml"), []byte("name: api"), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "src", "nested", "data.json"), []byte("{}"), 0644))
+			m := mocks.NewMockcommandExecutor(ctrl)
+			m.EXPECT().ExecuteCommand(gomock.Any()).DoAndReturn(func(in ecs.ExecuteCommandInput) error {
+				if tc.execErr != nil {
+					return tc.execErr
+				}
+				archive := receiveUpload(t, in.Stdin)
+				if tc.wantedDir != "" {
+					require.Equal(t, shellCommand(uploadScript(tc.wantedDir, checksumOf(archive))), in.Command)
+					tr := tar.NewReader(bytes.NewReader(archive))
+					var entries []string
+					for {
+						hdr, err := tr.Next()
+						if err == io.EOF {
+							break
+						}
+						require.NoError(t, err)
+						entries = append(entries, hdr.Name)
+					}
+					require.Equal(t, tc.wantedEntries, entries)
+				}
+				_, err := io.WriteString(in.Stdout, tc.output)
+				require.NoError(t, err)
+				return nil
+			})
+
+			err := New(m, mockContainer, io.Discard).Upload(filepath.Join(dir, tc.inLocalPath), tc.inRemotePath)
+
+			if tc.wantedError != "" {
+				require.EqualError(t, err, tc.wantedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_extractArchive(t *testing.T) {
+	testCases := map[string]struct {
+		files       map[string]string
+		wantedError string
+	}{
+		"rejects entries outside of the root": {
+			files: map[string]string{
+				"logs/../../etc/passwd": "root",
+			},
+			wantedError: "unexpected entry logs/../../etc/passwd in archive",
+		},
+		"rejects entries of another root": {
+			files: map[string]string{
+				"other.log": "hello",
+			},
+			wantedError: "unexpected entry other.log in archive",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := extractArchive(bytes.NewReader(tarball(t, tc.files)), "logs", t.TempDir())
+
+			require.EqualError(t, err, tc.wantedError)
+		})
+	}
+}
+
+func Test_shellCommand(t *testing.T) {
+	cmd := shellCommand(downloadScript("/var/it's", "app.log"))
+
+	require.True(t, strings.HasPrefix(cmd, `/bin/sh -c 'eval "$(echo `))
+	encoded := strings.TrimSuffix(strings.TrimPrefix(cmd, `/bin/sh -c 'eval "$(echo `), ` | base64 -d)"'`)
+	script, err := base64.StdEncoding.DecodeString(encoded)
+	require.NoError(t, err)
+	require.Contains(t, string(script), `tar cf "$f" -C '/var/it'"'"'s' 'app.log'`)
+}
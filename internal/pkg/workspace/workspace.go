@@ -4,14 +4,20 @@
 // Package workspace contains functionality to manage a user's local workspace. This includes
 // creating an application directory, reading and writing a summary file to associate the workspace with the application,
 // and managing infrastructure-as-code files. The typical workspace will be structured like:
-//  .
-//  ├── copilot                        (application directory)
-//  │   ├── .workspace                 (workspace summary)
-//  │   └── my-service
-//  │   │   └── manifest.yml           (service manifest)
-//  │   ├── buildspec.yml              (buildspec for the pipeline's build stage)
-//  │   └── pipeline.yml               (pipeline manifest)
-//  └── my-service-src                 (customer service code)
+//
+//	.
+//	├── copilot                        (application directory)
+//	│   ├── .workspace                 (workspace summary)
+//	│   ├── environments
+//	│   │   └── test
+//	│   │       └── manifest.yml       (environment manifest)
+//	│   └── my-service
+//	│   │   └── manifest.yml           (service manifest)
+//	│   └── pipelines
+//	│       └── my-pipeline
+//	│           ├── buildspec.yml      (buildspec for the pipeline's build stage)
+//	│           └── manifest.yml       (pipeline manifest)
+//	└── my-service-src                 (customer service code)
 package workspace
 
 import (
@@ -19,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -35,10 +42,14 @@ const (
 	SummaryFileName = ".workspace"
 
 	addonsDirName             = "addons"
+	environmentsDirName       = "environments"
+	pipelinesDirName          = "pipelines"
 	maximumParentDirsToSearch = 5
-	pipelineFileName          = "pipeline.yml"
+	pipelineFileName          = "pipeline.yml" // Legacy manifest of the single pipeline of a workspace, under the copilot directory.
 	manifestFileName          = "manifest.yml"
 	buildspecFileName         = "buildspec.yml"
+	githubWorkflowsDir        = ".github/workflows" // Relative to the root of the repository, the parent of the copilot directory.
+	fmtGitHubWorkflowFileName = "copilot-%s.yml"
 
 	ymlFileExtension = ".yml"
 
@@ -207,21 +218,131 @@ func (ws *Workspace) readWorkloadManifest(name string) ([]byte, error) {
 	return ws.read(name, manifestFileName)
 }
 
-// ReadPipelineManifest returns the contents of the pipeline manifest under copilot/pipeline.yml.
-func (ws *Workspace) ReadPipelineManifest() ([]byte, error) {
-	pmPath, err := ws.pipelineManifestPath()
+// ReadEnvironmentManifest returns the contents of the environment's manifest under copilot/environments/{name}/manifest.yml.
+func (ws *Workspace) ReadEnvironmentManifest(name string) ([]byte, error) {
+	mf, err := ws.read(environmentsDirName, name, manifestFileName)
+	if err != nil {
+		return nil, fmt.Errorf("read environment %s manifest file: %w", name, err)
+	}
+	return mf, nil
+}
+
+// EnvNames returns the names of the environments that have a manifest in the workspace.
+func (ws *Workspace) EnvNames() ([]string, error) {
+	copilotPath, err := ws.CopilotDirPath()
 	if err != nil {
 		return nil, err
 	}
-	manifestExists, err := ws.fsUtils.Exists(pmPath)
+	envsPath := filepath.Join(copilotPath, environmentsDirName)
+	exists, err := ws.fsUtils.Exists(envsPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	files, err := ws.fsUtils.ReadDir(envsPath)
+	if err != nil {
+		return nil, fmt.Errorf("read directory %s: %w", envsPath, err)
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() {
+			continue
+		}
+		if exists, _ := ws.fsUtils.Exists(filepath.Join(envsPath, f.Name(), manifestFileName)); !exists {
+			continue
+		}
+		names = append(names, f.Name())
+	}
+	return names, nil
+}
 
+// PipelineManifest holds identifying information about a pipeline manifest in the workspace.
+type PipelineManifest struct {
+	Name string // Name of the pipeline.
+	Path string // Slash-separated path of the manifest relative to the workspace root, e.g. "copilot/pipelines/api/manifest.yml".
+}
+
+// BuildspecPath returns the slash-separated path relative to the workspace root of the buildspec
+// that runs in the pipeline's build stage. The buildspec is next to the pipeline manifest.
+func (m PipelineManifest) BuildspecPath() string {
+	return path.Join(path.Dir(m.Path), buildspecFileName)
+}
+
+// PipelineManifestPath returns the slash-separated path relative to the workspace root of the manifest
+// written by WritePipelineManifest for the pipeline name.
+func PipelineManifestPath(name string) string {
+	return path.Join(CopilotDirName, pipelinesDirName, name, manifestFileName)
+}
+
+// ListPipelines returns the pipeline manifests in the workspace: the legacy copilot/pipeline.yml manifest
+// followed by the manifests under copilot/pipelines/{name}/manifest.yml.
+// If there are no pipelines in the workspace, it returns ErrNoPipelineInWorkspace.
+func (ws *Workspace) ListPipelines() ([]PipelineManifest, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	var relPaths [][]string
+	if exists, _ := ws.fsUtils.Exists(filepath.Join(copilotPath, pipelineFileName)); exists {
+		relPaths = append(relPaths, []string{pipelineFileName})
+	}
+	pipelinesPath := filepath.Join(copilotPath, pipelinesDirName)
+	if exists, _ := ws.fsUtils.DirExists(pipelinesPath); exists {
+		files, err := ws.fsUtils.ReadDir(pipelinesPath)
+		if err != nil {
+			return nil, fmt.Errorf("read directory %s: %w", pipelinesPath, err)
+		}
+		for _, f := range files {
+			if !f.IsDir() {
+				continue
+			}
+			if exists, _ := ws.fsUtils.Exists(filepath.Join(pipelinesPath, f.Name(), manifestFileName)); !exists {
+				continue
+			}
+			relPaths = append(relPaths, []string{pipelinesDirName, f.Name(), manifestFileName})
+		}
+	}
+	if len(relPaths) == 0 {
+		return nil, ErrNoPipelineInWorkspace
+	}
+
+	pipelines := make([]PipelineManifest, 0, len(relPaths))
+	for _, elems := range relPaths {
+		data, err := ws.read(elems...)
+		if err != nil {
+			return nil, fmt.Errorf("read pipeline manifest %s: %w", filepath.Join(elems...), err)
+		}
+		var pipeline struct {
+			Name string `yaml:"name"`
+		}
+		if err := yaml.Unmarshal(data, &pipeline); err != nil {
+			return nil, fmt.Errorf("unmarshal pipeline manifest %s: %w", filepath.Join(elems...), err)
+		}
+		pipelines = append(pipelines, PipelineManifest{
+			Name: pipeline.Name,
+			Path: path.Join(append([]string{CopilotDirName}, elems...)...),
+		})
+	}
+	return pipelines, nil
+}
+
+// ReadPipelineManifest returns the contents of the pipeline manifest at the slash-separated path relative to the workspace root.
+func (ws *Workspace) ReadPipelineManifest(manifestPath string) ([]byte, error) {
+	copilotPath, err := ws.CopilotDirPath()
+	if err != nil {
+		return nil, err
+	}
+	fullPath := filepath.Join(filepath.Dir(copilotPath), filepath.FromSlash(manifestPath))
+	manifestExists, err := ws.fsUtils.Exists(fullPath)
 	if err != nil {
 		return nil, err
 	}
 	if !manifestExists {
 		return nil, ErrNoPipelineInWorkspace
 	}
-	return ws.read(pipelineFileName)
+	return ws.fsUtils.ReadFile(fullPath)
 }
 
 // WriteServiceManifest writes the service's manifest under the copilot/{name}/ directory.
@@ -242,24 +363,45 @@ func (ws *Workspace) WriteJobManifest(marshaler encoding.BinaryMarshaler, name s
 	return ws.write(data, name, manifestFileName)
 }
 
-// WritePipelineBuildspec writes the pipeline buildspec under the copilot/ directory.
+// WriteEnvironmentManifest writes the environment's manifest under the copilot/environments/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WriteEnvironmentManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal environment %s manifest to binary: %w", name, err)
+	}
+	return ws.write(data, environmentsDirName, name, manifestFileName)
+}
+
+// WritePipelineBuildspec writes the buildspec of the pipeline under the copilot/pipelines/{name}/ directory.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) WritePipelineBuildspec(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline buildspec to binary: %w", err)
 	}
-	return ws.write(data, buildspecFileName)
+	return ws.write(data, pipelinesDirName, name, buildspecFileName)
 }
 
-// WritePipelineManifest writes the pipeline manifest under the copilot directory.
+// WritePipelineManifest writes the manifest of the pipeline under the copilot/pipelines/{name}/ directory.
 // If successful returns the full path of the file, otherwise returns an empty string and the error.
-func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler) (string, error) {
+func (ws *Workspace) WritePipelineManifest(marshaler encoding.BinaryMarshaler, name string) (string, error) {
 	data, err := marshaler.MarshalBinary()
 	if err != nil {
 		return "", fmt.Errorf("marshal pipeline manifest to binary: %w", err)
 	}
-	return ws.write(data, pipelineFileName)
+	return ws.write(data, pipelinesDirName, name, manifestFileName)
+}
+
+// WriteGitHubActionsWorkflow writes the GitHub Actions workflow of the pipeline under the .github/workflows/ directory
+// at the root of the repository, next to the copilot directory.
+// If successful returns the full path of the file, otherwise returns an empty string and the error.
+func (ws *Workspace) WriteGitHubActionsWorkflow(marshaler encoding.BinaryMarshaler, name string) (string, error) {
+	data, err := marshaler.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal github actions workflow to binary: %w", err)
+	}
+	return ws.write(data, "..", githubWorkflowsDir, fmt.Sprintf(fmtGitHubWorkflowFileName, name))
 }
 
 // DeleteWorkspaceFile removes the .workspace file under copilot/ directory.
@@ -270,13 +412,45 @@ func (ws *Workspace) DeleteWorkspaceFile() error {
 
 // ReadAddonsDir returns a list of file names under a service's "addons/" directory.
 func (ws *Workspace) ReadAddonsDir(svcName string) ([]string, error) {
+	return ws.readAddonsDir(svcName, addonsDirName)
+}
+
+// ReadAddon returns the contents of a file under the service's "addons/" directory.
+func (ws *Workspace) ReadAddon(svc, fname string) ([]byte, error) {
+	return ws.read(svc, addonsDirName, fname)
+}
+
+// WriteAddon writes the content of an addon file under "{svc}/addons/{name}.yml".
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name string) (string, error) {
+	return ws.writeAddon(content, svc, addonsDirName, name+ymlFileExtension)
+}
+
+// ReadEnvAddonsDir returns a list of file names under the "environments/addons/" directory.
+// These addons are shared by the workloads of every environment.
+func (ws *Workspace) ReadEnvAddonsDir() ([]string, error) {
+	return ws.readAddonsDir(environmentsDirName, addonsDirName)
+}
+
+// ReadEnvAddon returns the contents of a file under the "environments/addons/" directory.
+func (ws *Workspace) ReadEnvAddon(fname string) ([]byte, error) {
+	return ws.read(environmentsDirName, addonsDirName, fname)
+}
+
+// WriteEnvAddon writes the content of an addon file under "environments/addons/{name}.yml".
+// If successful returns the full path of the file, otherwise an empty string and an error.
+func (ws *Workspace) WriteEnvAddon(content encoding.BinaryMarshaler, name string) (string, error) {
+	return ws.writeAddon(content, environmentsDirName, addonsDirName, name+ymlFileExtension)
+}
+
+func (ws *Workspace) readAddonsDir(elem ...string) ([]string, error) {
 	copilotPath, err := ws.CopilotDirPath()
 	if err != nil {
 		return nil, err
 	}
 
 	var names []string
-	files, err := ws.fsUtils.ReadDir(filepath.Join(copilotPath, svcName, addonsDirName))
+	files, err := ws.fsUtils.ReadDir(filepath.Join(append([]string{copilotPath}, elem...)...))
 	if err != nil {
 		return nil, err
 	}
@@ -286,20 +460,12 @@ func (ws *Workspace) ReadAddonsDir(svcName string) ([]string, error) {
 	return names, nil
 }
 
-// ReadAddon returns the contents of a file under the service's "addons/" directory.
-func (ws *Workspace) ReadAddon(svc, fname string) ([]byte, error) {
-	return ws.read(svc, addonsDirName, fname)
-}
-
-// WriteAddon writes the content of an addon file under "{svc}/addons/{name}.yml".
-// If successful returns the full path of the file, otherwise an empty string and an error.
-func (ws *Workspace) WriteAddon(content encoding.BinaryMarshaler, svc, name string) (string, error) {
+func (ws *Workspace) writeAddon(content encoding.BinaryMarshaler, elem ...string) (string, error) {
 	data, err := content.MarshalBinary()
 	if err != nil {
 		return "", fmt.Errorf("marshal binary addon content: %w", err)
 	}
-	fname := name + ymlFileExtension
-	return ws.write(data, svc, addonsDirName, fname)
+	return ws.write(data, elem...)
 }
 
 // FileStat wraps the os.Stat function.
@@ -331,15 +497,6 @@ func (ws *Workspace) writeSummary(appName string) error {
 	return ws.fsUtils.WriteFile(summaryPath, serializedWorkspaceSummary, 0644)
 }
 
-func (ws *Workspace) pipelineManifestPath() (string, error) {
-	copilotPath, err := ws.CopilotDirPath()
-	if err != nil {
-		return "", err
-	}
-	pipelineManifestPath := filepath.Join(copilotPath, pipelineFileName)
-	return pipelineManifestPath, nil
-}
-
 func (ws *Workspace) summaryPath() (string, error) {
 	copilotPath, err := ws.CopilotDirPath()
 	if err != nil {
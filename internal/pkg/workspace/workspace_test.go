@@ -721,6 +721,225 @@ func TestWorkspace_WriteAddon(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ReadEnvAddonsDir(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedFileNames []string
+		wantedErr       error
+	}{
+		"dir not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				return fs
+			},
+			wantedErr: &os.PathError{
+				Op:   "open",
+				Path: "/copilot/environments/addons",
+				Err:  os.ErrNotExist,
+			},
+		},
+		"retrieves file names": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/addons", 0755)
+				fs.Create("/copilot/environments/addons/orders.yml")
+				fs.Create("/copilot/environments/addons/jobs.yml")
+				return fs
+			},
+			wantedFileNames: []string{"jobs.yml", "orders.yml"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			// WHEN
+			actualFileNames, actualErr := ws.ReadEnvAddonsDir()
+
+			// THEN
+			require.Equal(t, tc.wantedErr, actualErr)
+			require.Equal(t, tc.wantedFileNames, actualFileNames)
+		})
+	}
+}
+
+func TestWorkspace_WriteEnvAddon(t *testing.T) {
+	// GIVEN
+	utils := &afero.Afero{
+		Fs: afero.NewMemMapFs(),
+	}
+	utils.MkdirAll(filepath.Join("/", "copilot"), 0755)
+	ws := &Workspace{
+		workingDir: "/",
+		copilotDir: "/copilot",
+		fsUtils:    utils,
+	}
+
+	// WHEN
+	path, err := ws.WriteEnvAddon(mockBinaryMarshaler{content: []byte("hello")}, "orders")
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, "/copilot/environments/addons/orders.yml", path)
+	out, err := ws.ReadEnvAddon("orders.yml")
+	require.NoError(t, err)
+	require.Equal(t, []byte("hello"), out)
+}
+
+func TestWorkspace_EnvNames(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedNames []string
+	}{
+		"returns nothing if there is no environments directory": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.Mkdir("/copilot", 0755)
+				return fs
+			},
+		},
+		"retrieve only directories with manifest files": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				fs.Create("/copilot/environments/test/manifest.yml")
+				fs.MkdirAll("/copilot/environments/prod", 0755)
+				fs.Create("/copilot/environments/prod/manifest.yml")
+				fs.Create("/copilot/environments/README.md")
+
+				// Missing manifest.yml.
+				fs.MkdirAll("/copilot/environments/staging", 0755)
+				return fs
+			},
+
+			wantedNames: []string{"test", "prod"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			names, err := ws.EnvNames()
+
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.wantedNames, names)
+		})
+	}
+}
+
+func TestWorkspace_ReadEnvironmentManifest(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedContent []byte
+		wantedErr     string
+	}{
+		"reads the manifest of the environment": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments/test", 0755)
+				afero.WriteFile(fs, "/copilot/environments/test/manifest.yml", []byte("name: test"), 0644)
+				return fs
+			},
+			wantedContent: []byte("name: test"),
+		},
+		"wraps error if the manifest does not exist": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/environments", 0755)
+				return fs
+			},
+			wantedErr: "read environment test manifest file: open /copilot/environments/test/manifest.yml: file does not exist",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils: &afero.Afero{
+					Fs: tc.fs(),
+				},
+			}
+
+			out, err := ws.ReadEnvironmentManifest("test")
+
+			if tc.wantedErr != "" {
+				require.EqualError(t, err, tc.wantedErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.wantedContent, out)
+			}
+		})
+	}
+}
+
+func TestWorkspace_WriteEnvironmentManifest(t *testing.T) {
+	testCases := map[string]struct {
+		marshaler mockBinaryMarshaler
+
+		wantedPath string
+		wantedErr  error
+	}{
+		"writes the environment manifest": {
+			marshaler: mockBinaryMarshaler{
+				content: []byte("name: test"),
+			},
+			wantedPath: "/copilot/environments/test/manifest.yml",
+		},
+		"wraps error if cannot marshal to binary": {
+			marshaler: mockBinaryMarshaler{
+				err: errors.New("some error"),
+			},
+			wantedErr: errors.New("marshal environment test manifest to binary: some error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// GIVEN
+			utils := &afero.Afero{
+				Fs: afero.NewMemMapFs(),
+			}
+			utils.MkdirAll("/copilot", 0755)
+			ws := &Workspace{
+				workingDir: "/",
+				copilotDir: "/copilot",
+				fsUtils:    utils,
+			}
+
+			// WHEN
+			actualPath, actualErr := ws.WriteEnvironmentManifest(tc.marshaler, "test")
+
+			// THEN
+			if tc.wantedErr != nil {
+				require.EqualError(t, actualErr, tc.wantedErr.Error())
+			} else {
+				require.Equal(t, tc.wantedPath, actualPath)
+				out, err := utils.ReadFile(tc.wantedPath)
+				require.NoError(t, err)
+				require.Equal(t, tc.marshaler.content, out)
+			}
+		})
+	}
+}
+
 func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	copilotDir := "/copilot"
 	testCases := map[string]struct {
@@ -759,7 +978,7 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 			}
 
 			// WHEN
-			_, err := ws.ReadPipelineManifest()
+			_, err := ws.ReadPipelineManifest("copilot/pipeline.yml")
 
 			// THEN
 			if tc.expectedError != nil {
@@ -771,6 +990,122 @@ func TestWorkspace_ReadPipelineManifest(t *testing.T) {
 	}
 }
 
+func TestWorkspace_ListPipelines(t *testing.T) {
+	testCases := map[string]struct {
+		fs func() afero.Fs
+
+		wantedPipelines []PipelineManifest
+		wantedErr       error
+	}{
+		"returns ErrNoPipelineInWorkspace if there are no pipelines": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/api", 0755)
+				return fs
+			},
+			wantedErr: ErrNoPipelineInWorkspace,
+		},
+		"lists the legacy pipeline first followed by the named pipelines": {
+			fs: func() afero.Fs {
+				fs := afero.NewMemMapFs()
+				fs.MkdirAll("/copilot/pipelines/api", 0755)
+				fs.MkdirAll("/copilot/pipelines/worker", 0755)
+				fs.MkdirAll("/copilot/pipelines/empty", 0755)
+				afero.WriteFile(fs, "/copilot/pipeline.yml", []byte("name: pipeline-app-repo"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/api/manifest.yml", []byte("name: api"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/worker/manifest.yml", []byte("name: worker-pipeline"), 0644)
+				afero.WriteFile(fs, "/copilot/pipelines/README.md", []byte("hello"), 0644)
+				return fs
+			},
+			wantedPipelines: []PipelineManifest{
+				{
+					Name: "pipeline-app-repo",
+					Path: "copilot/pipeline.yml",
+				},
+				{
+					Name: "api",
+					Path: "copilot/pipelines/api/manifest.yml",
+				},
+				{
+					Name: "worker-pipeline",
+					Path: "copilot/pipelines/worker/manifest.yml",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ws := &Workspace{
+				copilotDir: "/copilot",
+				fsUtils:    &afero.Afero{Fs: tc.fs()},
+			}
+
+			pipelines, err := ws.ListPipelines()
+
+			if tc.wantedErr != nil {
+				require.EqualError(t, err, tc.wantedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantedPipelines, pipelines)
+		})
+	}
+}
+
+func TestPipelineManifest_BuildspecPath(t *testing.T) {
+	require.Equal(t, "copilot/buildspec.yml", PipelineManifest{Path: "copilot/pipeline.yml"}.BuildspecPath())
+	require.Equal(t, "copilot/pipelines/api/buildspec.yml", PipelineManifest{Path: "copilot/pipelines/api/manifest.yml"}.BuildspecPath())
+}
+
+func TestWorkspace_WritePipelineManifest(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	utils := &afero.Afero{Fs: fs}
+	utils.MkdirAll("/copilot", 0755)
+	ws := &Workspace{
+		copilotDir: "/copilot",
+		fsUtils:    utils,
+	}
+
+	// WHEN
+	manifestPath, err := ws.WritePipelineManifest(mockBinaryMarshaler{content: []byte("name: api")}, "api")
+	require.NoError(t, err)
+	buildspecPath, err := ws.WritePipelineBuildspec(mockBinaryMarshaler{content: []byte("version: 0.2")}, "api")
+	require.NoError(t, err)
+
+	// THEN
+	require.Equal(t, "/copilot/pipelines/api/manifest.yml", manifestPath)
+	require.Equal(t, "/copilot/pipelines/api/buildspec.yml", buildspecPath)
+	out, err := utils.ReadFile(manifestPath)
+	require.NoError(t, err)
+	require.Equal(t, []byte("name: api"), out)
+}
+
+func TestWorkspace_WriteGitHubActionsWorkflow(t *testing.T) {
+	// GIVEN
+	fs := afero.NewMemMapFs()
+	utils := &afero.Afero{Fs: fs}
+	utils.MkdirAll("/repo/copilot", 0755)
+	ws := &Workspace{
+		copilotDir: "/repo/copilot",
+		fsUtils:    utils,
+	}
+
+	// WHEN
+	workflowPath, err := ws.WriteGitHubActionsWorkflow(mockBinaryMarshaler{content: []byte("name: api")}, "api")
+	require.NoError(t, err)
+	_, err = ws.WriteGitHubActionsWorkflow(mockBinaryMarshaler{content: []byte("name: api")}, "api")
+
+	// THEN
+	require.Equal(t, "/repo/.github/workflows/copilot-api.yml", workflowPath)
+	out, rerr := utils.ReadFile(workflowPath)
+	require.NoError(t, rerr)
+	require.Equal(t, []byte("name: api"), out)
+	var errExists *ErrFileExists
+	require.True(t, errors.As(err, &errExists))
+}
+
 func TestWorkspace_DeleteWorkspaceFile(t *testing.T) {
 	testCases := map[string]struct {
 		copilotDir string